@@ -76,8 +76,8 @@ func NewPocketCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clie
 	// The governance keeper
 	app.govKeeper = govKeeper.NewKeeper(
 		app.cdc,
-		app.Keys[pocketTypes.StoreKey],
-		app.Tkeys[pocketTypes.StoreKey],
+		app.Keys[govTypes.StoreKey],
+		app.Tkeys[govTypes.TStoreKey],
 		govTypes.DefaultCodespace,
 		app.accountKeeper,
 		authSubspace, nodesSubspace, appsSubspace, pocketSubspace,
@@ -87,6 +87,8 @@ func NewPocketCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clie
 	// give pocket keeper to nodes module for easy cache clearing
 	app.nodesKeeper.PocketKeeper = app.pocketKeeper
 	app.appsKeeper.PocketKeeper = app.pocketKeeper
+	// give nodes keeper to gov module for weighting proposal votes
+	app.govKeeper.PosKeeper = app.nodesKeeper
 	// setup module manager
 	app.mm = module.NewManager(
 		auth.NewAppModule(app.accountKeeper),
@@ -97,7 +99,7 @@ func NewPocketCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clie
 	)
 	// setup the order of begin and end blockers
 	app.mm.SetOrderBeginBlockers(nodesTypes.ModuleName, appsTypes.ModuleName, pocketTypes.ModuleName, govTypes.ModuleName)
-	app.mm.SetOrderEndBlockers(nodesTypes.ModuleName, appsTypes.ModuleName, pocketTypes.ModuleName, govTypes.ModuleName)
+	app.mm.SetOrderEndBlockers(nodesTypes.ModuleName, appsTypes.ModuleName, pocketTypes.ModuleName, govTypes.ModuleName, auth.ModuleName)
 	// setup the order of Genesis
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName,
@@ -114,7 +116,7 @@ func NewPocketCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clie
 	} else {
 		app.SetInitChainer(app.InitChainerWithGenesis)
 	}
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper))
+	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, GlobalConfig.PocketConfig.MinTip))
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	// initialize stores
@@ -35,6 +35,10 @@ func init() {
 	accountsCmd.AddCommand(exportRawCmd)
 	accountsCmd.AddCommand(sendTxCmd)
 	accountsCmd.AddCommand(sendRawTxCmd)
+	accountsCmd.AddCommand(grantSessionKeyCmd)
+	accountsCmd.AddCommand(revokeSessionKeyCmd)
+	accountsCmd.AddCommand(grantFeeAllowanceCmd)
+	accountsCmd.AddCommand(revokeFeeAllowanceCmd)
 	accountsCmd.AddCommand(newMultiPublicKey)
 	accountsCmd.AddCommand(signMS)
 	accountsCmd.AddCommand(signNexMS)
@@ -485,6 +489,91 @@ Prompts the user for <fromAddr> account passphrase.`,
 	},
 }
 
+var grantSessionKeyCmd = &cobra.Command{
+	Use:   "grant-session-key <fromAddr> <sessionPubKey> <msgTypes comma separated> <networkID> <fee> [<spendLimit>] [<expirationHeight>]",
+	Short: "Authorize a session key",
+	Long: `Authorizes <sessionPubKey> to sign the <msgTypes> (e.g. claim,proof) on behalf of <fromAddr>, replacing any previous
+grant to the same key. The fees and uPOKT sent by the session key are paid by <fromAddr> and capped by <spendLimit>
+(0 is uncapped). The session key can't sign from <expirationHeight> on (0 never expires).
+Prompts the user for <fromAddr> account passphrase.`,
+	Args: cobra.RangeArgs(5, 7),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		spendLimit := types.ZeroInt()
+		if len(args) > 5 {
+			limit, ok := types.NewIntFromString(args[5])
+			if !ok {
+				fmt.Println("invalid spend limit: " + args[5])
+				return
+			}
+			spendLimit = limit
+		}
+		var expirationHeight int64
+		if len(args) > 6 {
+			expirationHeight, err = strconv.ParseInt(args[6], 10, 64)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := GrantSessionKey(args[0], args[1], strings.Split(args[2], ","), spendLimit, expirationHeight, app.Credentials(pwd), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var revokeSessionKeyCmd = &cobra.Command{
+	Use:   "revoke-session-key <fromAddr> <sessionKeyAddr> <networkID> <fee>",
+	Short: "Revoke a session key",
+	Long: `Removes the session key with the address <sessionKeyAddr> granted by <fromAddr>.
+Prompts the user for <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := RevokeSessionKey(args[0], args[1], app.Credentials(pwd), args[2], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
 // sendRawTxCmd represents the sendTx command
 var sendRawTxCmd = &cobra.Command{
 	Use:   "send-raw-tx <fromAddr> <txBytes>",
@@ -645,3 +734,93 @@ NOTE: you MUST be the next signer (in order of public keys in the ms public key
 		fmt.Println("Multisig transaction: \n" + hex.EncodeToString(bz))
 	},
 }
+
+var grantFeeAllowanceCmd = &cobra.Command{
+	Use:   "grant-fee-allowance <fromAddr> <granteeAddr> <networkID> <fee> [<spendLimit>] [<expirationHeight>] [<msgTypes comma separated>]",
+	Short: "Pay the fees of another account",
+	Long: `Allows the transactions of <granteeAddr> carrying <fromAddr> as fee payer to deduct their fees from <fromAddr>,
+replacing any previous allowance to <granteeAddr>. The fees paid are capped by <spendLimit> (0 is uncapped).
+The allowance can't pay fees from <expirationHeight> on (0 never expires), and only pays for the <msgTypes>
+(e.g. claim,proof; every message type if omitted).
+Prompts the user for <fromAddr> account passphrase.`,
+	Args: cobra.RangeArgs(4, 7),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		spendLimit := types.ZeroInt()
+		if len(args) > 4 {
+			limit, ok := types.NewIntFromString(args[4])
+			if !ok {
+				fmt.Println("invalid spend limit: " + args[4])
+				return
+			}
+			spendLimit = limit
+		}
+		var expirationHeight int64
+		if len(args) > 5 {
+			expirationHeight, err = strconv.ParseInt(args[5], 10, 64)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		var msgTypes []string
+		if len(args) > 6 {
+			msgTypes = strings.Split(args[6], ",")
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := GrantFeeAllowance(args[0], args[1], spendLimit, expirationHeight, msgTypes, app.Credentials(pwd), args[2], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var revokeFeeAllowanceCmd = &cobra.Command{
+	Use:   "revoke-fee-allowance <fromAddr> <granteeAddr> <networkID> <fee>",
+	Short: "Stop paying the fees of another account",
+	Long: `Removes the fee allowance granted by <fromAddr> to <granteeAddr>.
+Prompts the user for <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := RevokeFeeAllowance(args[0], args[1], app.Credentials(pwd), args[2], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
@@ -20,6 +20,9 @@ func init() {
 	appCmd.AddCommand(appStakeCmd)
 	appCmd.AddCommand(appUnstakeCmd)
 	appCmd.AddCommand(createAATCmd)
+	appCmd.AddCommand(createScopedAATCmd)
+	appCmd.AddCommand(appRevokeClientCmd)
+	appCmd.AddCommand(appTransferCmd)
 }
 
 var appCmd = &cobra.Command{
@@ -33,6 +36,9 @@ func init() {
 	appStakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	appUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	createAATCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	createScopedAATCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appRevokeClientCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appTransferCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 }
 
 var appStakeCmd = &cobra.Command{
@@ -155,3 +161,130 @@ NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES
 		fmt.Println(string(aat))
 	},
 }
+
+var createScopedAATCmd = &cobra.Command{
+	Use:   "create-scoped-aat <appAddr> <clientPubKey> <expirationHeight> <maxRelaysPerSession> [allowedChainIDs]",
+	Short: "Creates a scoped application authentication token",
+	Long: `Creates a signed application authentication token (version 0.0.2 of the AAT spec), restricted to an expiration height,
+a maximum number of relays per session and an optional comma separated list of allowed relay chains. Use 0 to leave the expiration height
+or the maximum relays per session unrestricted.
+Will prompt the user for the <appAddr> account passphrase.
+Read the Application Authentication Token documentation for more information.
+NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES IN ORDER TO UNDERSTAND WHAT'S THE RECOMMENDED AAT CONFIGURATION FOR YOUR APPLICATION.`,
+	Args: cobra.RangeArgs(4, 5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		kb := app.MustGetKeybase()
+		if kb == nil {
+			fmt.Println(app.UninitializedKeybaseError)
+			return
+		}
+		addr, err := types.AddressFromHex(args[0])
+		if err != nil {
+			fmt.Printf("Address Error %s", err)
+			return
+		}
+		expirationHeight, err := strconv.ParseInt(args[2], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		maxRelays, err := strconv.ParseInt(args[3], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var chains []string
+		if len(args) == 5 {
+			reg, err := regexp.Compile("[^,a-fA-F0-9]+")
+			if err != nil {
+				log.Fatal(err)
+			}
+			chains = strings.Split(reg.ReplaceAllString(args[4], ""), ",")
+		}
+		kp, err := kb.Get(addr)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		cred := app.Credentials(pwd)
+		privkey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, cred)
+		if err != nil {
+			return
+		}
+		aat, err := app.GenerateScopedAAT(hex.EncodeToString(kp.PublicKey.RawBytes()), args[1], expirationHeight, maxRelays, chains, privkey)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(aat))
+	},
+}
+
+var appRevokeClientCmd = &cobra.Command{
+	Use:   "revoke-client <appAddr> <clientPubKey> <networkID> <fee>",
+	Short: "Revoke a client of an app",
+	Long: `Revoke a client public key of the app, invalidating every application authentication token issued to it.
+Prompts the user for the <appAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fee, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := RevokeClient(args[0], args[1], app.Credentials(pwd), args[2], int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var appTransferCmd = &cobra.Command{
+	Use:   "transfer <appAddr> <newAppPubKey> <networkID> <fee>",
+	Short: "Transfer an app to a new public key",
+	Long: `Transfer the staked tokens, chains and status of the app to <newAppPubKey> without unstaking.
+The new public key must not belong to an existing application. Sessions already dispatched for the app stay valid until they end.
+Prompts the user for the <appAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fee, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := TransferApplication(args[0], args[1], app.Credentials(pwd), args[2], int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/types"
 	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
 	"github.com/spf13/cobra"
@@ -17,9 +18,19 @@ func init() {
 	rootCmd.AddCommand(govCmd)
 	govCmd.AddCommand(govDAOTransfer)
 	govCmd.AddCommand(govDAOBurn)
+	govCmd.AddCommand(govCreateDAOStream)
+	govCmd.AddCommand(govCancelDAOStream)
 	govCmd.AddCommand(govChangeParam)
+	govCmd.AddCommand(govCancelParamChange)
+	govCmd.AddCommand(govCreateMultisigOwner)
+	govCmd.AddCommand(govApproveParamChange)
 	govCmd.AddCommand(govUpgrade)
 	govCmd.AddCommand(govFeatureEnable)
+	govCmd.AddCommand(govProposeParamChange)
+	govCmd.AddCommand(govProposeUpgrade)
+	govCmd.AddCommand(govProposeDAOTransfer)
+	govCmd.AddCommand(govVote)
+	govCmd.AddCommand(govSignalReadiness)
 }
 
 var govCmd = &cobra.Command{
@@ -34,6 +45,11 @@ func init() {
 	govDAOBurn.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	govChangeParam.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	govUpgrade.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govProposeParamChange.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govProposeUpgrade.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govProposeDAOTransfer.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govVote.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govSignalReadiness.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 }
 
 var govDAOTransfer = &cobra.Command{
@@ -120,22 +136,249 @@ Actions: [burn, transfer]`,
 		fmt.Println(resp)
 	},
 }
+var govCreateDAOStream = &cobra.Command{
+	Use:   "create_stream <fromAddr> <toAddr> <total> <startHeight> <endHeight> <networkID> <fees> [<cliffHeight>]",
+	Short: "Stream funds from the DAO",
+	Long: `If authorized, pay <total> from the DAO to <toAddr>, released linearly every block from <startHeight> to <endHeight>.
+If a <cliffHeight> is provided, nothing is released before it and the amount vested until then is released at once.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.RangeArgs(7, 8),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		total, ok := types.NewIntFromString(args[2])
+		if !ok {
+			fmt.Println("invalid total: " + args[2])
+			return
+		}
+		startHeight, err := strconv.ParseInt(args[3], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		endHeight, err := strconv.ParseInt(args[4], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[6])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var cliffHeight int64
+		if len(args) == 8 {
+			cliffHeight, err = strconv.ParseInt(args[7], 10, 64)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		fmt.Println("Enter Password: ")
+		res, err := CreateDAOStream(args[0], args[1], total, startHeight, endHeight, cliffHeight, app.Credentials(pwd), args[5], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govCancelDAOStream = &cobra.Command{
+	Use:   "cancel_stream <fromAddr> <streamID> <networkID> <fees>",
+	Short: "Cancel a DAO stream",
+	Long: `If authorized, cancel the DAO stream with <streamID>.
+The amount vested so far is released to the recipient and the unvested remainder stays in the DAO.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		streamID, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := CancelDAOStream(args[0], streamID, app.Credentials(pwd), args[2], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
 var govChangeParam = &cobra.Command{
-	Use:   "change_param <fromAddr> <networkID> <paramKey module/param> <paramValue (jsonObj)> <fees>",
+	Use:   "change_param <fromAddr> <networkID> <paramKey module/param> <paramValue (jsonObj)> <fees> [<activationHeight>]",
 	Short: "Edit a param in the network",
 	Long: `If authorized, submit a tx to change any param from any module.
+If an <activationHeight> is provided, the change is scheduled and applied at that height instead.
 Will prompt the user for the <fromAddr> account passphrase.`,
-	Args: cobra.ExactArgs(5),
+	Args: cobra.RangeArgs(5, 6),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var activationHeight int
+		if len(args) == 6 {
+			activationHeight, err = strconv.Atoi(args[5])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
 		fmt.Println("Enter Password: ")
+		res, err := ChangeParam(args[0], args[2], []byte(args[3]), app.Credentials(pwd), args[1], int64(fees), int64(activationHeight), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govCancelParamChange = &cobra.Command{
+	Use:   "cancel_param_change <fromAddr> <paramKey module/param> <activationHeight> <networkID> <fees>",
+	Short: "Cancel a scheduled param change",
+	Long: `If authorized, cancel a param change waiting for its <activationHeight>.
+Only the account that scheduled the change may cancel it.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		activationHeight, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
 		fees, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
+		fmt.Println("Enter Password: ")
+		res, err := CancelParamChange(args[0], args[1], int64(activationHeight), app.Credentials(pwd), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
 
-		res, err := ChangeParam(args[0], args[2], []byte(args[3]), app.Credentials(pwd), args[1], int64(fees), false)
+var govCreateMultisigOwner = &cobra.Command{
+	Use:   "create_multisig_owner <fromAddr> <threshold> <expiryBlocks> <members (comma separated)> <networkID> <fees>",
+	Short: "Register an M-of-N acl owner",
+	Long: `Registers an acl owner that changes params once <threshold> of its <members> approve.
+Param changes that do not reach the threshold within <expiryBlocks> are discarded.
+The DAO owner may then assign params to the address of the multisig owner in the ACL.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		threshold, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		expiryBlocks, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[5])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := CreateMultisigOwner(args[0], strings.Split(args[3], ","), int64(threshold), int64(expiryBlocks), app.Credentials(pwd), args[4], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govApproveParamChange = &cobra.Command{
+	Use:   "approve_param_change <fromAddr> <approvalID> <networkID> <fees>",
+	Short: "Approve a param change of a multisig owner",
+	Long: `If a member of the multisig owner, approve the outstanding param change with <approvalID>.
+The change is applied, or scheduled if it has an activation height, once the threshold is reached.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		approvalID, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := ApproveParamChange(args[0], approvalID, app.Credentials(pwd), args[2], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -217,6 +460,10 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		height := args[1]
 		key := args[2]
+		if _, ok := codec.GetFeature(key); !ok {
+			fmt.Printf("unknown feature key %s, see pocket query features\n", key)
+			return
+		}
 		fstring := fmt.Sprintf("%s:%s", key, height)
 
 		u := govTypes.Upgrade{
@@ -249,3 +496,245 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 		fmt.Println(resp)
 	},
 }
+
+var govProposeParamChange = &cobra.Command{
+	Use:   "propose_param_change <fromAddr> <paramKey module/param> <paramValue (jsonObj)> <deposit> <networkID> <fees>",
+	Short: "Propose a param change to the validators",
+	Long: `Submit a proposal to change any param from any module, escrowing the <deposit> in the DAO.
+If the staked validators vote it through before the voting period ends, the param is changed.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		deposit, ok := types.NewIntFromString(args[3])
+		if !ok {
+			fmt.Println("invalid deposit amount: " + args[3])
+			return
+		}
+		fees, err := strconv.Atoi(args[5])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		valueBytes, err := app.Codec().MarshalJSON(json.RawMessage(args[2]))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		content := govTypes.ProposalContent{
+			Type:     govTypes.ParamChangeProposalType,
+			ParamKey: args[1],
+			ParamVal: valueBytes,
+		}
+		fmt.Println("Enter Password: ")
+		res, err := SubmitProposal(args[0], content, deposit, app.Credentials(pwd), args[4], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govProposeUpgrade = &cobra.Command{
+	Use:   "propose_upgrade <fromAddr> <atHeight> <version> <deposit> <networkID> <fees>",
+	Short: "Propose a protocol upgrade to the validators",
+	Long: `Submit a proposal to upgrade the protocol, escrowing the <deposit> in the DAO.
+If the staked validators vote it through before the voting period ends, the upgrade is set.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		i, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		deposit, ok := types.NewIntFromString(args[3])
+		if !ok {
+			fmt.Println("invalid deposit amount: " + args[3])
+			return
+		}
+		fees, err := strconv.Atoi(args[5])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		content := govTypes.ProposalContent{
+			Type: govTypes.UpgradeProposalType,
+			Upgrade: govTypes.Upgrade{
+				Height:  int64(i),
+				Version: dropTag(args[2]),
+			},
+		}
+		fmt.Println("Enter Password: ")
+		res, err := SubmitProposal(args[0], content, deposit, app.Credentials(pwd), args[4], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govProposeDAOTransfer = &cobra.Command{
+	Use:   "propose_dao_transfer <fromAddr> <action> <amount> <toAddr> <deposit> <networkID> <fees>",
+	Short: "Propose a DAO transfer to the validators",
+	Long: `Submit a proposal to move funds from the DAO, escrowing the <deposit> in the DAO.
+If the staked validators vote it through before the voting period ends, the action is performed.
+Actions: [dao_transfer, dao_burn], the <toAddr> is ignored for a burn.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(7),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, ok := types.NewIntFromString(args[2])
+		if !ok {
+			fmt.Println("invalid amount: " + args[2])
+			return
+		}
+		var toAddr types.Address
+		if args[1] == govTypes.DAOTransferString {
+			var err error
+			toAddr, err = types.AddressFromHex(args[3])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		deposit, ok := types.NewIntFromString(args[4])
+		if !ok {
+			fmt.Println("invalid deposit amount: " + args[4])
+			return
+		}
+		fees, err := strconv.Atoi(args[6])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		content := govTypes.ProposalContent{
+			Type:      govTypes.DAOTransferProposalType,
+			DaoAction: args[1],
+			Amount:    amount,
+			ToAddress: toAddr,
+		}
+		fmt.Println("Enter Password: ")
+		res, err := SubmitProposal(args[0], content, deposit, app.Credentials(pwd), args[5], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govVote = &cobra.Command{
+	Use:   "vote <validatorAddr> <signerAddr> <proposalID> <option> <networkID> <fees>",
+	Short: "Vote on a proposal",
+	Long: `Cast the vote of a staked validator on a proposal in its voting period, replacing any previous vote.
+The <signerAddr> must be the validator or its output address. Votes are weighted by the validator stake.
+Options: [yes, no, abstain]
+Will prompt the user for the <signerAddr> account passphrase.`,
+	Args: cobra.ExactArgs(6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		proposalID, err := strconv.ParseUint(args[2], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[5])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := Vote(args[0], args[1], proposalID, args[3], app.Credentials(pwd), args[4], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govSignalReadiness = &cobra.Command{
+	Use:   "signal_readiness <validatorAddr> <signerAddr> <networkID> <fees> [<versions comma separated>] [<features comma separated>]",
+	Short: "Signal upgrade readiness",
+	Long: `Signal the versions and feature keys supported by the binary of a staked validator, replacing any previous signal.
+The <signerAddr> must be the validator or its output address. <versions> defaults to the version of this binary.
+Will prompt the user for the <signerAddr> account passphrase.`,
+	Args: cobra.RangeArgs(4, 6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		versions := []string{app.AppVersion}
+		if len(args) > 4 && args[4] != "" {
+			versions = strings.Split(args[4], ",")
+		}
+		var features []string
+		if len(args) > 5 && args[5] != "" {
+			features = strings.Split(args[5], ",")
+		}
+		fmt.Println("Enter Password: ")
+		res, err := SignalReadiness(args[0], args[1], versions, features, app.Credentials(pwd), args[2], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
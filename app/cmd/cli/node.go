@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/types"
 	"github.com/spf13/cobra"
 	"strconv"
 )
@@ -12,6 +13,7 @@ func init() {
 	rootCmd.AddCommand(nodesCmd)
 	nodesCmd.AddCommand(nodeUnstakeCmd)
 	nodesCmd.AddCommand(nodeUnjailCmd)
+	nodesCmd.AddCommand(nodePartialUnstakeCmd)
 }
 
 var nodesCmd = &cobra.Command{
@@ -24,6 +26,7 @@ from staking and unstaking; to unjailing.`,
 func init() {
 	nodeUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	nodeUnjailCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	nodePartialUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 }
 
 var nodeUnstakeCmd = &cobra.Command{
@@ -101,3 +104,42 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 		fmt.Println(resp)
 	},
 }
+
+var nodePartialUnstakeCmd = &cobra.Command{
+	Use:   "partial-unstake <operatorAddr> <fromAddr> <amount> <networkID> <fee>",
+	Short: "Unstake part of a node's stake",
+	Long: `Moves <amount> of a node's stake into the unstaking queue while the node stays staked and keeps serving.
+The remaining stake must stay above the minimum stake. The amount is returned to the output address after the unstaking time.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, ok := types.NewIntFromString(args[2])
+		if !ok {
+			fmt.Println("invalid amount: " + args[2])
+			return
+		}
+		fee, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := PartialUnstakeNode(args[0], args[1], app.Credentials(pwd), args[3], amount, int64(fee))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
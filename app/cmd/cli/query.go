@@ -25,9 +25,12 @@ func init() {
 	queryCmd.AddCommand(queryNodes)
 	queryCmd.AddCommand(queryBalance)
 	queryCmd.AddCommand(queryAccount)
+	queryCmd.AddCommand(querySessionKeys)
+	queryCmd.AddCommand(queryFeeAllowances)
 	queryCmd.AddCommand(queryNode)
 	queryCmd.AddCommand(queryApps)
 	queryCmd.AddCommand(queryApp)
+	queryCmd.AddCommand(queryAppRelays)
 	queryCmd.AddCommand(queryNodeParams)
 	queryCmd.AddCommand(queryAppParams)
 	queryCmd.AddCommand(queryNodeClaims)
@@ -37,6 +40,16 @@ func init() {
 	queryCmd.AddCommand(querySupply)
 	queryCmd.AddCommand(queryUpgrade)
 	queryCmd.AddCommand(queryACL)
+	queryCmd.AddCommand(queryProposals)
+	queryCmd.AddCommand(queryProposal)
+	queryCmd.AddCommand(queryTally)
+	queryCmd.AddCommand(queryPendingParams)
+	queryCmd.AddCommand(queryMultisigOwners)
+	queryCmd.AddCommand(queryParamApprovals)
+	queryCmd.AddCommand(queryDAOStreams)
+	queryCmd.AddCommand(queryUpgradeReadiness)
+	queryCmd.AddCommand(queryFeatures)
+	queryCmd.AddCommand(queryFeeMarket)
 	queryCmd.AddCommand(queryAllParams)
 	queryCmd.AddCommand(queryParam)
 	queryCmd.AddCommand(queryDAOOwner)
@@ -333,6 +346,78 @@ var queryAccount = &cobra.Command{
 	},
 }
 
+var querySessionKeys = &cobra.Command{
+	Use:   "session-keys <address> [<height>]",
+	Short: "Gets the session keys of an account",
+	Long:  `Retrieves the session keys granted by the account at <address>, with their message types, spend limit and expiration.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetSessionKeysPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryFeeAllowances = &cobra.Command{
+	Use:   "fee-allowances <address> [<height>]",
+	Short: "Gets the fee allowances of an account",
+	Long:  `Retrieves the fee allowances granted by the account at <address>, with their grantee, spend limit, expiration and message types.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetFeeAllowancesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var nodeStakingStatus string
 var nodeJailedStatus string
 var blockchain string
@@ -576,6 +661,42 @@ var queryApp = &cobra.Command{
 	},
 }
 
+var queryAppRelays = &cobra.Command{
+	Use:   "app-relays <address> [<height>]",
+	Short: "Gets the relay allowance of an app",
+	Long:  `Retrieves the max relays of the app at the specified <height>, along with the stake and claimed relays they are computed from.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetAppRelaysPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryAppParams = &cobra.Command{
 	Use:   "app-params [<height>]",
 	Short: "Gets app parameters",
@@ -965,6 +1086,362 @@ var queryUpgrade = &cobra.Command{
 	},
 }
 
+var queryProposals = &cobra.Command{
+	Use:   "proposals [<height>]",
+	Short: "Gets the gov proposals",
+	Long:  `Retrieves every governance proposal at the specified <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetProposalsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryPendingParams = &cobra.Command{
+	Use:   "pending-params [<height>]",
+	Short: "Gets the scheduled param changes",
+	Long:  `Retrieves every param change waiting for its activation height at the specified <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetPendingParamsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryMultisigOwners = &cobra.Command{
+	Use:   "multisig-owners [<height>]",
+	Short: "Gets the multisig acl owners",
+	Long:  `Retrieves every registered M-of-N acl owner at the specified <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetMultisigOwnersPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryParamApprovals = &cobra.Command{
+	Use:   "param-approvals [<height>]",
+	Short: "Gets the outstanding param approvals",
+	Long:  `Retrieves every param change of a multisig acl owner still waiting for approvals at the specified <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetParamApprovalsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryDAOStreams = &cobra.Command{
+	Use:   "dao-streams [<height>]",
+	Short: "Gets the DAO streams",
+	Long:  `Retrieves every DAO stream that is not complete or cancelled at the specified <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetDAOStreamsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryUpgradeReadiness = &cobra.Command{
+	Use:   "upgrade-readiness [<height>]",
+	Short: "Gets the upgrade readiness",
+	Long: `Retrieves the stake weighted readiness of the validators for the version and features of the pending upgrade
+at the specified <height>, as signalled with gov signal_readiness.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetUpgradeReadinessPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryFeatures = &cobra.Command{
+	Use:   "features [<height>]",
+	Short: "Gets the protocol features",
+	Long: `Retrieves every registered protocol feature, and any unregistered key enabled by an upgrade,
+with its activation height and status (inactive, scheduled or active) at the specified <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetFeaturesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryFeeMarket = &cobra.Command{
+	Use:   "fee-market [<height>]",
+	Short: "Gets the fee market",
+	Long: `Retrieves the fee market params and the base fee at the specified <height>. When the fee market is enabled
+the minimum fee of a message is its fee multiplier fee scaled by base_fee / 10000.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetFeeMarketPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryProposal = &cobra.Command{
+	Use:   "proposal <proposalID> [<height>]",
+	Short: "Gets a gov proposal",
+	Long:  `Retrieves the governance proposal with <proposalID> at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		proposalID, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndProposalParams{
+			Height:     int64(height),
+			ProposalID: proposalID,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetProposalPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryTally = &cobra.Command{
+	Use:   "tally <proposalID> [<height>]",
+	Short: "Gets the tally of a gov proposal",
+	Long: `Retrieves the vote tally of the proposal with <proposalID> at the specified <height>.
+The final tally is returned once the voting period has ended.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		proposalID, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndProposalParams{
+			Height:     int64(height),
+			ProposalID: proposalID,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetTallyPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var querySigningInfo = &cobra.Command{
 	Use:   "signing-info <address> [<height>]",
 	Short: "Gets validator signing info",
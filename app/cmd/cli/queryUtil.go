@@ -34,7 +34,20 @@ var (
 	GetSigningInfoPath,
 	GetAppsPath,
 	GetAppParamsPath,
+	GetAppRelaysPath,
 	GetPocketParamsPath,
+	GetProposalPath,
+	GetProposalsPath,
+	GetTallyPath,
+	GetPendingParamsPath,
+	GetMultisigOwnersPath,
+	GetParamApprovalsPath,
+	GetDAOStreamsPath,
+	GetUpgradeReadinessPath,
+	GetFeaturesPath,
+	GetFeeMarketPath,
+	GetSessionKeysPath,
+	GetFeeAllowancesPath,
 	GetNodeClaimsPath,
 	GetNodeClaimPath,
 	GetBlockTxsPath,
@@ -85,8 +98,34 @@ func init() {
 			GetAppsPath = route.Path
 		case "QueryAppParams":
 			GetAppParamsPath = route.Path
+		case "QueryAppRelays":
+			GetAppRelaysPath = route.Path
 		case "QueryPocketParams":
 			GetPocketParamsPath = route.Path
+		case "QueryProposal":
+			GetProposalPath = route.Path
+		case "QueryProposals":
+			GetProposalsPath = route.Path
+		case "QueryTally":
+			GetTallyPath = route.Path
+		case "QueryPendingParams":
+			GetPendingParamsPath = route.Path
+		case "QueryMultisigOwners":
+			GetMultisigOwnersPath = route.Path
+		case "QueryParamApprovals":
+			GetParamApprovalsPath = route.Path
+		case "QueryDAOStreams":
+			GetDAOStreamsPath = route.Path
+		case "QueryUpgradeReadiness":
+			GetUpgradeReadinessPath = route.Path
+		case "QueryFeatures":
+			GetFeaturesPath = route.Path
+		case "QueryFeeMarket":
+			GetFeeMarketPath = route.Path
+		case "QuerySessionKeys":
+			GetSessionKeysPath = route.Path
+		case "QueryFeeAllowances":
+			GetFeeAllowancesPath = route.Path
 		case "QueryBlockTxs":
 			GetBlockTxsPath = route.Path
 		case "QuerySupply":
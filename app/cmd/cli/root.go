@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -26,6 +27,7 @@ var (
 	testnet         bool
 	profileApp      bool
 	useCache        bool
+	supervise       bool
 )
 
 var CLIVersion = app.AppVersion
@@ -58,6 +60,7 @@ func init() {
 	startCmd.Flags().BoolVar(&testnet, "testnet", false, "run with testnet genesis")
 	startCmd.Flags().BoolVar(&profileApp, "profileApp", false, "expose cpu & memory profiling")
 	startCmd.Flags().BoolVar(&useCache, "useCache", false, "use cache")
+	startCmd.Flags().BoolVar(&supervise, "supervise", false, "run the node under a supervisor that switches to <datadir>/upgrades/<version>/bin/pocket at the upgrade height")
 	rootCmd.AddCommand(startCmd)
 	rootCmd.AddCommand(resetCmd)
 	rootCmd.AddCommand(version)
@@ -66,10 +69,16 @@ func init() {
 
 // startCmd represents the start command
 var startCmd = &cobra.Command{
-	Use:   "start [--keybase=(true | false)]",
+	Use:   "start [--keybase=(true | false)] [--supervise]",
 	Short: "starts pocket-core daemon",
-	Long:  `Starts the Pocket node, picks up the config from the assigned <datadir>`,
+	Long: `Starts the Pocket node, picks up the config from the assigned <datadir>.
+With --supervise, the node halts at the height of an upgrade to a newer version and is restarted
+with the binary found at <datadir>/upgrades/<version>/bin/pocket.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if supervise {
+			startSupervisor()
+			return
+		}
 		t := time.Unix(1625263200, 0) // Friday, July 2, 2021 6:00:00 PM GMT-04:00
 		sleepDuration := time.Until(t)
 		if time.Now().Before(t) {
@@ -117,6 +126,31 @@ func start(cmd *cobra.Command, args []string) {
 	}()
 }
 
+func startSupervisor() {
+	app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+	s, err := app.NewSupervisor(app.GlobalConfig.PocketConfig.DataDir, supervisedArgs(os.Args[1:]))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if err := s.Run(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// supervisedArgs - Returns the arguments for the supervised node, without the supervise flag
+func supervisedArgs(args []string) []string {
+	res := make([]string, 0, len(args))
+	for _, arg := range args {
+		if strings.TrimLeft(arg, "-") == "supervise" || strings.HasPrefix(strings.TrimLeft(arg, "-"), "supervise=") {
+			continue
+		}
+		res = append(res, arg)
+	}
+	return res
+}
+
 // resetCmd represents the reset command
 var resetCmd = &cobra.Command{
 	Use:   "reset",
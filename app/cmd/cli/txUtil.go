@@ -14,6 +14,7 @@ import (
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/tendermint/tendermint/libs/rand"
+	"strings"
 
 	//"github.com/pokt-network/pocket-core/crypto/keys/mintkey"
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -248,6 +249,39 @@ func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 	}, nil
 }
 
+// PartialUnstakeNode - start unstaking part of a node's stake
+func PartialUnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	oa, err := sdk.AddressFromHex(operatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	msg := &nodeTypes.MsgPartialUnstake{
+		Address: oa,
+		Amount:  amount,
+		Signer:  fa,
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 // UnjailNode - Remove node from jail
 func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
@@ -355,6 +389,60 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	}, nil
 }
 
+func RevokeClient(fromAddr, clientPubKey, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := appsType.MsgRevokeClient{
+		AppAddr:      fa,
+		ClientPubKey: clientPubKey,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func TransferApplication(fromAddr, newPubKey, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := appsType.MsgTransferApplication{
+		AppAddr:   fa,
+		NewPubKey: newPubKey,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -388,7 +476,69 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	}, nil
 }
 
-func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func CreateDAOStream(fromAddr, toAddr string, total sdk.BigInt, startHeight, endHeight, cliffHeight int64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	ta, err := sdk.AddressFromHex(toAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgCreateDAOStream{
+		FromAddress: fa,
+		ToAddress:   ta,
+		Total:       total,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		CliffHeight: cliffHeight,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func CancelDAOStream(fromAddr string, streamID uint64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgCancelDAOStream{
+		FromAddress: fa,
+		StreamID:    streamID,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passphrase, chainID string, fees, activationHeight int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -404,9 +554,102 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passp
 
 	}
 	msg := govTypes.MsgChangeParam{
+		FromAddress:      fa,
+		ParamKey:         paramACLKey,
+		ParamVal:         valueBytes,
+		ActivationHeight: activationHeight,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func CancelParamChange(fromAddr, paramACLKey string, activationHeight int64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgCancelParamChange{
+		FromAddress:      fa,
+		ParamKey:         paramACLKey,
+		ActivationHeight: activationHeight,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func CreateMultisigOwner(fromAddr string, members []string, threshold, expiryBlocks int64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	memberAddrs := make([]sdk.Address, 0, len(members))
+	for _, m := range members {
+		addr, err := sdk.AddressFromHex(strings.TrimSpace(m))
+		if err != nil {
+			return nil, err
+		}
+		memberAddrs = append(memberAddrs, addr)
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgCreateMultisigOwner{
+		FromAddress:  fa,
+		Members:      memberAddrs,
+		Threshold:    threshold,
+		ExpiryBlocks: expiryBlocks,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func ApproveParamChange(fromAddr string, approvalID uint64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgApproveParamChange{
 		FromAddress: fa,
-		ParamKey:    paramACLKey,
-		ParamVal:    valueBytes,
+		ApprovalID:  approvalID,
 	}
 	err = msg.ValidateBasic()
 	if err != nil {
@@ -449,6 +692,230 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	}, nil
 }
 
+func SubmitProposal(fromAddr string, content govTypes.ProposalContent, deposit sdk.BigInt, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgSubmitProposal{
+		Proposer: fa,
+		Content:  content,
+		Deposit:  deposit,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func Vote(validatorAddr, signerAddr string, proposalID uint64, option, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	va, err := sdk.AddressFromHex(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	sa, err := sdk.AddressFromHex(signerAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgVote{
+		ProposalID:       proposalID,
+		ValidatorAddress: va,
+		Signer:           sa,
+		Option:           option,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, sa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        signerAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func SignalReadiness(validatorAddr, signerAddr string, versions, features []string, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	va, err := sdk.AddressFromHex(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	sa, err := sdk.AddressFromHex(signerAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgSignalReadiness{
+		ValidatorAddress: va,
+		Signer:           sa,
+		Versions:         versions,
+		Features:         features,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, sa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        signerAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// GrantSessionKey - Deliver a grant session key message to authorize a secondary public key for some message types
+func GrantSessionKey(fromAddr, publicKey string, msgTypes []string, spendLimit sdk.BigInt, expirationHeight int64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgGrantSessionKey{
+		Granter:          fa,
+		PublicKey:        publicKey,
+		MsgTypes:         msgTypes,
+		SpendLimit:       spendLimit,
+		ExpirationHeight: expirationHeight,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// RevokeSessionKey - Deliver a revoke session key message to remove a secondary public key
+func RevokeSessionKey(fromAddr, sessionKeyAddr, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	sa, err := sdk.AddressFromHex(sessionKeyAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgRevokeSessionKey{
+		Granter: fa,
+		Address: sa,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// GrantFeeAllowance - Deliver a grant fee allowance message to pay the fees of another account
+func GrantFeeAllowance(fromAddr, granteeAddr string, spendLimit sdk.BigInt, expirationHeight int64, msgTypes []string, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	ga, err := sdk.AddressFromHex(granteeAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgGrantFeeAllowance{
+		Granter:          fa,
+		Grantee:          ga,
+		SpendLimit:       spendLimit,
+		ExpirationHeight: expirationHeight,
+		MsgTypes:         msgTypes,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// RevokeFeeAllowance - Deliver a revoke fee allowance message to stop paying the fees of another account
+func RevokeFeeAllowance(fromAddr, granteeAddr, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	ga, err := sdk.AddressFromHex(granteeAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgRevokeFeeAllowance{
+		Granter: fa,
+		Grantee: ga,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, legacyCodec bool) (transactionBz []byte, err error) {
 	// fees
 	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
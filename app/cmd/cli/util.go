@@ -1,15 +1,24 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/store/badgerdb"
+	"github.com/pokt-network/pocket-core/store/export"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	"github.com/pokt-network/pocket-core/store/snapshots"
+	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/state"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func init() {
@@ -22,6 +31,21 @@ func init() {
 	utilCmd.AddCommand(completionCmd)
 	utilCmd.AddCommand(updateConfigsCmd)
 	utilCmd.AddCommand(printDefaultConfigCmd)
+	utilCmd.AddCommand(pruneCmd)
+	pruneCmd.Flags().Int64Var(&pruneToHeight, "to-height", 0, "the height below which the application state is deleted")
+	utilCmd.AddCommand(createSnapshotCmd)
+	utilCmd.AddCommand(listSnapshotsCmd)
+	utilCmd.AddCommand(restoreSnapshotCmd)
+	utilCmd.AddCommand(exportStateCmd)
+	utilCmd.AddCommand(importStateCmd)
+	utilCmd.AddCommand(migrateDBCmd)
+	migrateDBCmd.Flags().StringVar(&migrateFrom, "from", sdk.GoLevelDBBackend, "the backend the databases are copied from")
+	migrateDBCmd.Flags().StringVar(&migrateTo, "to", sdk.BadgerDBBackend, "the backend the databases are copied to")
+	utilCmd.AddCommand(stateDiffCmd)
+	stateDiffCmd.Flags().StringVar(&stateDiffModule, "store", "", "the module whose stores are compared: nodes, apps, pocketcore, auth or gov, every store when empty")
+	utilCmd.AddCommand(checkDBCmd)
+	checkDBCmd.Flags().Int64Var(&checkDBHeight, "height", 0, "the height checked, the latest height when 0")
+	checkDBCmd.Flags().BoolVar(&checkDBRepair, "repair", false, "roll back every store to the newest consistent height")
 }
 
 var utilCmd = &cobra.Command{
@@ -213,3 +237,389 @@ $ pocket util completion fish > ~/.config/fish/completions/pocket.fish
 		}
 	},
 }
+
+var pruneToHeight int64
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune --to-height <height>",
+	Short: "Deletes the application state below a height",
+	Long: `Deletes the application state below the given height and compacts the application database to reclaim disk space.
+The node must be stopped. The heights still needed to validate claims and proofs are never pruned.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		if pruneToHeight <= 0 {
+			fmt.Println("the --to-height flag must be a positive height")
+			return
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false, app.GlobalConfig.PocketConfig.IavlCacheSize)
+		if err := a.PruneState(pruneToHeight); err != nil {
+			fmt.Println("could not prune the application state: ", err.Error())
+			return
+		}
+		var compactErr error
+		switch cdb := db.(type) {
+		case *dbm.GoLevelDB:
+			compactErr = cdb.Compact(util.Range{})
+		case *badgerdb.DB:
+			compactErr = cdb.Compact()
+		}
+		if compactErr != nil {
+			fmt.Println("could not compact the application database: ", compactErr.Error())
+			return
+		}
+		fmt.Printf("Successfully pruned the application state below height %d.\n", pruneToHeight)
+	},
+}
+
+var createSnapshotCmd = &cobra.Command{
+	Use:   "create-snapshot <height>",
+	Short: "Writes a snapshot of the state at a height",
+	Long: `Writes a snapshot of the application state, the blocks and the validators at the given height to the snapshots directory of the datadir.
+The node must be stopped. The snapshot holds the heights still needed to validate claims and proofs at the given height.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		height, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			fmt.Println("error parsing height: ", err)
+			return
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false, app.GlobalConfig.PocketConfig.IavlCacheSize)
+		blockStore, _, blockStoreDB, stateDB, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+		if err != nil {
+			fmt.Println("err loading blockstore: ", err.Error())
+			return
+		}
+		defer blockStoreDB.Close()
+		defer stateDB.Close()
+		a.SetBlockstore(blockStore)
+		meta, err := a.CreateSnapshot(height, stateDB, app.SnapshotsDir(app.GlobalConfig))
+		if err != nil {
+			fmt.Println("could not create the snapshot: ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully created the snapshot at height %d with app hash %s in %d chunks.\n", meta.Height, meta.AppHash, len(meta.Chunks))
+	},
+}
+
+var listSnapshotsCmd = &cobra.Command{
+	Use:   "list-snapshots",
+	Short: "Lists the snapshots of the datadir",
+	Long:  `Lists the height, app hash and number of chunks of each snapshot in the snapshots directory of the datadir.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		list, err := snapshots.List(app.SnapshotsDir(app.GlobalConfig))
+		if err != nil {
+			fmt.Println("could not list the snapshots: ", err.Error())
+			return
+		}
+		for _, meta := range list {
+			fmt.Printf("height: %d app_hash: %s chunks: %d\n", meta.Height, meta.AppHash, len(meta.Chunks))
+		}
+	},
+}
+
+var restoreSnapshotCmd = &cobra.Command{
+	Use:   "restore-snapshot <height> [<snapshotsDir>]",
+	Short: "Restores the state of a snapshot into an empty datadir",
+	Long: `Restores the application state, the blocks and the validators of the snapshot at the given height into the empty datadir, checking the snapshot against its hashes and commits.
+The snapshot is read from the given directory, or from the snapshots directory of the datadir. Compare the printed block hash with a trusted source before starting the node.
+The transactions of the heights before the snapshot are not indexed.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		height, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			fmt.Println("error parsing height: ", err)
+			return
+		}
+		dir := app.SnapshotsDir(app.GlobalConfig)
+		if len(args) == 2 {
+			dir = args[1]
+		}
+		r, err := snapshots.NewReader(dir, height)
+		if err != nil {
+			fmt.Println("could not open the snapshot: ", err.Error())
+			return
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false, app.GlobalConfig.PocketConfig.IavlCacheSize)
+		blockStore, _, blockStoreDB, stateDB, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+		if err != nil {
+			fmt.Println("err loading blockstore: ", err.Error())
+			return
+		}
+		defer blockStoreDB.Close()
+		defer stateDB.Close()
+		blockID, err := a.RestoreSnapshot(r, blockStore, stateDB)
+		if err != nil {
+			fmt.Println("could not restore the snapshot, delete the data directory before trying again: ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully restored the snapshot at height %d with block hash %X and app hash %s.\n", height, blockID.Hash, r.Metadata().AppHash)
+	},
+}
+
+var exportStateCmd = &cobra.Command{
+	Use:   "export-state <height> <exportDir>",
+	Short: "Streams the state at a height to a directory",
+	Long: `Writes the key value pairs of each store at the given height to its own newline delimited JSON file in the export directory, along with a manifest of their checksums.
+The state is streamed one store at a time, so the export does not need the memory of export-genesis-for-reset. The node must be stopped.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		height, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			fmt.Println("error parsing height: ", err)
+			return
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false, app.GlobalConfig.PocketConfig.IavlCacheSize)
+		manifest, err := a.ExportStateFiles(height, args[1])
+		if err != nil {
+			fmt.Println("could not export the state: ", err.Error())
+			return
+		}
+		for _, store := range manifest.Stores {
+			fmt.Printf("%s: %d pairs, sha256 %s\n", store.Name, store.Pairs, store.Checksum)
+		}
+		fmt.Printf("Successfully exported the state at height %d.\n", height)
+	},
+}
+
+var importStateCmd = &cobra.Command{
+	Use:   "import-state <exportDir>",
+	Short: "Rebuilds the application state of the datadir from an export",
+	Long: `Writes the state exported by export-state into the empty application database of the datadir at the exported height, checking each store file against its checksum.
+The rebuilt state has its own app hash, as its nodes are all of the exported height: it serves migrations and analysis, not to continue the exported chain.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		r, err := export.NewReader(args[0])
+		if err != nil {
+			fmt.Println("could not open the export: ", err.Error())
+			return
+		}
+		defer r.Close()
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false, app.GlobalConfig.PocketConfig.IavlCacheSize)
+		commitID, err := a.ImportStateFiles(r)
+		if err != nil {
+			fmt.Println("could not import the state, delete the application database before trying again: ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully imported the state at height %d with app hash %X.\n", commitID.Version, commitID.Hash)
+	},
+}
+
+var (
+	migrateFrom string
+	migrateTo   string
+)
+
+var migrateDBCmd = &cobra.Command{
+	Use:   "migrate-db --from <backend> --to <backend>",
+	Short: "Copies the databases of the datadir to another backend",
+	Long: `Copies the application, transaction indexer and evidence databases of the datadir from one backend (goleveldb or badgerdb) to the other.
+The node must be stopped. The source databases are left as they are: set db_backend in config.json to the new backend to start the node with the copies.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		config := app.GlobalConfig
+		dataDir := filepath.Join(config.TendermintConfig.RootDir, config.TendermintConfig.DBPath)
+		dbs := []struct{ name, dir string }{
+			{sdk.ApplicationDBName, dataDir},
+			{sdk.TransactionIndexerDBName, dataDir},
+			{config.PocketConfig.EvidenceDBName, config.PocketConfig.DataDir},
+		}
+		for _, d := range dbs {
+			if !sdk.DBExists(d.name, d.dir, migrateFrom) {
+				fmt.Printf("%s: no %s database, skipped\n", d.name, migrateFrom)
+				continue
+			}
+			pairs, err := sdk.MigrateDB(d.name, d.dir, migrateFrom, migrateTo, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
+			if err != nil {
+				fmt.Printf("could not migrate the %s database: %s\n", d.name, err.Error())
+				return
+			}
+			fmt.Printf("%s: %d pairs copied\n", d.name, pairs)
+		}
+		fmt.Printf("Successfully migrated the databases from %s to %s.\n", migrateFrom, migrateTo)
+	},
+}
+
+var stateDiffModule string
+
+var stateDiffCmd = &cobra.Command{
+	Use:   "state-diff <fromHeight> <toHeight> [--store nodes|apps|pocketcore|auth|gov]",
+	Short: "Lists the state keys changed between two heights",
+	Long: `Prints a json line for each key added, removed or modified from the application state at the first height to the state at the second one, in store then key order.
+The values are decoded into the module objects where the key prefix is known, and printed in hex otherwise. The node must be stopped, and both heights must not be pruned.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		from, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			fmt.Println("error parsing fromHeight: ", err)
+			return
+		}
+		to, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			fmt.Println("error parsing toHeight: ", err)
+			return
+		}
+		var stores []string
+		if stateDiffModule != "" {
+			var ok bool
+			if stores, ok = app.StateDiffModules[stateDiffModule]; !ok {
+				fmt.Printf("unknown store: %s, expected nodes, apps, pocketcore, auth or gov\n", stateDiffModule)
+				return
+			}
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false, app.GlobalConfig.PocketConfig.IavlCacheSize)
+		changes, err := a.DiffState(from, to, stores...)
+		if err != nil {
+			fmt.Println("could not diff the state: ", err.Error())
+			return
+		}
+		counts := make(map[string]int)
+		for _, change := range changes {
+			j, err := json.Marshal(change)
+			if err != nil {
+				fmt.Println("could not encode the change: ", err.Error())
+				return
+			}
+			fmt.Println(string(j))
+			counts[change.Change]++
+		}
+		fmt.Printf("%d keys changed from height %d to %d: %d added, %d removed, %d modified.\n", len(changes), from, to,
+			counts[app.StateAdded], counts[app.StateRemoved], counts[app.StateModified])
+	},
+}
+
+var (
+	checkDBHeight int64
+	checkDBRepair bool
+)
+
+var checkDBCmd = &cobra.Command{
+	Use:   "check-db [--height <height>] [--repair]",
+	Short: "Checks the application database for damaged stores",
+	Long: `Walks the tree of every store of the application state at the latest (or given) height, verifying the hash of each node against its parent and the root hashes against the commit info of the height, along with the orphan records and the latest version of each store.
+When the height is damaged, the heights below it are checked down to the newest consistent one, and --repair rolls back every store to it. The blocks above it are replayed from the block store on the next start. The node must be stopped.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		checker := rootmulti.NewChecker(db)
+		latest := checker.LatestVersion()
+		height := checkDBHeight
+		if height <= 0 {
+			height = latest
+		}
+		if height > latest {
+			fmt.Printf("the height %d is above the latest height %d\n", height, latest)
+			return
+		}
+		fmt.Printf("Checking the application state at height %d, latest height %d.\n", height, latest)
+		// the stores of the latest commit info, or of the height when the latest one is lost
+		storeNames, err := checker.StoreNames(latest)
+		if err != nil {
+			if storeNames, err = checker.StoreNames(height); err != nil {
+				fmt.Println("could not read the commit info: ", err.Error())
+				return
+			}
+		}
+		versions, err := checker.StoreVersions(storeNames)
+		if err != nil {
+			fmt.Println("could not read the store versions: ", err.Error())
+			return
+		}
+		damagedDB := false
+		for _, name := range storeNames {
+			if versions[name] != latest {
+				fmt.Printf("store %s: latest version %d does not match the latest height %d\n", name, versions[name], latest)
+				damagedDB = true
+			}
+		}
+		for name, err := range checker.CheckOrphans(storeNames) {
+			fmt.Printf("store %s: damaged orphans: %s\n", name, err.Error())
+			damagedDB = true
+		}
+		consistent, damaged := checker.LatestConsistentVersion(height)
+		for _, vc := range damaged {
+			if vc.Err != nil {
+				fmt.Printf("height %d: %s\n", vc.Version, vc.Err.Error())
+			}
+			for _, name := range storeNames {
+				if err, ok := vc.Damaged[name]; ok {
+					fmt.Printf("height %d: store %s: %s\n", vc.Version, name, err.Error())
+				}
+			}
+		}
+		if len(damaged) == 0 && !damagedDB {
+			fmt.Printf("The application state at height %d is consistent.\n", height)
+			return
+		}
+		if consistent == 0 {
+			fmt.Printf("No height at or below %d is consistent, the node must resync.\n", height)
+			return
+		}
+		fmt.Printf("The newest consistent height is %d.\n", consistent)
+		if !checkDBRepair {
+			fmt.Println("Run again with --repair to roll back every store to it.")
+			return
+		}
+		if err := checker.Rollback(consistent); err != nil {
+			fmt.Println("could not roll back the application state: ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully rolled back the application state to height %d.\n", consistent)
+	},
+}
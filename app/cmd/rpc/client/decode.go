@@ -0,0 +1,98 @@
+package client
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/pokt-network/pocket-core/codec"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// ErrAbsent is returned when decoding the value of a state proof of the absence of the key.
+var ErrAbsent = errors.New("the state proof is of the absence of the key")
+
+// provenValue returns the raw proven value, empty when the key is absent.
+func provenValue(p StateProof) ([]byte, error) {
+	return hex.DecodeString(p.Value)
+}
+
+// DecodeAccount decodes the proven account of the auth store, nil when the account does not exist.
+func DecodeAccount(cdc *codec.Codec, p StateProof) (exported.Account, error) {
+	bz, err := provenValue(p)
+	if err != nil || len(bz) == 0 {
+		return nil, err
+	}
+	var ba authTypes.BaseAccount
+	if err := cdc.UnmarshalBinaryBare(bz, &ba, p.Height); err == nil {
+		return &ba, nil
+	}
+	var ma authTypes.ModuleAccount
+	if err := cdc.UnmarshalBinaryBare(bz, &ma, p.Height); err != nil {
+		return nil, err
+	}
+	return &ma, nil
+}
+
+// DecodeValidator decodes the proven validator of the pos store.
+func DecodeValidator(cdc *codec.Codec, p StateProof) (val nodesTypes.Validator, err error) {
+	bz, err := provenValue(p)
+	if err != nil {
+		return val, err
+	}
+	if len(bz) == 0 {
+		return val, ErrAbsent
+	}
+	if cdc.IsAfterNonCustodialUpgrade(p.Height) {
+		err = cdc.UnmarshalBinaryLengthPrefixed(bz, &val, p.Height)
+		return val, err
+	}
+	var legacy nodesTypes.LegacyValidator
+	err = cdc.UnmarshalBinaryLengthPrefixed(bz, &legacy, p.Height)
+	return legacy.ToValidator(), err
+}
+
+// DecodeApplication decodes the proven application of the application store.
+func DecodeApplication(cdc *codec.Codec, p StateProof) (application appsTypes.Application, err error) {
+	bz, err := provenValue(p)
+	if err != nil {
+		return application, err
+	}
+	if len(bz) == 0 {
+		return application, ErrAbsent
+	}
+	err = cdc.UnmarshalBinaryLengthPrefixed(bz, &application, p.Height)
+	return
+}
+
+// DecodeClaim decodes the proven claim of the pocketcore store.
+func DecodeClaim(cdc *codec.Codec, p StateProof) (claim pocketTypes.MsgClaim, err error) {
+	bz, err := provenValue(p)
+	if err != nil {
+		return claim, err
+	}
+	if len(bz) == 0 {
+		return claim, ErrAbsent
+	}
+	err = cdc.UnmarshalBinaryBare(bz, &claim, p.Height)
+	return
+}
+
+// DecodeParam decodes the proven json value of a parameter of the params store, unquoted when it is a json string.
+func DecodeParam(p StateProof) (string, error) {
+	bz, err := provenValue(p)
+	if err != nil {
+		return "", err
+	}
+	if len(bz) == 0 {
+		return "", ErrAbsent
+	}
+	if s, err := strconv.Unquote(string(bz)); err == nil {
+		return s, nil
+	}
+	return string(bz), nil
+}
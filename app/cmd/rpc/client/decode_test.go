@@ -0,0 +1,60 @@
+package client
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/pocket-core/codec"
+	cdcTypes "github.com/pokt-network/pocket-core/codec/types"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+func TestDecodeProvenValue(t *testing.T) {
+	cdc := codec.NewCodec(cdcTypes.NewInterfaceRegistry())
+	authTypes.RegisterCodec(cdc)
+	appsTypes.RegisterCodec(cdc)
+	crypto.RegisterAmino(cdc.AminoCodec().Amino)
+
+	// an account decodes from its stored bytes, and to nil when it is proven absent
+	acc := authTypes.NewBaseAccountWithAddress(sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()))
+	require.Nil(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10)))))
+	bz, err := cdc.MarshalBinaryBare(&acc, 1)
+	require.Nil(t, err)
+	decoded, err := DecodeAccount(cdc, StateProof{Height: 1, Value: hex.EncodeToString(bz)})
+	require.Nil(t, err)
+	require.Equal(t, acc.GetAddress(), decoded.GetAddress())
+	require.True(t, decoded.GetCoins().IsEqual(acc.GetCoins()))
+	decoded, err = DecodeAccount(cdc, StateProof{Height: 1})
+	require.Nil(t, err)
+	require.Nil(t, decoded)
+
+	// an application decodes from its stored bytes, and fails when it is proven absent
+	application := appsTypes.Application{Address: acc.GetAddress(), Chains: []string{"0001"}, StakedTokens: sdk.NewInt(10), MaxRelays: sdk.NewInt(1)}
+	bz, err = cdc.MarshalBinaryLengthPrefixed(&application, 1)
+	require.Nil(t, err)
+	decodedApp, err := DecodeApplication(cdc, StateProof{Height: 1, Value: hex.EncodeToString(bz)})
+	require.Nil(t, err)
+	require.Equal(t, application.Address, decodedApp.Address)
+	require.Equal(t, application.Chains, decodedApp.Chains)
+	_, err = DecodeApplication(cdc, StateProof{Height: 1})
+	require.Equal(t, ErrAbsent, err)
+	_, err = DecodeValidator(cdc, StateProof{Height: 1})
+	require.Equal(t, ErrAbsent, err)
+	_, err = DecodeClaim(cdc, StateProof{Height: 1})
+	require.Equal(t, ErrAbsent, err)
+
+	// a json string parameter is unquoted, other json values are left as they are
+	value, err := DecodeParam(StateProof{Value: hex.EncodeToString([]byte(`"3600"`))})
+	require.Nil(t, err)
+	require.Equal(t, "3600", value)
+	value, err = DecodeParam(StateProof{Value: hex.EncodeToString([]byte(`["0001"]`))})
+	require.Nil(t, err)
+	require.Equal(t, `["0001"]`, value)
+	_, err = DecodeParam(StateProof{})
+	require.Equal(t, ErrAbsent, err)
+}
@@ -0,0 +1,55 @@
+// Package client holds what an RPC client needs to check the responses of a node without trusting it.
+package client
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// StateProof is the raw value of a key of a store at a height, with the merkle proof chaining it up to the app hash
+// of the height. The app hash of a height is committed in the header of the next block.
+type StateProof struct {
+	Height int64         `json:"height"`
+	Store  string        `json:"store"`
+	Key    string        `json:"key"`             // hex encoded
+	Value  string        `json:"value,omitempty"` // hex encoded, empty when the key is absent
+	Proof  *merkle.Proof `json:"proof"`
+}
+
+// NewStateProof returns the state proof of the response of a proved query of the store.
+func NewStateProof(store string, res abci.ResponseQuery) StateProof {
+	return StateProof{
+		Height: res.Height,
+		Store:  store,
+		Key:    hex.EncodeToString(res.Key),
+		Value:  hex.EncodeToString(res.Value),
+		Proof:  res.Proof,
+	}
+}
+
+// VerifyStateProof verifies the value, or the absence, of the key against the app hash of the height of the proof,
+// taken from the header of the block at height+1 of a trusted source.
+func VerifyStateProof(p StateProof, appHash []byte) error {
+	if p.Proof == nil || len(p.Proof.Ops) == 0 {
+		return errors.New("the state proof is empty")
+	}
+	key, err := hex.DecodeString(p.Key)
+	if err != nil {
+		return fmt.Errorf("invalid key: %s", err)
+	}
+	value, err := hex.DecodeString(p.Value)
+	if err != nil {
+		return fmt.Errorf("invalid value: %s", err)
+	}
+	keyPath := merkle.KeyPath{}.AppendKey([]byte(p.Store), merkle.KeyEncodingURL).AppendKey(key, merkle.KeyEncodingHex).String()
+	prt := rootmulti.DefaultProofRuntime()
+	if len(value) == 0 {
+		return prt.VerifyAbsence(p.Proof, appHash, keyPath)
+	}
+	return prt.VerifyValue(p.Proof, appHash, keyPath, value)
+}
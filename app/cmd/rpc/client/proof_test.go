@@ -0,0 +1,62 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+func TestVerifyStateProof(t *testing.T) {
+	store := rootmulti.NewStore(dbm.NewMemDB(), false, 5000000)
+	accKey, posKey := types.NewKVStoreKey("acc"), types.NewKVStoreKey("pos")
+	store.MountStoreWithDB(accKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(posKey, types.StoreTypeIAVL, nil)
+	require.Nil(t, store.LoadLatestVersion())
+
+	key := []byte{0x01, 0xff, '/', 0x00}
+	require.Nil(t, store.GetKVStore(accKey).Set(key, []byte("first")))
+	require.Nil(t, store.GetKVStore(posKey).Set([]byte("validator"), []byte("staked")))
+	first := store.Commit()
+	require.Nil(t, store.GetKVStore(accKey).Set(key, []byte("second")))
+	second := store.Commit()
+
+	query := func(storeName string, key []byte, height int64) StateProof {
+		res := store.Query(abci.RequestQuery{Path: "/" + storeName + "/key", Data: key, Height: height, Prove: true})
+		require.Zero(t, res.Code, res.Log)
+		return NewStateProof(storeName, res)
+	}
+
+	// the value of each height is proven against the app hash of the height
+	p := query("acc", key, first.Version)
+	require.Equal(t, "6669727374", p.Value)
+	require.Nil(t, VerifyStateProof(p, first.Hash))
+	require.NotNil(t, VerifyStateProof(p, second.Hash))
+	p = query("acc", key, second.Version)
+	require.Nil(t, VerifyStateProof(p, second.Hash))
+
+	// a tampered value, key or store fails
+	tampered := p
+	tampered.Value = "6669727374"
+	require.NotNil(t, VerifyStateProof(tampered, second.Hash))
+	tampered = p
+	tampered.Key = "01ff"
+	require.NotNil(t, VerifyStateProof(tampered, second.Hash))
+	tampered = p
+	tampered.Store = "pos"
+	require.NotNil(t, VerifyStateProof(tampered, second.Hash))
+	tampered = p
+	tampered.Proof = nil
+	require.NotNil(t, VerifyStateProof(tampered, second.Hash))
+
+	// an absent key is proven absent, and cannot be passed off as present
+	p = query("pos", []byte("unknown"), second.Version)
+	require.Empty(t, p.Value)
+	require.Nil(t, VerifyStateProof(p, second.Hash))
+	p.Value = "6669727374"
+	require.NotNil(t, VerifyStateProof(p, second.Hash))
+}
@@ -379,6 +379,7 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("pos/SlashFractionDoubleSign", kp.GetAddress())
 		acl.SetOwner("pos/SlashFractionDowntime", kp.GetAddress())
 		acl.SetOwner("auth/FeeMultipliers", kp.GetAddress())
+		acl.SetOwner("auth/FeeMarket", kp.GetAddress())
 		acl.SetOwner("application/ApplicationStakeMinimum", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimExpiration", kp.GetAddress())
 		acl.SetOwner("pocketcore/SessionNodeCount", kp.GetAddress())
@@ -402,9 +403,19 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("application/MaxApplications", kp.GetAddress())
 		acl.SetOwner("gov/daoOwner", kp.GetAddress())
 		acl.SetOwner("gov/upgrade", kp.GetAddress())
+		acl.SetOwner("gov/minProposalDeposit", kp.GetAddress())
+		acl.SetOwner("gov/proposalQuorum", kp.GetAddress())
+		acl.SetOwner("gov/proposalThreshold", kp.GetAddress())
+		acl.SetOwner("gov/votingPeriod", kp.GetAddress())
 		acl.SetOwner("application/MaximumChains", kp.GetAddress())
+		acl.SetOwner("application/UsageHeadroomPercentage", kp.GetAddress())
+		acl.SetOwner("application/UsageMaxRelaysPercentage", kp.GetAddress())
+		acl.SetOwner("application/UsageMinRelaysPercentage", kp.GetAddress())
+		acl.SetOwner("application/UsageRelaysOn", kp.GetAddress())
+		acl.SetOwner("application/UsageWindowSessions", kp.GetAddress())
 		acl.SetOwner("pos/MaximumChains", kp.GetAddress())
 		acl.SetOwner("pos/MaxJailedBlocks", kp.GetAddress())
+		acl.SetOwner("pos/RelaysToTokensMultiplierMap", kp.GetAddress())
 		testACL = acl
 	}
 	return testACL
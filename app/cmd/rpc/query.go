@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	bytes2 "bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -15,11 +16,43 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc/client"
 	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 )
 
+type provedQueryResponse struct {
+	Result json.RawMessage   `json:"result"`
+	Proof  client.StateProof `json:"state_proof"`
+}
+
+// writeProvedResponse writes the result of a query along with the proof of the state it was read from, after checking
+// the result is the one rendered from the proven value
+func writeProvedResponse(w http.ResponseWriter, r *http.Request, result []byte, storeName string, proof abci.ResponseQuery, err error, render func(p client.StateProof) ([]byte, error)) {
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	stateProof := client.NewStateProof(storeName, proof)
+	proven, err := render(stateProof)
+	if err != nil {
+		WriteErrorResponse(w, 400, fmt.Sprintf("could not decode the proven value: %s", err.Error()))
+		return
+	}
+	if !bytes2.Equal(proven, result) {
+		WriteErrorResponse(w, 400, "the result does not match the proven value")
+		return
+	}
+	j, err := json.Marshal(provedQueryResponse{Result: result, Proof: stateProof})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func Version(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	WriteResponse(w, APIVersion, r.URL.Path, r.Host)
 }
@@ -30,6 +63,7 @@ type HeightParams struct {
 type HeightAndKeyParams struct {
 	Height int64  `json:"height"`
 	Key    string `json:"key"`
+	Prove  bool   `json:"prove,omitempty"`
 }
 
 type HashAndProveParams struct {
@@ -40,6 +74,12 @@ type HashAndProveParams struct {
 type HeightAndAddrParams struct {
 	Height  int64  `json:"height"`
 	Address string `json:"address"`
+	Prove   bool   `json:"prove,omitempty"`
+}
+
+type HeightAndProposalParams struct {
+	Height     int64  `json:"height"`
+	ProposalID uint64 `json:"proposal_id"`
 }
 
 type HeightAndValidatorOptsParams struct {
@@ -61,6 +101,14 @@ type PaginateAddrParams struct {
 	Sort     string `json:"order,omitempty"`
 }
 
+type TxSearchParams struct {
+	app.TxFilter
+	Page    int    `json:"page,omitempty"`
+	PerPage int    `json:"per_page,omitempty"`
+	Prove   bool   `json:"prove,omitempty"`
+	Sort    string `json:"order,omitempty"`
+}
+
 type PaginatedHeightParams struct {
 	Height  int64  `json:"height"`
 	Page    int    `json:"page,omitempty"`
@@ -250,6 +298,26 @@ func AccountTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+func TxSearch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = TxSearchParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryTxSearch(params.TxFilter, params.Page, params.PerPage, params.Prove, params.Sort)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	rpcResponse := ResultTxSearchToRPC(res)
+	s, er := json.MarshalIndent(rpcResponse, "", "  ")
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
 func BlockTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = PaginatedHeightParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -316,6 +384,10 @@ type queryBalanceResponse struct {
 	Balance *big.Int `json:"balance"`
 }
 
+func renderBalance(balance sdk.BigInt) ([]byte, error) {
+	return json.MarshalIndent(&queryBalanceResponse{Balance: balance.BigInt()}, "", "")
+}
+
 func Balance(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightAndAddrParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -330,11 +402,25 @@ func Balance(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	s, err := json.MarshalIndent(&queryBalanceResponse{Balance: balance.BigInt()}, "", "")
+	s, err := renderBalance(balance)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		proof, err := app.PCA.QueryAccountProof(params.Address, params.Height)
+		writeProvedResponse(w, r, s, types2.StoreKey, proof, err, func(p client.StateProof) ([]byte, error) {
+			acc, err := client.DecodeAccount(app.Codec(), p)
+			if err != nil {
+				return nil, err
+			}
+			if acc == nil {
+				return renderBalance(sdk.ZeroInt())
+			}
+			return renderBalance(acc.GetCoins().AmountOf(sdk.DefaultStakeDenom))
+		})
+		return
+	}
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
@@ -357,9 +443,64 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		proof, err := app.PCA.QueryAccountProof(params.Address, params.Height)
+		writeProvedResponse(w, r, s, types2.StoreKey, proof, err, func(p client.StateProof) ([]byte, error) {
+			acc, err := client.DecodeAccount(app.Codec(), p)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(&acc)
+		})
+		return
+	}
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+func SessionKeys(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QuerySessionKeys(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func FeeAllowances(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryFeeAllowances(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func Nodes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightAndValidatorOptsParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -411,6 +552,17 @@ func Node(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		proof, err := app.PCA.QueryNodeProof(params.Address, params.Height)
+		writeProvedResponse(w, r, j, nodeTypes.StoreKey, proof, err, func(p client.StateProof) ([]byte, error) {
+			val, err := client.DecodeValidator(app.Codec(), p)
+			if err != nil {
+				return nil, err
+			}
+			return val.MarshalJSON()
+		})
+		return
+	}
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
@@ -523,6 +675,7 @@ type QueryNodeReceiptParam struct {
 	SBlockHeight int64  `json:"session_block_height"`
 	Height       int64  `json:"height"`
 	ReceiptType  string `json:"receipt_type"`
+	Prove        bool   `json:"prove,omitempty"`
 }
 
 func NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -544,6 +697,17 @@ func NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		proof, err := app.PCA.QueryClaimProof(params.Address, params.AppPubKey, params.Blockchain, params.ReceiptType, params.SBlockHeight, params.Height)
+		writeProvedResponse(w, r, j, pocketTypes.StoreKey, proof, err, func(p client.StateProof) ([]byte, error) {
+			claim, err := client.DecodeClaim(app.Codec(), p)
+			if err != nil {
+				return nil, err
+			}
+			return app.Codec().MarshalJSON(&claim)
+		})
+		return
+	}
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
@@ -620,6 +784,39 @@ func App(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		proof, err := app.PCA.QueryAppProof(params.Address, params.Height)
+		writeProvedResponse(w, r, j, appTypes.StoreKey, proof, err, func(p client.StateProof) ([]byte, error) {
+			application, err := client.DecodeApplication(app.Codec(), p)
+			if err != nil {
+				return nil, err
+			}
+			return application.MarshalJSON()
+		})
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func AppRelays(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryAppRelayAllowance(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
@@ -845,6 +1042,20 @@ func Param(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		proof, err := app.PCA.QueryParamProof(params.Height, params.Key)
+		writeProvedResponse(w, r, j, sdk.ParamsKey.Name(), proof, err, func(p client.StateProof) ([]byte, error) {
+			value, err := client.DecodeParam(p)
+			if err == client.ErrAbsent {
+				return app.Codec().MarshalJSON(app.SingleParamReturn{})
+			}
+			if err != nil {
+				return nil, err
+			}
+			return app.Codec().MarshalJSON(app.SingleParamReturn{Key: params.Key, Value: value})
+		})
+		return
+	}
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
@@ -864,3 +1075,223 @@ func State(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 	WriteRaw(w, res, r.URL.Path, r.Host)
 }
+
+func Proposals(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryProposals(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func PendingParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryPendingParams(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func MultisigOwners(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryMultisigOwners(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ParamApprovals(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryParamApprovals(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func DAOStreams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryDAOStreams(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func FeeMarket(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryFeeMarket(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Features(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryFeatures(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func UpgradeReadiness(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryUpgradeReadiness(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Proposal(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryProposal(params.ProposalID, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Tally(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryProposalTally(params.ProposalID, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/pokt-network/pocket-core/codec"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc/client"
 	"github.com/pokt-network/pocket-core/crypto"
 	rand2 "github.com/tendermint/tendermint/libs/rand"
 
@@ -175,6 +176,51 @@ func TestRPC_QueryAccountTXs(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryTxSearch(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	memCLI, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	tx, err := nodes.Send(memCodec(), memCLI, kb, cb.GetAddress(), cb.GetAddress(), "test", types.NewInt(100), true)
+	assert.Nil(t, err)
+	assert.NotNil(t, tx)
+	<-evtChan // Wait for tx
+
+	type searchResult struct {
+		Txs []struct {
+			Hash string `json:"hash"`
+		} `json:"txs"`
+	}
+	search := func(params TxSearchParams) (searchResult, int) {
+		q := newQueryRequest("txsearch", newBody(params))
+		rec := httptest.NewRecorder()
+		TxSearch(rec, q, httprouter.Params{})
+		var res searchResult
+		if rec.Code == 200 {
+			assert.Nil(t, json.Unmarshal([]byte(getJSONResponse(rec)), &res))
+		}
+		return res, rec.Code
+	}
+	code := uint32(0)
+	res, status := search(TxSearchParams{TxFilter: app.TxFilter{Signer: cb.GetAddress().String(), MessageType: types2.MsgSendName, Code: &code}})
+	assert.Equal(t, 200, status)
+	if assert.Len(t, res.Txs, 1) {
+		assert.True(t, strings.EqualFold(tx.TxHash, res.Txs[0].Hash))
+	}
+	res, status = search(TxSearchParams{TxFilter: app.TxFilter{Signer: cb.GetAddress().String(), MessageType: "claim"}})
+	assert.Equal(t, 200, status)
+	assert.Empty(t, res.Txs)
+	_, status = search(TxSearchParams{})
+	assert.Equal(t, 400, status)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryBlockTXs(t *testing.T) {
 	codec.UpgradeHeight = 7000
 	var tx *types.TxResponse
@@ -307,6 +353,26 @@ func TestRPC_QueryAccount(t *testing.T) {
 	resp = getJSONResponse(rec)
 	assert.Regexp(t, "upokt", string(resp))
 
+	params.Prove = true
+	q = newQueryRequest("account", newBody(params))
+	rec = httptest.NewRecorder()
+	Account(rec, q, httprouter.Params{})
+	var proved provedQueryResponse
+	assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &proved))
+	assert.Regexp(t, "upokt", string(proved.Result))
+	<-evtChan // the app hash of the proven height is in the header of the next block
+	appHash := app.PCA.BlockStore().LoadBlockMeta(proved.Proof.Height + 1).Header.AppHash
+	assert.Nil(t, client.VerifyStateProof(proved.Proof, appHash))
+	acc, err := client.DecodeAccount(app.Codec(), proved.Proof)
+	assert.Nil(t, err)
+	assert.Equal(t, params.Address, acc.GetAddress().String())
+
+	q = newQueryRequest("balance", newBody(params))
+	rec = httptest.NewRecorder()
+	Balance(rec, q, httprouter.Params{})
+	assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &proved))
+	assert.Regexp(t, "balance", string(proved.Result))
+
 	cleanup()
 	stopCli()
 }
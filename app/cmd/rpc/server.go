@@ -101,24 +101,38 @@ func GetRoutes() Routes {
 		Route{Name: "QueryAllParams", Method: "POST", Path: "/v1/query/allparams", HandlerFunc: AllParams},
 		Route{Name: "QueryApp", Method: "POST", Path: "/v1/query/app", HandlerFunc: App},
 		Route{Name: "QueryAppParams", Method: "POST", Path: "/v1/query/appparams", HandlerFunc: AppParams},
+		Route{Name: "QueryAppRelays", Method: "POST", Path: "/v1/query/apprelays", HandlerFunc: AppRelays},
 		Route{Name: "QueryApps", Method: "POST", Path: "/v1/query/apps", HandlerFunc: Apps},
 		Route{Name: "QueryBalance", Method: "POST", Path: "/v1/query/balance", HandlerFunc: Balance},
 		Route{Name: "QueryBlock", Method: "POST", Path: "/v1/query/block", HandlerFunc: Block},
 		Route{Name: "QueryBlockTxs", Method: "POST", Path: "/v1/query/blocktxs", HandlerFunc: BlockTxs},
 		Route{Name: "QueryDAOOwner", Method: "POST", Path: "/v1/query/daoowner", HandlerFunc: DAOOwner},
+		Route{Name: "QueryDAOStreams", Method: "POST", Path: "/v1/query/daostreams", HandlerFunc: DAOStreams},
+		Route{Name: "QueryFeatures", Method: "POST", Path: "/v1/query/features", HandlerFunc: Features},
+		Route{Name: "QueryFeeAllowances", Method: "POST", Path: "/v1/query/feeallowances", HandlerFunc: FeeAllowances},
+		Route{Name: "QueryFeeMarket", Method: "POST", Path: "/v1/query/feemarket", HandlerFunc: FeeMarket},
 		Route{Name: "QueryHeight", Method: "POST", Path: "/v1/query/height", HandlerFunc: Height},
 		Route{Name: "QueryNode", Method: "POST", Path: "/v1/query/node", HandlerFunc: Node},
 		Route{Name: "QueryNodeClaim", Method: "POST", Path: "/v1/query/nodeclaim", HandlerFunc: NodeClaim},
 		Route{Name: "QueryNodeClaims", Method: "POST", Path: "/v1/query/nodeclaims", HandlerFunc: NodeClaims},
 		Route{Name: "QueryNodeParams", Method: "POST", Path: "/v1/query/nodeparams", HandlerFunc: NodeParams},
 		Route{Name: "QueryNodes", Method: "POST", Path: "/v1/query/nodes", HandlerFunc: Nodes},
+		Route{Name: "QueryMultisigOwners", Method: "POST", Path: "/v1/query/multisigowners", HandlerFunc: MultisigOwners},
 		Route{Name: "QueryParam", Method: "POST", Path: "/v1/query/param", HandlerFunc: Param},
+		Route{Name: "QueryParamApprovals", Method: "POST", Path: "/v1/query/paramapprovals", HandlerFunc: ParamApprovals},
+		Route{Name: "QueryPendingParams", Method: "POST", Path: "/v1/query/pendingparams", HandlerFunc: PendingParams},
 		Route{Name: "QueryPocketParams", Method: "POST", Path: "/v1/query/pocketparams", HandlerFunc: PocketParams},
+		Route{Name: "QueryProposal", Method: "POST", Path: "/v1/query/proposal", HandlerFunc: Proposal},
+		Route{Name: "QueryProposals", Method: "POST", Path: "/v1/query/proposals", HandlerFunc: Proposals},
+		Route{Name: "QuerySessionKeys", Method: "POST", Path: "/v1/query/sessionkeys", HandlerFunc: SessionKeys},
 		Route{Name: "QueryState", Method: "POST", Path: "/v1/query/state", HandlerFunc: State},
 		Route{Name: "QuerySupply", Method: "POST", Path: "/v1/query/supply", HandlerFunc: Supply},
 		Route{Name: "QuerySupportedChains", Method: "POST", Path: "/v1/query/supportedchains", HandlerFunc: SupportedChains},
+		Route{Name: "QueryTally", Method: "POST", Path: "/v1/query/tally", HandlerFunc: Tally},
 		Route{Name: "QueryTX", Method: "POST", Path: "/v1/query/tx", HandlerFunc: Tx},
+		Route{Name: "QueryTxSearch", Method: "POST", Path: "/v1/query/txsearch", HandlerFunc: TxSearch},
 		Route{Name: "QueryUpgrade", Method: "POST", Path: "/v1/query/upgrade", HandlerFunc: Upgrade},
+		Route{Name: "QueryUpgradeReadiness", Method: "POST", Path: "/v1/query/upgradereadiness", HandlerFunc: UpgradeReadiness},
 		Route{Name: "QuerySigningInfo", Method: "POST", Path: "/v1/query/signinginfo", HandlerFunc: SigningInfo},
 		Route{Name: "QueryChains", Method: "POST", Path: "/v1/private/chains", HandlerFunc: Chains},
 	}
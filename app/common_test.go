@@ -515,12 +515,22 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("application/MaximumChains", kp.GetAddress())
 		acl.SetOwner("application/ParticipationRateOn", kp.GetAddress())
 		acl.SetOwner("application/StabilityAdjustment", kp.GetAddress())
+		acl.SetOwner("application/UsageHeadroomPercentage", kp.GetAddress())
+		acl.SetOwner("application/UsageMaxRelaysPercentage", kp.GetAddress())
+		acl.SetOwner("application/UsageMinRelaysPercentage", kp.GetAddress())
+		acl.SetOwner("application/UsageRelaysOn", kp.GetAddress())
+		acl.SetOwner("application/UsageWindowSessions", kp.GetAddress())
 		acl.SetOwner("auth/MaxMemoCharacters", kp.GetAddress())
 		acl.SetOwner("auth/TxSigLimit", kp.GetAddress())
 		acl.SetOwner("auth/FeeMultipliers", kp.GetAddress())
+		acl.SetOwner("auth/FeeMarket", kp.GetAddress())
 		acl.SetOwner("gov/acl", kp.GetAddress())
 		acl.SetOwner("gov/daoOwner", kp.GetAddress())
 		acl.SetOwner("gov/upgrade", kp.GetAddress())
+		acl.SetOwner("gov/minProposalDeposit", kp.GetAddress())
+		acl.SetOwner("gov/proposalQuorum", kp.GetAddress())
+		acl.SetOwner("gov/proposalThreshold", kp.GetAddress())
+		acl.SetOwner("gov/votingPeriod", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimExpiration", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimSubmissionWindow", kp.GetAddress())
 		acl.SetOwner("pocketcore/MinimumNumberOfProofs", kp.GetAddress())
@@ -533,6 +543,7 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("pos/MaxEvidenceAge", kp.GetAddress())
 		acl.SetOwner("pos/MaximumChains", kp.GetAddress())
 		acl.SetOwner("pos/MaxJailedBlocks", kp.GetAddress())
+		acl.SetOwner("pos/RelaysToTokensMultiplierMap", kp.GetAddress())
 		acl.SetOwner("pos/MaxValidators", kp.GetAddress())
 		acl.SetOwner("pos/MinSignedPerWindow", kp.GetAddress())
 		acl.SetOwner("pos/ProposerPercentage", kp.GetAddress())
@@ -11,7 +11,6 @@ import (
 	types2 "github.com/pokt-network/pocket-core/codec/types"
 	"github.com/pokt-network/pocket-core/crypto"
 	kb "github.com/pokt-network/pocket-core/crypto/keys"
-	"github.com/pokt-network/pocket-core/store"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/types/module"
 	apps "github.com/pokt-network/pocket-core/x/apps"
@@ -321,8 +320,13 @@ func InitTendermint(keybase bool, chains *types.HostedBlockchains, logger log.Lo
 	default:
 		keys = MustGetKeybase()
 	}
+	pruning, err := GlobalConfig.PocketConfig.Pruning.Options()
+	if err != nil {
+		log2.Fatal(err)
+	}
 	appCreatorFunc := func(logger log.Logger, db dbm.DB, _ io.Writer) *PocketCoreApp {
-		return NewPocketCoreApp(nil, keys, getTMClient(), chains, logger, db, GlobalConfig.PocketConfig.Cache, GlobalConfig.PocketConfig.IavlCacheSize, baseapp.SetPruning(store.PruneNothing))
+		return NewPocketCoreApp(nil, keys, getTMClient(), chains, logger, db, GlobalConfig.PocketConfig.Cache, GlobalConfig.PocketConfig.IavlCacheSize, baseapp.SetPruning(pruning),
+			baseapp.SetHeightCache(GlobalConfig.PocketConfig.HeightCache.Depth, GlobalConfig.PocketConfig.HeightCache.MaxBytes))
 	}
 	tmNode, app, err := NewClient(config(c), appCreatorFunc)
 	if err != nil {
@@ -337,6 +341,14 @@ func InitTendermint(keybase bool, chains *types.HostedBlockchains, logger log.Lo
 
 func InitKeyfiles() {
 	datadir := GlobalConfig.PocketConfig.DataDir
+	// the session key signing the automatic claims and proofs instead of the validator key
+	if GlobalConfig.PocketConfig.SessionKeyFile != "" {
+		file, path := loadPKFromFile(datadir + FS + GlobalConfig.PocketConfig.SessionKeyFile)
+		if file.PrivKey == nil {
+			log2.Fatalf("the session key file %s has no private key", path)
+		}
+		types.InitSessionKeyFile(file)
+	}
 	// Check if privvalkey file exist
 	if _, err := os.Stat(datadir + FS + GlobalConfig.TendermintConfig.PrivValidatorKey); err != nil {
 		// if not exist continue creating as other files may be missing
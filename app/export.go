@@ -0,0 +1,43 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pokt-network/pocket-core/store/export"
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// ExportStateFiles writes the key value pairs of each store at the height to its own file in the directory, one store
+// at a time, so that the state is never held in memory
+func (app *PocketCoreApp) ExportStateFiles(height int64, dir string) (export.Manifest, error) {
+	ms, err := app.Store().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return export.Manifest{}, fmt.Errorf("unable to load the state at height %d: %s", height, err.Error())
+	}
+	w, err := export.NewWriter(dir, height)
+	if err != nil {
+		return export.Manifest{}, err
+	}
+	names := make([]string, 0, len(app.Keys))
+	for name := range app.Keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		it, err := ms.GetKVStore(app.Keys[name]).Iterator(nil, nil)
+		if err != nil {
+			return export.Manifest{}, err
+		}
+		if err := w.WriteStore(name, it); err != nil {
+			return export.Manifest{}, fmt.Errorf("unable to export store %s: %s", name, err.Error())
+		}
+	}
+	return w.Close()
+}
+
+// ImportStateFiles writes the state exported by ExportStateFiles into the empty application database at the exported
+// height. The imported nodes are all of that height, so the app hash differs from the one of the exported chain
+func (app *PocketCoreApp) ImportStateFiles(r *export.Reader) (sdk.CommitID, error) {
+	return app.Store().ImportVersion(r.Manifest().Height, r.Next)
+}
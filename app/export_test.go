@@ -0,0 +1,63 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/store/export"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestExportImportStateFiles(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	defer cleanup()
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	defer stopCli()
+	for i := 0; i < 3; i++ {
+		<-evtChan // Wait for block
+	}
+	dir, err := ioutil.TempDir("", "export")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	manifest, err := PCA.ExportStateFiles(2, dir)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), manifest.Height)
+	assert.Len(t, manifest.Stores, len(PCA.Keys))
+
+	r, err := export.NewReader(dir)
+	require.Nil(t, err)
+	imported := GetApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+	commitID, err := imported.ImportStateFiles(r)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), commitID.Version)
+
+	original, err := PCA.Store().CacheMultiStoreWithVersion(2)
+	require.Nil(t, err)
+	for name, key := range PCA.Keys {
+		it, err := original.GetKVStore(key).Iterator(nil, nil)
+		require.Nil(t, err)
+		importedStore := imported.Store().GetKVStore(imported.Keys[name])
+		var pairs int64
+		for ; it.Valid(); it.Next() {
+			value, err := importedStore.Get(it.Key())
+			require.Nil(t, err)
+			assert.Equal(t, it.Value(), value)
+			pairs++
+		}
+		it.Close()
+		for _, sm := range manifest.Stores {
+			if sm.Name == name {
+				assert.Equal(t, pairs, sm.Pairs)
+			}
+		}
+	}
+}
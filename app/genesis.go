@@ -15527,13 +15527,23 @@ func createDummyACL(kp crypto.PublicKey) govTypes.ACL {
 	acl.SetOwner("application/MaximumChains", addr)
 	acl.SetOwner("application/ParticipationRateOn", addr)
 	acl.SetOwner("application/StabilityAdjustment", addr)
+	acl.SetOwner("application/UsageHeadroomPercentage", addr)
+	acl.SetOwner("application/UsageMaxRelaysPercentage", addr)
+	acl.SetOwner("application/UsageMinRelaysPercentage", addr)
+	acl.SetOwner("application/UsageRelaysOn", addr)
+	acl.SetOwner("application/UsageWindowSessions", addr)
 	acl.SetOwner("auth/MaxMemoCharacters", addr)
 	acl.SetOwner("auth/TxSigLimit", addr)
 	acl.SetOwner("gov/acl", addr)
 	acl.SetOwner("gov/daoOwner", addr)
 	acl.SetOwner("gov/upgrade", addr)
+	acl.SetOwner("gov/minProposalDeposit", addr)
+	acl.SetOwner("gov/proposalQuorum", addr)
+	acl.SetOwner("gov/proposalThreshold", addr)
+	acl.SetOwner("gov/votingPeriod", addr)
 	acl.SetOwner("pocketcore/ClaimExpiration", addr)
 	acl.SetOwner("auth/FeeMultipliers", addr)
+	acl.SetOwner("auth/FeeMarket", addr)
 	acl.SetOwner("pocketcore/ReplayAttackBurnMultiplier", addr)
 	acl.SetOwner("pos/ProposerPercentage", addr)
 	acl.SetOwner("pocketcore/ClaimSubmissionWindow", addr)
@@ -15546,6 +15556,7 @@ func createDummyACL(kp crypto.PublicKey) govTypes.ACL {
 	acl.SetOwner("pos/MaxEvidenceAge", addr)
 	acl.SetOwner("pos/MaximumChains", addr)
 	acl.SetOwner("pos/MaxJailedBlocks", addr)
+	acl.SetOwner("pos/RelaysToTokensMultiplierMap", addr)
 	acl.SetOwner("pos/MaxValidators", addr)
 	acl.SetOwner("pos/MinSignedPerWindow", addr)
 	acl.SetOwner("pos/RelaysToTokensMultiplier", addr)
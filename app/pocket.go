@@ -44,6 +44,10 @@ type PocketCoreApp struct {
 	pocketKeeper  pocketKeeper.Keeper
 	// Module Manager
 	mm *module.Manager
+	// the tendermint state db, read by the snapshots
+	stateDB db.DB
+	// set while a snapshot is being created
+	snapshotting int32
 }
 
 // new pocket core base
@@ -91,11 +95,20 @@ func (app *PocketCoreApp) InitChainerWithGenesis(ctx sdk.Ctx, req abci.RequestIn
 
 // setups all of the begin blockers for each module
 func (app *PocketCoreApp) BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if supervised {
+		app.haltForUpgrade(ctx)
+	}
+	// the block of the height is saved by now, so the previous height can be verified by its commit
+	if interval := GlobalConfig.PocketConfig.Snapshots.Interval; interval > 0 && ctx.BlockHeight() > 1 && (ctx.BlockHeight()-1)%interval == 0 {
+		app.snapshot(ctx, ctx.BlockHeight()-1)
+	}
 	return app.mm.BeginBlock(ctx, req)
 }
 
 // setups all of the end blockers for each module
 func (app *PocketCoreApp) EndBlocker(ctx sdk.Ctx, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	// never prune the heights still read through PrevCtx
+	app.RetainRecentVersions(app.pocketKeeper.PrevCtxRetention(ctx))
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -189,6 +202,16 @@ func (app *PocketCoreApp) NewContext(height int64) (sdk.Ctx, error) {
 	return ctx.PrevCtx(height)
 }
 
+// PruneState deletes the application state below the given height, refusing the heights still read through PrevCtx
+func (app *PocketCoreApp) PruneState(toHeight int64) error {
+	latest := app.LastBlockHeight()
+	ctx := sdk.NewContext(app.Store(), abci.Header{Height: latest}, false, app.Logger())
+	if retained := latest - app.pocketKeeper.PrevCtxRetention(ctx); toHeight > retained {
+		return fmt.Errorf("the prune height: %d must not be greater than %d, the later heights are needed to validate claims and proofs", toHeight, retained)
+	}
+	return app.Store().PruneVersions(toHeight)
+}
+
 func (app *PocketCoreApp) GetClient() client.Client {
 	return app.pocketKeeper.TmNode
 }
@@ -196,12 +219,13 @@ func (app *PocketCoreApp) GetClient() client.Client {
 var (
 	// module account permissions
 	moduleAccountPermissions = map[string][]string{
-		auth.FeeCollectorName:     {auth.Burner, auth.Minter, auth.Staking},
-		nodesTypes.StakedPoolName: {auth.Burner, auth.Minter, auth.Staking},
-		appsTypes.StakedPoolName:  {auth.Burner, auth.Minter, auth.Staking},
-		govTypes.DAOAccountName:   {auth.Burner, auth.Minter, auth.Staking},
-		nodesTypes.ModuleName:     {auth.Burner, auth.Minter, auth.Staking},
-		appsTypes.ModuleName:      nil,
+		auth.FeeCollectorName:              {auth.Burner, auth.Minter, auth.Staking},
+		nodesTypes.StakedPoolName:          {auth.Burner, auth.Minter, auth.Staking},
+		appsTypes.StakedPoolName:           {auth.Burner, auth.Minter, auth.Staking},
+		govTypes.DAOAccountName:            {auth.Burner, auth.Minter, auth.Staking},
+		govTypes.ProposalEscrowAccountName: nil,
+		nodesTypes.ModuleName:              {auth.Burner, auth.Minter, auth.Staking},
+		appsTypes.ModuleName:               nil,
 	}
 )
 
@@ -3,19 +3,24 @@ package app
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"math"
 	"reflect"
 	"strconv"
+	"strings"
 
+	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/auth/exported"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/auth/util"
 	"github.com/pokt-network/pocket-core/x/gov/types"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 )
 
@@ -72,6 +77,68 @@ func (app PocketCoreApp) QueryRecipientTxs(addr string, page, perPage int, prove
 	return
 }
 
+// TxFilter are the conditions of a tx search, the empty ones being left out. At least one is needed.
+type TxFilter struct {
+	Height        int64   `json:"height,omitempty"`
+	Signer        string  `json:"signer,omitempty"`
+	Recipient     string  `json:"recipient,omitempty"`
+	Chain         string  `json:"chain,omitempty"`
+	SessionHeight int64   `json:"session_height,omitempty"`
+	MessageType   string  `json:"message_type,omitempty"`
+	Code          *uint32 `json:"code,omitempty"`
+}
+
+// Query returns the tx search query of the filter. The indexer iterates the txs of the first condition, so the most
+// selective conditions come first
+func (f TxFilter) Query() (string, error) {
+	var conditions []string
+	for _, addr := range []struct{ key, value string }{{sdk.TxSignerKey, f.Signer}, {sdk.TxRecipientKey, f.Recipient}} {
+		if addr.value == "" {
+			continue
+		}
+		if _, err := hex.DecodeString(addr.value); err != nil {
+			return "", fmt.Errorf("invalid %s: %s", addr.key, err.Error())
+		}
+		conditions = append(conditions, fmt.Sprintf("%s='%s'", addr.key, addr.value))
+	}
+	if f.Height != 0 {
+		conditions = append(conditions, fmt.Sprintf("%s=%d", sdk.TxHeightKey, f.Height))
+	}
+	if f.Chain != "" {
+		conditions = append(conditions, fmt.Sprintf("%s='%s'", sdk.TxChainKey, f.Chain))
+	}
+	if f.SessionHeight != 0 {
+		conditions = append(conditions, fmt.Sprintf("%s=%d", sdk.TxSessionHeightKey, f.SessionHeight))
+	}
+	if f.MessageType != "" {
+		conditions = append(conditions, fmt.Sprintf("%s='%s'", sdk.TxMessageTypeKey, f.MessageType))
+	}
+	if f.Code != nil {
+		conditions = append(conditions, fmt.Sprintf("%s=%d", sdk.TxCodeKey, *f.Code))
+	}
+	for _, value := range []string{f.Chain, f.MessageType} {
+		if strings.ContainsAny(value, "'/") {
+			return "", fmt.Errorf("invalid filter value: %s", value)
+		}
+	}
+	if len(conditions) == 0 {
+		return "", errors.New("the tx search needs at least one filter")
+	}
+	return strings.Join(conditions, " AND "), nil
+}
+
+func (app PocketCoreApp) QueryTxSearch(filter TxFilter, page, perPage int, prove bool, sort string) (res *core_types.ResultTxSearch, err error) {
+	query, err := filter.Query()
+	if err != nil {
+		return nil, err
+	}
+	tmClient := app.GetClient()
+	defer func() { _ = tmClient.Stop() }()
+	page, perPage = checkPagination(page, perPage)
+	res, err = tmClient.TxSearch(query, prove, page, perPage, checkSort(sort))
+	return
+}
+
 func (app PocketCoreApp) QueryBlockTxs(height int64, page, perPage int, prove bool, sort string) (res *core_types.ResultTxSearch, err error) {
 	tmClient := app.GetClient()
 	defer func() { _ = tmClient.Stop() }()
@@ -158,6 +225,38 @@ func (app PocketCoreApp) QueryAccount(addr string, height int64) (res *exported.
 	return &acc, nil
 }
 
+func (app PocketCoreApp) QueryFeeMarket(height int64) (res authTypes.FeeMarket, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.accountKeeper.GetFeeMarket(ctx), nil
+}
+
+func (app PocketCoreApp) QuerySessionKeys(addr string, height int64) (res []authTypes.SessionKey, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.accountKeeper.GetSessionKeys(ctx, a), nil
+}
+
+func (app PocketCoreApp) QueryFeeAllowances(addr string, height int64) (res []authTypes.FeeAllowance, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.accountKeeper.GetFeeAllowances(ctx, a), nil
+}
+
 func (app PocketCoreApp) QueryNodes(height int64, opts nodesTypes.QueryValidatorsParams) (res Page, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -285,6 +384,86 @@ func (app PocketCoreApp) QueryACL(height int64) (res types.ACL, err error) {
 	return app.govKeeper.GetACL(ctx), nil
 }
 
+func (app PocketCoreApp) QueryProposals(height int64) (res []types.Proposal, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetProposals(ctx), nil
+}
+
+func (app PocketCoreApp) QueryPendingParams(height int64) (res []types.PendingParamChange, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetPendingParamChanges(ctx), nil
+}
+
+func (app PocketCoreApp) QueryMultisigOwners(height int64) (res []types.MultisigOwner, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetMultisigOwners(ctx), nil
+}
+
+func (app PocketCoreApp) QueryParamApprovals(height int64) (res []types.ParamApproval, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetParamApprovals(ctx), nil
+}
+
+func (app PocketCoreApp) QueryDAOStreams(height int64) (res []types.DAOStream, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetDAOStreams(ctx), nil
+}
+
+func (app PocketCoreApp) QueryFeatures(height int64) (res []codec.FeatureStatus, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetFeatureStatuses(ctx), nil
+}
+
+func (app PocketCoreApp) QueryUpgradeReadiness(height int64) (res types.UpgradeReadiness, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetUpgradeReadiness(ctx), nil
+}
+
+func (app PocketCoreApp) QueryProposal(id uint64, height int64) (res types.Proposal, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res, found := app.govKeeper.GetProposal(ctx, id)
+	if !found {
+		return res, types.ErrProposalNotFound(types.ModuleName, id)
+	}
+	return
+}
+
+func (app PocketCoreApp) QueryProposalTally(id uint64, height int64) (res types.TallyResult, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res, er := app.govKeeper.GetProposalTally(ctx, id)
+	if er != nil {
+		return res, er
+	}
+	return
+}
+
 type AllParamsReturn struct {
 	AppParams    []SingleParamReturn `json:"app_params"`
 	NodeParams   []SingleParamReturn `json:"node_params"`
@@ -378,6 +557,23 @@ func (app PocketCoreApp) QueryApps(height int64, opts appsTypes.QueryApplication
 	return paginate(opts.Page, opts.Limit, applications, int(app.appsKeeper.GetParams(ctx).MaxApplications))
 }
 
+func (app PocketCoreApp) QueryAppRelayAllowance(addr string, height int64) (res appsTypes.RelayAllowance, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	application, found := app.appsKeeper.GetApplication(ctx, a)
+	if !found {
+		err = appsTypes.ErrNoApplicationFound(appsTypes.ModuleName)
+		return
+	}
+	return app.appsKeeper.GetRelayAllowance(ctx, application), nil
+}
+
 func (app PocketCoreApp) QueryApp(addr string, height int64) (res appsTypes.Application, err error) {
 	a, err := sdk.AddressFromHex(addr)
 	if err != nil {
@@ -494,6 +690,75 @@ func (app PocketCoreApp) QueryPocketParams(height int64) (res pocketTypes.Params
 	return p, nil
 }
 
+// QueryStateProof returns the raw value of the key in the named store at the height, with the merkle proof chaining it
+// up to the app hash of the height, found in the header of the next block
+func (app PocketCoreApp) QueryStateProof(storeName string, key []byte, height int64) (res abci.ResponseQuery, err error) {
+	res = app.BaseApp.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if res.Code != 0 {
+		return res, errors.New(res.Log)
+	}
+	return
+}
+
+func (app PocketCoreApp) QueryAccountProof(addr string, height int64) (res abci.ResponseQuery, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	return app.QueryStateProof(authTypes.StoreKey, authTypes.AddressStoreKey(a), height)
+}
+
+func (app PocketCoreApp) QueryNodeProof(addr string, height int64) (res abci.ResponseQuery, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	return app.QueryStateProof(nodesTypes.StoreKey, nodesTypes.KeyForValByAllVals(a), height)
+}
+
+func (app PocketCoreApp) QueryAppProof(addr string, height int64) (res abci.ResponseQuery, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	return app.QueryStateProof(appsTypes.StoreKey, appsTypes.KeyForAppByAllApps(a), height)
+}
+
+func (app PocketCoreApp) QueryClaimProof(address, appPubkey, chain, evidenceType string, sessionBlockHeight int64, height int64) (res abci.ResponseQuery, err error) {
+	a, err := sdk.AddressFromHex(address)
+	if err != nil {
+		return res, err
+	}
+	header := pocketTypes.SessionHeader{
+		ApplicationPubKey:  appPubkey,
+		Chain:              chain,
+		SessionBlockHeight: sessionBlockHeight,
+	}
+	et, err := pocketTypes.EvidenceTypeFromString(evidenceType)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	key, err := pocketTypes.KeyForClaim(ctx, a, header, et)
+	if err != nil {
+		return res, err
+	}
+	return app.QueryStateProof(pocketTypes.StoreKey, key, height)
+}
+
+// QueryParamProof proves the raw json value of the parameter, stored under its "subspace/key" name
+func (app PocketCoreApp) QueryParamProof(height int64, paramkey string) (res abci.ResponseQuery, err error) {
+	return app.QueryStateProof(sdk.ParamsKey.Name(), []byte(paramkey), height)
+}
+
 func (app PocketCoreApp) HandleChallenge(c pocketTypes.ChallengeProofInvalidData) (res *pocketTypes.ChallengeResponse, err error) {
 	ctx, err := app.NewContext(app.LastBlockHeight())
 	if err != nil {
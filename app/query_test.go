@@ -11,11 +11,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pokt-network/pocket-core/app/cmd/rpc/client"
 	"github.com/pokt-network/pocket-core/crypto"
 	"github.com/pokt-network/pocket-core/crypto/keys"
 	sdk "github.com/pokt-network/pocket-core/types"
 	apps "github.com/pokt-network/pocket-core/x/apps"
 	types3 "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/gov"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
@@ -954,3 +956,56 @@ func TestQueryNonExistingAccountBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryStateProof(t *testing.T) {
+	tt := []struct {
+		name         string
+		memoryNodeFn func(t *testing.T, genesisState []byte) (tendermint *node.Node, keybase keys.Keybase, cleanup func())
+		*upgrades
+	}{
+		{name: "query state proof amino", memoryNodeFn: NewInMemoryTendermintNodeAmino, upgrades: &upgrades{codecUpgrade: codecUpgrade{false, 7000}}},
+		{name: "query state proof proto", memoryNodeFn: NewInMemoryTendermintNodeProto, upgrades: &upgrades{codecUpgrade: codecUpgrade{true, 2}}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.upgrades != nil { // NOTE: Use to perform neccesary upgrades for test
+				codec.UpgradeHeight = tc.upgrades.codecUpgrade.height
+				_ = memCodecMod(tc.upgrades.codecUpgrade.upgradeMod)
+			}
+			_, kb, cleanup := tc.memoryNodeFn(t, oneAppTwoNodeGenesis())
+			_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+			for PCA.LastBlockHeight() < 3 {
+				<-evtChan // Wait for block
+			}
+			// the app hash of a height is in the header of the next block
+			height := PCA.LastBlockHeight() - 1
+			appHash := PCA.BlockStore().LoadBlockMeta(height + 1).Header.AppHash
+
+			acc := getUnstakedAccount(kb)
+			res, err := PCA.QueryAccountProof(acc.GetAddress().String(), height)
+			assert.Nil(t, err)
+			assert.NotEmpty(t, res.Value)
+			assert.Nil(t, client.VerifyStateProof(client.NewStateProof(authTypes.StoreKey, res), appHash))
+			res, err = PCA.QueryAccountProof(hex.EncodeToString(crypto.GenerateEd25519PrivKey().PublicKey().Address()), height)
+			assert.Nil(t, err)
+			assert.Empty(t, res.Value)
+			assert.Nil(t, client.VerifyStateProof(client.NewStateProof(authTypes.StoreKey, res), appHash))
+
+			cb, err := kb.GetCoinbase()
+			assert.Nil(t, err)
+			res, err = PCA.QueryNodeProof(cb.GetAddress().String(), height)
+			assert.Nil(t, err)
+			assert.Nil(t, client.VerifyStateProof(client.NewStateProof(types2.StoreKey, res), appHash))
+
+			res, err = PCA.QueryParamProof(height, "pocketcore/SupportedBlockchains")
+			assert.Nil(t, err)
+			assert.NotEmpty(t, res.Value)
+			proof := client.NewStateProof(sdk.ParamsKey.Name(), res)
+			assert.Nil(t, client.VerifyStateProof(proof, appHash))
+			assert.NotNil(t, client.VerifyStateProof(proof, PCA.LastCommitID().Hash))
+
+			cleanup()
+			stopCli()
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/pokt-network/pocket-core/store/snapshots"
+	sdk "github.com/pokt-network/pocket-core/types"
+	amino "github.com/tendermint/go-amino"
+	abci "github.com/tendermint/tendermint/abci/types"
+	sm "github.com/tendermint/tendermint/state"
+	tmStore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+	db "github.com/tendermint/tm-db"
+)
+
+// The items a snapshot carries after the application state
+const (
+	snapshotItemValidators = snapshots.ItemTypeApp + iota
+	snapshotItemConsensusParams
+	snapshotItemBlock
+)
+
+var snapshotCdc = newSnapshotCodec()
+
+func newSnapshotCodec() *amino.Codec {
+	c := amino.NewCodec()
+	tmtypes.RegisterBlockAmino(c)
+	return c
+}
+
+type snapshotValidators struct {
+	Height     int64                 `json:"height"`
+	Validators *tmtypes.ValidatorSet `json:"validators"`
+}
+
+type snapshotConsensusParams struct {
+	Height          int64                   `json:"height"`
+	ConsensusParams tmtypes.ConsensusParams `json:"consensus_params"`
+}
+
+// SnapshotsDir returns the directory the snapshots of the node are kept in
+func SnapshotsDir(config sdk.Config) string {
+	return filepath.Join(config.PocketConfig.DataDir, sdk.SnapshotsDirName)
+}
+
+// CreateSnapshot writes a snapshot of the given height to the directory: the versions of the application state still
+// read through PrevCtx at that height, the blocks committing them and the validators and consensus params needed to
+// verify them and to continue from the height
+func (app *PocketCoreApp) CreateSnapshot(height int64, stateDB db.DB, dir string) (snapshots.Metadata, error) {
+	if height <= 0 || height > app.LastBlockHeight() {
+		return snapshots.Metadata{}, fmt.Errorf("the snapshot height: %d must be between 1 and the latest height: %d", height, app.LastBlockHeight())
+	}
+	ctx := sdk.NewContext(app.Store(), abci.Header{Height: height}, false, app.Logger())
+	return app.createSnapshot(app.snapshotFrom(ctx, height), height, stateDB, dir)
+}
+
+// the first version of a snapshot at the height
+func (app *PocketCoreApp) snapshotFrom(ctx sdk.Ctx, height int64) int64 {
+	from := height - app.pocketKeeper.PrevCtxRetention(ctx)
+	if from < 1 {
+		return 1
+	}
+	return from
+}
+
+func (app *PocketCoreApp) createSnapshot(from, height int64, stateDB db.DB, dir string) (snapshots.Metadata, error) {
+	blockStore := app.BlockStore()
+	if blockStore == nil || stateDB == nil {
+		return snapshots.Metadata{}, fmt.Errorf("the block store and the state db are needed to create a snapshot")
+	}
+	// the next block carries the commit and the results of the height
+	if blockStore.Base() > from || blockStore.Height() < height+1 {
+		return snapshots.Metadata{}, fmt.Errorf("the blocks %d to %d are needed to create a snapshot at height %d, the block store has %d to %d", from, height+1, height, blockStore.Base(), blockStore.Height())
+	}
+	w, err := snapshots.NewWriter(dir, height, snapshots.DefaultChunkSize)
+	if err != nil {
+		return snapshots.Metadata{}, err
+	}
+	meta, err := writeSnapshot(w, app.Store(), blockStore, stateDB, from, height)
+	if err != nil {
+		w.Abort()
+		return snapshots.Metadata{}, err
+	}
+	return meta, nil
+}
+
+func writeSnapshot(w *snapshots.Writer, cms sdk.CommitMultiStore, blockStore *tmStore.BlockStore, stateDB db.DB, from, height int64) (snapshots.Metadata, error) {
+	appHash, err := cms.ExportSnapshot(from, height, w)
+	if err != nil {
+		return snapshots.Metadata{}, err
+	}
+	// the validators and consensus params come first so that each block is verified as it is restored
+	for h := from; h <= height+2; h++ {
+		vals, err := sm.LoadValidators(stateDB, h)
+		if err != nil {
+			return snapshots.Metadata{}, err
+		}
+		if err := writeSnapshotItem(w, snapshotItemValidators, snapshotValidators{Height: h, Validators: vals}); err != nil {
+			return snapshots.Metadata{}, err
+		}
+	}
+	for h := from; h <= height+1; h++ {
+		params, err := sm.LoadConsensusParams(stateDB, h)
+		if err != nil {
+			return snapshots.Metadata{}, err
+		}
+		if err := writeSnapshotItem(w, snapshotItemConsensusParams, snapshotConsensusParams{Height: h, ConsensusParams: params}); err != nil {
+			return snapshots.Metadata{}, err
+		}
+	}
+	for h := from; h <= height+1; h++ {
+		block := blockStore.LoadBlock(h)
+		if block == nil {
+			return snapshots.Metadata{}, fmt.Errorf("block %d not found", h)
+		}
+		if err := writeSnapshotItem(w, snapshotItemBlock, block); err != nil {
+			return snapshots.Metadata{}, err
+		}
+	}
+	return w.Close(appHash)
+}
+
+func writeSnapshotItem(w *snapshots.Writer, typ byte, o interface{}) error {
+	bz, err := snapshotCdc.MarshalBinaryBare(o)
+	if err != nil {
+		return err
+	}
+	return w.WriteItem(typ, bz)
+}
+
+// snapshot creates the snapshot of the height in the background, unless one is still being created
+func (app *PocketCoreApp) snapshot(ctx sdk.Ctx, height int64) {
+	if app.stateDB == nil || !atomic.CompareAndSwapInt32(&app.snapshotting, 0, 1) {
+		return
+	}
+	from := app.snapshotFrom(ctx, height)
+	// the versions are held before the commit of this block, whose pruning keeps no more than the PrevCtx retention,
+	// and released once written
+	release, err := app.Store().HoldVersions(from, height)
+	if err != nil {
+		atomic.StoreInt32(&app.snapshotting, 0)
+		app.Logger().Error(fmt.Sprintf("unable to create the snapshot at height %d: %s", height, err.Error()))
+		return
+	}
+	go func() {
+		defer atomic.StoreInt32(&app.snapshotting, 0)
+		defer release()
+		dir := SnapshotsDir(GlobalConfig)
+		meta, err := app.createSnapshot(from, height, app.stateDB, dir)
+		if err != nil {
+			app.Logger().Error(fmt.Sprintf("unable to create the snapshot at height %d: %s", height, err.Error()))
+			return
+		}
+		app.Logger().Info(fmt.Sprintf("created the snapshot at height %d with app hash %s in %d chunks", height, meta.AppHash, len(meta.Chunks)))
+		if err := snapshots.Prune(dir, GlobalConfig.PocketConfig.Snapshots.KeepRecent); err != nil {
+			app.Logger().Error(fmt.Sprintf("unable to prune the snapshots: %s", err.Error()))
+		}
+	}()
+}
+
+// RestoreSnapshot restores a snapshot into the empty application state, block store and state db, checking the
+// state of each version against the app hash of the next block, the blocks against each other and against their
+// validators, and the commit of each block against the validators of its height. Returns the id of the block at the
+// snapshot height, which must be compared to the block of a trusted source
+func (app *PocketCoreApp) RestoreSnapshot(r *snapshots.Reader, blockStore *tmStore.BlockStore, stateDB db.DB) (tmtypes.BlockID, error) {
+	if blockStore.Height() != 0 || !sm.LoadState(stateDB).IsEmpty() {
+		return tmtypes.BlockID{}, fmt.Errorf("a snapshot can only be restored into an empty data directory")
+	}
+	restore := &snapshotRestore{
+		blockStore: blockStore,
+		height:     r.Metadata().Height,
+		validators: make(map[int64]*tmtypes.ValidatorSet),
+		params:     make(map[int64]tmtypes.ConsensusParams),
+		appHashes:  make(map[int64][]byte),
+	}
+	commitIDs, err := app.Store().RestoreSnapshot(r, restore.addItem)
+	if err != nil {
+		return tmtypes.BlockID{}, err
+	}
+	return restore.finish(commitIDs, stateDB)
+}
+
+// snapshotRestore verifies and saves the blocks of a snapshot as they are read
+type snapshotRestore struct {
+	blockStore *tmStore.BlockStore
+	height     int64
+	validators map[int64]*tmtypes.ValidatorSet
+	params     map[int64]tmtypes.ConsensusParams
+	// the app hash of each block, which is the state after the previous one
+	appHashes map[int64][]byte
+	first     int64
+	// the last saved block and the block read after it, saved once the block after it gives its commit
+	last      *tmtypes.Block
+	prev      *tmtypes.Block
+	prevParts *tmtypes.PartSet
+}
+
+func (sr *snapshotRestore) addItem(typ byte, bz []byte) error {
+	switch typ {
+	case snapshotItemValidators:
+		var item snapshotValidators
+		if err := snapshotCdc.UnmarshalBinaryBare(bz, &item); err != nil {
+			return err
+		}
+		sr.validators[item.Height] = item.Validators
+	case snapshotItemConsensusParams:
+		var item snapshotConsensusParams
+		if err := snapshotCdc.UnmarshalBinaryBare(bz, &item); err != nil {
+			return err
+		}
+		sr.params[item.Height] = item.ConsensusParams
+	case snapshotItemBlock:
+		block := new(tmtypes.Block)
+		if err := snapshotCdc.UnmarshalBinaryBare(bz, block); err != nil {
+			return err
+		}
+		if err := sr.addBlock(block); err != nil {
+			return fmt.Errorf("invalid block %d in the snapshot: %s", block.Height, err.Error())
+		}
+	default:
+		return fmt.Errorf("unknown snapshot item type %d", typ)
+	}
+	return nil
+}
+
+func (sr *snapshotRestore) addBlock(block *tmtypes.Block) error {
+	if err := block.ValidateBasic(); err != nil {
+		return err
+	}
+	h := block.Height
+	if sr.prev == nil {
+		sr.first = h
+	} else if h != sr.prev.Height+1 || block.ChainID != sr.prev.ChainID {
+		return fmt.Errorf("the block does not follow block %d of %s", sr.prev.Height, sr.prev.ChainID)
+	}
+	if h > sr.height+1 {
+		return fmt.Errorf("the snapshot at height %d ends at block %d", sr.height, sr.height+1)
+	}
+	vals, nextVals := sr.validators[h], sr.validators[h+1]
+	if vals == nil || !bytes.Equal(vals.Hash(), block.ValidatorsHash) {
+		return fmt.Errorf("the validators do not match the validators hash %X", block.ValidatorsHash)
+	}
+	if nextVals == nil || !bytes.Equal(nextVals.Hash(), block.NextValidatorsHash) {
+		return fmt.Errorf("the next validators do not match the next validators hash %X", block.NextValidatorsHash)
+	}
+	params, ok := sr.params[h]
+	if !ok || !bytes.Equal(params.Hash(), block.ConsensusHash) {
+		return fmt.Errorf("the consensus params do not match the consensus hash %X", block.ConsensusHash)
+	}
+	if sr.prev != nil {
+		prevID := tmtypes.BlockID{Hash: sr.prev.Hash(), PartsHeader: sr.prevParts.Header()}
+		if !block.LastBlockID.Equals(prevID) {
+			return fmt.Errorf("the last block id %v does not match block %d", block.LastBlockID, sr.prev.Height)
+		}
+		if err := sr.validators[h-1].VerifyCommit(block.ChainID, prevID, h-1, block.LastCommit); err != nil {
+			return err
+		}
+		sr.blockStore.SaveBlock(sr.prev, sr.prevParts, block.LastCommit)
+		sr.last = sr.prev
+	}
+	sr.appHashes[h] = block.AppHash
+	sr.prev, sr.prevParts = block, block.MakePartSet(tmtypes.BlockPartSizeBytes)
+	return nil
+}
+
+func (sr *snapshotRestore) finish(commitIDs []sdk.CommitID, stateDB db.DB) (tmtypes.BlockID, error) {
+	if sr.last == nil || sr.prev.Height != sr.height+1 || sr.first != commitIDs[0].Version || commitIDs[len(commitIDs)-1].Version != sr.height {
+		return tmtypes.BlockID{}, fmt.Errorf("the snapshot at height %d must have the blocks of its versions and the next block", sr.height)
+	}
+	for _, id := range commitIDs {
+		if !bytes.Equal(sr.appHashes[id.Version+1], id.Hash) {
+			return tmtypes.BlockID{}, fmt.Errorf("the state of version %d does not match the app hash %X of block %d", id.Version, sr.appHashes[id.Version+1], id.Version+1)
+		}
+	}
+	h, next := sr.height, sr.prev
+	state := sm.State{
+		Version:                          sm.Version{Consensus: sr.last.Version, Software: version.TMCoreSemVer},
+		ChainID:                          sr.last.ChainID,
+		LastBlockHeight:                  h,
+		LastBlockTotalTx:                 sr.last.TotalTxs,
+		LastBlockID:                      next.LastBlockID,
+		LastBlockTime:                    sr.last.Time,
+		NextValidators:                   sr.validators[h+2],
+		Validators:                       sr.validators[h+1],
+		LastValidators:                   sr.validators[h],
+		LastHeightValidatorsChanged:      h + 1,
+		ConsensusParams:                  sr.params[h+1],
+		LastHeightConsensusParamsChanged: h + 1,
+		LastResultsHash:                  next.LastResultsHash,
+		AppHash:                          next.AppHash,
+	}
+	if state.NextValidators == nil {
+		return tmtypes.BlockID{}, fmt.Errorf("the snapshot is missing the validators of height %d", h+2)
+	}
+	// save the validators and consensus params of the restored blocks, then the state at the snapshot height
+	for k := sr.first - 1; k < h; k++ {
+		past := state.Copy()
+		past.LastBlockHeight = k
+		past.Validators, past.NextValidators = sr.validators[k+1], sr.validators[k+2]
+		past.LastHeightValidatorsChanged = k + 2
+		past.ConsensusParams, past.LastHeightConsensusParamsChanged = sr.params[k+1], k+1
+		sm.SaveState(stateDB, past)
+	}
+	sm.SaveState(stateDB, state)
+	return next.LastBlockID, nil
+}
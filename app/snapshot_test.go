@@ -0,0 +1,66 @@
+package app
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/store/snapshots"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	tmStore "github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestCreateRestoreSnapshot(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	defer cleanup()
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	defer stopCli()
+	for i := 0; i < 4; i++ {
+		<-evtChan // Wait for block
+	}
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// the next block is needed to create a snapshot
+	_, err = PCA.CreateSnapshot(PCA.LastBlockHeight(), getInMemoryDB(), dir)
+	assert.Error(t, err)
+	meta, err := PCA.CreateSnapshot(2, getInMemoryDB(), dir)
+	require.Nil(t, err)
+	block3 := PCA.BlockStore().LoadBlock(3)
+	assert.Equal(t, hex.EncodeToString(block3.AppHash), meta.AppHash)
+
+	r, err := snapshots.NewReader(dir, 2)
+	require.Nil(t, err)
+	restored := GetApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+	blockStore, stateDB := tmStore.NewBlockStore(dbm.NewMemDB()), dbm.NewMemDB()
+	blockID, err := restored.RestoreSnapshot(r, blockStore, stateDB)
+	require.Nil(t, err)
+	assert.Equal(t, block3.LastBlockID, blockID)
+	assert.Equal(t, int64(2), restored.Store().LastCommitID().Version)
+	assert.Equal(t, []byte(block3.AppHash), restored.Store().LastCommitID().Hash)
+	assert.Equal(t, int64(1), blockStore.Base())
+	assert.Equal(t, int64(2), blockStore.Height())
+	assert.Equal(t, block3.LastCommit, blockStore.LoadSeenCommit(2))
+	state := sm.LoadState(stateDB)
+	assert.Equal(t, int64(2), state.LastBlockHeight)
+	assert.Equal(t, []byte(block3.AppHash), state.AppHash)
+	vals, err := sm.LoadValidators(stateDB, 3)
+	require.Nil(t, err)
+	assert.Equal(t, block3.ValidatorsHash.Bytes(), vals.Hash())
+
+	// a snapshot is only restored into empty databases
+	r, err = snapshots.NewReader(dir, 2)
+	require.Nil(t, err)
+	_, err = GetApp(log.NewNopLogger(), dbm.NewMemDB(), nil).RestoreSnapshot(r, blockStore, stateDB)
+	assert.Error(t, err)
+}
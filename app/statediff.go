@@ -0,0 +1,120 @@
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	StateAdded    = "added"
+	StateRemoved  = "removed"
+	StateModified = "modified"
+)
+
+// StateDiffModules are the stores of each module, as named by the state-diff command
+var StateDiffModules = map[string][]string{
+	"auth":       {authTypes.StoreKey},
+	"nodes":      {nodesTypes.StoreKey},
+	"apps":       {appsTypes.StoreKey},
+	"pocketcore": {pocketTypes.StoreKey},
+	"gov":        {govTypes.StoreKey, sdk.ParamsKey.Name()},
+}
+
+// StateChange is a key changed between two heights. The values are the json of the module objects where the prefix of
+// the key is known, and hex strings otherwise
+type StateChange struct {
+	Store  string          `json:"store"`
+	Key    string          `json:"key"`
+	Change string          `json:"change"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// DiffState returns the keys of the stores changed from one height to a later one, in store then key order, every store
+// when none is given. The versioned trees are compared so that only their changed nodes are read
+func (app *PocketCoreApp) DiffState(from, to int64, storeNames ...string) ([]StateChange, error) {
+	if len(storeNames) == 0 {
+		for name := range app.Keys {
+			storeNames = append(storeNames, name)
+		}
+	}
+	sort.Strings(storeNames)
+	fromCtx := sdk.NewContext(app.Store(), abci.Header{Height: from}, false, app.Logger())
+	toCtx := sdk.NewContext(app.Store(), abci.Header{Height: to}, false, app.Logger())
+	var changes []StateChange
+	for _, name := range storeNames {
+		kvChanges, err := app.Store().DiffVersions(name, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("unable to diff store %s: %s", name, err.Error())
+		}
+		for _, c := range kvChanges {
+			change := StateChange{Store: name, Key: hex.EncodeToString(c.Key), Change: StateModified}
+			switch {
+			case c.Before == nil:
+				change.Change = StateAdded
+			case c.After == nil:
+				change.Change = StateRemoved
+			}
+			if c.Before != nil {
+				change.Before = app.decodeStateValue(fromCtx, name, c.Key, c.Before)
+			}
+			if c.After != nil {
+				change.After = app.decodeStateValue(toCtx, name, c.Key, c.After)
+			}
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// decodeStateValue returns the json of the module object stored under the key at the height of the context, falling
+// back on the hex of the value for the unknown prefixes and the values that fail to decode
+func (app *PocketCoreApp) decodeStateValue(ctx sdk.Ctx, storeName string, key, value []byte) json.RawMessage {
+	height := ctx.BlockHeight()
+	var obj interface{}
+	var err error
+	switch {
+	case storeName == authTypes.StoreKey && bytes.HasPrefix(key, authTypes.AddressStoreKeyPrefix):
+		obj, err = app.accountKeeper.DecodeAccount(value, ctx)
+	case storeName == nodesTypes.StoreKey && bytes.HasPrefix(key, nodesTypes.AllValidatorsKey):
+		obj, err = app.nodesKeeper.UnmarshalValidator(ctx, value)
+	case storeName == nodesTypes.StoreKey && bytes.HasPrefix(key, nodesTypes.ValidatorSigningInfoKey):
+		var info nodesTypes.ValidatorSigningInfo
+		err = app.cdc.UnmarshalBinaryLengthPrefixed(value, &info, height)
+		obj = info
+	case storeName == appsTypes.StoreKey && bytes.HasPrefix(key, appsTypes.AllApplicationsKey):
+		obj, err = appsTypes.UnmarshalApplication(app.cdc, ctx, value)
+	case storeName == pocketTypes.StoreKey && bytes.HasPrefix(key, pocketTypes.ClaimKey):
+		var claim pocketTypes.MsgClaim
+		if err = app.cdc.UnmarshalBinaryBare(value, &claim, height); err == nil {
+			if bz, err := app.cdc.MarshalJSON(claim); err == nil {
+				return bz
+			}
+		}
+	case storeName == govTypes.StoreKey && bytes.HasPrefix(key, govTypes.ProposalsKey):
+		var proposal govTypes.Proposal
+		err = app.cdc.UnmarshalBinaryLengthPrefixed(value, &proposal, height)
+		obj = proposal
+	case storeName == sdk.ParamsKey.Name() && json.Valid(value):
+		// the parameters are stored as json
+		return value
+	}
+	if obj != nil && err == nil {
+		if bz, err := json.Marshal(obj); err == nil {
+			return bz
+		}
+	}
+	bz, _ := json.Marshal(hex.EncodeToString(value))
+	return bz
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/pokt-network/pocket-core/x/nodes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDiffState(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	_, kb, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	defer cleanup()
+	cb, err := kb.GetCoinbase()
+	require.Nil(t, err)
+	kp, err := kb.Create("test")
+	require.Nil(t, err)
+	_, _, blockChan := subscribeTo(t, tmTypes.EventNewBlock)
+	for PCA.LastBlockHeight() < 2 {
+		<-blockChan // Wait for block
+	}
+	from := PCA.LastBlockHeight()
+	memCli, stopCli, txChan := subscribeTo(t, tmTypes.EventTx)
+	defer stopCli()
+	tx, err := nodes.Send(memCodec(), memCli, kb, cb.GetAddress(), kp.GetAddress(), "test", sdk.NewInt(1000), true)
+	require.Nil(t, err)
+	require.Equal(t, 0, int(tx.Code))
+	<-txChan // Wait for tx
+	to := PCA.LastBlockHeight() + 1
+	for PCA.LastBlockHeight() < to {
+		<-blockChan // Wait for block
+	}
+
+	changes, err := PCA.DiffState(from, to, authTypes.StoreKey)
+	require.Nil(t, err)
+	var sender, recipient *StateChange
+	for i, change := range changes {
+		assert.Equal(t, authTypes.StoreKey, change.Store)
+		switch change.Key {
+		case hex.EncodeToString(authTypes.AddressStoreKey(cb.GetAddress())):
+			sender = &changes[i]
+		case hex.EncodeToString(authTypes.AddressStoreKey(kp.GetAddress())):
+			recipient = &changes[i]
+		}
+	}
+	require.NotNil(t, sender)
+	assert.Equal(t, StateModified, sender.Change)
+	assert.NotEqual(t, string(sender.Before), string(sender.After))
+	require.NotNil(t, recipient)
+	assert.Equal(t, StateAdded, recipient.Change)
+	assert.Nil(t, recipient.Before)
+	// the account is decoded rather than left in hex
+	assert.Contains(t, string(recipient.After), kp.GetAddress().String())
+	assert.Contains(t, string(recipient.After), "1000")
+
+	// every store is compared when none is given, and a height cannot be compared with an earlier one
+	all, err := PCA.DiffState(from, to)
+	require.Nil(t, err)
+	assert.True(t, len(all) > len(changes))
+	_, err = PCA.DiffState(to, from)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,175 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	govKeeper "github.com/pokt-network/pocket-core/x/gov/keeper"
+)
+
+const (
+	// UpgradesDirName is the directory of the datadir holding the binary of each upgrade version
+	UpgradesDirName = "upgrades"
+	// UpgradeBinaryName is the name of the binary under <datadir>/upgrades/<version>/bin
+	UpgradeBinaryName = "pocket"
+	// UpgradeInfoFileName is the file written by a supervised node when it halts for an upgrade
+	UpgradeInfoFileName = "upgrade-info.json"
+	// UpgradeExitCode is the exit code of a node halted at the height of an upgrade
+	UpgradeExitCode = 2
+	// SupervisedEnv is set by the supervisor in the environment of the node it runs
+	SupervisedEnv = "POCKET_SUPERVISED"
+)
+
+// UpgradeInfo is the upgrade a supervised node halted for
+type UpgradeInfo struct {
+	Version string `json:"version"`
+	Height  int64  `json:"height"`
+}
+
+// UpgradeBinaryPath - Returns the path of the binary for the upgrade version
+func UpgradeBinaryPath(datadir, version string) string {
+	return filepath.Join(datadir, UpgradesDirName, version, "bin", UpgradeBinaryName)
+}
+
+// FindUpgradeBinary - Returns the path of the binary for the upgrade version if it is an executable file
+func FindUpgradeBinary(datadir, version string) (string, error) {
+	path := UpgradeBinaryPath(datadir, version)
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() || info.Mode()&0111 == 0 {
+		return "", fmt.Errorf("%s is not an executable file", path)
+	}
+	return path, nil
+}
+
+// WriteUpgradeInfo - Records the upgrade the node halted for under <datadir>/upgrades
+func WriteUpgradeInfo(datadir string, info UpgradeInfo) error {
+	dir := filepath.Join(datadir, UpgradesDirName)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(info, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, UpgradeInfoFileName), bz, 0644)
+}
+
+// ReadUpgradeInfo - Returns the last upgrade the node halted for, if any
+func ReadUpgradeInfo(datadir string) (info UpgradeInfo, found bool, err error) {
+	bz, err := ioutil.ReadFile(filepath.Join(datadir, UpgradesDirName, UpgradeInfoFileName))
+	if os.IsNotExist(err) {
+		return info, false, nil
+	}
+	if err != nil {
+		return info, false, err
+	}
+	if err = json.Unmarshal(bz, &info); err != nil {
+		return info, false, err
+	}
+	return info, true, nil
+}
+
+// the node is run by the supervisor
+var supervised = os.Getenv(SupervisedEnv) != ""
+
+// haltForUpgrade - Exits before the block at the height of an upgrade to a newer version is processed,
+// leaving the upgrade for the supervisor to switch binaries
+func (app *PocketCoreApp) haltForUpgrade(ctx sdk.Ctx) {
+	u := app.govKeeper.GetUpgrade(ctx)
+	if u.Version == govKeeper.FeatureUpgradeKey || ctx.BlockHeight() == 0 || ctx.BlockHeight() != u.UpgradeHeight() || ctx.AppVersion() >= u.Version {
+		return
+	}
+	err := WriteUpgradeInfo(GlobalConfig.PocketConfig.DataDir, UpgradeInfo{Version: u.Version, Height: u.Height})
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("unable to write the upgrade info: %s", err.Error()))
+		os.Exit(1)
+	}
+	ctx.Logger().Info(fmt.Sprintf("halting at height %d for the upgrade to version %s", ctx.BlockHeight(), u.Version))
+	ShutdownPocketCore()
+	os.Exit(UpgradeExitCode)
+}
+
+// Supervisor runs the node and switches to the binary of each upgrade at its height
+type Supervisor struct {
+	DataDir string
+	Binary  string
+	Args    []string
+}
+
+// NewSupervisor - Returns a supervisor running the binary of the last upgrade the node halted for,
+// or the current executable if there is none
+func NewSupervisor(datadir string, args []string) (*Supervisor, error) {
+	binary, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	info, found, err := ReadUpgradeInfo(datadir)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		if path, err := FindUpgradeBinary(datadir, info.Version); err == nil {
+			binary = path
+		}
+	}
+	return &Supervisor{DataDir: datadir, Binary: binary, Args: args}, nil
+}
+
+// Run - Runs the node until it exits for a reason other than an upgrade with an available binary
+func (s *Supervisor) Run() error {
+	signalChannel := make(chan os.Signal, 1)
+	signal.Notify(signalChannel, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+	defer signal.Stop(signalChannel)
+	for {
+		cmd := exec.Command(s.Binary, s.Args...)
+		cmd.Env = append(os.Environ(), SupervisedEnv+"=true")
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		done := make(chan struct{})
+		go func() {
+			for {
+				select {
+				case sig := <-signalChannel:
+					_ = cmd.Process.Signal(sig)
+				case <-done:
+					return
+				}
+			}
+		}()
+		err := cmd.Wait()
+		close(done)
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() != UpgradeExitCode {
+			return err
+		}
+		info, found, err := ReadUpgradeInfo(s.DataDir)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("the node exited with code %d without an upgrade info", UpgradeExitCode)
+		}
+		binary, err := FindUpgradeBinary(s.DataDir, info.Version)
+		if err != nil {
+			return fmt.Errorf("the node halted at height %d for the upgrade to version %s but no binary is available: %s", info.Height, info.Version, err.Error())
+		}
+		if binary == s.Binary {
+			return fmt.Errorf("the binary %s halted for its own upgrade to version %s", binary, info.Version)
+		}
+		fmt.Printf("switching to the binary %s for the upgrade to version %s at height %d\n", binary, info.Version, info.Height)
+		s.Binary = binary
+	}
+}
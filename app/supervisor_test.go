@@ -0,0 +1,68 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeScript(t *testing.T, path, body string) {
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	assert.Nil(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0755))
+}
+
+func TestSupervisor_Run(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("dummy binaries are shell scripts")
+	}
+	datadir := t.TempDir()
+	marker := filepath.Join(datadir, "marker")
+	// the current binary halts for the upgrade, the upgrade binary records its args and environment
+	current := filepath.Join(datadir, "current")
+	writeScript(t, current, `mkdir -p "$2/upgrades" && printf '{"version":"2.0.0","height":10}' > "$2/upgrades/upgrade-info.json" && exit 2`)
+	s := &Supervisor{DataDir: datadir, Binary: current, Args: []string{"start", datadir}}
+	// no binary for the upgrade
+	assert.NotNil(t, s.Run())
+	writeScript(t, UpgradeBinaryPath(datadir, "2.0.0"), `echo "$1 $`+SupervisedEnv+`" > "`+marker+`"`)
+	s.Binary = current
+	assert.Nil(t, s.Run())
+	assert.Equal(t, UpgradeBinaryPath(datadir, "2.0.0"), s.Binary)
+	bz, err := ioutil.ReadFile(marker)
+	assert.Nil(t, err)
+	assert.Equal(t, "start true\n", string(bz))
+	// a restarted supervisor picks the binary of the last upgrade
+	restarted, err := NewSupervisor(datadir, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, UpgradeBinaryPath(datadir, "2.0.0"), restarted.Binary)
+}
+
+func TestSupervisor_RunExitError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("dummy binaries are shell scripts")
+	}
+	datadir := t.TempDir()
+	failing := filepath.Join(datadir, "failing")
+	writeScript(t, failing, "exit 1")
+	s := &Supervisor{DataDir: datadir, Binary: failing}
+	assert.NotNil(t, s.Run())
+	assert.Equal(t, failing, s.Binary)
+}
+
+func TestFindUpgradeBinary(t *testing.T) {
+	datadir := t.TempDir()
+	_, err := FindUpgradeBinary(datadir, "2.0.0")
+	assert.NotNil(t, err)
+	path := UpgradeBinaryPath(datadir, "2.0.0")
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	assert.Nil(t, ioutil.WriteFile(path, []byte{}, 0644))
+	_, err = FindUpgradeBinary(datadir, "2.0.0")
+	assert.NotNil(t, err)
+	assert.Nil(t, os.Chmod(path, 0755))
+	found, err := FindUpgradeBinary(datadir, "2.0.0")
+	assert.Nil(t, err)
+	assert.Equal(t, path, found)
+}
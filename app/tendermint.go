@@ -7,12 +7,15 @@ import (
 	"path/filepath"
 
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -43,16 +46,35 @@ func NewClient(c config, creator AppCreator) (*node.Node, *PocketCoreApp, error)
 	// upgrade the privVal file
 	app := creator(c.Logger, appDB, traceWriter)
 	PCA = app
+	transactionIndexer.SetSessionTagger(app.TxSessionTags)
+	privVal := pvm.LoadOrGenFilePV(c.TmConfig.PrivValidatorKeyFile(), c.TmConfig.PrivValidatorStateFile())
+	// set the txs kept by the transaction indexer
+	txIndexerConfig := GlobalConfig.PocketConfig.TxIndexer
+	var localAddrs []sdk.Address
+	if txIndexerConfig.LocalOnly {
+		localAddrs = append(localAddrs, sdk.Address(privVal.Key.Address))
+		if sessionKey, ok := pocketTypes.GetSessionKeyFile(); ok {
+			localAddrs = append(localAddrs, sdk.Address(sessionKey.Address))
+		}
+	}
+	transactionIndexer.SetRetention(txIndexerConfig.RetainBlocks, localAddrs, txIndexerConfig.PruneBatch, c.Logger.With("module", "txindexer"))
 	// create & start tendermint node
 	tmNode, err := node.NewNode(app,
 		c.TmConfig,
 		codec.GetCodecUpgradeHeight(),
-		pvm.LoadOrGenFilePV(c.TmConfig.PrivValidatorKeyFile(), c.TmConfig.PrivValidatorStateFile()),
+		privVal,
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		transactionIndexer,
 		node.DefaultGenesisDocProviderFunc(c.TmConfig),
-		node.DefaultDBProvider,
+		func(ctx *node.DBContext) (dbm.DB, error) {
+			// keep the state db for the snapshots
+			stateDB, err := node.DefaultDBProvider(ctx)
+			if err == nil && ctx.ID == "state" {
+				app.stateDB = stateDB
+			}
+			return stateDB, err
+		},
 		node.DefaultMetricsProvider(c.TmConfig.Instrumentation),
 		c.Logger.With("module", "node"),
 	)
@@ -63,14 +85,40 @@ func NewClient(c config, creator AppCreator) (*node.Node, *PocketCoreApp, error)
 	return tmNode, app, nil
 }
 
+// TxSessionTags returns the chain and the session height of the claim and proof txs, for the transaction indexer
+func (app *PocketCoreApp) TxSessionTags(txBz tmtypes.Tx, height int64) (chain string, sessionHeight int64, ok bool) {
+	tx, err := auth.DefaultTxDecoder(app.cdc)(txBz, height)
+	if err != nil {
+		return "", 0, false
+	}
+	var header pocketTypes.SessionHeader
+	switch msg := tx.GetMsg().(type) {
+	case pocketTypes.MsgClaim:
+		header = msg.SessionHeader
+	case *pocketTypes.MsgClaim:
+		header = msg.SessionHeader
+	case pocketTypes.MsgProof:
+		header = msg.GetLeaf().SessionHeader()
+	case *pocketTypes.MsgProof:
+		header = msg.GetLeaf().SessionHeader()
+	default:
+		return "", 0, false
+	}
+	return header.Chain, header.SessionBlockHeight, true
+}
+
 func OpenApplicationDB(config sdk.Config) (dbm.DB, error) {
-	dataDir := filepath.Join(config.TendermintConfig.RootDir, GlobalConfig.TendermintConfig.DBPath)
-	return sdk.NewLevelDB(sdk.ApplicationDBName, dataDir, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
+	return openDB(sdk.ApplicationDBName, config)
 }
 
 func OpenTxIndexerDB(config sdk.Config) (dbm.DB, error) {
+	return openDB(sdk.TransactionIndexerDBName, config)
+}
+
+// openDB opens the database with the configured backend, see sdk.OpenDB
+func openDB(name string, config sdk.Config) (dbm.DB, error) {
 	dataDir := filepath.Join(config.TendermintConfig.RootDir, GlobalConfig.TendermintConfig.DBPath)
-	return sdk.NewLevelDB(sdk.TransactionIndexerDBName, dataDir, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
+	return sdk.OpenDB(name, dataDir, config.PocketConfig.DBBackend, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
 }
 
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
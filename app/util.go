@@ -23,6 +23,14 @@ func GenerateAAT(appPubKey, clientPubKey string, key crypto.PrivateKey) (aatjson
 	return json.MarshalIndent(aat, "", "  ")
 }
 
+func GenerateScopedAAT(appPubKey, clientPubKey string, expirationHeight, maxRelaysPerSession int64, allowedChains []string, key crypto.PrivateKey) (aatjson []byte, err error) {
+	aat, er := pocketKeeper.ScopedAATGeneration(appPubKey, clientPubKey, expirationHeight, maxRelaysPerSession, allowedChains, key)
+	if er != nil {
+		return nil, er
+	}
+	return json.MarshalIndent(aat, "", "  ")
+}
+
 func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.PublicKeyMultiSig, fees int64, legacyCodec bool) ([]byte, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
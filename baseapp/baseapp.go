@@ -117,6 +117,10 @@ type BaseApp struct {
 
 	// application's version string
 	appVersion string
+
+	// pruning options set on the multistore, and the number of recent versions it must keep regardless
+	pruning       sdk.PruningOptions
+	minKeepRecent int64
 }
 
 var _ abci.Application = (*BaseApp)(nil)
@@ -133,6 +137,7 @@ func NewBaseApp(name string, logger log.Logger, db dbm.DB, cache bool, iavlCache
 		db:               db,
 		cdc:              cdc,
 		cms:              store.NewCommitMultiStore(db, cache, iavlCacheSize),
+		pruning:          store.PruneNothing,
 		router:           NewRouter(),
 		queryRouter:      NewQueryRouter(),
 		transactionCache: make(map[string]struct{}),
@@ -146,6 +151,19 @@ func NewBaseApp(name string, logger log.Logger, db dbm.DB, cache bool, iavlCache
 	return app
 }
 
+// RetainRecentVersions keeps at least the given number of recent versions in a pruning multistore
+func (app *BaseApp) RetainRecentVersions(minKeepRecent int64) {
+	if app.pruning.KeepEvery() == 1 || minKeepRecent == app.minKeepRecent {
+		return
+	}
+	app.minKeepRecent = minKeepRecent
+	keepRecent := app.pruning.KeepRecent()
+	if keepRecent < minKeepRecent {
+		keepRecent = minKeepRecent
+	}
+	app.cms.SetPruning(store.NewPruningOptions(keepRecent, app.pruning.KeepEvery()))
+}
+
 func (app *BaseApp) SetTendermintNode(node *node.Node) {
 	app.tmNode = node
 }
@@ -13,7 +13,17 @@ import (
 
 // SetPruning sets a pruning option on the multistore associated with the app
 func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
-	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
+	return func(bap *BaseApp) {
+		bap.pruning = opts
+		bap.cms.SetPruning(opts)
+	}
+}
+
+// SetHeightCache sets the past heights kept by the height cache of the multistore and the memory they may use
+func SetHeightCache(depth, maxBytes int64) func(*BaseApp) {
+	return func(bap *BaseApp) {
+		bap.cms.SetHeightCache(depth, maxBytes)
+	}
 }
 
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
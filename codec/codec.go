@@ -43,6 +43,17 @@ const (
 	NonCustodialUpdateKey   = "NCUST"
 	TxCacheEnhancementKey   = "REDUP"
 	ReplayBurnKey           = "REPBR"
+	PartialUnstakeKey       = "PUNST"
+	ScopedTokenKey          = "AATV2"
+	AppTransferKey          = "APPTR"
+	GovProposalKey          = "GOVPR"
+	ScheduledParamKey       = "SCHPC"
+	MultisigACLKey          = "MSACL"
+	DAOStreamKey            = "DAOST"
+	UpgradeSignalKey        = "UPSIG"
+	SessionKeysKey          = "SKEYS"
+	FeeGrantKey             = "FGRNT"
+	ParamValidationKey      = "PARVL"
 )
 
 func GetCodecUpgradeHeight() int64 {
@@ -0,0 +1,127 @@
+package codec
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	FeatureInactive  = "inactive"  // not part of any upgrade
+	FeatureScheduled = "scheduled" // part of an upgrade, activation height not reached
+	FeatureActive    = "active"    // activation height reached
+)
+
+// Feature is a protocol change activated at the height set for its key in the upgrade features
+type Feature struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Module      string `json:"module"`
+}
+
+// FeatureStatus is the lifecycle of a feature at a height
+type FeatureStatus struct {
+	Key              string `json:"key"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Module           string `json:"module"`
+	Registered       bool   `json:"registered"`
+	ActivationHeight int64  `json:"activation_height"`
+	Status           string `json:"status"`
+}
+
+var featureRegistry = make(map[string]Feature)
+
+func init() {
+	RegisterFeature(Feature{Key: NonCustodialUpdateKey, Name: "Non Custodial", Module: "pos",
+		Description: "stake validators with an output address receiving the rewards and unstaked tokens"})
+	RegisterFeature(Feature{Key: TxCacheEnhancementKey, Name: "Tx Cache Enhancement", Module: "baseapp",
+		Description: "reject transactions already in the transaction cache"})
+	RegisterFeature(Feature{Key: ReplayBurnKey, Name: "Replay Burn", Module: "pocketcore",
+		Description: "burn the stake of nodes submitting replayed relay proofs"})
+	RegisterFeature(Feature{Key: PartialUnstakeKey, Name: "Partial Unstake", Module: "pos",
+		Description: "unstake part of the tokens of a validator"})
+	RegisterFeature(Feature{Key: ScopedTokenKey, Name: "Scoped AAT", Module: "pocketcore",
+		Description: "application auth tokens scoped to chains and expiry, and revocation of clients"})
+	RegisterFeature(Feature{Key: AppTransferKey, Name: "App Transfer", Module: "application",
+		Description: "transfer the stake of an application to a new public key"})
+	RegisterFeature(Feature{Key: ParamValidationKey, Name: "Param Validation", Module: "gov",
+		Description: "reject param changes failing to decode or validate, and merge map params entry by entry"})
+	RegisterFeature(Feature{Key: GovProposalKey, Name: "Gov Proposals", Module: "gov",
+		Description: "proposals voted by the validators, weighted by stake"})
+	RegisterFeature(Feature{Key: ScheduledParamKey, Name: "Scheduled Params", Module: "gov",
+		Description: "param changes applied at an activation height"})
+	RegisterFeature(Feature{Key: MultisigACLKey, Name: "Multisig ACL", Module: "gov",
+		Description: "multisig acl owners approving param changes on chain"})
+	RegisterFeature(Feature{Key: DAOStreamKey, Name: "DAO Streams", Module: "gov",
+		Description: "DAO funds released linearly to a recipient"})
+	RegisterFeature(Feature{Key: UpgradeSignalKey, Name: "Upgrade Signals", Module: "gov",
+		Description: "validators signalling the versions and features their binary supports"})
+	RegisterFeature(Feature{Key: SessionKeysKey, Name: "Session Keys", Module: "auth",
+		Description: "secondary keys signing a subset of the message types of an account, with a spend limit and expiry"})
+	RegisterFeature(Feature{Key: FeeGrantKey, Name: "Fee Grants", Module: "auth",
+		Description: "accounts paying the transaction fees of other accounts, with a spend limit, expiry and message types"})
+}
+
+// RegisterFeature - Declares a feature key, panics if the key is empty or already registered
+func RegisterFeature(f Feature) {
+	if f.Key == "" {
+		panic("cannot register a feature with an empty key")
+	}
+	if _, ok := featureRegistry[f.Key]; ok {
+		panic(fmt.Sprintf("feature %s already registered", f.Key))
+	}
+	featureRegistry[f.Key] = f
+}
+
+// GetFeature - Returns the registered feature with the key
+func GetFeature(key string) (f Feature, found bool) {
+	f, found = featureRegistry[key]
+	return
+}
+
+// RegisteredFeatures - Returns every registered feature sorted by key
+func RegisteredFeatures() []Feature {
+	features := make([]Feature, 0, len(featureRegistry))
+	for _, f := range featureRegistry {
+		features = append(features, f)
+	}
+	sort.Slice(features, func(i, j int) bool { return features[i].Key < features[j].Key })
+	return features
+}
+
+// FeatureStatuses - Returns the status at the height of every registered feature and of every unregistered
+// key in the upgrade features, sorted by key
+func FeatureStatuses(upgradeFeatures []string, height int64) []FeatureStatus {
+	activations := SliceToMap(upgradeFeatures)
+	statuses := make([]FeatureStatus, 0, len(featureRegistry))
+	for _, f := range RegisteredFeatures() {
+		statuses = append(statuses, newFeatureStatus(f, true, activations[f.Key], height))
+	}
+	for key, activation := range activations {
+		if _, ok := featureRegistry[key]; !ok {
+			statuses = append(statuses, newFeatureStatus(Feature{Key: key}, false, activation, height))
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Key < statuses[j].Key })
+	return statuses
+}
+
+func newFeatureStatus(f Feature, registered bool, activation, height int64) FeatureStatus {
+	status := FeatureInactive
+	switch {
+	case activation != 0 && height >= activation:
+		status = FeatureActive
+	case activation != 0:
+		status = FeatureScheduled
+	}
+	return FeatureStatus{
+		Key:              f.Key,
+		Name:             f.Name,
+		Description:      f.Description,
+		Module:           f.Module,
+		Registered:       registered,
+		ActivationHeight: activation,
+		Status:           status,
+	}
+}
@@ -0,0 +1,329 @@
+package badgerdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/dgraph-io/badger/v2"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// DirSuffix is appended to the name of a database to get its directory, so a badger database never shares the
+// directory of a leveldb database of the same name.
+const DirSuffix = ".badgerdb"
+
+// MaxTableSize is the size of the badger tables and memtables. A badger transaction holds up to 15% of it, so a batch
+// may hold 38MB of pairs (values of 1KB and over count as pointers) instead of the 9.6MB of the default 64MB tables,
+// enough for the IAVL commit of a block.
+const MaxTableSize = 256 << 20
+
+var errKeyEmpty = errors.New("badgerdb: key cannot be empty")
+
+// DB is a pure go database backed by badger (github.com/dgraph-io/badger).
+//
+// Like goleveldb, Set, Delete and batch writes are only flushed to disk by their Sync variants.
+type DB struct {
+	db *badger.DB
+}
+
+var _ dbm.DB = (*DB)(nil)
+
+// NewDB opens or creates the badger database of the given name in the directory.
+func NewDB(name, dir string) (*DB, error) {
+	opts := badger.DefaultOptions(filepath.Join(dir, name+DirSuffix))
+	opts.SyncWrites = false
+	opts.Logger = nil
+	// keep the default four tables in level one, and about the default memory of the memtables
+	opts.MaxTableSize = MaxTableSize
+	opts.LevelOneSize = 4 * MaxTableSize
+	opts.NumMemtables = 2
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{db: db}, nil
+}
+
+// Get implements DB.
+func (bdb *DB) Get(key []byte) (value []byte, err error) {
+	if len(key) == 0 {
+		return nil, errKeyEmpty
+	}
+	err = bdb.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		// tm-db returns an empty value for a key set to nil
+		if err == nil && value == nil {
+			value = []byte{}
+		}
+		return err
+	})
+	return value, err
+}
+
+// Has implements DB.
+func (bdb *DB) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, errKeyEmpty
+	}
+	err := bdb.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(key)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Set implements DB.
+func (bdb *DB) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	return bdb.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, value)
+	})
+}
+
+// SetSync implements DB.
+func (bdb *DB) SetSync(key, value []byte) error {
+	if err := bdb.Set(key, value); err != nil {
+		return err
+	}
+	return bdb.db.Sync()
+}
+
+// Delete implements DB.
+func (bdb *DB) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	return bdb.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+// DeleteSync implements DB.
+func (bdb *DB) DeleteSync(key []byte) error {
+	if err := bdb.Delete(key); err != nil {
+		return err
+	}
+	return bdb.db.Sync()
+}
+
+// Iterator implements DB.
+func (bdb *DB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return bdb.newIterator(start, end, false)
+}
+
+// ReverseIterator implements DB.
+func (bdb *DB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return bdb.newIterator(start, end, true)
+}
+
+// Close implements DB.
+func (bdb *DB) Close() error {
+	return bdb.db.Close()
+}
+
+// NewBatch implements DB.
+func (bdb *DB) NewBatch() dbm.Batch {
+	return &batch{db: bdb.db, txn: bdb.db.NewTransaction(true)}
+}
+
+// Print implements DB.
+func (bdb *DB) Print() error {
+	it, err := bdb.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", it.Key(), it.Value())
+	}
+	return nil
+}
+
+// Stats implements DB.
+func (bdb *DB) Stats() map[string]string {
+	lsm, vlog := bdb.db.Size()
+	return map[string]string{
+		"badger.lsm-size":  strconv.FormatInt(lsm, 10),
+		"badger.vlog-size": strconv.FormatInt(vlog, 10),
+	}
+}
+
+// Compact rewrites the value log files that are mostly garbage, as goleveldb compaction does for its tables.
+func (bdb *DB) Compact() error {
+	for {
+		err := bdb.db.RunValueLogGC(0.5)
+		if err == badger.ErrNoRewrite {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// batch writes its pairs in a single badger transaction, so a batch is committed entirely or not at all. A batch
+// larger than a badger transaction, see MaxTableSize, fails with badger.ErrTxnTooBig instead of being split.
+type batch struct {
+	db  *badger.DB
+	txn *badger.Txn
+	// the first failed write, reported when the batch is written
+	err error
+}
+
+var _ dbm.Batch = (*batch)(nil)
+
+// Set implements Batch.
+func (b *batch) Set(key, value []byte) {
+	if b.err == nil {
+		b.err = b.txn.Set(key, value)
+	}
+}
+
+// Delete implements Batch.
+func (b *batch) Delete(key []byte) {
+	if b.err == nil {
+		b.err = b.txn.Delete(key)
+	}
+}
+
+// Write implements Batch.
+func (b *batch) Write() error {
+	if b.err != nil {
+		return b.err
+	}
+	return b.txn.Commit()
+}
+
+// WriteSync implements Batch.
+func (b *batch) WriteSync() error {
+	if err := b.Write(); err != nil {
+		return err
+	}
+	return b.db.Sync()
+}
+
+// Close implements Batch.
+func (b *batch) Close() {
+	b.txn.Discard()
+}
+
+type iterator struct {
+	txn       *badger.Txn
+	source    *badger.Iterator
+	start     []byte
+	end       []byte
+	isReverse bool
+	isInvalid bool
+}
+
+var _ dbm.Iterator = (*iterator)(nil)
+
+func (bdb *DB) newIterator(start, end []byte, isReverse bool) (*iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+	txn := bdb.db.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = isReverse
+	source := txn.NewIterator(opts)
+	if isReverse {
+		if end == nil {
+			source.Rewind()
+		} else {
+			// in reverse badger seeks the last key at or before end, which is excluded
+			source.Seek(end)
+			if source.Valid() && bytes.Equal(source.Item().Key(), end) {
+				source.Next()
+			}
+		}
+	} else {
+		if start == nil {
+			source.Rewind()
+		} else {
+			source.Seek(start)
+		}
+	}
+	return &iterator{txn: txn, source: source, start: start, end: end, isReverse: isReverse}, nil
+}
+
+// Domain implements Iterator.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements Iterator.
+func (itr *iterator) Valid() bool {
+	if itr.isInvalid {
+		return false
+	}
+	if !itr.source.Valid() {
+		itr.isInvalid = true
+		return false
+	}
+	key := itr.source.Item().Key()
+	if itr.isReverse {
+		if itr.start != nil && bytes.Compare(key, itr.start) < 0 {
+			itr.isInvalid = true
+		}
+	} else if itr.end != nil && bytes.Compare(key, itr.end) >= 0 {
+		itr.isInvalid = true
+	}
+	return !itr.isInvalid
+}
+
+// Next implements Iterator.
+func (itr *iterator) Next() {
+	itr.assertIsValid()
+	itr.source.Next()
+}
+
+// Key implements Iterator.
+func (itr *iterator) Key() []byte {
+	itr.assertIsValid()
+	return itr.source.Item().KeyCopy(nil)
+}
+
+// Value implements Iterator.
+func (itr *iterator) Value() []byte {
+	itr.assertIsValid()
+	value, err := itr.source.Item().ValueCopy(nil)
+	if err != nil {
+		panic(err)
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	return value
+}
+
+// Error implements Iterator.
+func (itr *iterator) Error() error {
+	return nil
+}
+
+// Close implements Iterator.
+func (itr *iterator) Close() {
+	itr.source.Close()
+	itr.txn.Discard()
+}
+
+func (itr *iterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("badgerdb: iterator is invalid")
+	}
+}
@@ -0,0 +1,95 @@
+package badgerdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestBadgerDB(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewDB("test", dir)
+	require.Nil(t, err)
+
+	require.Nil(t, db.Set([]byte("b"), []byte("2")))
+	require.Nil(t, db.Set([]byte("d"), []byte{}))
+	value, err := db.Get([]byte("b"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("2"), value)
+	value, err = db.Get([]byte("d"))
+	require.Nil(t, err)
+	require.Equal(t, []byte{}, value)
+	value, err = db.Get([]byte("c"))
+	require.Nil(t, err)
+	require.Nil(t, value)
+	has, err := db.Has([]byte("c"))
+	require.Nil(t, err)
+	require.False(t, has)
+	_, err = db.Get(nil)
+	require.Error(t, err)
+
+	batch := db.NewBatch()
+	batch.Set([]byte("a"), []byte("1"))
+	batch.Set([]byte("c"), []byte("3"))
+	batch.Delete([]byte("d"))
+	require.Nil(t, batch.WriteSync())
+	batch.Close()
+
+	// a closed database is opened again with its pairs
+	require.Nil(t, db.Close())
+	db, err = NewDB("test", dir)
+	require.Nil(t, err)
+	defer db.Close()
+
+	keysOf := func(it dbm.Iterator, err error) (keys []string) {
+		require.Nil(t, err)
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, string(it.Key()))
+		}
+		return keys
+	}
+	require.Equal(t, []string{"a", "b", "c"}, keysOf(db.Iterator(nil, nil)))
+	require.Equal(t, []string{"b", "c"}, keysOf(db.Iterator([]byte("b"), nil)))
+	require.Equal(t, []string{"a", "b"}, keysOf(db.Iterator([]byte("a"), []byte("c"))))
+	require.Nil(t, keysOf(db.Iterator([]byte("bb"), []byte("c"))))
+	require.Equal(t, []string{"c", "b", "a"}, keysOf(db.ReverseIterator(nil, nil)))
+	require.Equal(t, []string{"b", "a"}, keysOf(db.ReverseIterator(nil, []byte("c"))))
+	require.Equal(t, []string{"b"}, keysOf(db.ReverseIterator([]byte("b"), []byte("bb"))))
+	require.Equal(t, []string{"c", "b"}, keysOf(db.ReverseIterator([]byte("b"), []byte("z"))))
+
+	require.Nil(t, db.DeleteSync([]byte("b")))
+	require.Equal(t, []string{"a", "c"}, keysOf(db.Iterator(nil, nil)))
+}
+
+func TestBadgerDBBatchTooBig(t *testing.T) {
+	db, err := NewDB("test", t.TempDir())
+	require.Nil(t, err)
+	defer db.Close()
+
+	// a batch over the transaction limit of the default badger tables is written
+	batch := db.NewBatch()
+	value := make([]byte, 1000)
+	for i := 0; i < 20000; i++ {
+		batch.Set([]byte(fmt.Sprintf("key%d", i)), value)
+	}
+	require.Nil(t, batch.Write())
+	batch.Close()
+	has, err := db.Has([]byte("key19999"))
+	require.Nil(t, err)
+	require.True(t, has)
+
+	// a batch larger than a badger transaction is not split, none of its pairs are written
+	batch = db.NewBatch()
+	for i := 0; i < 50000; i++ {
+		batch.Set([]byte(fmt.Sprintf("big%d", i)), value)
+	}
+	require.Equal(t, badger.ErrTxnTooBig, batch.Write())
+	batch.Close()
+	has, err = db.Has([]byte("big0"))
+	require.Nil(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,209 @@
+package export
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+const (
+	// CurrentFormat is the format of the exports written by this version
+	CurrentFormat uint32 = 1
+	// ManifestName is the file describing an export
+	ManifestName = "manifest.json"
+	storeFileFmt = "%s.jsonl"
+)
+
+// Manifest describes the state of each store at a height.
+type Manifest struct {
+	Format uint32          `json:"format"`
+	Height int64           `json:"height"`
+	Stores []StoreManifest `json:"stores"`
+}
+
+// StoreManifest describes the file holding the pairs of a store.
+type StoreManifest struct {
+	Name     string `json:"name"`
+	File     string `json:"file"`
+	Pairs    int64  `json:"pairs"`
+	Checksum string `json:"checksum"` // the sha256 of the file
+}
+
+// Pair is a line of a store file.
+type Pair struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// Writer writes the pairs of each store to its own newline delimited JSON file.
+type Writer struct {
+	dir      string
+	manifest Manifest
+}
+
+// NewWriter starts an export of the height in the directory, which must not exist or be empty.
+func NewWriter(dir string, height int64) (*Writer, error) {
+	if entries, err := ioutil.ReadDir(dir); err == nil && len(entries) != 0 {
+		return nil, fmt.Errorf("the export directory %s is not empty", dir)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return &Writer{dir: dir, manifest: Manifest{Format: CurrentFormat, Height: height}}, nil
+}
+
+// WriteStore writes the pairs of the iterator as the pairs of the named store, closing the iterator.
+func (w *Writer) WriteStore(name string, it types.Iterator) error {
+	defer it.Close()
+	sm := StoreManifest{Name: name, File: fmt.Sprintf(storeFileFmt, name)}
+	file, err := os.Create(filepath.Join(w.dir, sm.File))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	hasher := sha256.New()
+	buf := bufio.NewWriter(io.MultiWriter(file, hasher))
+	encoder := json.NewEncoder(buf)
+	for ; it.Valid(); it.Next() {
+		// the encoder ends each pair with a newline
+		if err := encoder.Encode(Pair{Key: it.Key(), Value: it.Value()}); err != nil {
+			return err
+		}
+		sm.Pairs++
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+	sm.Checksum = hex.EncodeToString(hasher.Sum(nil))
+	w.manifest.Stores = append(w.manifest.Stores, sm)
+	return file.Sync()
+}
+
+// Close writes the manifest of the export.
+func (w *Writer) Close() (Manifest, error) {
+	bz, err := json.MarshalIndent(w.manifest, "", "    ")
+	if err != nil {
+		return Manifest{}, err
+	}
+	return w.manifest, ioutil.WriteFile(filepath.Join(w.dir, ManifestName), bz, 0644)
+}
+
+// Reader streams the pairs of an export store by store, checking each store file against its checksum and number of
+// pairs before moving on to the next store.
+type Reader struct {
+	dir      string
+	manifest Manifest
+	index    int
+	file     *os.File
+	buf      *bufio.Reader
+	hasher   hash.Hash
+	pairs    int64
+}
+
+// NewReader opens the export in the directory.
+func NewReader(dir string) (*Reader, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(dir, ManifestName))
+	if err != nil {
+		return nil, err
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid export manifest: %s", err.Error())
+	}
+	if manifest.Format != CurrentFormat {
+		return nil, fmt.Errorf("unsupported export format %d, expected %d", manifest.Format, CurrentFormat)
+	}
+	seen := make(map[string]bool)
+	for _, sm := range manifest.Stores {
+		if seen[sm.Name] {
+			return nil, fmt.Errorf("store %s is in the export twice", sm.Name)
+		}
+		seen[sm.Name] = true
+	}
+	return &Reader{dir: dir, manifest: manifest, index: -1}, nil
+}
+
+// Manifest returns the manifest of the export.
+func (r *Reader) Manifest() Manifest {
+	return r.manifest
+}
+
+// Next returns the next pair of the export and the name of its store, or io.EOF after the last one.
+func (r *Reader) Next() (store string, key, value []byte, err error) {
+	for {
+		if r.file != nil {
+			line, err := r.buf.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				return "", nil, nil, err
+			}
+			if len(line) != 0 {
+				_, _ = r.hasher.Write(line)
+				var pair Pair
+				if err := json.Unmarshal(line, &pair); err != nil {
+					return "", nil, nil, fmt.Errorf("invalid pair %d of store %s: %s", r.pairs, r.store().Name, err.Error())
+				}
+				r.pairs++
+				return r.store().Name, pair.Key, pair.Value, nil
+			}
+			if err := r.closeStore(); err != nil {
+				return "", nil, nil, err
+			}
+		}
+		if r.index+1 >= len(r.manifest.Stores) {
+			return "", nil, nil, io.EOF
+		}
+		r.index++
+		if err := r.openStore(); err != nil {
+			return "", nil, nil, err
+		}
+	}
+}
+
+// Close closes the store file being read.
+func (r *Reader) Close() error {
+	if r.file == nil {
+		return nil
+	}
+	return r.file.Close()
+}
+
+func (r *Reader) store() StoreManifest {
+	return r.manifest.Stores[r.index]
+}
+
+func (r *Reader) openStore() (err error) {
+	// the file name is not trusted to stay in the export directory
+	r.file, err = os.Open(filepath.Join(r.dir, filepath.Base(r.store().File)))
+	if err != nil {
+		return err
+	}
+	r.buf = bufio.NewReader(r.file)
+	r.hasher = sha256.New()
+	r.pairs = 0
+	return nil
+}
+
+func (r *Reader) closeStore() error {
+	sm := r.store()
+	_ = r.file.Close()
+	r.file = nil
+	if checksum := hex.EncodeToString(r.hasher.Sum(nil)); checksum != sm.Checksum {
+		return fmt.Errorf("store %s does not match its checksum %s", sm.Name, sm.Checksum)
+	}
+	if r.pairs != sm.Pairs {
+		return fmt.Errorf("store %s has %d pairs, expected %d", sm.Name, r.pairs, sm.Pairs)
+	}
+	return nil
+}
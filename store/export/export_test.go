@@ -0,0 +1,65 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestWriteReadExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	stores := map[string]int{"acc": 3, "empty": 0, "pos": 2}
+	w, err := NewWriter(dir, 100)
+	require.Nil(t, err)
+	for _, name := range []string{"acc", "empty", "pos"} {
+		db := dbm.NewMemDB()
+		for i := 0; i < stores[name]; i++ {
+			db.Set([]byte(fmt.Sprintf("%s-key%d", name, i)), []byte{byte(i), '\n'})
+		}
+		it, err := db.Iterator(nil, nil)
+		require.Nil(t, err)
+		require.Nil(t, w.WriteStore(name, it))
+	}
+	manifest, err := w.Close()
+	require.Nil(t, err)
+	require.Equal(t, int64(100), manifest.Height)
+	require.Len(t, manifest.Stores, 3)
+	// an export is not written over another
+	_, err = NewWriter(dir, 100)
+	require.Error(t, err)
+
+	r, err := NewReader(dir)
+	require.Nil(t, err)
+	require.Equal(t, manifest, r.Manifest())
+	read := make(map[string]int)
+	for {
+		store, key, value, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		require.Equal(t, fmt.Sprintf("%s-key%d", store, read[store]), string(key))
+		require.Equal(t, []byte{byte(read[store]), '\n'}, value)
+		read[store]++
+	}
+	require.Equal(t, map[string]int{"acc": 3, "pos": 2}, read)
+
+	// a changed store file does not match its checksum
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "acc.jsonl"), []byte(`{"key":"YQ==","value":"Yg=="}`+"\n"), 0644))
+	r, err = NewReader(dir)
+	require.Nil(t, err)
+	defer r.Close()
+	_, _, _, err = r.Next()
+	require.Nil(t, err)
+	_, _, _, err = r.Next()
+	require.Error(t, err)
+}
@@ -0,0 +1,189 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// CheckVersion verifies the tree of a version in the database without loading it: every node must be saved, hash to
+// the hash its parent records and be no newer than the version. It returns the root hash of the version. The inner
+// nodes of the checked set are trusted along with their subtrees, and the inner nodes verified are added to it, so
+// that the versions of a tree, sharing most of their nodes, are checked once.
+func CheckVersion(db dbm.DB, version int64, checked map[string]struct{}) ([]byte, error) {
+	rootHash, err := db.Get(rootKeyFormat.Key(version))
+	if err != nil {
+		return nil, err
+	}
+	if rootHash == nil {
+		return nil, fmt.Errorf("the root of version %d is missing", version)
+	}
+	if len(rootHash) == 0 {
+		// an empty tree
+		return nil, nil
+	}
+	if checked == nil {
+		checked = make(map[string]struct{})
+	}
+	var check func(hash []byte) (*Node, error)
+	check = func(hash []byte) (*Node, error) {
+		node, err := readNode(db, hash)
+		if err != nil {
+			return nil, err
+		}
+		if node.version > version {
+			return nil, fmt.Errorf("node %X of version %d is newer than the version %d", hash, node.version, version)
+		}
+		if node.isLeaf() {
+			return node, nil
+		}
+		if _, ok := checked[string(hash)]; ok {
+			return node, nil
+		}
+		left, err := check(node.leftHash)
+		if err != nil {
+			return nil, err
+		}
+		right, err := check(node.rightHash)
+		if err != nil {
+			return nil, err
+		}
+		if node.height != maxInt8(left.height, right.height)+1 || node.size != left.size+right.size {
+			return nil, fmt.Errorf("node %X has a height or size not matching its children", hash)
+		}
+		checked[string(hash)] = struct{}{}
+		return node, nil
+	}
+	if _, err := check(rootHash); err != nil {
+		return nil, err
+	}
+	return rootHash, nil
+}
+
+// CheckOrphans verifies that every orphan record of the database refers to a saved node, within versions that node
+// can be found in. It returns the number of orphans checked.
+func CheckOrphans(db dbm.DB) (int, error) {
+	count := 0
+	var err error
+	if e := scanPrefix(db, orphanKeyFormat.Key(), func(key, hash []byte) bool {
+		var toVersion, fromVersion int64
+		orphanKeyFormat.Scan(key, &toVersion, &fromVersion)
+		node, e := readNode(db, hash)
+		switch {
+		case e != nil:
+			err = fmt.Errorf("orphan of versions %d to %d: %s", fromVersion, toVersion, e.Error())
+		case fromVersion > toVersion:
+			err = fmt.Errorf("orphan %X is recorded from version %d to the earlier version %d", hash, fromVersion, toVersion)
+		case node.version > fromVersion:
+			err = fmt.Errorf("orphan %X of version %d is recorded from the earlier version %d", hash, node.version, fromVersion)
+		}
+		count++
+		return err != nil
+	}); e != nil {
+		return count, e
+	}
+	return count, err
+}
+
+// LatestVersion returns the latest version of the tree saved in the database, 0 for none.
+func LatestVersion(db dbm.DB) (int64, error) {
+	latest := int64(0)
+	err := scanPrefix(db, rootKeyFormat.Key(), func(key, _ []byte) bool {
+		var version int64
+		rootKeyFormat.Scan(key, &version)
+		if version > latest {
+			latest = version
+		}
+		return false
+	})
+	return latest, err
+}
+
+// RollbackDB deletes the versions of the tree newer than the version from the database without loading them, so that
+// a damaged version can be discarded. Every node is read for its version, as the newer trees can not be trusted to
+// reach them.
+func RollbackDB(db dbm.DB, version int64) error {
+	batch := db.NewBatch()
+	defer batch.Close()
+	err := scanPrefix(db, nodeKeyFormat.Key(), func(key, value []byte) bool {
+		node, e := MakeNode(value)
+		if e != nil {
+			// a node that can not be decoded is of no version
+			batch.Delete(key)
+			return false
+		}
+		if node.version > version {
+			batch.Delete(key)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	// the orphans of the newer versions go with their nodes, and the nodes orphaned after the version are alive again
+	err = scanPrefix(db, orphanKeyFormat.Key(), func(key, _ []byte) bool {
+		var toVersion, fromVersion int64
+		orphanKeyFormat.Scan(key, &toVersion, &fromVersion)
+		if fromVersion > version || toVersion >= version {
+			batch.Delete(key)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	err = scanRange(db, rootKeyFormat.Key(version+1), rootKeyFormat.Key(int64(math.MaxInt64)), func(key, _ []byte) bool {
+		batch.Delete(key)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// readNode reads the node of the hash from the database, and verifies its content hashes to it
+func readNode(db dbm.DB, hash []byte) (*Node, error) {
+	if len(hash) == 0 {
+		return nil, fmt.Errorf("a child hash is missing")
+	}
+	buf, err := db.Get(nodeKeyFormat.KeyBytes(hash))
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, fmt.Errorf("node %X is missing", hash)
+	}
+	node, err := MakeNode(buf)
+	if err != nil {
+		return nil, fmt.Errorf("node %X can not be decoded: %s", hash, err.Error())
+	}
+	if h := node._hash(); !bytes.Equal(h, hash) {
+		return nil, fmt.Errorf("node %X hashes to %X", hash, h)
+	}
+	node.hash = hash
+	return node, nil
+}
+
+// scanRange calls the function on the pairs from the start key, inclusive, to the end key, exclusive, until it
+// returns true
+func scanRange(db dbm.DB, start, end []byte, fn func(key, value []byte) bool) error {
+	itr, err := db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		if fn(itr.Key(), itr.Value()) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// scanPrefix calls the function on the pairs of the prefix until it returns true
+func scanPrefix(db dbm.DB, prefix []byte, fn func(key, value []byte) bool) error {
+	return scanRange(db, prefix, cpIncr(prefix), fn)
+}
@@ -0,0 +1,62 @@
+package iavl
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckVersion(t *testing.T) {
+	tree := newVersionedTree(t, 10)
+	db := tree.ndb.db
+	checked := make(map[string]struct{})
+	for v := int64(1); v <= 10; v++ {
+		hash, err := CheckVersion(db, v, checked)
+		require.Nil(t, err)
+		expected, err := tree.GetImmutable(v)
+		require.Nil(t, err)
+		require.Equal(t, expected.Hash(), hash)
+	}
+	count, err := CheckOrphans(db)
+	require.Nil(t, err)
+	require.NotZero(t, count)
+	latest, err := LatestVersion(db)
+	require.Nil(t, err)
+	require.Equal(t, int64(10), latest)
+	_, err = CheckVersion(db, 11, nil)
+	require.NotNil(t, err)
+
+	// a leaf of the latest version rewritten in place no longer hashes to the hash its parent records
+	var leaf *Node
+	for _, node := range tree.ndb.leafNodes() {
+		if node.version == 10 {
+			leaf = node
+		}
+	}
+	require.NotNil(t, leaf)
+	leaf.value = []byte("tampered")
+	buf := new(bytes.Buffer)
+	require.Nil(t, leaf.writeBytes(buf))
+	require.Nil(t, db.Set(nodeKeyFormat.KeyBytes(leaf.hash), buf.Bytes()))
+	_, err = CheckVersion(db, 10, nil)
+	require.NotNil(t, err)
+	_, err = CheckVersion(db, 9, nil)
+	require.Nil(t, err)
+
+	// the damaged version is rolled back, and the tree loads at the version before
+	require.Nil(t, RollbackDB(db, 9))
+	latest, err = LatestVersion(db)
+	require.Nil(t, err)
+	require.Equal(t, int64(9), latest)
+	_, err = CheckOrphans(db)
+	require.Nil(t, err)
+	reloaded, err := NewMutableTree(db, cacheSize)
+	require.Nil(t, err)
+	_, err = reloaded.LoadVersion(0)
+	require.Nil(t, err)
+	require.Equal(t, int64(9), reloaded.Version())
+	expected, err := tree.GetImmutable(9)
+	require.Nil(t, err)
+	require.Equal(t, expected.Hash(), reloaded.Hash())
+}
@@ -0,0 +1,81 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+// DiffTrees returns the keys changed from the tree at a version to the tree of the same store at a later version, in
+// key order. A node is never modified once saved, so a node of the later tree not newer than the earlier version is in
+// the earlier tree as well, along with all of its subtree: only the nodes the two trees do not share are walked.
+func DiffTrees(from, to *ImmutableTree) ([]types.KVChange, error) {
+	if from.version >= to.version {
+		return nil, fmt.Errorf("the version %d must be before the version %d", from.version, to.version)
+	}
+	shared := make(map[string]struct{})
+	after := make(map[string][]byte)
+	var walkTo func(node *Node)
+	walkTo = func(node *Node) {
+		if node.version <= from.version {
+			shared[string(node._hash())] = struct{}{}
+			return
+		}
+		if node.isLeaf() {
+			after[string(node.key)] = node.value
+			return
+		}
+		walkTo(node.getLeftNode(to))
+		walkTo(node.getRightNode(to))
+	}
+	before := make(map[string][]byte)
+	var walkFrom func(node *Node)
+	walkFrom = func(node *Node) {
+		if _, ok := shared[string(node._hash())]; ok {
+			return
+		}
+		if node.isLeaf() {
+			before[string(node.key)] = node.value
+			return
+		}
+		walkFrom(node.getLeftNode(from))
+		walkFrom(node.getRightNode(from))
+	}
+	if to.root != nil {
+		walkTo(to.root)
+	}
+	if from.root != nil {
+		walkFrom(from.root)
+	}
+	changes := make([]types.KVChange, 0, len(after)+len(before))
+	for key, value := range after {
+		previous, ok := before[key]
+		// a key set again to its value is rewritten without changing
+		if ok && bytes.Equal(previous, value) {
+			continue
+		}
+		changes = append(changes, types.KVChange{Key: []byte(key), Before: previous, After: value})
+	}
+	for key, value := range before {
+		if _, ok := after[key]; !ok {
+			changes = append(changes, types.KVChange{Key: []byte(key), Before: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return bytes.Compare(changes[i].Key, changes[j].Key) < 0 })
+	return changes, nil
+}
+
+// DiffVersions returns the keys changed from a saved version of the store to a later one, in key order.
+func (st *Store) DiffVersions(from, to int64) ([]types.KVChange, error) {
+	fromTree, err := st.tree.GetImmutable(from)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load version %d: %s", from, err.Error())
+	}
+	toTree, err := st.tree.GetImmutable(to)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load version %d: %s", to, err.Error())
+	}
+	return DiffTrees(fromTree, toTree)
+}
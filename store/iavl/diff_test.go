@@ -0,0 +1,66 @@
+package iavl
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+// diffByIteration compares every pair of the two trees
+func diffByIteration(from, to *ImmutableTree) []types.KVChange {
+	changes := make([]types.KVChange, 0)
+	before := make(map[string][]byte)
+	from.Iterate(func(key, value []byte) bool {
+		before[string(key)] = value
+		return false
+	})
+	to.Iterate(func(key, value []byte) bool {
+		previous, ok := before[string(key)]
+		if !ok || !bytes.Equal(previous, value) {
+			changes = append(changes, types.KVChange{Key: key, Before: previous, After: value})
+		}
+		delete(before, string(key))
+		return false
+	})
+	from.Iterate(func(key, value []byte) bool {
+		if _, ok := before[string(key)]; ok {
+			changes = append(changes, types.KVChange{Key: key, Before: value})
+		}
+		return false
+	})
+	sort.Slice(changes, func(i, j int) bool { return bytes.Compare(changes[i].Key, changes[j].Key) < 0 })
+	return changes
+}
+
+func TestDiffTrees(t *testing.T) {
+	tree := newVersionedTree(t, 10)
+	// a key set again to its value is not a change
+	_, value := tree.Get([]byte("key5"))
+	tree.Set([]byte("key5"), value)
+	_, _, err := tree.SaveVersion()
+	require.Nil(t, err)
+
+	for from := int64(1); from <= 11; from++ {
+		for to := from + 1; to <= 11; to++ {
+			fromTree, err := tree.GetImmutable(from)
+			require.Nil(t, err)
+			toTree, err := tree.GetImmutable(to)
+			require.Nil(t, err)
+			changes, err := DiffTrees(fromTree, toTree)
+			require.Nil(t, err)
+			expected := diffByIteration(fromTree, toTree)
+			require.Equal(t, expected, changes, "from %d to %d", from, to)
+		}
+	}
+	fromTree, _ := tree.GetImmutable(10)
+	toTree, _ := tree.GetImmutable(11)
+	changes, err := DiffTrees(fromTree, toTree)
+	require.Nil(t, err)
+	require.Empty(t, changes)
+	_, err = DiffTrees(toTree, fromTree)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,203 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// importBatchSize is the number of imported nodes written to disk at once
+const importBatchSize = 10000
+
+// ExportVersion walks the nodes of a saved version that are not in the tree of prevVersion, children before
+// parents, then the nodes of the prevVersion tree that the version orphans. A prevVersion of 0 walks the whole
+// tree. The nodes are passed in their stored encoding. Returns the root hash of the version.
+func (tree *MutableTree) ExportVersion(version, prevVersion int64, nodeFn func(nodeBz []byte) error, orphanFn func(hash []byte, fromVersion int64) error) ([]byte, error) {
+	// hold the versions being read back from pruning
+	tree.ndb.incrVersionReaders(version)
+	defer tree.ndb.decrVersionReaders(version)
+	rootHash, err := tree.ndb.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+	if rootHash == nil {
+		return nil, errors.Wrap(ErrVersionDoesNotExist, fmt.Sprintf("version %d", version))
+	}
+	// the roots of the subtrees shared with the previous version
+	shared := make(map[string]bool)
+	if len(rootHash) != 0 {
+		if err := tree.exportNode(rootHash, prevVersion, shared, nodeFn); err != nil {
+			return nil, err
+		}
+	}
+	if prevVersion <= 0 {
+		return rootHash, nil
+	}
+	tree.ndb.incrVersionReaders(prevVersion)
+	defer tree.ndb.decrVersionReaders(prevVersion)
+	prevRootHash, err := tree.ndb.getRoot(prevVersion)
+	if err != nil {
+		return nil, err
+	}
+	if prevRootHash == nil {
+		return nil, errors.Wrap(ErrVersionDoesNotExist, fmt.Sprintf("version %d", prevVersion))
+	}
+	if len(prevRootHash) != 0 {
+		if err := tree.exportOrphans(prevRootHash, shared, orphanFn); err != nil {
+			return nil, err
+		}
+	}
+	return rootHash, nil
+}
+
+// HoldVersions keeps the saved versions from..to from being deleted until the returned release is called.
+func (tree *MutableTree) HoldVersions(from, to int64) (release func()) {
+	for version := from; version <= to; version++ {
+		tree.ndb.incrVersionReaders(version)
+	}
+	return func() {
+		for version := from; version <= to; version++ {
+			tree.ndb.decrVersionReaders(version)
+		}
+	}
+}
+
+func (tree *MutableTree) exportNode(hash []byte, prevVersion int64, shared map[string]bool, nodeFn func(nodeBz []byte) error) error {
+	node := tree.ndb.GetNode(hash)
+	// a node that is not newer than the previous version is in its tree along with its descendants
+	if node.version <= prevVersion {
+		shared[string(hash)] = true
+		return nil
+	}
+	if !node.isLeaf() {
+		if err := tree.exportNode(node.leftHash, prevVersion, shared, nodeFn); err != nil {
+			return err
+		}
+		if err := tree.exportNode(node.rightHash, prevVersion, shared, nodeFn); err != nil {
+			return err
+		}
+	}
+	var buf bytes.Buffer
+	buf.Grow(node.aminoSize())
+	if err := node.writeBytes(&buf); err != nil {
+		return err
+	}
+	return nodeFn(buf.Bytes())
+}
+
+func (tree *MutableTree) exportOrphans(hash []byte, shared map[string]bool, orphanFn func(hash []byte, fromVersion int64) error) error {
+	if shared[string(hash)] {
+		return nil
+	}
+	node := tree.ndb.GetNode(hash)
+	if err := orphanFn(hash, node.version); err != nil {
+		return err
+	}
+	if node.isLeaf() {
+		return nil
+	}
+	if err := tree.exportOrphans(node.leftHash, shared, orphanFn); err != nil {
+		return err
+	}
+	return tree.exportOrphans(node.rightHash, shared, orphanFn)
+}
+
+// Importer rebuilds a saved version of a tree from the nodes exported by ExportVersion.
+type Importer struct {
+	tree    *MutableTree
+	version int64
+	orphans map[string]int64
+	// the hashes of the nodes not yet written to disk
+	pending map[string]bool
+}
+
+// Import returns an importer of the given version, which must follow the latest saved version of the tree. The
+// first version imported into an empty tree may be any version.
+func (tree *MutableTree) Import(version int64) (*Importer, error) {
+	if version <= 0 {
+		return nil, errors.New("version must be greater than 0")
+	}
+	latest := tree.ndb.getLatestVersion()
+	switch {
+	case latest == 0:
+		tree.ndb.resetLatestVersion(version - 1)
+	case latest != version-1:
+		return nil, errors.Errorf("must import consecutive versions; expected %d, got %d", latest+1, version)
+	}
+	return &Importer{
+		tree:    tree,
+		version: version,
+		orphans: make(map[string]int64),
+		pending: make(map[string]bool),
+	}, nil
+}
+
+// Add saves an exported node, which must come after its children.
+func (i *Importer) Add(nodeBz []byte) error {
+	node, err := MakeNode(nodeBz)
+	if err != nil {
+		return err
+	}
+	if node.version <= 0 || node.version > i.version {
+		return errors.Errorf("node version %d is not in the imported version %d", node.version, i.version)
+	}
+	if !node.isLeaf() {
+		if node.leftHash == nil || node.rightHash == nil {
+			return errors.New("inner node must have two children")
+		}
+		for _, child := range [][]byte{node.leftHash, node.rightHash} {
+			if ok, err := i.has(child); err != nil || !ok {
+				return errors.Errorf("missing child %X of node %X", child, node._hash())
+			}
+		}
+	}
+	node._hash()
+	i.tree.ndb.SaveNode(node)
+	i.pending[string(node.hash)] = true
+	if len(i.pending) >= importBatchSize {
+		if err := i.tree.ndb.Commit(); err != nil {
+			return err
+		}
+		i.pending = make(map[string]bool)
+	}
+	return nil
+}
+
+// AddOrphan records a node of the previous version orphaned by the imported version.
+func (i *Importer) AddOrphan(hash []byte, fromVersion int64) error {
+	if fromVersion <= 0 || fromVersion >= i.version {
+		return errors.Errorf("orphan version %d is not before the imported version %d", fromVersion, i.version)
+	}
+	i.orphans[string(hash)] = fromVersion
+	return nil
+}
+
+// Commit saves the imported version with the given root hash, which must be the hash of an imported node.
+func (i *Importer) Commit(rootHash []byte) error {
+	if len(rootHash) == 0 {
+		if err := i.tree.ndb.SaveEmptyRoot(i.version); err != nil {
+			return err
+		}
+	} else {
+		if ok, err := i.has(rootHash); err != nil || !ok {
+			return errors.Errorf("missing root %X of version %d", rootHash, i.version)
+		}
+		if err := i.tree.ndb.saveRoot(rootHash, i.version); err != nil {
+			return err
+		}
+	}
+	i.tree.ndb.SaveOrphans(i.version, i.orphans)
+	if err := i.tree.ndb.Commit(); err != nil {
+		return err
+	}
+	i.tree.versions[i.version] = true
+	return nil
+}
+
+func (i *Importer) has(hash []byte) (bool, error) {
+	if i.pending[string(hash)] {
+		return true, nil
+	}
+	return i.tree.ndb.Has(hash)
+}
@@ -0,0 +1,100 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// make a tree with overwritten and deleted keys in each version
+func newVersionedTree(t *testing.T, versions int) *MutableTree {
+	tree, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.Nil(t, err)
+	for v := 1; v <= versions; v++ {
+		for i := 0; i < 20; i++ {
+			tree.Set([]byte(fmt.Sprintf("key%d", (v*7+i)%50)), []byte(fmt.Sprintf("value%d-%d", v, i)))
+		}
+		tree.Remove([]byte(fmt.Sprintf("key%d", v%50)))
+		_, _, err := tree.SaveVersion()
+		require.Nil(t, err)
+	}
+	return tree
+}
+
+func importVersions(t *testing.T, tree *MutableTree, from, to int64) *MutableTree {
+	imported, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.Nil(t, err)
+	for v := from; v <= to; v++ {
+		importer, err := imported.Import(v)
+		require.Nil(t, err)
+		prev := v - 1
+		if v == from {
+			prev = 0
+		}
+		rootHash, err := tree.ExportVersion(v, prev, importer.Add, importer.AddOrphan)
+		require.Nil(t, err)
+		require.Nil(t, importer.Commit(rootHash))
+	}
+	_, err = imported.LoadVersion(0)
+	require.Nil(t, err)
+	return imported
+}
+
+func TestExportImportVersions(t *testing.T) {
+	tree := newVersionedTree(t, 20)
+	imported := importVersions(t, tree, 5, 20)
+
+	require.Equal(t, int64(20), imported.Version())
+	require.False(t, imported.VersionExists(4))
+	for v := int64(5); v <= 20; v++ {
+		original, err := tree.GetImmutable(v)
+		require.Nil(t, err)
+		restored, err := imported.GetImmutable(v)
+		require.Nil(t, err)
+		require.Equal(t, original.Hash(), restored.Hash())
+		original.Iterate(func(key []byte, value []byte) bool {
+			_, restoredValue := restored.Get(key)
+			require.Equal(t, value, restoredValue)
+			return false
+		})
+		require.Equal(t, original.Size(), restored.Size())
+	}
+
+	// the imported orphans let the old versions be deleted without leaving their nodes behind
+	for v := int64(5); v < 20; v++ {
+		require.Nil(t, imported.DeleteVersion(v))
+	}
+	latest, err := imported.GetImmutable(20)
+	require.Nil(t, err)
+	reachable := 0
+	latest.root.traverse(latest, true, func(node *Node) bool {
+		reachable++
+		return false
+	})
+	require.Equal(t, reachable, len(imported.ndb.nodes()))
+}
+
+func TestImportRejectsInvalidNodes(t *testing.T) {
+	tree := newVersionedTree(t, 3)
+	imported, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.Nil(t, err)
+	importer, err := imported.Import(2)
+	require.Nil(t, err)
+
+	// an inner node can not come before its children
+	var nodes [][]byte
+	rootHash, err := tree.ExportVersion(2, 0, func(nodeBz []byte) error {
+		nodes = append(nodes, nodeBz)
+		return nil
+	}, nil)
+	require.Nil(t, err)
+	require.Error(t, importer.Add(nodes[len(nodes)-1]))
+	// the root must be imported
+	require.Error(t, importer.Commit(rootHash))
+
+	// versions are imported in order
+	_, err = imported.Import(4)
+	require.Error(t, err)
+}
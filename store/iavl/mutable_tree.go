@@ -400,6 +400,21 @@ func (tree *MutableTree) GetImmutable(version int64) (*ImmutableTree, error) {
 	}, nil
 }
 
+// SetInitialVersion makes the next saved version of an empty tree the given version.
+func (tree *MutableTree) SetInitialVersion(version int64) error {
+	if version <= 0 {
+		return errors.New("version must be greater than 0")
+	}
+	if tree.version != 0 || tree.ndb.getLatestVersion() != 0 {
+		return errors.Errorf("the initial version can only be set on an empty tree, the tree is at version %d", tree.ndb.getLatestVersion())
+	}
+	tree.version = version - 1
+	tree.ImmutableTree = &ImmutableTree{ndb: tree.ndb, version: tree.version}
+	tree.lastSaved = tree.ImmutableTree.clone()
+	tree.ndb.resetLatestVersion(tree.version)
+	return nil
+}
+
 // Rollback resets the working tree to the latest saved version, discarding
 // any unsaved modifications.
 func (tree *MutableTree) Rollback() {
@@ -6,10 +6,12 @@ import (
 	"log"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/pokt-network/pocket-core/store/cachekv"
 	serrors "github.com/pokt-network/pocket-core/store/errors"
+	"github.com/pokt-network/pocket-core/store/rootmulti/heightcache"
 	"github.com/pokt-network/pocket-core/store/tracekv"
 	"github.com/pokt-network/pocket-core/store/types"
 
@@ -48,17 +50,7 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyL
 	iavl := UnsafeNewStore(tree, int64(0), int64(0), cache)
 	iavl.SetPruning(pruning)
 
-	if iavl.cache.IsValid() {
-		cacheDatasetIterator, _ := iavl.Iterator(nil, nil)
-		cacheDataset := make(map[string]string, 10)
-		for cacheDatasetIterator.Valid() {
-			cacheDataset[string(cacheDatasetIterator.Key())] = string(cacheDatasetIterator.Value())
-			cacheDatasetIterator.Next()
-		}
-
-		iavl.cache.Initialize(cacheDataset, iavl.tree.Version())
-		log.Println("Cache warmed.")
-	}
+	iavl.cache.Initialize(iavl.committedStore(), iavl.tree.Version())
 	return iavl, nil
 }
 
@@ -84,6 +76,9 @@ type Store struct {
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
 
+	// Old versions still to be released, e.g. while they were being read.
+	toRelease []int64
+
 	cache types.SingleStoreCache
 }
 
@@ -118,6 +113,15 @@ func (st *Store) LazyLoadStore(version int64, cache types.SingleStoreCache) (*St
 	return iavl, nil
 }
 
+// committedStore returns a read only store of the last committed version, which later commits leave as is.
+func (st *Store) committedStore() types.KVStore {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return st
+	}
+	return &Store{tree: &immutableTree{tree.lastSaved}, cache: heightcache.InvalidCache{}}
+}
+
 func (st *Store) Rollback(version int64) error {
 	r, ok := st.tree.(*MutableTree)
 	if !ok {
@@ -134,23 +138,21 @@ func (st *Store) Rollback(version int64) error {
 func (st *Store) Commit() types.CommitID {
 	// Save a new version.
 	hash, version, err := st.tree.SaveVersion()
-	st.cache.Commit(version)
 	if err != nil {
 		// TODO: Do we want to extend Commit to allow returning errors?
 		panic(err)
 	}
+	st.cache.Commit(version, st.committedStore())
 
 	// Release an old version of history, if not a sync waypoint.
-	//previous := version - 1 TODO removed for testing
-	//if st.numRecent < previous {
-	//	toRelease := previous - st.numRecent
-	//	if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-	//		err := st.tree.DeleteVersion(toRelease)
-	//		if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
-	//			panic(err)
-	//		}
-	//	}
-	//}
+	previous := version - 1
+	if st.numRecent < previous {
+		toRelease := previous - st.numRecent
+		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
+			st.toRelease = append(st.toRelease, toRelease)
+		}
+	}
+	st.releaseVersions()
 
 	return types.CommitID{
 		Version: version,
@@ -158,6 +160,75 @@ func (st *Store) Commit() types.CommitID {
 	}
 }
 
+// PruneVersions deletes every saved version below toVersion from disk, regardless of the pruning options.
+func (st *Store) PruneVersions(toVersion int64) error {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return fmt.Errorf("not mutable tree in PruneVersions")
+	}
+	for _, v := range tree.AvailableVersions() {
+		version := int64(v)
+		if version >= toVersion || version == tree.Version() {
+			break
+		}
+		if err := tree.DeleteVersion(version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseVersions deletes the versions to release, keeping the ones that could not be deleted for a later commit.
+func (st *Store) releaseVersions() {
+	remaining := st.toRelease[:0]
+	for _, version := range st.toRelease {
+		err := st.tree.DeleteVersion(version)
+		// a version still being read is left for the next commit rather than halting the chain
+		if errCause := errors.Cause(err); errCause != nil && errCause != ErrVersionDoesNotExist {
+			log.Printf("unable to prune version %d: %s\n", version, err.Error())
+			remaining = append(remaining, version)
+		}
+	}
+	st.toRelease = remaining
+}
+
+// ExportVersion walks the nodes of a saved version that are not in prevVersion, see MutableTree.ExportVersion.
+func (st *Store) ExportVersion(version, prevVersion int64, nodeFn func(nodeBz []byte) error, orphanFn func(hash []byte, fromVersion int64) error) ([]byte, error) {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return nil, fmt.Errorf("not mutable tree in ExportVersion")
+	}
+	return tree.ExportVersion(version, prevVersion, nodeFn, orphanFn)
+}
+
+// HoldVersions keeps the saved versions from..to from being pruned until the returned release is called, see
+// MutableTree.HoldVersions.
+func (st *Store) HoldVersions(from, to int64) (func(), error) {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return nil, fmt.Errorf("not mutable tree in HoldVersions")
+	}
+	return tree.HoldVersions(from, to), nil
+}
+
+// Import returns an importer of the given version, see MutableTree.Import.
+func (st *Store) Import(version int64) (*Importer, error) {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return nil, fmt.Errorf("not mutable tree in Import")
+	}
+	return tree.Import(version)
+}
+
+// SetInitialVersion makes the next commit of an empty store the given version, see MutableTree.SetInitialVersion.
+func (st *Store) SetInitialVersion(version int64) error {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return fmt.Errorf("not mutable tree in SetInitialVersion")
+	}
+	return tree.SetInitialVersion(version)
+}
+
 // Implements Committer.
 func (st *Store) LastCommitID() types.CommitID {
 	return types.CommitID{
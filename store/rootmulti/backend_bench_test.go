@@ -0,0 +1,110 @@
+package rootmulti
+
+import (
+	"math/rand"
+	"testing"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/pocket-core/store/badgerdb"
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+// The benchmarks compare the commit and query latency of the database backends on a synthetic state, run with
+// go test ./store/rootmulti -run none -bench Backend
+
+const (
+	benchStores        = 3
+	benchKeysPerCommit = 1000
+	benchStateVersions = 20
+	// small enough for the queries to reach the database
+	benchIAVLCacheSize = 10000
+)
+
+type benchBackend struct {
+	name string
+	open func(dir string) (dbm.DB, error)
+}
+
+var benchBackends = []benchBackend{
+	{"goleveldb", func(dir string) (dbm.DB, error) { return dbm.NewGoLevelDB("bench", dir) }},
+	{"badgerdb", func(dir string) (dbm.DB, error) { return badgerdb.NewDB("bench", dir) }},
+}
+
+func newBenchStore(b *testing.B, db dbm.DB) *Store {
+	store := NewStore(db, false, benchIAVLCacheSize)
+	store.pruningOpts = types.PruneNothing
+	for i := 0; i < benchStores; i++ {
+		store.MountStoreWithDB(types.NewKVStoreKey(benchStoreName(i)), types.StoreTypeIAVL, nil)
+	}
+	if err := store.LoadLatestVersion(); err != nil {
+		b.Fatal(err)
+	}
+	return store
+}
+
+func benchStoreName(i int) string {
+	return string(rune('a'+i)) + "store"
+}
+
+// commitRandomPairs sets keysPerCommit random pairs spread over the stores and commits them, returning the keys.
+func commitRandomPairs(b *testing.B, store *Store, r *rand.Rand) [][]byte {
+	keys := make([][]byte, benchKeysPerCommit)
+	for i := range keys {
+		key, value := make([]byte, 32), make([]byte, 128)
+		r.Read(key)
+		r.Read(value)
+		if err := store.getStoreByName(benchStoreName(i%benchStores)).(types.KVStore).Set(key, value); err != nil {
+			b.Fatal(err)
+		}
+		keys[i] = key
+	}
+	store.Commit()
+	return keys
+}
+
+func BenchmarkBackendCommit(b *testing.B) {
+	for _, backend := range benchBackends {
+		b.Run(backend.name, func(b *testing.B) {
+			db, err := backend.open(b.TempDir())
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer db.Close()
+			store := newBenchStore(b, db)
+			r := rand.New(rand.NewSource(1))
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				commitRandomPairs(b, store, r)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendQuery(b *testing.B) {
+	for _, backend := range benchBackends {
+		b.Run(backend.name, func(b *testing.B) {
+			db, err := backend.open(b.TempDir())
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer db.Close()
+			store := newBenchStore(b, db)
+			r := rand.New(rand.NewSource(1))
+			var keys [][]byte
+			for v := 0; v < benchStateVersions; v++ {
+				keys = append(keys, commitRandomPairs(b, store, r)...)
+			}
+			// reload the state so the queries start from an empty node cache
+			store = newBenchStore(b, db)
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				i := r.Intn(len(keys))
+				value, err := store.getStoreByName(benchStoreName(i % benchKeysPerCommit % benchStores)).(types.KVStore).Get(keys[i])
+				if err != nil || value == nil {
+					b.Fatalf("missing key %X: %v", keys[i], err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/pokt-network/pocket-core/store/iavl"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// VersionCheck is the result of the check of the stores of the database at a version
+type VersionCheck struct {
+	Version int64
+	Err     error            // the commit info of the version can not be read
+	Damaged map[string]error // the damaged stores by name
+}
+
+// Consistent returns whether every store of the version is intact and matches the commit info
+func (vc VersionCheck) Consistent() bool {
+	return vc.Err == nil && len(vc.Damaged) == 0
+}
+
+// Checker verifies the stores of a database without loading them, as loading a damaged store panics. The trees are
+// read from the database directly so that a damaged database can be checked, and rolled back to a consistent version.
+type Checker struct {
+	db      dbm.DB
+	checked map[string]map[string]struct{} // the verified inner nodes of each store
+}
+
+// NewChecker returns a checker of the multistore saved in the database
+func NewChecker(db dbm.DB) *Checker {
+	return &Checker{db: db, checked: make(map[string]map[string]struct{})}
+}
+
+// LatestVersion returns the latest version committed by the multistore
+func (c *Checker) LatestVersion() int64 {
+	return getLatestVersion(c.db)
+}
+
+// StoreNames returns the names of the stores of the commit info at the version, sorted
+func (c *Checker) StoreNames(version int64) ([]string, error) {
+	cInfo, err := getCommitInfo(c.db, version)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(cInfo.StoreInfos))
+	for _, info := range cInfo.StoreInfos {
+		names = append(names, info.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// StoreVersions returns the latest version saved by each store, which after a clean shutdown is the latest version of
+// the multistore
+func (c *Checker) StoreVersions(storeNames []string) (map[string]int64, error) {
+	versions := make(map[string]int64, len(storeNames))
+	for _, name := range storeNames {
+		v, err := iavl.LatestVersion(c.storeDB(name))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the versions of store %s: %s", name, err.Error())
+		}
+		versions[name] = v
+	}
+	return versions, nil
+}
+
+// CheckVersion walks the tree of every store of the commit info at the version, verifying the hash of each node, and
+// compares the root hashes to the commit info
+func (c *Checker) CheckVersion(version int64) VersionCheck {
+	vc := VersionCheck{Version: version, Damaged: make(map[string]error)}
+	cInfo, err := getCommitInfo(c.db, version)
+	if err != nil {
+		vc.Err = fmt.Errorf("unable to read the commit info of version %d: %s", version, err.Error())
+		return vc
+	}
+	for _, info := range cInfo.StoreInfos {
+		checked, ok := c.checked[info.Name]
+		if !ok {
+			checked = make(map[string]struct{})
+			c.checked[info.Name] = checked
+		}
+		hash, err := iavl.CheckVersion(c.storeDB(info.Name), version, checked)
+		if err != nil {
+			vc.Damaged[info.Name] = err
+			continue
+		}
+		if !bytes.Equal(hash, info.Core.CommitID.Hash) {
+			vc.Damaged[info.Name] = fmt.Errorf("the root hash %X does not match the commit info hash %X", hash,
+				info.Core.CommitID.Hash)
+		}
+	}
+	return vc
+}
+
+// CheckOrphans verifies the orphan records of each store, returning the error of the damaged stores
+func (c *Checker) CheckOrphans(storeNames []string) map[string]error {
+	damaged := make(map[string]error)
+	for _, name := range storeNames {
+		if _, err := iavl.CheckOrphans(c.storeDB(name)); err != nil {
+			damaged[name] = err
+		}
+	}
+	return damaged
+}
+
+// LatestConsistentVersion checks the versions down from the given one until a consistent version, which it returns
+// along with the checks of the damaged versions above it. The version is 0 when none is consistent.
+func (c *Checker) LatestConsistentVersion(from int64) (int64, []VersionCheck) {
+	var damaged []VersionCheck
+	for v := from; v > 0; v-- {
+		vc := c.CheckVersion(v)
+		if vc.Consistent() {
+			return v, damaged
+		}
+		damaged = append(damaged, vc)
+	}
+	return 0, damaged
+}
+
+// Rollback deletes the versions newer than the version from every store of the commit info at the version, along with
+// their commit infos, and sets the version as the latest one of the multistore. Rolling back to the latest version
+// deletes the versions a store saved past it.
+func (c *Checker) Rollback(version int64) error {
+	latest := c.LatestVersion()
+	if version > latest {
+		return fmt.Errorf("the rollback height: %d must not be above the actual app height: %d", version, latest)
+	}
+	storeNames, err := c.StoreNames(version)
+	if err != nil {
+		return err
+	}
+	for _, name := range storeNames {
+		if err := iavl.RollbackDB(c.storeDB(name), version); err != nil {
+			return fmt.Errorf("unable to rollback store %s: %s", name, err.Error())
+		}
+	}
+	b := c.db.NewBatch()
+	defer b.Close()
+	setLatestVersion(b, version)
+	for i := version + 1; i <= latest; i++ {
+		b.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, i)))
+	}
+	c.checked = make(map[string]map[string]struct{})
+	return b.Write()
+}
+
+// storeDB returns the database of the iavl store of the name, as mounted by the multistore
+func (c *Checker) storeDB(name string) dbm.DB {
+	return dbm.NewPrefixDB(c.db, []byte("s/k:"+name+"/"))
+}
@@ -0,0 +1,82 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+func newCheckedStore(db dbm.DB) (*Store, types.StoreKey, types.StoreKey) {
+	store := NewStore(db, false, 5000000)
+	accKey, posKey := types.NewKVStoreKey("acc"), types.NewKVStoreKey("pos")
+	store.MountStoreWithDB(accKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(posKey, types.StoreTypeIAVL, nil)
+	return store, accKey, posKey
+}
+
+func TestChecker(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, accKey, posKey := newCheckedStore(db)
+	require.Nil(t, store.LoadLatestVersion())
+	for v := 1; v <= 5; v++ {
+		for i := 0; i < 10; i++ {
+			require.Nil(t, store.GetKVStore(accKey).Set([]byte(fmt.Sprintf("key%d", (v+i)%12)), []byte(fmt.Sprintf("value%d", v))))
+		}
+		require.Nil(t, store.GetKVStore(posKey).Set([]byte(fmt.Sprintf("key%d", v)), []byte("staked")))
+		store.Commit()
+	}
+
+	checker := NewChecker(db)
+	require.Equal(t, int64(5), checker.LatestVersion())
+	names, err := checker.StoreNames(5)
+	require.Nil(t, err)
+	require.Equal(t, []string{"acc", "pos"}, names)
+	versions, err := checker.StoreVersions(names)
+	require.Nil(t, err)
+	require.Equal(t, map[string]int64{"acc": 5, "pos": 5}, versions)
+	for v := int64(1); v <= 5; v++ {
+		require.True(t, checker.CheckVersion(v).Consistent(), "version %d", v)
+	}
+	require.Empty(t, checker.CheckOrphans(names))
+
+	// lose the root node of the latest version of a store, as after an unclean shutdown
+	accDB := dbm.NewPrefixDB(db, []byte("s/k:acc/"))
+	rootHash, err := accDB.Get(append([]byte{'r'}, 0, 0, 0, 0, 0, 0, 0, 5))
+	require.Nil(t, err)
+	require.NotEmpty(t, rootHash)
+	require.Nil(t, accDB.Delete(append([]byte{'n'}, rootHash...)))
+
+	checker = NewChecker(db)
+	vc := checker.CheckVersion(5)
+	require.False(t, vc.Consistent())
+	require.Contains(t, vc.Damaged, "acc")
+	require.NotContains(t, vc.Damaged, "pos")
+	version, damaged := checker.LatestConsistentVersion(5)
+	require.Equal(t, int64(4), version)
+	require.Len(t, damaged, 1)
+	require.Equal(t, int64(5), damaged[0].Version)
+
+	// the rolled back database loads at the consistent version and commits again
+	require.NotNil(t, checker.Rollback(6))
+	require.Nil(t, checker.Rollback(version))
+	require.Equal(t, int64(4), checker.LatestVersion())
+	versions, err = checker.StoreVersions(names)
+	require.Nil(t, err)
+	require.Equal(t, map[string]int64{"acc": 4, "pos": 4}, versions)
+	require.True(t, checker.CheckVersion(4).Consistent())
+	require.Empty(t, checker.CheckOrphans(names))
+
+	store, accKey, _ = newCheckedStore(db)
+	require.Nil(t, store.LoadLatestVersion())
+	require.Equal(t, int64(4), store.LastCommitID().Version)
+	value, err := store.GetKVStore(accKey).Get([]byte("key4"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value4"), value)
+	require.Nil(t, store.GetKVStore(accKey).Set([]byte("key4"), []byte("value5")))
+	require.Equal(t, int64(5), store.Commit().Version)
+	require.True(t, NewChecker(db).CheckVersion(5).Consistent())
+}
@@ -33,10 +33,10 @@ func (i InvalidCache) ReverseIterator(height int64, start, end []byte) (types.It
 	return nil, errors.New("invalid cache has no iterators")
 }
 
-func (i InvalidCache) Commit(height int64) {
+func (i InvalidCache) Commit(height int64, committed types.KVStore) {
 }
 
-func (i InvalidCache) Initialize(currentData map[string]string, version int64) {
+func (i InvalidCache) Initialize(committed types.KVStore, version int64) {
 }
 
 func (i InvalidCache) IsValid() bool {
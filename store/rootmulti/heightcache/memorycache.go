@@ -2,126 +2,266 @@ package heightcache
 
 import (
 	"errors"
-	"github.com/pokt-network/pocket-core/store/types"
-	"math"
 	"sort"
+	"sync"
+	"sync/atomic"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/pocket-core/store/cachekv"
+	"github.com/pokt-network/pocket-core/store/types"
 )
 
 var _ types.SingleStoreCache = &MemoryCache{}
 
+// entryOverhead approximates the memory a diff entry uses besides its key and value
+const entryOverhead = 64
+
+var errHeightNotCached = errors.New("height is not cached")
+
+// heightDiff holds the values the keys changed at a height had before it, nil for a key that did not exist.
+type heightDiff struct {
+	height int64
+	before map[string][]byte
+	size   int64
+}
+
+// MemoryCache answers the queries of a store at its past heights from the diffs between them: a key changed after
+// the queried height has the value it had before the first such change, any other key has its committed value.
 type MemoryCache struct {
-	capacity    int64
-	pastHeights []*StoreAtHeight
-	current     *StoreAtHeight
+	mtx       sync.RWMutex
+	name      string
+	depth     int64
+	committed types.KVStore
+	height    int64
+	diffs     []heightDiff       // the diffs of the cached heights, in order up to height
+	history   map[string][]int64 // the cached heights at which each key changed, in order
+	dirty     map[string]struct{}
+	size      int64
+	hits      int64
+	misses    int64
 }
 
-func NewMemoryCache(size int64) *MemoryCache {
-	storesAtHeight := make([]*StoreAtHeight, size)
-	for i := range storesAtHeight {
-		storesAtHeight[i] = NewStoreAtHeight()
-	}
+// Metrics are the counters of a cache.
+type Metrics struct {
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
+	Heights int64 `json:"heights"`
+	Bytes   int64 `json:"bytes"`
+}
+
+// NewMemoryCache returns the cache of the named store, keeping up to depth past heights, 0 for no limit.
+func NewMemoryCache(name string, depth int64) *MemoryCache {
 	return &MemoryCache{
-		capacity:    size,
-		current:     NewStoreAtHeight(),
-		pastHeights: storesAtHeight,
+		name:    name,
+		depth:   depth,
+		height:  -1,
+		history: make(map[string][]int64),
+		dirty:   make(map[string]struct{}),
 	}
 }
 
-func (m *MemoryCache) InitializeStoreCache(height int64) error {
-	m.current.height = height
-	if m.current.data == nil {
-		m.current.data = map[string]string{}
+func (m *MemoryCache) isHeightSafeToRead(height int64) bool {
+	// the diffs after a height are all needed to read it, and the latest height is left to the store as it may have
+	// uncommitted changes
+	return m.committed != nil && len(m.diffs) != 0 && height >= m.diffs[0].height-1 && height < m.height
+}
+
+func (m *MemoryCache) get(height int64, key []byte) ([]byte, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	if !m.isHeightSafeToRead(height) {
+		return nil, errHeightNotCached
+	}
+	heights := m.history[string(key)]
+	i := sort.Search(len(heights), func(i int) bool { return heights[i] > height })
+	if i < len(heights) {
+		return m.diffs[heights[i]-m.diffs[0].height].before[string(key)], nil
 	}
+	return m.committed.Get(key)
+}
+
+func (m *MemoryCache) Get(height int64, key []byte) ([]byte, error) {
+	value, err := m.get(height, key)
+	m.count(err)
+	return value, err
+}
+
+func (m *MemoryCache) Has(height int64, key []byte) (bool, error) {
+	value, err := m.get(height, key)
+	m.count(err)
+	return value != nil, err
+}
+
+func (m *MemoryCache) Set(key []byte, value []byte) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.dirty[string(key)] = struct{}{}
+}
+
+func (m *MemoryCache) Remove(key []byte) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.dirty[string(key)] = struct{}{}
 	return nil
 }
 
-func (m MemoryCache) isHeightSafeToRead(height int64) bool {
-	if height != m.current.height && height > m.current.height-(1+m.capacity) {
-		for _, v := range m.pastHeights {
-			if v.height == height {
-				return true
+func (m *MemoryCache) Iterator(height int64, start, end []byte) (types.Iterator, error) {
+	it, err := m.iterator(height, start, end, true)
+	m.count(err)
+	return it, err
+}
+
+func (m *MemoryCache) ReverseIterator(height int64, start, end []byte) (types.Iterator, error) {
+	it, err := m.iterator(height, start, end, false)
+	m.count(err)
+	return it, err
+}
+
+// iterator overlays the committed store with the values the keys changed after the height had at the height.
+func (m *MemoryCache) iterator(height int64, start, end []byte, ascending bool) (types.Iterator, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	if !m.isHeightSafeToRead(height) {
+		return nil, errHeightNotCached
+	}
+	overlay := cachekv.NewStore(m.committed)
+	// going back from the latest height, the first change after the height is the last one set
+	for i := len(m.diffs) - 1; i >= 0 && m.diffs[i].height > height; i-- {
+		for key, value := range m.diffs[i].before {
+			if !dbm.IsKeyInDomain([]byte(key), start, end) {
+				continue
+			}
+			if value == nil {
+				_ = overlay.Delete([]byte(key))
+			} else {
+				_ = overlay.Set([]byte(key), value)
 			}
 		}
 	}
-	return false
-
+	if ascending {
+		return overlay.Iterator(start, end)
+	}
+	return overlay.ReverseIterator(start, end)
 }
 
-func (m MemoryCache) Get(height int64, key []byte) ([]byte, error) {
-	if m.isHeightSafeToRead(height) {
-		for i := range m.pastHeights {
-			if m.pastHeights[i].height == height {
-				return []byte(m.pastHeights[i].data[string(key)]), nil
-			}
+// Commit records the values the keys changed since the last commit had at the last committed height.
+func (m *MemoryCache) Commit(height int64, committed types.KVStore) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.committed == nil || height != m.height+1 {
+		m.reset(committed, height)
+		return
+	}
+	diff := heightDiff{height: height, before: make(map[string][]byte, len(m.dirty))}
+	for key := range m.dirty {
+		value, err := m.committed.Get([]byte(key))
+		if err != nil {
+			m.reset(committed, height)
+			return
 		}
+		diff.before[key] = value
+		diff.size += int64(len(key)+len(value)) + entryOverhead
+		m.history[key] = append(m.history[key], height)
+	}
+	m.diffs = append(m.diffs, diff)
+	m.size += diff.size
+	m.committed, m.height = committed, height
+	m.dirty = make(map[string]struct{})
+	for m.depth > 0 && int64(len(m.diffs)) > m.depth {
+		m.evictOldest()
 	}
-	return nil, errors.New("invalid height for get")
+	m.updateGauges()
 }
 
-func (m MemoryCache) Has(height int64, key []byte) (bool, error) {
-	return false, errors.New("not implemented")
+// Initialize starts the cache at the committed version of the store.
+func (m *MemoryCache) Initialize(committed types.KVStore, version int64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.reset(committed, version)
 }
 
-func (m MemoryCache) Set(key []byte, value []byte) {
-	m.current.data[string(key)] = string(value)
+func (m *MemoryCache) IsValid() bool {
+	return true
 }
 
-func (m MemoryCache) Remove(key []byte) error {
-	delete(m.current.data, string(key))
-	return nil
+// Metrics returns the counters of the cache.
+func (m *MemoryCache) Metrics() Metrics {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	return Metrics{
+		Hits:    atomic.LoadInt64(&m.hits),
+		Misses:  atomic.LoadInt64(&m.misses),
+		Heights: int64(len(m.diffs)),
+		Bytes:   m.size,
+	}
 }
 
-func (m MemoryCache) Iterator(height int64, start, end []byte) (types.Iterator, error) {
-	if m.isHeightSafeToRead(height) {
-		for _, v := range m.pastHeights {
-			if v.height == height {
-				return NewMemoryHeightIterator(v.data, string(start), string(end), v.orderedKeys, true), nil
-			}
-		}
+func (m *MemoryCache) setDepth(depth int64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.depth = depth
+	for m.depth > 0 && int64(len(m.diffs)) > m.depth {
+		m.evictOldest()
 	}
-	return nil, errors.New("invalid height for iterator")
+	m.updateGauges()
 }
 
-func (m MemoryCache) ReverseIterator(height int64, start, end []byte) (types.Iterator, error) {
-	if m.isHeightSafeToRead(height) {
-		for _, v := range m.pastHeights {
-			if v.height == height {
-				return NewMemoryHeightIterator(v.data, string(start), string(end), v.orderedKeys, false), nil
-			}
-		}
+// evictThrough drops the diffs of the heights up to the given height.
+func (m *MemoryCache) evictThrough(height int64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for len(m.diffs) != 0 && m.diffs[0].height <= height {
+		m.evictOldest()
 	}
-	return nil, errors.New("invalid height for iterator")
+	m.updateGauges()
 }
 
-func (m MemoryCache) Commit(height int64) {
-	lowestHeight := int64(math.MaxInt64)
-	lowestIdx := -1
+// oldest returns the oldest cached height and the memory used by the cache.
+func (m *MemoryCache) oldest() (height, size int64, ok bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	if len(m.diffs) == 0 {
+		return 0, m.size, false
+	}
+	return m.diffs[0].height, m.size, true
+}
 
-	for idx, v := range m.pastHeights {
-		if v.height < lowestHeight {
-			lowestHeight = v.height
-			lowestIdx = idx
+func (m *MemoryCache) evictOldest() {
+	diff := m.diffs[0]
+	for key := range diff.before {
+		// the oldest diff holds the first change of each of its keys
+		if heights := m.history[key][1:]; len(heights) != 0 {
+			m.history[key] = heights
+		} else {
+			delete(m.history, key)
 		}
 	}
-	m.current.height = height
-
-	m.pastHeights[lowestIdx].height = m.current.height
-	m.pastHeights[lowestIdx].data = map[string]string{}
+	m.size -= diff.size
+	m.diffs[0] = heightDiff{}
+	m.diffs = m.diffs[1:]
+}
 
-	orderedKeys := make([]string, len(m.current.data))
-	for k, v := range m.current.data {
-		m.pastHeights[lowestIdx].data[k] = v
-		orderedKeys = append(orderedKeys, k)
-	}
-	sort.Strings(orderedKeys)
-	m.pastHeights[lowestIdx].orderedKeys = orderedKeys
+func (m *MemoryCache) reset(committed types.KVStore, height int64) {
+	m.committed, m.height = committed, height
+	m.diffs = nil
+	m.history = make(map[string][]int64)
+	m.dirty = make(map[string]struct{})
+	m.size = 0
+	m.updateGauges()
 }
 
-func (m MemoryCache) Initialize(currentData map[string]string, version int64) {
-	m.current.data = currentData
-	m.current.height = version
+func (m *MemoryCache) count(err error) {
+	if err == nil {
+		atomic.AddInt64(&m.hits, 1)
+		hitsCounter.With(storeLabel, m.name).Add(1)
+	} else {
+		atomic.AddInt64(&m.misses, 1)
+		missesCounter.With(storeLabel, m.name).Add(1)
+	}
 }
 
-func (m MemoryCache) IsValid() bool {
-	return true
+func (m *MemoryCache) updateGauges() {
+	heightsGauge.With(storeLabel, m.name).Set(float64(len(m.diffs)))
+	bytesGauge.With(storeLabel, m.name).Set(float64(m.size))
 }
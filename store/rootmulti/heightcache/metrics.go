@@ -0,0 +1,40 @@
+package heightcache
+
+import (
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdPrometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "store"
+	metricsSubsystem = "height_cache"
+	storeLabel       = "store"
+)
+
+// the metrics of the caches, served by the prometheus server of the node
+var (
+	hitsCounter = prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "hits",
+		Help:      "the number of past height queries answered by the height cache",
+	}, []string{storeLabel})
+	missesCounter = prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "misses",
+		Help:      "the number of past height queries left to the store by the height cache",
+	}, []string{storeLabel})
+	heightsGauge = prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "heights",
+		Help:      "the number of past heights held by the height cache",
+	}, []string{storeLabel})
+	bytesGauge = prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "bytes",
+		Help:      "the approximate memory in bytes used by the height cache",
+	}, []string{storeLabel})
+)
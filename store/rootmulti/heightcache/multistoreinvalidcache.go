@@ -19,3 +19,6 @@ func (m MultiStoreInvalidCache) InitializeSingleStoreCache(height int64, storeKe
 func (m MultiStoreInvalidCache) GetSingleStoreCache(storekey types.StoreKey) types.SingleStoreCache {
 	return &InvalidCache{}
 }
+
+func (m MultiStoreInvalidCache) SetLimits(depth, maxBytes int64) {
+}
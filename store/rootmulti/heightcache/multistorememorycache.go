@@ -1,34 +1,114 @@
 package heightcache
 
 import (
+	"sync"
+
 	"github.com/pokt-network/pocket-core/store/types"
 )
 
 var _ types.MultiStoreCache = &MultiStoreMemoryCache{}
 
+const (
+	// DefaultDepth is the number of past heights cached by default
+	DefaultDepth = 100
+	// DefaultMaxBytes is the memory the caches of the stores may use by default
+	DefaultMaxBytes = 256 << 20
+)
+
+// MultiStoreMemoryCache holds the caches of the stores of a multistore, which share a memory limit: past it the
+// oldest height is dropped from all of them, as a query at a height usually reads more than one store.
 type MultiStoreMemoryCache struct {
-	capacity int64
+	mtx      sync.Mutex
+	depth    int64
+	maxBytes int64
 	stores   map[types.StoreKey]*MemoryCache
 }
 
-func NewMultiStoreMemoryCache(capacity int64) types.MultiStoreCache {
-	stores := make(map[types.StoreKey]*MemoryCache)
-	return &MultiStoreMemoryCache{
-		capacity: capacity,
-		stores:   stores,
+// NewMultiStoreMemoryCache returns the caches of a multistore with the given limits, see SetLimits.
+func NewMultiStoreMemoryCache(depth, maxBytes int64) types.MultiStoreCache {
+	m := &MultiStoreMemoryCache{stores: make(map[types.StoreKey]*MemoryCache)}
+	m.SetLimits(depth, maxBytes)
+	return m
+}
+
+func (m *MultiStoreMemoryCache) InitializeSingleStoreCache(height int64, storeKey types.StoreKey) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.storeCache(storeKey)
+	return nil
+}
+
+func (m *MultiStoreMemoryCache) GetSingleStoreCache(storeKey types.StoreKey) types.SingleStoreCache {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return &multiStoreMemberCache{MemoryCache: m.storeCache(storeKey), parent: m}
+}
+
+// SetLimits sets the number of past heights cached and the memory they may use, 0 for the default.
+func (m *MultiStoreMemoryCache) SetLimits(depth, maxBytes int64) {
+	if depth <= 0 {
+		depth = DefaultDepth
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
 	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.depth, m.maxBytes = depth, maxBytes
+	for _, store := range m.stores {
+		store.setDepth(depth)
+	}
+	m.enforceMaxBytes()
 }
 
-func (m MultiStoreMemoryCache) InitializeSingleStoreCache(height int64, storeKey types.StoreKey) error {
-	if m.stores[storeKey] == nil {
-		m.stores[storeKey] = NewMemoryCache(m.capacity)
+// Metrics returns the counters of the cache of each store by name.
+func (m *MultiStoreMemoryCache) Metrics() map[string]Metrics {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	metrics := make(map[string]Metrics, len(m.stores))
+	for key, store := range m.stores {
+		metrics[key.Name()] = store.Metrics()
 	}
-	return m.stores[storeKey].InitializeStoreCache(height)
+	return metrics
 }
 
-func (m MultiStoreMemoryCache) GetSingleStoreCache(storeKey types.StoreKey) types.SingleStoreCache {
+func (m *MultiStoreMemoryCache) storeCache(storeKey types.StoreKey) *MemoryCache {
 	if m.stores[storeKey] == nil {
-		m.InitializeSingleStoreCache(-1, storeKey)
+		m.stores[storeKey] = NewMemoryCache(storeKey.Name(), m.depth)
 	}
 	return m.stores[storeKey]
 }
+
+// enforceMaxBytes drops the oldest heights of all the stores until they fit in the memory limit.
+func (m *MultiStoreMemoryCache) enforceMaxBytes() {
+	for {
+		var total, oldest int64
+		var found bool
+		for _, store := range m.stores {
+			height, size, ok := store.oldest()
+			total += size
+			if ok && (!found || height < oldest) {
+				oldest, found = height, true
+			}
+		}
+		if total <= m.maxBytes || !found {
+			return
+		}
+		for _, store := range m.stores {
+			store.evictThrough(oldest)
+		}
+	}
+}
+
+// multiStoreMemberCache is the cache of a store of the multistore, checking the memory limit after each commit.
+type multiStoreMemberCache struct {
+	*MemoryCache
+	parent *MultiStoreMemoryCache
+}
+
+func (c *multiStoreMemberCache) Commit(height int64, committed types.KVStore) {
+	c.MemoryCache.Commit(height, committed)
+	c.parent.mtx.Lock()
+	defer c.parent.mtx.Unlock()
+	c.parent.enforceMaxBytes()
+}
@@ -0,0 +1,87 @@
+package rootmulti
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pokt-network/pocket-core/store/iavl"
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+// ImportVersion writes the pairs returned by next into the empty stores as the given version. The pairs must be grouped
+// by store, each store being committed once its pairs are read so that only one store is held in memory, and next
+// returns io.EOF after the last one. The mounted stores without pairs are committed empty.
+func (rs *Store) ImportVersion(version int64, next func() (storeName string, key, value []byte, err error)) (types.CommitID, error) {
+	if latest := getLatestVersion(rs.DB); latest != 0 {
+		return types.CommitID{}, fmt.Errorf("can not import into a store at version %d", latest)
+	}
+	if err := rs.LoadVersion(0); err != nil {
+		return types.CommitID{}, err
+	}
+	committed := make(map[string]types.CommitID)
+	var current string
+	var store *iavl.Store
+	for {
+		name, key, value, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return types.CommitID{}, err
+		}
+		if store == nil || name != current {
+			if store != nil {
+				committed[current] = store.Commit()
+			}
+			if _, ok := committed[name]; ok {
+				return types.CommitID{}, fmt.Errorf("the pairs of store %s are not grouped", name)
+			}
+			if store, err = rs.initialIAVLStore(name, version); err != nil {
+				return types.CommitID{}, err
+			}
+			current = name
+		}
+		if err := store.Set(key, value); err != nil {
+			return types.CommitID{}, fmt.Errorf("failed to import a pair of store %s: %s", name, err.Error())
+		}
+	}
+	if store != nil {
+		committed[current] = store.Commit()
+	}
+	cInfo := CommitInfo{Version: version}
+	for key, s := range rs.stores {
+		if s.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
+		commitID, ok := committed[key.Name()]
+		if !ok {
+			empty, err := rs.initialIAVLStore(key.Name(), version)
+			if err != nil {
+				return types.CommitID{}, err
+			}
+			commitID = empty.Commit()
+		}
+		cInfo.StoreInfos = append(cInfo.StoreInfos, StoreInfo{Name: key.Name(), Core: StoreCore{CommitID: commitID}})
+	}
+	batch := rs.DB.NewBatch()
+	defer batch.Close()
+	setCommitInfo(batch, version, cInfo)
+	setLatestVersion(batch, version)
+	if err := batch.Write(); err != nil {
+		return types.CommitID{}, err
+	}
+	rs.lastCommitID = cInfo.CommitID()
+	return rs.lastCommitID, nil
+}
+
+func (rs *Store) initialIAVLStore(name string, version int64) (*iavl.Store, error) {
+	key, ok := rs.keysByName[name]
+	if !ok {
+		return nil, fmt.Errorf("store %s is not mounted", name)
+	}
+	store, ok := rs.stores[key].(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("only iavl stores can be imported, store %s is not", name)
+	}
+	return store, store.SetInitialVersion(version)
+}
@@ -0,0 +1,54 @@
+package rootmulti
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+func TestMultiStoreImportVersion(t *testing.T) {
+	pairs := []struct {
+		store, key, value string
+	}{
+		{"store1", "a", "1"},
+		{"store1", "b", "2"},
+		{"store3", "c", "3"},
+	}
+	var i int
+	next := func() (string, []byte, []byte, error) {
+		if i == len(pairs) {
+			return "", nil, nil, io.EOF
+		}
+		i++
+		return pairs[i-1].store, []byte(pairs[i-1].key), []byte(pairs[i-1].value), nil
+	}
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+	commitID, err := store.ImportVersion(50, next)
+	require.Nil(t, err)
+	require.Equal(t, int64(50), commitID.Version)
+	require.Equal(t, getExpectedCommitID(store, 50), commitID)
+
+	// the imported version is loaded and committed on like any other
+	store = newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+	require.Equal(t, commitID, store.LastCommitID())
+	value, _ := store.getStoreByName("store1").(types.KVStore).Get([]byte("b"))
+	require.Equal(t, []byte("2"), value)
+	value, _ = store.getStoreByName("store2").(types.KVStore).Get([]byte("a"))
+	require.Nil(t, value)
+	require.Equal(t, int64(51), store.Commit().Version)
+
+	// a store is only imported into once
+	i = 0
+	_, err = store.ImportVersion(50, next)
+	require.Error(t, err)
+	pairs = append(pairs, pairs[0])
+	_, err = newMultiStoreWithMounts(dbm.NewMemDB()).ImportVersion(50, next)
+	require.Error(t, err)
+}
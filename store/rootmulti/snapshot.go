@@ -0,0 +1,276 @@
+package rootmulti
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pokt-network/pocket-core/store/iavl"
+	"github.com/pokt-network/pocket-core/store/snapshots"
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+// ExportSnapshot writes the versions from..to of the iavl stores to the snapshot: for each version its commit info,
+// then for each store the nodes it adds to the previous version and the nodes of the previous version it orphans.
+// Returns the commit hash of the last version.
+func (rs *Store) ExportSnapshot(from, to int64, w *snapshots.Writer) ([]byte, error) {
+	if from <= 0 || from > to {
+		return nil, fmt.Errorf("invalid snapshot versions %d to %d", from, to)
+	}
+	var prev CommitInfo
+	for version := from; version <= to; version++ {
+		cInfo, err := getCommitInfo(rs.DB, version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit info of version %d: %s", version, err.Error())
+		}
+		cInfoBytes, err := cdc.LegacyMarshalBinaryLengthPrefixed(&cInfo)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.WriteItem(snapshots.ItemTypeCommitInfo, cInfoBytes); err != nil {
+			return nil, err
+		}
+		// sort the stores so that a snapshot of the same versions is always written the same way
+		infos := append([]StoreInfo(nil), cInfo.StoreInfos...)
+		sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+		for _, info := range infos {
+			if err := rs.exportStore(info, prev, w); err != nil {
+				return nil, fmt.Errorf("failed to export store %s at version %d: %s", info.Name, version, err.Error())
+			}
+		}
+		prev = cInfo
+	}
+	return prev.Hash(), nil
+}
+
+// HoldVersions keeps the versions from..to of the iavl stores from being pruned until the returned release is called,
+// so the commits made while a snapshot of the versions is written do not prune them.
+func (rs *Store) HoldVersions(from, to int64) (release func(), err error) {
+	var releases []func()
+	release = func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+	for key, s := range rs.stores {
+		store, ok := s.(*iavl.Store)
+		if !ok {
+			continue
+		}
+		r, err := store.HoldVersions(from, to)
+		if err != nil {
+			release()
+			return nil, fmt.Errorf("failed to hold the versions of store %s: %s", key.Name(), err.Error())
+		}
+		releases = append(releases, r)
+	}
+	return release, nil
+}
+
+func (rs *Store) exportStore(info StoreInfo, prev CommitInfo, w *snapshots.Writer) error {
+	key, ok := rs.keysByName[info.Name]
+	if !ok {
+		return fmt.Errorf("store is not mounted")
+	}
+	store, ok := rs.stores[key].(*iavl.Store)
+	if !ok {
+		return fmt.Errorf("only iavl stores can be exported")
+	}
+	// the first exported version, or a store added in this version, is written whole
+	var prevVersion int64
+	for _, prevInfo := range prev.StoreInfos {
+		if prevInfo.Name == info.Name {
+			prevVersion = prevInfo.Core.CommitID.Version
+		}
+	}
+	if err := w.WriteItem(snapshots.ItemTypeStore, []byte(info.Name)); err != nil {
+		return err
+	}
+	rootHash, err := store.ExportVersion(info.Core.CommitID.Version, prevVersion, func(nodeBz []byte) error {
+		return w.WriteItem(snapshots.ItemTypeNode, nodeBz)
+	}, func(hash []byte, fromVersion int64) error {
+		return w.WriteItem(snapshots.ItemTypeOrphan, encodeOrphan(hash, fromVersion))
+	})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rootHash, info.Core.CommitID.Hash) {
+		return fmt.Errorf("root hash %X does not match the committed hash %X", rootHash, info.Core.CommitID.Hash)
+	}
+	return nil
+}
+
+// RestoreSnapshot imports the versions of a snapshot written by ExportSnapshot into an empty store, checking each
+// store against the hash recorded in the commit info of its version and the last version against the hash of the
+// snapshot. The items the stores did not write are passed to appItemFn in order. Returns the commit id of each
+// restored version.
+func (rs *Store) RestoreSnapshot(r *snapshots.Reader, appItemFn func(typ byte, bz []byte) error) ([]types.CommitID, error) {
+	if latest := getLatestVersion(rs.DB); latest != 0 {
+		return nil, fmt.Errorf("can not restore a snapshot into a store at version %d", latest)
+	}
+	if err := rs.LoadVersion(0); err != nil {
+		return nil, err
+	}
+	restore := snapshotRestore{rs: rs}
+	for {
+		typ, bz, err := r.ReadItem()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch typ {
+		case snapshots.ItemTypeCommitInfo:
+			err = restore.startVersion(bz)
+		case snapshots.ItemTypeStore:
+			err = restore.startStore(string(bz))
+		case snapshots.ItemTypeNode:
+			if restore.importer == nil {
+				return nil, fmt.Errorf("snapshot node outside of a store")
+			}
+			err = restore.importer.Add(bz)
+		case snapshots.ItemTypeOrphan:
+			if restore.importer == nil {
+				return nil, fmt.Errorf("snapshot orphan outside of a store")
+			}
+			hash, fromVersion, decodeErr := decodeOrphan(bz)
+			if decodeErr != nil {
+				return nil, decodeErr
+			}
+			err = restore.importer.AddOrphan(hash, fromVersion)
+		default:
+			if err = restore.finishVersion(); err == nil {
+				err = appItemFn(typ, bz)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := restore.finishVersion(); err != nil {
+		return nil, err
+	}
+	if len(restore.commitIDs) == 0 {
+		return nil, fmt.Errorf("the snapshot has no versions")
+	}
+	last := restore.commitIDs[len(restore.commitIDs)-1]
+	if hex.EncodeToString(last.Hash) != r.Metadata().AppHash {
+		return nil, fmt.Errorf("restored hash %X does not match the snapshot hash %s", last.Hash, r.Metadata().AppHash)
+	}
+	return restore.commitIDs, rs.LoadLatestVersion()
+}
+
+// snapshotRestore tracks the version and store being restored.
+type snapshotRestore struct {
+	rs        *Store
+	cInfo     *CommitInfo
+	restored  map[string]bool
+	store     StoreInfo
+	importer  *iavl.Importer
+	commitIDs []types.CommitID
+}
+
+func (sr *snapshotRestore) startVersion(bz []byte) error {
+	if err := sr.finishVersion(); err != nil {
+		return err
+	}
+	var cInfo CommitInfo
+	if err := cdc.LegacyUnmarshalBinaryLengthPrefixed(bz, &cInfo); err != nil {
+		return fmt.Errorf("invalid snapshot commit info: %s", err.Error())
+	}
+	if n := len(sr.commitIDs); n != 0 && cInfo.Version != sr.commitIDs[n-1].Version+1 {
+		return fmt.Errorf("snapshot versions must be consecutive; expected %d, got %d", sr.commitIDs[n-1].Version+1, cInfo.Version)
+	}
+	sr.cInfo = &cInfo
+	sr.restored = make(map[string]bool)
+	return nil
+}
+
+func (sr *snapshotRestore) startStore(name string) error {
+	if sr.cInfo == nil {
+		return fmt.Errorf("snapshot store %s outside of a version", name)
+	}
+	if err := sr.finishStore(); err != nil {
+		return err
+	}
+	if sr.restored[name] {
+		return fmt.Errorf("store %s is restored twice at version %d", name, sr.cInfo.Version)
+	}
+	var found bool
+	for _, info := range sr.cInfo.StoreInfos {
+		if info.Name == name {
+			sr.store, found = info, true
+		}
+	}
+	if !found {
+		return fmt.Errorf("store %s is not in the commit info of version %d", name, sr.cInfo.Version)
+	}
+	key, ok := sr.rs.keysByName[name]
+	if !ok {
+		return fmt.Errorf("store %s is not mounted", name)
+	}
+	store, ok := sr.rs.stores[key].(*iavl.Store)
+	if !ok {
+		return fmt.Errorf("only iavl stores can be restored")
+	}
+	importer, err := store.Import(sr.store.Core.CommitID.Version)
+	if err != nil {
+		return fmt.Errorf("failed to restore store %s: %s", name, err.Error())
+	}
+	sr.importer = importer
+	return nil
+}
+
+func (sr *snapshotRestore) finishStore() error {
+	if sr.importer == nil {
+		return nil
+	}
+	if err := sr.importer.Commit(sr.store.Core.CommitID.Hash); err != nil {
+		return fmt.Errorf("failed to restore store %s at version %d: %s", sr.store.Name, sr.cInfo.Version, err.Error())
+	}
+	sr.restored[sr.store.Name] = true
+	sr.importer = nil
+	return nil
+}
+
+func (sr *snapshotRestore) finishVersion() error {
+	if sr.cInfo == nil {
+		return nil
+	}
+	if err := sr.finishStore(); err != nil {
+		return err
+	}
+	for _, info := range sr.cInfo.StoreInfos {
+		if !sr.restored[info.Name] {
+			return fmt.Errorf("store %s is missing from version %d of the snapshot", info.Name, sr.cInfo.Version)
+		}
+	}
+	batch := sr.rs.DB.NewBatch()
+	defer batch.Close()
+	setCommitInfo(batch, sr.cInfo.Version, *sr.cInfo)
+	setLatestVersion(batch, sr.cInfo.Version)
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	sr.commitIDs = append(sr.commitIDs, sr.cInfo.CommitID())
+	sr.cInfo = nil
+	return nil
+}
+
+func encodeOrphan(hash []byte, fromVersion int64) []byte {
+	bz := make([]byte, 8+len(hash))
+	binary.BigEndian.PutUint64(bz, uint64(fromVersion))
+	copy(bz[8:], hash)
+	return bz
+}
+
+func decodeOrphan(bz []byte) ([]byte, int64, error) {
+	if len(bz) <= 8 {
+		return nil, 0, fmt.Errorf("invalid snapshot orphan of %d bytes", len(bz))
+	}
+	return bz[8:], int64(binary.BigEndian.Uint64(bz)), nil
+}
@@ -0,0 +1,139 @@
+package rootmulti
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/pocket-core/store/iavl"
+	"github.com/pokt-network/pocket-core/store/snapshots"
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+func TestMultiStoreSnapshotRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	store.pruningOpts = types.PruneNothing
+	require.Nil(t, store.LoadLatestVersion())
+	for i := 0; i < 10; i++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			kv := store.getStoreByName(name).(types.KVStore)
+			_ = kv.Set([]byte(fmt.Sprintf("key%d", i%4)), []byte(fmt.Sprintf("%s-%d", name, i)))
+			_ = kv.Delete([]byte(fmt.Sprintf("key%d", (i+1)%4)))
+		}
+		store.Commit()
+	}
+
+	w, err := snapshots.NewWriter(dir, 10, 256)
+	require.Nil(t, err)
+	hash, err := store.ExportSnapshot(6, 10, w)
+	require.Nil(t, err)
+	require.Nil(t, w.WriteItem(snapshots.ItemTypeApp, []byte("app")))
+	meta, err := w.Close(hash)
+	require.Nil(t, err)
+	require.True(t, len(meta.Chunks) > 1)
+
+	r, err := snapshots.NewReader(dir, 10)
+	require.Nil(t, err)
+	restored := newMultiStoreWithMounts(dbm.NewMemDB())
+	var appItems []string
+	commitIDs, err := restored.RestoreSnapshot(r, func(typ byte, bz []byte) error {
+		require.Equal(t, snapshots.ItemTypeApp, typ)
+		appItems = append(appItems, string(bz))
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"app"}, appItems)
+	require.Len(t, commitIDs, 5)
+	require.Equal(t, store.LastCommitID(), restored.LastCommitID())
+	for ver := int64(6); ver <= 10; ver++ {
+		expected, err := getCommitInfo(store.DB, ver)
+		require.Nil(t, err)
+		require.Equal(t, expected.CommitID(), commitIDs[ver-6])
+		cms, err := restored.CacheMultiStoreWithVersion(ver)
+		require.Nil(t, err)
+		original, err := store.CacheMultiStoreWithVersion(ver)
+		require.Nil(t, err)
+		for _, key := range []string{"key0", "key1", "key2", "key3"} {
+			got, _ := cms.GetKVStore(restored.keysByName["store2"]).Get([]byte(key))
+			want, _ := original.GetKVStore(store.keysByName["store2"]).Get([]byte(key))
+			require.Equal(t, want, got)
+		}
+	}
+	_, err = restored.CacheMultiStoreWithVersion(5)
+	require.Error(t, err)
+
+	// the restored store keeps committing from the snapshot
+	_ = restored.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("value"))
+	_ = store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("value"))
+	require.Equal(t, store.Commit(), restored.Commit())
+
+	// a store that is not empty can not be restored into
+	r, err = snapshots.NewReader(dir, 10)
+	require.Nil(t, err)
+	_, err = restored.RestoreSnapshot(r, func(byte, []byte) error { return nil })
+	require.Error(t, err)
+
+	// a corrupted chunk is rejected
+	require.Nil(t, ioutil.WriteFile(fmt.Sprintf("%s/10/%06d", dir, 1), []byte("corrupted"), 0644))
+	r, err = snapshots.NewReader(dir, 10)
+	require.Nil(t, err)
+	_, err = newMultiStoreWithMounts(dbm.NewMemDB()).RestoreSnapshot(r, func(byte, []byte) error { return nil })
+	require.Error(t, err)
+}
+
+func TestMultiStoreSnapshotPruning(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	store.pruningOpts = types.NewPruningOptions(4, 0)
+	require.Nil(t, store.LoadLatestVersion())
+	commit := func(i int) {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			_ = store.getStoreByName(name).(types.KVStore).Set([]byte(fmt.Sprintf("key%d", i%4)), []byte(fmt.Sprintf("%s-%d", name, i)))
+		}
+		store.Commit()
+	}
+	for i := 0; i < 10; i++ {
+		commit(i)
+	}
+	// the versions of the snapshot outlive the commits made while it is written
+	release, err := store.HoldVersions(6, 10)
+	require.Nil(t, err)
+	for i := 10; i < 13; i++ {
+		commit(i)
+	}
+	w, err := snapshots.NewWriter(dir, 10, 256)
+	require.Nil(t, err)
+	hash, err := store.ExportSnapshot(6, 10, w)
+	require.Nil(t, err)
+	_, err = w.Close(hash)
+	require.Nil(t, err)
+	// and are pruned by the next commit once released
+	release()
+	commit(13)
+	iavlStore := store.getStoreByName("store1").(*iavl.Store)
+	for ver := int64(6); ver <= 9; ver++ {
+		require.False(t, iavlStore.VersionExists(ver))
+	}
+	require.True(t, iavlStore.VersionExists(10))
+
+	// without holding them the versions are pruned before they are written
+	for i := 14; i < 17; i++ {
+		commit(i)
+	}
+	w, err = snapshots.NewWriter(dir, 14, 256)
+	require.Nil(t, err)
+	_, err = store.ExportSnapshot(10, 14, w)
+	require.Error(t, err)
+	w.Abort()
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	dbm "github.com/tendermint/tm-db"
 	"io"
-	"log"
 	"strings"
 
 	"github.com/pokt-network/pocket-core/store/cachemulti"
@@ -79,12 +78,10 @@ func (rs *Store) CopyStore() *types.Store {
 var _ types.CommitMultiStore = (*Store)(nil)
 var _ types.Queryable = (*Store)(nil)
 
-const MemoryCacheCapacity = 12
-
 func NewStore(db dbm.DB, cache bool, iavlCacheSize int64) *Store {
 	var multiStoreCache types.MultiStoreCache
 	if cache {
-		multiStoreCache = heightcache.NewMultiStoreMemoryCache(MemoryCacheCapacity)
+		multiStoreCache = heightcache.NewMultiStoreMemoryCache(heightcache.DefaultDepth, heightcache.DefaultMaxBytes)
 	} else {
 		multiStoreCache = heightcache.NewMultiStoreInvalidCache()
 	}
@@ -92,6 +89,7 @@ func NewStore(db dbm.DB, cache bool, iavlCacheSize int64) *Store {
 	return &Store{
 		DB:            db,
 		Cache:         multiStoreCache,
+		pruningOpts:   types.PruneNothing,
 		storesParams:  make(map[types.StoreKey]storeParams),
 		stores:        make(map[types.StoreKey]types.CommitStore),
 		keysByName:    make(map[string]types.StoreKey),
@@ -107,6 +105,11 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	}
 }
 
+// Implements CommitMultiStore
+func (rs *Store) SetHeightCache(depth, maxBytes int64) {
+	rs.Cache.SetLimits(depth, maxBytes)
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -263,6 +266,32 @@ func (rs *Store) LoadVersion(ver int64) error {
 	return nil
 }
 
+// PruneVersions deletes every version of the iavl stores below the given version, keeping the commit infos
+func (rs *Store) PruneVersions(toVersion int64) error {
+	if toVersion > rs.lastCommitID.Version {
+		return fmt.Errorf("the prune height: %d must not be greater than the latest height: %d", toVersion, rs.lastCommitID.Version)
+	}
+	for key, store := range rs.stores {
+		s, ok := store.(*iavl.Store)
+		if !ok {
+			continue
+		}
+		if err := s.PruneVersions(toVersion); err != nil {
+			return fmt.Errorf("failed to prune store: %s: %s", key.Name(), err.Error())
+		}
+	}
+	return nil
+}
+
+// DiffVersions returns the keys of the named store changed from a persisted version to a later one, in key order.
+func (rs *Store) DiffVersions(storeName string, from, to int64) ([]types.KVChange, error) {
+	store, ok := rs.getStoreByName(storeName).(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("no such iavl store: %s", storeName)
+	}
+	return store.DiffVersions(from, to)
+}
+
 func (rs *Store) LoadLazyVersion(ver int64) (*types.Store, error) {
 	newStores := make(map[types.StoreKey]types.CommitStore)
 	for k, v := range rs.stores {
@@ -543,11 +572,7 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		panic("recursive MultiStores not yet supported")
 
 	case types.StoreTypeIAVL:
-		cacheForStore := rs.Cache.GetSingleStoreCache(key)
-		if cacheForStore.IsValid() {
-			log.Printf("Warming up cache for %s\n", key.Name())
-		}
-		return iavl.LoadStore(db, id, rs.pruningOpts, rs.lazyLoading, cacheForStore, rs.iavlCacheSize)
+		return iavl.LoadStore(db, id, rs.pruningOpts, rs.lazyLoading, rs.Cache.GetSingleStoreCache(key), rs.iavlCacheSize)
 
 	case types.StoreTypeDB:
 		return commitDBStoreAdapter{dbadapter.Store{DB: db}}, nil
@@ -9,6 +9,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/pokt-network/pocket-core/store/errors"
+	"github.com/pokt-network/pocket-core/store/rootmulti/heightcache"
 	"github.com/pokt-network/pocket-core/store/types"
 )
 
@@ -67,6 +68,84 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	require.Equal(t, kg, v)
 }
 
+func TestHeightCache(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := NewStore(db, true, 5000000)
+	key := types.NewKVStoreKey("store1")
+	ms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.Nil(t, ms.LoadLatestVersion())
+	ms.SetHeightCache(3, 0)
+	cache := ms.Cache.(*heightcache.MultiStoreMemoryCache)
+
+	// version 1 sets a and b, 2 changes a, 3 deletes b and sets c, 4 and 5 set d
+	store := ms.GetKVStore(key)
+	_ = store.Set([]byte("a"), []byte("a1"))
+	_ = store.Set([]byte("b"), []byte("b1"))
+	ms.Commit()
+	_ = store.Set([]byte("a"), []byte("a2"))
+	ms.Commit()
+	_ = store.Delete([]byte("b"))
+	_ = store.Set([]byte("c"), []byte("c3"))
+	ms.Commit()
+	_ = store.Set([]byte("d"), []byte("d4"))
+	ms.Commit()
+	_ = store.Set([]byte("d"), []byte("d5"))
+	ms.Commit()
+
+	expected := map[int64][]string{
+		1: {"a", "a1", "b", "b1"},
+		2: {"a", "a2", "b", "b1"},
+		3: {"a", "a2", "c", "c3"},
+		4: {"a", "a2", "c", "c3", "d", "d4"},
+	}
+	for version, pairs := range expected {
+		cms, err := ms.CacheMultiStoreWithVersion(version)
+		require.Nil(t, err)
+		past := cms.GetKVStore(key)
+		var got []string
+		it, err := past.Iterator(nil, nil)
+		require.Nil(t, err)
+		for ; it.Valid(); it.Next() {
+			got = append(got, string(it.Key()), string(it.Value()))
+		}
+		it.Close()
+		require.Equal(t, pairs, got, "version %d", version)
+		it, err = past.ReverseIterator([]byte("b"), []byte("d"))
+		require.Nil(t, err)
+		got = nil
+		for ; it.Valid(); it.Next() {
+			got = append(got, string(it.Key()))
+		}
+		it.Close()
+		var inRange []string
+		for i := len(pairs) - 2; i >= 0; i -= 2 {
+			if pairs[i] >= "b" && pairs[i] < "d" {
+				inRange = append(inRange, pairs[i])
+			}
+		}
+		require.Equal(t, inRange, got, "version %d", version)
+		value, _ := past.Get([]byte("b"))
+		if version < 3 {
+			require.Equal(t, []byte("b1"), value)
+		} else {
+			require.Nil(t, value)
+		}
+	}
+	// version 1 is older than the 3 cached heights and is read from the store
+	metrics := cache.Metrics()["store1"]
+	require.Equal(t, int64(3), metrics.Heights)
+	require.Equal(t, int64(1*3), metrics.Misses)
+	require.Equal(t, int64(3*3), metrics.Hits)
+
+	// past the memory limit the oldest heights are dropped
+	ms.SetHeightCache(3, 1)
+	require.Equal(t, int64(0), cache.Metrics()["store1"].Heights)
+	cms, err := ms.CacheMultiStoreWithVersion(2)
+	require.Nil(t, err)
+	value, _ := cms.GetKVStore(key).Get([]byte("a"))
+	require.Equal(t, []byte("a2"), value)
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db)
@@ -151,6 +230,45 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestMultiStorePruneVersions(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := NewStore(db, false, 5000000)
+	store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
+	require.Nil(t, store.LoadLatestVersion())
+
+	store1 := store.getStoreByName("store1").(types.KVStore)
+	for i := byte(0); i < 10; i++ {
+		_ = store1.Set([]byte{i}, []byte{i})
+		store.Commit()
+	}
+	// nothing is pruned by default
+	for ver := int64(1); ver <= 10; ver++ {
+		_, err := store.CacheMultiStoreWithVersion(ver)
+		require.Nil(t, err)
+	}
+
+	// the latest version can not be pruned past
+	require.Error(t, store.PruneVersions(11))
+	require.Nil(t, store.PruneVersions(6))
+	for ver := int64(1); ver < 6; ver++ {
+		_, err := store.CacheMultiStoreWithVersion(ver)
+		require.Error(t, err)
+	}
+	for ver := int64(6); ver <= 10; ver++ {
+		_, err := store.CacheMultiStoreWithVersion(ver)
+		require.Nil(t, err)
+	}
+
+	// the pruned versions stay pruned after a reload
+	store = NewStore(db, false, 5000000)
+	store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
+	require.Nil(t, store.LoadLatestVersion())
+	_, err := store.CacheMultiStoreWithVersion(5)
+	require.Error(t, err)
+	_, err = store.CacheMultiStoreWithVersion(6)
+	require.Nil(t, err)
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -0,0 +1,283 @@
+package snapshots
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+const (
+	// CurrentFormat is the format of the snapshots written by this version
+	CurrentFormat uint32 = 1
+	// DefaultChunkSize is the size after which a snapshot starts a new chunk
+	DefaultChunkSize = 10 << 20
+	// MaxItemSize is the largest item a snapshot reads
+	MaxItemSize   = 64 << 20
+	metadataName  = "metadata.json"
+	chunkNameFmt  = "%06d"
+	writingSuffix = ".tmp"
+)
+
+// The types of the items written by the stores. Types from ItemTypeApp up are left to the application.
+const (
+	ItemTypeCommitInfo byte = iota + 1
+	ItemTypeStore
+	ItemTypeNode
+	ItemTypeOrphan
+	ItemTypeApp byte = 0x10
+)
+
+// Metadata describes a snapshot at a height and the chunks it is split into.
+type Metadata struct {
+	Height  int64    `json:"height"`
+	Format  uint32   `json:"format"`
+	AppHash string   `json:"app_hash"`
+	Chunks  []string `json:"chunks"` // the sha256 of each chunk
+}
+
+// Writer writes a stream of typed items into the hashed chunks of a snapshot.
+type Writer struct {
+	dir       string
+	height    int64
+	chunkSize int
+	file      *os.File
+	buf       *bufio.Writer
+	hasher    hash.Hash
+	written   int
+	chunks    []string
+}
+
+// NewWriter starts a snapshot at the height in the snapshots directory. The snapshot is only listed once closed.
+func NewWriter(dir string, height int64, chunkSize int) (*Writer, error) {
+	w := &Writer{
+		dir:       filepath.Join(dir, strconv.FormatInt(height, 10)+writingSuffix),
+		height:    height,
+		chunkSize: chunkSize,
+	}
+	if _, err := os.Stat(snapshotDir(dir, height)); err == nil {
+		return nil, fmt.Errorf("a snapshot at height %d already exists", height)
+	}
+	if err := os.RemoveAll(w.dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(w.dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// WriteItem appends an item of the given type to the snapshot.
+func (w *Writer) WriteItem(typ byte, bz []byte) error {
+	if len(bz) > MaxItemSize {
+		return fmt.Errorf("snapshot item of %d bytes is larger than the maximum %d", len(bz), MaxItemSize)
+	}
+	if w.file == nil {
+		if err := w.openChunk(); err != nil {
+			return err
+		}
+	}
+	header := make([]byte, 1+binary.MaxVarintLen64)
+	header[0] = typ
+	n := 1 + binary.PutUvarint(header[1:], uint64(len(bz)))
+	if _, err := w.buf.Write(header[:n]); err != nil {
+		return err
+	}
+	if _, err := w.buf.Write(bz); err != nil {
+		return err
+	}
+	w.written += n + len(bz)
+	// items are never split, so a chunk is checked before any of its items are read
+	if w.written >= w.chunkSize {
+		return w.closeChunk()
+	}
+	return nil
+}
+
+// Close finishes the snapshot, recording the app hash it restores to.
+func (w *Writer) Close(appHash []byte) (Metadata, error) {
+	if w.file != nil {
+		if err := w.closeChunk(); err != nil {
+			return Metadata{}, err
+		}
+	}
+	meta := Metadata{
+		Height:  w.height,
+		Format:  CurrentFormat,
+		AppHash: hex.EncodeToString(appHash),
+		Chunks:  w.chunks,
+	}
+	bz, err := json.MarshalIndent(meta, "", "    ")
+	if err != nil {
+		return Metadata{}, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(w.dir, metadataName), bz, 0644); err != nil {
+		return Metadata{}, err
+	}
+	return meta, os.Rename(w.dir, filepath.Join(filepath.Dir(w.dir), strconv.FormatInt(w.height, 10)))
+}
+
+// Abort deletes the unfinished snapshot.
+func (w *Writer) Abort() {
+	if w.file != nil {
+		_ = w.file.Close()
+	}
+	_ = os.RemoveAll(w.dir)
+}
+
+func (w *Writer) openChunk() (err error) {
+	w.file, err = os.Create(filepath.Join(w.dir, fmt.Sprintf(chunkNameFmt, len(w.chunks))))
+	if err != nil {
+		return err
+	}
+	w.hasher = sha256.New()
+	w.buf = bufio.NewWriter(io.MultiWriter(w.file, w.hasher))
+	w.written = 0
+	return nil
+}
+
+func (w *Writer) closeChunk() error {
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.chunks = append(w.chunks, hex.EncodeToString(w.hasher.Sum(nil)))
+	w.file = nil
+	return nil
+}
+
+// Reader reads the items of a snapshot, checking each chunk against its hash before reading it.
+type Reader struct {
+	dir   string
+	meta  Metadata
+	index int
+	chunk *bytes.Reader
+}
+
+// NewReader opens the snapshot at the height in the snapshots directory.
+func NewReader(dir string, height int64) (*Reader, error) {
+	meta, err := Load(dir, height)
+	if err != nil {
+		return nil, err
+	}
+	if meta.Format != CurrentFormat {
+		return nil, fmt.Errorf("unsupported snapshot format %d, expected %d", meta.Format, CurrentFormat)
+	}
+	return &Reader{dir: snapshotDir(dir, height), meta: meta}, nil
+}
+
+// Metadata returns the metadata of the snapshot.
+func (r *Reader) Metadata() Metadata {
+	return r.meta
+}
+
+// ReadItem returns the next item of the snapshot, or io.EOF after the last one.
+func (r *Reader) ReadItem() (typ byte, bz []byte, err error) {
+	for r.chunk == nil || r.chunk.Len() == 0 {
+		if r.index >= len(r.meta.Chunks) {
+			return 0, nil, io.EOF
+		}
+		if err := r.openChunk(); err != nil {
+			return 0, nil, err
+		}
+	}
+	typ, err = r.chunk.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	size, err := binary.ReadUvarint(r.chunk)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid snapshot item in chunk %d: %s", r.index-1, err.Error())
+	}
+	if size > uint64(r.chunk.Len()) {
+		return 0, nil, fmt.Errorf("snapshot item of %d bytes overruns chunk %d", size, r.index-1)
+	}
+	bz = make([]byte, size)
+	_, err = io.ReadFull(r.chunk, bz)
+	return typ, bz, err
+}
+
+func (r *Reader) openChunk() error {
+	bz, err := ioutil.ReadFile(filepath.Join(r.dir, fmt.Sprintf(chunkNameFmt, r.index)))
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(bz)
+	if hex.EncodeToString(sum[:]) != r.meta.Chunks[r.index] {
+		return fmt.Errorf("snapshot chunk %d does not match its hash %s", r.index, r.meta.Chunks[r.index])
+	}
+	r.chunk = bytes.NewReader(bz)
+	r.index++
+	return nil
+}
+
+// Load returns the metadata of the snapshot at the height in the snapshots directory.
+func Load(dir string, height int64) (Metadata, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(snapshotDir(dir, height), metadataName))
+	if err != nil {
+		return Metadata{}, fmt.Errorf("snapshot at height %d not found: %s", height, err.Error())
+	}
+	var meta Metadata
+	if err := json.Unmarshal(bz, &meta); err != nil {
+		return Metadata{}, err
+	}
+	if meta.Height != height {
+		return Metadata{}, fmt.Errorf("snapshot at height %d is for height %d", height, meta.Height)
+	}
+	return meta, nil
+}
+
+// List returns the metadata of the finished snapshots in the snapshots directory, latest first.
+func List(dir string) ([]Metadata, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Metadata, 0, len(entries))
+	for _, entry := range entries {
+		height, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if !entry.IsDir() || err != nil {
+			continue
+		}
+		meta, err := Load(dir, height)
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, meta)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Height > snapshots[j].Height })
+	return snapshots, nil
+}
+
+// Prune deletes all but the keepRecent latest snapshots in the snapshots directory.
+func Prune(dir string, keepRecent int) error {
+	snapshots, err := List(dir)
+	if err != nil {
+		return err
+	}
+	for i := keepRecent; i < len(snapshots); i++ {
+		if err := os.RemoveAll(snapshotDir(dir, snapshots[i].Height)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func snapshotDir(dir string, height int64) string {
+	return filepath.Join(dir, strconv.FormatInt(height, 10))
+}
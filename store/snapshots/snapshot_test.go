@@ -0,0 +1,71 @@
+package snapshots
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReadSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	items := [][]byte{[]byte("first"), {}, []byte("a longer third item"), []byte("fourth")}
+	for _, height := range []int64{5, 10, 15} {
+		w, err := NewWriter(dir, height, 16)
+		require.Nil(t, err)
+		for i, item := range items {
+			require.Nil(t, w.WriteItem(byte(i), item))
+		}
+		meta, err := w.Close([]byte{0xAB})
+		require.Nil(t, err)
+		require.Equal(t, "ab", meta.AppHash)
+		require.Len(t, meta.Chunks, 2)
+	}
+	// a snapshot is only written once
+	_, err = NewWriter(dir, 10, 16)
+	require.Error(t, err)
+	// an aborted snapshot is not listed
+	w, err := NewWriter(dir, 20, 16)
+	require.Nil(t, err)
+	require.Nil(t, w.WriteItem(0, []byte("item")))
+	w.Abort()
+
+	list, err := List(dir)
+	require.Nil(t, err)
+	require.Len(t, list, 3)
+	require.Equal(t, int64(15), list[0].Height)
+
+	r, err := NewReader(dir, 10)
+	require.Nil(t, err)
+	for i, item := range items {
+		typ, bz, err := r.ReadItem()
+		require.Nil(t, err)
+		require.Equal(t, byte(i), typ)
+		require.Equal(t, item, bz)
+	}
+	_, _, err = r.ReadItem()
+	require.Equal(t, io.EOF, err)
+
+	// a changed chunk does not match its hash
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "10", "000001"), []byte{0, 1, 'x'}, 0644))
+	r, err = NewReader(dir, 10)
+	require.Nil(t, err)
+	for range items[:3] {
+		_, _, err = r.ReadItem()
+		require.Nil(t, err)
+	}
+	_, _, err = r.ReadItem()
+	require.Error(t, err)
+
+	require.Nil(t, Prune(dir, 1))
+	list, err = List(dir)
+	require.Nil(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, int64(15), list[0].Height)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/kv"
 
+	"github.com/pokt-network/pocket-core/store/snapshots"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -104,6 +105,20 @@ type CommitMultiStore interface {
 	// Don't iterate through and collect all the roots and versions
 	LoadLazyVersion(ver int64) (*Store, error)
 	CopyStore() *Store
+	// Delete every persisted version below the given version.
+	PruneVersions(toVersion int64) error
+	// Write the persisted versions from..to into a snapshot, returning the commit hash of the last one.
+	ExportSnapshot(from, to int64, w *snapshots.Writer) ([]byte, error)
+	// Keep the persisted versions from..to from being pruned until the returned release is called.
+	HoldVersions(from, to int64) (release func(), err error)
+	// Restore the versions of a snapshot into an empty store, passing the items of the application on.
+	RestoreSnapshot(r *snapshots.Reader, appItemFn func(typ byte, bz []byte) error) ([]CommitID, error)
+	// Write the pairs of each store into an empty store as the given version.
+	ImportVersion(version int64, next func() (storeName string, key, value []byte, err error)) (CommitID, error)
+	// Set the number of past heights kept by the height cache and the memory in bytes they may use.
+	SetHeightCache(depth, maxBytes int64)
+	// Return the keys of the named store changed from one persisted version to a later one, in key order.
+	DiffVersions(storeName string, from, to int64) ([]KVChange, error)
 }
 
 //---------subsp-------------------------------
@@ -276,12 +291,21 @@ func (key *TransientStoreKey) String() string {
 // key-value result for iterator queries
 type KVPair kv.Pair
 
+// KVChange is a key changed between two versions of a store, with its value at each version, nil where it is absent
+type KVChange struct {
+	Key    []byte
+	Before []byte
+	After  []byte
+}
+
 //----------------------------------------
 
 // TraceContext contains TraceKVStore context data. It will be written with
 // every trace operation.
 type TraceContext map[string]interface{}
 
+// SingleStoreCache answers the queries of a store at its past heights. A query it can not answer returns an error and
+// is left to the store. Committed is a read only store of the last committed version.
 type SingleStoreCache interface {
 	Get(height int64, key []byte) ([]byte, error)
 	Has(height int64, key []byte) (bool, error)
@@ -289,12 +313,14 @@ type SingleStoreCache interface {
 	Remove(key []byte) error
 	Iterator(height int64, start, end []byte) (Iterator, error)
 	ReverseIterator(height int64, start, end []byte) (Iterator, error)
-	Commit(height int64)
-	Initialize(currentData map[string]string, version int64)
+	Commit(height int64, committed KVStore)
+	Initialize(committed KVStore, version int64)
 	IsValid() bool
 }
 
 type MultiStoreCache interface {
 	InitializeSingleStoreCache(height int64, storeKey StoreKey) error
 	GetSingleStoreCache(storekey StoreKey) SingleStoreCache
+	// SetLimits sets the number of past heights cached and the memory in bytes they may use, 0 for the default
+	SetLimits(depth, maxBytes int64)
 }
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"github.com/pokt-network/pocket-core/store/rootmulti/heightcache"
+	storeTypes "github.com/pokt-network/pocket-core/store/types"
 	"github.com/tendermint/tendermint/config"
 	db "github.com/tendermint/tm-db"
 	"sync"
@@ -17,33 +20,98 @@ type SDKConfig struct {
 }
 
 type PocketConfig struct {
-	DataDir                  string `json:"data_dir"`
-	GenesisName              string `json:"genesis_file"`
-	ChainsName               string `json:"chains_name"`
-	EvidenceDBName           string `json:"evidence_db_name"`
-	TendermintURI            string `json:"tendermint_uri"`
-	KeybaseName              string `json:"keybase_name"`
-	RPCPort                  string `json:"rpc_port"`
-	ClientBlockSyncAllowance int    `json:"client_block_sync_allowance"`
-	MaxEvidenceCacheEntires  int    `json:"max_evidence_cache_entries"`
-	MaxSessionCacheEntries   int    `json:"max_session_cache_entries"`
-	JSONSortRelayResponses   bool   `json:"json_sort_relay_responses"`
-	RemoteCLIURL             string `json:"remote_cli_url"`
-	UserAgent                string `json:"user_agent"`
-	ValidatorCacheSize       int64  `json:"validator_cache_size"`
-	ApplicationCacheSize     int64  `json:"application_cache_size"`
-	RPCTimeout               int64  `json:"rpc_timeout"`
-	PrometheusAddr           string `json:"pocket_prometheus_port"`
-	PrometheusMaxOpenfiles   int    `json:"prometheus_max_open_files"`
-	MaxClaimAgeForProofRetry int    `json:"max_claim_age_for_proof_retry"`
-	ProofPrevalidation       bool   `json:"proof_prevalidation"`
-	CtxCacheSize             int    `json:"ctx_cache_size"`
-	ABCILogging              bool   `json:"abci_logging"`
-	RelayErrors              bool   `json:"show_relay_errors"`
-	DisableTxEvents          bool   `json:"disable_tx_events"`
-	Cache                    bool   `json:"-"`
-	IavlCacheSize            int64  `json:"iavl_cache_size"`
-	ChainsHotReload          bool   `json:"chains_hot_reload"`
+	DataDir                  string            `json:"data_dir"`
+	GenesisName              string            `json:"genesis_file"`
+	ChainsName               string            `json:"chains_name"`
+	EvidenceDBName           string            `json:"evidence_db_name"`
+	TendermintURI            string            `json:"tendermint_uri"`
+	KeybaseName              string            `json:"keybase_name"`
+	RPCPort                  string            `json:"rpc_port"`
+	ClientBlockSyncAllowance int               `json:"client_block_sync_allowance"`
+	MaxEvidenceCacheEntires  int               `json:"max_evidence_cache_entries"`
+	MaxSessionCacheEntries   int               `json:"max_session_cache_entries"`
+	JSONSortRelayResponses   bool              `json:"json_sort_relay_responses"`
+	RemoteCLIURL             string            `json:"remote_cli_url"`
+	UserAgent                string            `json:"user_agent"`
+	ValidatorCacheSize       int64             `json:"validator_cache_size"`
+	ApplicationCacheSize     int64             `json:"application_cache_size"`
+	RPCTimeout               int64             `json:"rpc_timeout"`
+	PrometheusAddr           string            `json:"pocket_prometheus_port"`
+	PrometheusMaxOpenfiles   int               `json:"prometheus_max_open_files"`
+	MaxClaimAgeForProofRetry int               `json:"max_claim_age_for_proof_retry"`
+	ProofPrevalidation       bool              `json:"proof_prevalidation"`
+	CtxCacheSize             int               `json:"ctx_cache_size"`
+	ABCILogging              bool              `json:"abci_logging"`
+	RelayErrors              bool              `json:"show_relay_errors"`
+	DisableTxEvents          bool              `json:"disable_tx_events"`
+	Cache                    bool              `json:"-"`
+	IavlCacheSize            int64             `json:"iavl_cache_size"`
+	ChainsHotReload          bool              `json:"chains_hot_reload"`
+	SessionKeyFile           string            `json:"session_key_file"`
+	FeePayer                 string            `json:"fee_payer"`
+	MinTip                   int64             `json:"min_tip"`
+	Pruning                  PruningConfig     `json:"pruning"`
+	Snapshots                SnapshotConfig    `json:"snapshots"`
+	DBBackend                string            `json:"db_backend"`
+	HeightCache              HeightCacheConfig `json:"height_cache"`
+	TxIndexer                TxIndexerConfig   `json:"tx_indexer"`
+}
+
+// TxIndexerConfig sets the txs kept in full by the transaction indexer, see TransactionIndexer.SetRetention. The
+// hashes of the other txs are kept to reject their replays.
+type TxIndexerConfig struct {
+	RetainBlocks int64 `json:"retain_blocks"` // the number of recent blocks whose txs are kept, 0 keeps every block
+	LocalOnly    bool  `json:"local_only"`    // keep only the txs signed by or sent to the validator or session key
+	PruneBatch   int   `json:"prune_batch"`   // the number of txs deleted per batch by the pruner
+}
+
+// HeightCacheConfig sets the past heights kept by the height cache of the --useCache flag, see
+// heightcache.MultiStoreMemoryCache.
+type HeightCacheConfig struct {
+	Depth    int64 `json:"depth"`     // the number of past heights cached, 0 for the default
+	MaxBytes int64 `json:"max_bytes"` // the memory the cached heights may use, 0 for the default
+}
+
+// SnapshotConfig sets how often the node writes a snapshot of its state, see app.CreateSnapshot.
+type SnapshotConfig struct {
+	Interval   int64 `json:"interval"`    // the heights between snapshots, 0 disables them
+	KeepRecent int   `json:"keep_recent"` // the number of snapshots kept
+}
+
+// PruningConfig sets which past versions of the application state are kept on disk.
+type PruningConfig struct {
+	Strategy   string `json:"strategy"`
+	KeepRecent int64  `json:"keep_recent"`
+	KeepEvery  int64  `json:"keep_every"`
+}
+
+const (
+	PruningArchive = "archive" // keep every version
+	PruningDefault = "default" // keep the last 100 versions and every 10000th
+	PruningCustom  = "custom"  // keep the last keep_recent versions and every keep_every-th
+)
+
+// The backends of the application, evidence and transaction indexer databases, see NewDB.
+const (
+	GoLevelDBBackend = "goleveldb" // the default
+	BadgerDBBackend  = "badgerdb"  // pure go, see store/badgerdb
+)
+
+// Options returns the store pruning options of the configured strategy.
+func (pc PruningConfig) Options() (PruningOptions, error) {
+	switch pc.Strategy {
+	case PruningArchive, "":
+		return storeTypes.PruneNothing, nil
+	case PruningDefault:
+		return storeTypes.PruneSyncable, nil
+	case PruningCustom:
+		if pc.KeepRecent < 0 || pc.KeepEvery < 0 {
+			return PruningOptions{}, fmt.Errorf("invalid pruning: keep_recent %d and keep_every %d must not be negative", pc.KeepRecent, pc.KeepEvery)
+		}
+		return storeTypes.NewPruningOptions(pc.KeepRecent, pc.KeepEvery), nil
+	default:
+		return PruningOptions{}, fmt.Errorf("unknown pruning strategy: %s", pc.Strategy)
+	}
 }
 
 type Config struct {
@@ -79,6 +147,7 @@ const (
 	ConfigFileName                     = "config.json"
 	ApplicationDBName                  = "application"
 	TransactionIndexerDBName           = "txindexer"
+	SnapshotsDirName                   = "snapshots"
 	PlaceholderHash                    = "0001"
 	PlaceholderURL                     = "http://127.0.0.1:8081"
 	PlaceholderServiceURL              = PlaceholderURL
@@ -97,6 +166,9 @@ const (
 	AuthFileName                       = "auth.json"
 	DefaultIavlCacheSize               = 5000000
 	DefaultChainHotReload              = false
+	DefaultSnapshotKeepRecent          = 2
+	DefaultHeightCacheDepth            = heightcache.DefaultDepth
+	DefaultHeightCacheMaxBytes         = heightcache.DefaultMaxBytes
 )
 
 func DefaultConfig(dataDir string) Config {
@@ -129,6 +201,10 @@ func DefaultConfig(dataDir string) Config {
 			DisableTxEvents:          DefaultRPCDisableTransactionEvents,
 			IavlCacheSize:            DefaultIavlCacheSize,
 			ChainsHotReload:          DefaultChainHotReload,
+			Pruning:                  PruningConfig{Strategy: PruningArchive},
+			DBBackend:                GoLevelDBBackend,
+			HeightCache:              HeightCacheConfig{Depth: DefaultHeightCacheDepth, MaxBytes: DefaultHeightCacheMaxBytes},
+			TxIndexer:                TxIndexerConfig{PruneBatch: DefaultTxIndexerPruneBatch},
 		},
 	}
 	c.TendermintConfig.LevelDBOptions = config.DefaultLevelDBOpts()
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	storeTypes "github.com/pokt-network/pocket-core/store/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruningConfigOptions(t *testing.T) {
+	var testCases = []struct {
+		config   PruningConfig
+		expected PruningOptions
+		hasError bool
+	}{
+		{PruningConfig{}, storeTypes.PruneNothing, false},
+		{PruningConfig{Strategy: PruningArchive}, storeTypes.PruneNothing, false},
+		{PruningConfig{Strategy: PruningDefault}, storeTypes.PruneSyncable, false},
+		{PruningConfig{Strategy: PruningCustom, KeepRecent: 500, KeepEvery: 1000}, storeTypes.NewPruningOptions(500, 1000), false},
+		{PruningConfig{Strategy: PruningCustom, KeepRecent: -1}, PruningOptions{}, true},
+		{PruningConfig{Strategy: "syncable"}, PruningOptions{}, true},
+	}
+
+	for _, test := range testCases {
+		opts, err := test.config.Options()
+		if test.hasError {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, test.expected, opts)
+	}
+	require.Equal(t, PruningArchive, DefaultConfig("data").PocketConfig.Pruning.Strategy)
+}
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"github.com/jordanorelli/lexnum"
 	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/libs/pubsub/query"
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 	"math"
+	"strings"
 )
 
 var (
@@ -29,6 +31,10 @@ const (
 	TxSignerKey         = "tx.signer"
 	TxRecipientKey      = "tx.recipient"
 	TxHashKey           = "tx.hash"
+	TxMessageTypeKey    = "tx.message_type"
+	TxChainKey          = "tx.chain"
+	TxSessionHeightKey  = "tx.session_height"
+	TxCodeKey           = "tx.code"
 	SortAscending       = "asc"
 	SortDescending      = "desc"
 	AuthCodespace       = "auth"
@@ -37,12 +43,32 @@ const (
 	AnteHandlerMaxError = 10
 )
 
+// SessionTagger returns the chain and the session height of the pocketcore message of a tx, ok being false for the
+// other messages. The indexer does not know the messages of the modules, so the app decodes them.
+type SessionTagger func(tx types.Tx, height int64) (chain string, sessionHeight int64, ok bool)
+
 type TransactionIndexer struct {
-	store dbm.DB
+	store         dbm.DB
+	sessionTagger SessionTagger
+	retainBlocks  int64               // the number of recent blocks whose txs are kept in full, 0 for every block
+	localAddrs    map[string]struct{} // the addresses whose txs are kept in full, every address when empty
+	pruneBatch    int
+	pruneSignal   chan struct{}
+	logger        log.Logger
+	latestHeight  int64 // atomic
+	retainedFrom  int64 // atomic
 }
 
 func NewTransactionIndexer(store dbm.DB) *TransactionIndexer {
-	return &TransactionIndexer{store: store}
+	t := &TransactionIndexer{store: store}
+	t.loadRetainedFrom()
+	return t
+}
+
+// SetSessionTagger sets the function the txs are tagged with their chain and session height by, no tx being tagged
+// without it
+func (t *TransactionIndexer) SetSessionTagger(tagger SessionTagger) {
+	t.sessionTagger = tagger
 }
 
 func (t *TransactionIndexer) AddBatch(b *txindex.Batch) error {
@@ -50,63 +76,56 @@ func (t *TransactionIndexer) AddBatch(b *txindex.Batch) error {
 	defer storeBatch.Close()
 
 	for _, result := range b.Ops { // iterate through all the transaction results
-		if result.Result.Codespace == AuthCodespace && result.Result.Code < AnteHandlerMaxError {
-			continue // don't index any ante handler level errors
-		}
-		hash := result.Tx.Hash()
-
-		// index tx by sender
-		if result.Result.Signer != nil {
-			storeBatch.Set(keyForSigner(result), hash)
-		}
-
-		// index tx by recipient
-		if result.Result.Recipient != nil {
-			storeBatch.Set(keyForRecipient(result), hash)
-		}
-
-		// index tx by height
-		storeBatch.Set(keyForHeight(result), hash)
-
-		// index tx by hash
-		rawBytes, err := cdc.MarshalBinaryBare(result, 0) // TODO make protobuf compatible
-		if err != nil {
+		if err := t.indexResult(storeBatch, result); err != nil {
 			return err
 		}
-		storeBatch.Set(hash, rawBytes)
 	}
 
-	return storeBatch.WriteSync()
+	if err := storeBatch.WriteSync(); err != nil {
+		return err
+	}
+	t.indexed(b.Ops)
+	return nil
 }
 
 func (t *TransactionIndexer) Index(result *types.TxResult) error {
 	storeBatch := t.store.NewBatch()
 	defer storeBatch.Close()
+	if err := t.indexResult(storeBatch, result); err != nil {
+		return err
+	}
+	if err := storeBatch.WriteSync(); err != nil {
+		return err
+	}
+	t.indexed([]*types.TxResult{result})
+	return nil
+}
+
+// indexResult writes the index keys and the result of the tx to the batch, or only its hash for the txs not kept
+func (t *TransactionIndexer) indexResult(storeBatch dbm.Batch, result *types.TxResult) error {
 	if result.Result.Codespace == AuthCodespace && result.Result.Code < AnteHandlerMaxError {
 		return nil // no indexing for ante handler level errors
 	}
 	hash := result.Tx.Hash()
-	// index tx by sender
-	if result.Result.Signer != nil {
-		storeBatch.Set(keyForSigner(result), hash)
+	if !t.isLocal(result) {
+		// the hash is kept to reject the replays of the tx
+		storeBatch.Set(keyForHashOnly(result), hash)
+		storeBatch.Set(hash, hashOnlyValue(result.Height))
+		return nil
 	}
 
-	// index tx by recipient
-	if result.Result.Recipient != nil {
-		storeBatch.Set(keyForRecipient(result), hash)
+	// index tx by sender, recipient, message type, chain, session height, result code and height
+	for _, key := range t.indexKeys(result) {
+		storeBatch.Set(key, hash)
 	}
 
-	// index tx by height
-	storeBatch.Set(keyForHeight(result), hash)
-
 	// index tx by hash
 	rawBytes, err := cdc.MarshalBinaryBare(result, 0) // TODO make protobuf compatible
 	if err != nil {
 		return err
 	}
 	storeBatch.Set(hash, rawBytes)
-
-	return storeBatch.WriteSync()
+	return nil
 }
 
 func (t *TransactionIndexer) Get(hash []byte) (*types.TxResult, error) {
@@ -118,6 +137,9 @@ func (t *TransactionIndexer) Get(hash []byte) (*types.TxResult, error) {
 	if rawBytes == nil {
 		return nil, nil
 	}
+	if height, ok := parseHashOnlyValue(rawBytes); ok {
+		return nil, TxNotRetainedError{Hash: hash, Height: height, RetainedFrom: t.RetainedFrom()}
+	}
 
 	txResult := new(types.TxResult)
 	err := cdc.UnmarshalBinaryBare(rawBytes, &txResult, 0)
@@ -128,111 +150,166 @@ func (t *TransactionIndexer) Get(hash []byte) (*types.TxResult, error) {
 	return txResult, nil
 }
 
-// NOTE: Only supports op.Equal for hash, height, signer, or recipient, we only support op.Equal for simplicity and
-// optimization of our use case
+// NOTE: Only supports op.Equal conditions joined by AND, on the hash, height, signer, recipient, message type, chain,
+// session height or result code. The txs of the first condition are iterated in index order, and filtered by the
+// others, so the most selective condition should come first
 func (t *TransactionIndexer) Search(ctx context.Context, q *query.Query) (res []*types.TxResult, total int, err error) {
-	condition, err := q.Condition()
+	conditions, err := q.Conditions()
 	if err != nil {
-		return nil, 0, errors.Wrap(err, "error during parsing condition from query")
+		return nil, 0, errors.Wrap(err, "error during parsing conditions from query")
+	}
+	if len(conditions) == 0 {
+		return nil, 0, errors.New("the query has no condition")
 	}
 
 	if q.Pagination.Size > maxPerPage {
 		q.Pagination.Size = maxPerPage
 	}
 
-	if condition.Op != query.OpEqual {
-		return nil, 0, fmt.Errorf("transaction indexer only supports op.Equal not %v", condition.Op)
+	for _, condition := range conditions {
+		if condition.Op != query.OpEqual {
+			return nil, 0, fmt.Errorf("transaction indexer only supports op.Equal not %v", condition.Op)
+		}
+	}
+	filters := make(map[string]string, len(conditions)-1)
+	for _, condition := range conditions[1:] {
+		value, err := conditionValue(condition)
+		if err != nil {
+			return nil, 0, err
+		}
+		filters[condition.CompositeKey] = value
 	}
 
-	switch condition.CompositeKey {
+	switch condition := conditions[0]; condition.CompositeKey {
 	case TxHeightKey:
-		return t.heightQuery(condition, q.Pagination)
+		return t.heightQuery(condition, q.Pagination, filters)
 	case TxSignerKey:
-		return t.signerQuery(condition, q.Pagination)
+		return t.signerQuery(condition, q.Pagination, filters)
 	case TxRecipientKey:
-		return t.recipientQuery(condition, q.Pagination)
+		return t.recipientQuery(condition, q.Pagination, filters)
 	case TxHashKey:
-		return t.hashQuery(condition)
+		return t.hashQuery(condition, filters)
+	case TxMessageTypeKey, TxChainKey, TxSessionHeightKey, TxCodeKey:
+		value, err := conditionValue(condition)
+		if err != nil {
+			return nil, 0, err
+		}
+		return t.getByPrefix(prefixKeyForTag(condition.CompositeKey, value), q.Pagination, filters)
 	default:
 		return nil, 0, fmt.Errorf("Condition.CompositeKey: %v not supported on this indexer", condition.CompositeKey)
 	}
 }
 
 func (t *TransactionIndexer) DeleteFromHeight(ctx context.Context, height int64) error {
-	startKey := []byte(fmt.Sprintf("%s/%s",
-		TxHeightKey,
-		elenEncoder.EncodeInt(int(height)),
-	))
-	endKey := []byte(fmt.Sprintf("%s/%s",
-		TxHeightKey,
-		elenEncoder.EncodeInt(math.MaxInt64),
-	))
-	it, err := t.store.ReverseIterator(startKey, endKey)
-	if err != nil {
-		return errors.Wrap(err, "error creating the reverse iterator for deleteFromHeight")
-	}
-	defer it.Close()
 	b := t.store.NewBatch()
 	defer b.Close()
-	for ; it.Valid(); it.Next() {
-		b.Delete(it.Value())
+	for _, key := range []string{TxHeightKey, TxHashOnlyKey} {
+		startKey := []byte(fmt.Sprintf("%s/%s",
+			key,
+			elenEncoder.EncodeInt(int(height)),
+		))
+		endKey := []byte(fmt.Sprintf("%s/%s",
+			key,
+			elenEncoder.EncodeInt(math.MaxInt64),
+		))
+		it, err := t.store.ReverseIterator(startKey, endKey)
+		if err != nil {
+			return errors.Wrap(err, "error creating the reverse iterator for deleteFromHeight")
+		}
+		for ; it.Valid(); it.Next() {
+			hash := append([]byte{}, it.Value()...)
+			b.Delete(append([]byte{}, it.Key()...))
+			// the txs kept only by hash have no other index keys
+			if key == TxHeightKey {
+				result, err := t.Get(hash)
+				if err == nil && result != nil {
+					for _, indexKey := range t.indexKeys(result) {
+						b.Delete(indexKey)
+					}
+				}
+			}
+			b.Delete(hash)
+		}
+		it.Close()
 	}
 	return b.WriteSync()
 }
 
-func (t *TransactionIndexer) hashQuery(condition query.Condition) (res []*types.TxResult, total int, err error) {
+func (t *TransactionIndexer) hashQuery(condition query.Condition, filters map[string]string) (res []*types.TxResult, total int, err error) {
 	hash, err := hex.DecodeString(condition.Operand.(string))
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "error during searching for a hash in the query")
 	}
 	result, err := t.Get(hash)
+	if len(filters) != 0 && (result == nil || !t.matches(result, filters)) {
+		return nil, 0, err
+	}
 	if err == nil {
 		total = 1
 	}
 	return []*types.TxResult{result}, total, err
 }
 
-func (t *TransactionIndexer) heightQuery(condition query.Condition, pagination *query.Page) (res []*types.TxResult, total int, err error) {
+func (t *TransactionIndexer) heightQuery(condition query.Condition, pagination *query.Page, filters map[string]string) (res []*types.TxResult, total int, err error) {
 	height, ok := condition.Operand.(int64)
 	if !ok {
 		return nil, 0, errors.New("error during searching for a height in the query, c.Operand not type int64")
 	}
-	return t.getByPrefix(prefixKeyForHeight(height), pagination)
+	return t.getByPrefix(prefixKeyForHeight(height), pagination, filters)
 }
 
-func (t *TransactionIndexer) signerQuery(condition query.Condition, pagination *query.Page) (res []*types.TxResult, total int, err error) {
+func (t *TransactionIndexer) signerQuery(condition query.Condition, pagination *query.Page, filters map[string]string) (res []*types.TxResult, total int, err error) {
 	signer, err := hex.DecodeString(condition.Operand.(string))
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "error during searching for a address in the query")
 	}
-	return t.getByPrefix(prefixKeyForSigner(signer), pagination)
+	return t.getByPrefix(prefixKeyForSigner(signer), pagination, filters)
 }
 
-func (t *TransactionIndexer) recipientQuery(condition query.Condition, pagination *query.Page) (res []*types.TxResult, total int, err error) {
+func (t *TransactionIndexer) recipientQuery(condition query.Condition, pagination *query.Page, filters map[string]string) (res []*types.TxResult, total int, err error) {
 	recipient, err := hex.DecodeString(condition.Operand.(string))
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "error during searching for a address in the query")
 	}
-	return t.getByPrefix(prefixKeyForRecipient(recipient), pagination)
+	return t.getByPrefix(prefixKeyForRecipient(recipient), pagination, filters)
 }
 
-func (t *TransactionIndexer) getByPrefix(prefix []byte, pagination *query.Page) (res []*types.TxResult, total int, err error) {
+// getByPrefix pages the txs indexed under the prefix, skipping the txs not matching the filters
+func (t *TransactionIndexer) getByPrefix(prefix []byte, pagination *query.Page, filters map[string]string) (res []*types.TxResult, total int, err error) {
 	it, err := PrefixIterator(t.store, prefix, pagination.Sort)
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "error creating prefix iterator")
 	}
 	defer it.Close()
 	for i, skipCount := 0, 0; it.Valid(); it.Next() {
+		var val *types.TxResult
+		if len(filters) != 0 {
+			// the tx is read to be filtered
+			if val, err = t.Get(it.Value()); err != nil {
+				if _, pruned := err.(TxNotRetainedError); pruned {
+					// pruned since the iterator was created
+					continue
+				}
+				return nil, 0, errors.Wrap(err, "error during query iteration get()")
+			}
+			if val == nil || !t.matches(val, filters) {
+				continue
+			}
+		}
 		if skipCount < pagination.Skip {
 			skipCount++
 			total++
 			continue
 		}
-		val, err := t.Get(it.Value())
-		if err != nil {
-			return nil, 0, errors.Wrap(err, "error during query iteration get()")
-		}
 		if i < pagination.Size {
+			if val == nil {
+				if val, err = t.Get(it.Value()); err != nil {
+					if _, pruned := err.(TxNotRetainedError); pruned {
+						continue
+					}
+					return nil, 0, errors.Wrap(err, "error during query iteration get()")
+				}
+			}
 			res = append(res, val)
 		}
 		total++
@@ -241,6 +318,95 @@ func (t *TransactionIndexer) getByPrefix(prefix []byte, pagination *query.Page)
 	return
 }
 
+// indexKeys returns the keys the tx is indexed under: its signer, recipient, message type, the chain and the session
+// height of its pocketcore message, its result code and its height
+func (t *TransactionIndexer) indexKeys(result *types.TxResult) [][]byte {
+	var keys [][]byte
+	if result.Result.Signer != nil {
+		keys = append(keys, keyForSigner(result))
+	}
+	if result.Result.Recipient != nil {
+		keys = append(keys, keyForRecipient(result))
+	}
+	tags := t.txTags(result)
+	for _, tag := range []string{TxMessageTypeKey, TxChainKey, TxSessionHeightKey, TxCodeKey} {
+		// a value holding the separator would not be found back under its prefix
+		if value, ok := tags[tag]; ok && !strings.Contains(value, sep) {
+			keys = append(keys, keyForTag(tag, value, result))
+		}
+	}
+	return append(keys, keyForHeight(result))
+}
+
+// txTags returns the value of each tag of the tx, as written in the index keys
+func (t *TransactionIndexer) txTags(result *types.TxResult) map[string]string {
+	tags := map[string]string{
+		TxHashKey:   hex.EncodeToString(result.Tx.Hash()),
+		TxHeightKey: elenEncoder.EncodeInt(int(result.Height)),
+		TxCodeKey:   elenEncoder.EncodeInt(int(result.Result.Code)),
+	}
+	if result.Result.Signer != nil {
+		tags[TxSignerKey] = Address(result.Result.Signer).String()
+	}
+	if result.Result.Recipient != nil {
+		tags[TxRecipientKey] = Address(result.Result.Recipient).String()
+	}
+	if result.Result.MessageType != "" {
+		tags[TxMessageTypeKey] = result.Result.MessageType
+	}
+	if t.sessionTagger != nil {
+		if chain, sessionHeight, ok := t.sessionTagger(result.Tx, result.Height); ok {
+			tags[TxChainKey] = chain
+			tags[TxSessionHeightKey] = elenEncoder.EncodeInt(int(sessionHeight))
+		}
+	}
+	return tags
+}
+
+// matches returns whether the tx has the value of each tag of the filters
+func (t *TransactionIndexer) matches(result *types.TxResult, filters map[string]string) bool {
+	tags := t.txTags(result)
+	for tag, value := range filters {
+		if v, ok := tags[tag]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// conditionValue returns the operand of the condition, as written in the index keys
+func conditionValue(condition query.Condition) (string, error) {
+	switch condition.CompositeKey {
+	case TxHeightKey, TxSessionHeightKey, TxCodeKey:
+		n, ok := condition.Operand.(int64)
+		if !ok {
+			return "", fmt.Errorf("error during searching for %s in the query, c.Operand not type int64", condition.CompositeKey)
+		}
+		return elenEncoder.EncodeInt(int(n)), nil
+	case TxSignerKey, TxRecipientKey, TxHashKey:
+		s, ok := condition.Operand.(string)
+		if !ok {
+			return "", fmt.Errorf("error during searching for %s in the query, c.Operand not type string", condition.CompositeKey)
+		}
+		bz, err := hex.DecodeString(s)
+		if err != nil {
+			return "", errors.Wrap(err, "error during searching for "+condition.CompositeKey+" in the query")
+		}
+		return hex.EncodeToString(bz), nil
+	case TxMessageTypeKey, TxChainKey:
+		s, ok := condition.Operand.(string)
+		if !ok {
+			return "", fmt.Errorf("error during searching for %s in the query, c.Operand not type string", condition.CompositeKey)
+		}
+		if strings.Contains(s, sep) {
+			return "", fmt.Errorf("error during searching for %s in the query, c.Operand contains %s", condition.CompositeKey, sep)
+		}
+		return s, nil
+	default:
+		return "", fmt.Errorf("Condition.CompositeKey: %v not supported on this indexer", condition.CompositeKey)
+	}
+}
+
 func keyForHeight(result *types.TxResult) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s",
 		TxHeightKey,
@@ -290,6 +456,23 @@ func prefixKeyForRecipient(recipient Address) []byte {
 	))
 }
 
+func keyForTag(tag, value string, result *types.TxResult) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s",
+		tag,
+		value,
+		elenEncoder.EncodeInt(int(result.Height)),
+		elenEncoder.EncodeInt(int(result.Index)),
+	))
+}
+
+func prefixKeyForTag(tag, value string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s",
+		tag,
+		value,
+		elenEncoder.EncodeInt(0),
+	))
+}
+
 // contract: caller must close iterator
 func PrefixIterator(db dbm.DB, prefix []byte, order string) (dbm.Iterator, error) {
 	switch order {
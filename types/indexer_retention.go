@@ -0,0 +1,214 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	TxHashOnlyKey              = "tx.hash_only"     // the index of the txs kept only by hash, to roll them back
+	txRetainedFromKey          = "tx.retained_from" // the first height whose txs are kept in full
+	hashOnlyPrefix             = "hash_only/"       // the value of the hash of a tx kept only by hash, before its height
+	DefaultTxIndexerPruneBatch = 1000
+)
+
+// TxNotRetainedError is returned for a tx the indexer keeps only the hash of: a tx of a pruned height, or a tx not
+// involving the local keys.
+type TxNotRetainedError struct {
+	Hash         []byte
+	Height       int64
+	RetainedFrom int64
+}
+
+func (e TxNotRetainedError) Error() string {
+	if e.Height < e.RetainedFrom {
+		return fmt.Sprintf("tx (%X) of height %d is outside the range retained by the transaction indexer, which starts at height %d",
+			e.Hash, e.Height, e.RetainedFrom)
+	}
+	return fmt.Sprintf("tx (%X) of height %d is not retained, the transaction indexer only keeps the txs of the local keys",
+		e.Hash, e.Height)
+}
+
+// SetRetention sets the txs the indexer keeps in full: those of the last retainBlocks blocks, every block when 0, and
+// only those signed by or sent to the local addresses when any is given. The hash of every tx is kept regardless, as
+// the ante handler rejects the replays of the indexed txs. The older blocks are pruned in the background, by batches
+// of pruneBatch txs, so that indexing never waits on them.
+func (t *TransactionIndexer) SetRetention(retainBlocks int64, localAddrs []Address, pruneBatch int, logger log.Logger) {
+	t.retainBlocks = retainBlocks
+	t.localAddrs = nil
+	if len(localAddrs) != 0 {
+		t.localAddrs = make(map[string]struct{}, len(localAddrs))
+		for _, addr := range localAddrs {
+			t.localAddrs[string(addr)] = struct{}{}
+		}
+	}
+	t.pruneBatch = pruneBatch
+	if t.pruneBatch <= 0 {
+		t.pruneBatch = DefaultTxIndexerPruneBatch
+	}
+	t.logger = logger
+	if retainBlocks > 0 && t.pruneSignal == nil {
+		t.pruneSignal = make(chan struct{}, 1)
+		go t.pruneRoutine()
+	}
+}
+
+// HasTx returns whether the tx of the hash is indexed, in full or only by its hash
+func (t *TransactionIndexer) HasTx(hash []byte) (bool, error) {
+	return t.store.Has(hash)
+}
+
+// RetainedFrom returns the first height whose txs are kept in full, 0 when no height was pruned
+func (t *TransactionIndexer) RetainedFrom() int64 {
+	return atomic.LoadInt64(&t.retainedFrom)
+}
+
+// PruneBefore deletes the txs of the heights before the height, keeping their hashes, by batches of the prune batch
+// size. Returns the number of txs pruned.
+func (t *TransactionIndexer) PruneBefore(height int64) (int, error) {
+	if height <= t.RetainedFrom() {
+		return 0, nil
+	}
+	batchSize := t.pruneBatch
+	if batchSize <= 0 {
+		batchSize = DefaultTxIndexerPruneBatch
+	}
+	pruned := 0
+	for _, key := range []string{TxHeightKey, TxHashOnlyKey} {
+		start := []byte(key + sep)
+		end := []byte(fmt.Sprintf("%s/%s", key, elenEncoder.EncodeInt(int(height))))
+		for {
+			keys, hashes, err := t.scanKeys(start, end, batchSize)
+			if err != nil {
+				return pruned, err
+			}
+			if len(keys) == 0 {
+				break
+			}
+			b := t.store.NewBatch()
+			for i, hash := range hashes {
+				b.Delete(keys[i])
+				if key == TxHashOnlyKey {
+					// the hash only serves to roll back the recent heights
+					continue
+				}
+				result, err := t.Get(hash)
+				if err != nil || result == nil {
+					continue
+				}
+				for _, indexKey := range t.indexKeys(result) {
+					b.Delete(indexKey)
+				}
+				b.Set(hash, hashOnlyValue(result.Height))
+				pruned++
+			}
+			err = b.Write()
+			b.Close()
+			if err != nil {
+				return pruned, err
+			}
+		}
+	}
+	if err := t.store.SetSync([]byte(txRetainedFromKey), []byte(strconv.FormatInt(height, 10))); err != nil {
+		return pruned, err
+	}
+	atomic.StoreInt64(&t.retainedFrom, height)
+	return pruned, nil
+}
+
+// scanKeys returns up to limit keys of the range, along with their values
+func (t *TransactionIndexer) scanKeys(start, end []byte, limit int) (keys, values [][]byte, err error) {
+	it, err := t.store.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+	for ; it.Valid() && len(keys) < limit; it.Next() {
+		keys = append(keys, append([]byte{}, it.Key()...))
+		values = append(values, append([]byte{}, it.Value()...))
+	}
+	return keys, values, nil
+}
+
+// indexed records the height of the txs written, and wakes the pruner up
+func (t *TransactionIndexer) indexed(results []*types.TxResult) {
+	for _, result := range results {
+		if result != nil && result.Height > atomic.LoadInt64(&t.latestHeight) {
+			atomic.StoreInt64(&t.latestHeight, result.Height)
+		}
+	}
+	if t.pruneSignal == nil {
+		return
+	}
+	select {
+	case t.pruneSignal <- struct{}{}:
+	default:
+		// a prune is already pending
+	}
+}
+
+// pruneRoutine prunes the heights out of the retained range each time txs are indexed
+func (t *TransactionIndexer) pruneRoutine() {
+	for range t.pruneSignal {
+		height := atomic.LoadInt64(&t.latestHeight) - t.retainBlocks + 1
+		pruned, err := t.PruneBefore(height)
+		if t.logger == nil {
+			continue
+		}
+		if err != nil {
+			t.logger.Error(fmt.Sprintf("unable to prune the transaction indexer before height %d: %s", height, err.Error()))
+		} else if pruned > 0 {
+			t.logger.Info(fmt.Sprintf("pruned %d txs of the transaction indexer before height %d", pruned, height))
+		}
+	}
+}
+
+// isLocal returns whether the tx is signed by or sent to a local address, every tx being local when none is set
+func (t *TransactionIndexer) isLocal(result *types.TxResult) bool {
+	if len(t.localAddrs) == 0 {
+		return true
+	}
+	for _, addr := range [][]byte{result.Result.Signer, result.Result.Recipient} {
+		if addr == nil {
+			continue
+		}
+		if _, ok := t.localAddrs[string(addr)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// loadRetainedFrom reads the first height whose txs are kept in full
+func (t *TransactionIndexer) loadRetainedFrom() {
+	bz, _ := t.store.Get([]byte(txRetainedFromKey))
+	if height, err := strconv.ParseInt(string(bz), 10, 64); err == nil {
+		atomic.StoreInt64(&t.retainedFrom, height)
+	}
+}
+
+func keyForHashOnly(result *types.TxResult) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s",
+		TxHashOnlyKey,
+		elenEncoder.EncodeInt(int(result.Height)),
+		elenEncoder.EncodeInt(int(result.Index)),
+	))
+}
+
+// hashOnlyValue is stored under the hash of a tx kept only by hash. It never starts like an encoded tx result
+func hashOnlyValue(height int64) []byte {
+	return []byte(hashOnlyPrefix + strconv.FormatInt(height, 10))
+}
+
+func parseHashOnlyValue(bz []byte) (height int64, ok bool) {
+	if !bytes.HasPrefix(bz, []byte(hashOnlyPrefix)) {
+		return 0, false
+	}
+	height, err := strconv.ParseInt(string(bz[len(hashOnlyPrefix):]), 10, 64)
+	return height, err == nil
+}
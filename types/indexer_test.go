@@ -0,0 +1,181 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestTransactionIndexerSearch(t *testing.T) {
+	indexer := NewTransactionIndexer(dbm.NewMemDB())
+	// the test txs of the claims are "claim:<chain>:<sessionHeight>:<nonce>"
+	indexer.SetSessionTagger(func(tx types.Tx, height int64) (string, int64, bool) {
+		parts := strings.Split(string(tx), ":")
+		if parts[0] != "claim" {
+			return "", 0, false
+		}
+		sessionHeight, _ := strconv.ParseInt(parts[2], 10, 64)
+		return parts[1], sessionHeight, true
+	})
+	signer, other := Address{0x01, 0x02}, Address{0x03, 0x04}
+	add := func(height int64, index uint32, tx string, msgType string, code uint32, from Address) {
+		require.Nil(t, indexer.Index(&types.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     types.Tx(tx),
+			Result: abci.ResponseDeliverTx{Code: code, Signer: from, MessageType: msgType},
+		}))
+	}
+	add(1, 0, "claim:0001:1:a", "claim", 0, signer)
+	add(1, 1, "claim:0002:1:b", "claim", 0, other)
+	add(1, 2, "stake:a", "stake_validator", 0, signer)
+	add(2, 0, "claim:0001:1:c", "claim", 0, other)
+	add(2, 1, "claim:0001:2:d", "claim", 1, signer)
+	add(2, 2, "send:a", "send", 0, signer)
+
+	search := func(q string, size, skip int) ([]*types.TxResult, int) {
+		tmq, err := query.New(q)
+		require.Nil(t, err)
+		tmq.AddPage(size, skip, SortDescending)
+		res, total, err := indexer.Search(context.Background(), tmq)
+		require.Nil(t, err, q)
+		return res, total
+	}
+	txs := func(res []*types.TxResult) (s []string) {
+		for _, r := range res {
+			s = append(s, string(r.Tx))
+		}
+		return
+	}
+
+	res, total := search("tx.message_type='claim'", 30, 0)
+	assert.Equal(t, 4, total)
+	assert.Len(t, res, 4)
+	res, total = search("tx.chain='0001'", 30, 0)
+	assert.Equal(t, 3, total)
+	assert.ElementsMatch(t, []string{"claim:0001:1:a", "claim:0001:1:c", "claim:0001:2:d"}, txs(res))
+	res, total = search("tx.chain='0001' AND tx.session_height=1", 30, 0)
+	assert.Equal(t, 2, total)
+	assert.ElementsMatch(t, []string{"claim:0001:1:a", "claim:0001:1:c"}, txs(res))
+	res, total = search(fmt.Sprintf("tx.signer='%s' AND tx.message_type='claim'", signer), 30, 0)
+	assert.Equal(t, 2, total)
+	assert.ElementsMatch(t, []string{"claim:0001:1:a", "claim:0001:2:d"}, txs(res))
+	res, total = search("tx.code=1", 30, 0)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []string{"claim:0001:2:d"}, txs(res))
+	res, total = search("tx.height=2 AND tx.code=0", 30, 0)
+	assert.Equal(t, 2, total)
+	assert.ElementsMatch(t, []string{"claim:0001:1:c", "send:a"}, txs(res))
+	_, total = search("tx.message_type='unknown'", 30, 0)
+	assert.Zero(t, total)
+
+	// the filtered txs are paged in the order of the first condition
+	all, total := search(fmt.Sprintf("tx.signer='%s' AND tx.code=0", signer), 30, 0)
+	assert.Equal(t, 3, total)
+	var paged []*types.TxResult
+	for skip := 0; skip < 3; skip++ {
+		res, total = search(fmt.Sprintf("tx.signer='%s' AND tx.code=0", signer), 1, skip)
+		assert.Equal(t, 3, total)
+		paged = append(paged, res...)
+	}
+	assert.Equal(t, txs(all), txs(paged))
+
+	// a hash with a filter it does not match
+	hash := fmt.Sprintf("%X", types.Tx("send:a").Hash())
+	_, total = search(fmt.Sprintf("tx.hash='%s' AND tx.message_type='send'", hash), 30, 0)
+	assert.Equal(t, 1, total)
+	_, total = search(fmt.Sprintf("tx.hash='%s' AND tx.message_type='claim'", hash), 30, 0)
+	assert.Zero(t, total)
+
+	tmq, err := query.New("tx.session_height>1")
+	require.Nil(t, err)
+	tmq.AddPage(30, 0, SortDescending)
+	_, _, err = indexer.Search(context.Background(), tmq)
+	assert.NotNil(t, err)
+}
+
+func TestTransactionIndexerRetention(t *testing.T) {
+	indexer := NewTransactionIndexer(dbm.NewMemDB())
+	local, other := Address{0x01, 0x02}, Address{0x03, 0x04}
+	indexer.SetRetention(0, []Address{local}, 2, nil)
+	add := func(height int64, index uint32, tx string, from Address) []byte {
+		require.Nil(t, indexer.Index(&types.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     types.Tx(tx),
+			Result: abci.ResponseDeliverTx{Signer: from, MessageType: "send"},
+		}))
+		return types.Tx(tx).Hash()
+	}
+	count := func(q string) int {
+		tmq, err := query.New(q)
+		require.Nil(t, err)
+		tmq.AddPage(30, 0, SortDescending)
+		_, total, err := indexer.Search(context.Background(), tmq)
+		require.Nil(t, err, q)
+		return total
+	}
+	var hashes [][]byte
+	for height := int64(1); height <= 4; height++ {
+		hashes = append(hashes, add(height, 0, fmt.Sprintf("local:%d", height), local))
+		hashes = append(hashes, add(height, 1, fmt.Sprintf("other:%d", height), other))
+	}
+
+	// the txs of the other keys are kept only by hash
+	res, err := indexer.Get(hashes[0])
+	require.Nil(t, err)
+	assert.Equal(t, "local:1", string(res.Tx))
+	_, err = indexer.Get(hashes[1])
+	require.IsType(t, TxNotRetainedError{}, err)
+	assert.Equal(t, int64(1), err.(TxNotRetainedError).Height)
+	has, err := indexer.HasTx(hashes[1])
+	require.Nil(t, err)
+	assert.True(t, has)
+	assert.Equal(t, 4, count("tx.message_type='send'"))
+	assert.Zero(t, count(fmt.Sprintf("tx.signer='%s'", other)))
+
+	// the heights before 3 are pruned, keeping the hashes of their txs
+	pruned, err := indexer.PruneBefore(3)
+	require.Nil(t, err)
+	assert.Equal(t, 2, pruned)
+	assert.Equal(t, int64(3), indexer.RetainedFrom())
+	_, err = indexer.Get(hashes[0])
+	require.IsType(t, TxNotRetainedError{}, err)
+	assert.Contains(t, err.Error(), "outside the range retained")
+	has, err = indexer.HasTx(hashes[0])
+	require.Nil(t, err)
+	assert.True(t, has)
+	assert.Equal(t, 2, count("tx.message_type='send'"))
+	assert.Zero(t, count("tx.height=1"))
+	res, err = indexer.Get(hashes[4])
+	require.Nil(t, err)
+	assert.Equal(t, "local:3", string(res.Tx))
+	pruned, err = indexer.PruneBefore(2)
+	require.Nil(t, err)
+	assert.Zero(t, pruned)
+	assert.Equal(t, int64(3), NewTransactionIndexer(indexer.store).RetainedFrom())
+
+	// the rolled back heights delete the txs kept by hash too
+	require.Nil(t, indexer.DeleteFromHeight(context.Background(), 4))
+	for _, hash := range hashes[6:] {
+		has, err = indexer.HasTx(hash)
+		require.Nil(t, err)
+		assert.False(t, has)
+	}
+	has, err = indexer.HasTx(hashes[5])
+	require.Nil(t, err)
+	assert.True(t, has)
+	// along with the index keys of the rolled back txs
+	assert.Equal(t, 1, count("tx.message_type='send'"))
+	assert.Equal(t, 1, count(fmt.Sprintf("tx.signer='%s'", local)))
+	assert.Zero(t, count("tx.height=4"))
+}
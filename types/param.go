@@ -203,6 +203,18 @@ func (s Subspace) Set(ctx Ctx, key []byte, param interface{}) {
 	_ = tstore.Set(key, []byte{})
 }
 
+// ParamMerger is implemented by parameter types that an update merges into the
+// current value once the param validation feature is activated (e.g. editing a single entry of a map) rather than decoding onto it
+type ParamMerger interface {
+	MergeJSON(bz []byte) error
+}
+
+// ParamValidator is implemented by parameter types that check their value before
+// an update is stored
+type ParamValidator interface {
+	Validate() error
+}
+
 // Update stores raw parameter bytes. It returns error if the stored parameter
 // has a different type from the input. It also sets to the transient store to
 // record change.
@@ -215,10 +227,22 @@ func (s Subspace) Update(ctx Ctx, key []byte, param []byte) error {
 	ty := attr.ty
 	dest := reflect.New(ty).Interface()
 	s.GetIfExists(ctx, key, dest)
-	err := s.cdc.UnmarshalJSON(param, dest)
+	// params are merged and validated once the param validation feature is activated
+	validated := s.cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ParamValidationKey)
+	var err error
+	if merger, ok := dest.(ParamMerger); ok && validated {
+		err = merger.MergeJSON(param)
+	} else {
+		err = s.cdc.UnmarshalJSON(param, dest)
+	}
 	if err != nil {
 		return err
 	}
+	if validator, ok := dest.(ParamValidator); ok && validated {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
 
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
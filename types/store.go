@@ -119,6 +119,9 @@ type KVPair = types.KVPair
 
 type KvPairs []types.KVPair
 
+// key changed between two versions of a store
+type KVChange = types.KVChange
+
 //----------------------------------------
 
 // TraceContext contains TraceKVStore context data. It will be written with
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/pokt-network/pocket-core/store/badgerdb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 	"log"
+	"os"
+	"path/filepath"
 	"regexp"
 	"runtime"
 	"strconv"
@@ -101,6 +104,98 @@ func NewLevelDB(name, dir string, o *opt.Options) (db dbm.DB, err error) {
 	return db, err
 }
 
+// NewDB instantiates the database of the given name in the directory with the backend. The leveldb options are
+// ignored by the other backends.
+func NewDB(name, dir, backend string, o *opt.Options) (db dbm.DB, err error) {
+	switch backend {
+	case GoLevelDBBackend, "":
+		return NewLevelDB(name, dir, o)
+	case BadgerDBBackend:
+		return badgerdb.NewDB(name, dir)
+	default:
+		return nil, fmt.Errorf("unknown db backend %s, expected %s or %s", backend, GoLevelDBBackend, BadgerDBBackend)
+	}
+}
+
+// OpenDB opens the database like NewDB, refusing to start over an empty database when the database already exists with
+// the other backend.
+func OpenDB(name, dir, backend string, o *opt.Options) (dbm.DB, error) {
+	other := BadgerDBBackend
+	if backend == BadgerDBBackend {
+		other = GoLevelDBBackend
+	}
+	if !DBExists(name, dir, backend) && DBExists(name, dir, other) {
+		return nil, fmt.Errorf("the %s database exists with the %s backend but %s is configured, migrate it with pocket util migrate-db or set the db_backend back to %s", name, other, backend, other)
+	}
+	return NewDB(name, dir, backend, o)
+}
+
+// DBExists returns whether the database of the given name in the directory was created with the backend.
+func DBExists(name, dir, backend string) bool {
+	path := filepath.Join(dir, name+".db")
+	if backend == BadgerDBBackend {
+		path = filepath.Join(dir, name+badgerdb.DirSuffix)
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// migrateBatchSize is the number of pairs MigrateDB writes at a time
+const migrateBatchSize = 10000
+
+// MigrateDB copies the pairs of the database of the given name in the directory from one backend to the other,
+// returning the number of pairs copied. The source database is left as is, the destination must be empty.
+func MigrateDB(name, dir, from, to string, o *opt.Options) (pairs int64, err error) {
+	if from == to {
+		return 0, fmt.Errorf("the source and destination backends are both %s", from)
+	}
+	if !DBExists(name, dir, from) {
+		return 0, fmt.Errorf("the %s database %s does not exist in %s", from, name, dir)
+	}
+	src, err := NewDB(name, dir, from, o)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	dst, err := NewDB(name, dir, to, o)
+	if err != nil {
+		return 0, err
+	}
+	defer dst.Close()
+	dstIt, err := dst.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	empty := !dstIt.Valid()
+	dstIt.Close()
+	if !empty {
+		return 0, fmt.Errorf("the %s database %s in %s is not empty", to, name, dir)
+	}
+	it, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	batch := dst.NewBatch()
+	for ; it.Valid(); it.Next() {
+		batch.Set(it.Key(), it.Value())
+		pairs++
+		if pairs%migrateBatchSize == 0 {
+			err = batch.Write()
+			batch.Close()
+			if err != nil {
+				return pairs, err
+			}
+			batch = dst.NewBatch()
+		}
+	}
+	defer batch.Close()
+	if err := it.Error(); err != nil {
+		return pairs, err
+	}
+	return pairs, batch.WriteSync()
+}
+
 // Raw is a raw encoded JSON value.
 // It implements Marshaler and Unmarshaler and can
 // be used to delay JSON decoding or precompute a JSON encoding.
@@ -64,3 +64,43 @@ func TestTimeFormatAndParse(t *testing.T) {
 		require.Equal(t, timeFromRFC.Format(SortableTimeFormat), tc.SDKSortableTimeStr)
 	}
 }
+
+func TestMigrateDB(t *testing.T) {
+	dir := t.TempDir()
+	_, err := MigrateDB("test", dir, GoLevelDBBackend, BadgerDBBackend, nil)
+	require.Error(t, err)
+	db, err := NewDB("test", dir, GoLevelDBBackend, nil)
+	require.Nil(t, err)
+	for i := 0; i < migrateBatchSize+1; i++ {
+		require.Nil(t, db.Set(Uint64ToBigEndian(uint64(i)), []byte{byte(i)}))
+	}
+	require.Nil(t, db.Close())
+
+	pairs, err := MigrateDB("test", dir, GoLevelDBBackend, BadgerDBBackend, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(migrateBatchSize+1), pairs)
+	require.True(t, DBExists("test", dir, BadgerDBBackend))
+	db, err = NewDB("test", dir, BadgerDBBackend, nil)
+	require.Nil(t, err)
+	value, err := db.Get(Uint64ToBigEndian(migrateBatchSize))
+	require.Nil(t, err)
+	require.Equal(t, []byte{byte(migrateBatchSize % 256)}, value)
+	require.Nil(t, db.Close())
+
+	// the destination must be empty
+	_, err = MigrateDB("test", dir, GoLevelDBBackend, BadgerDBBackend, nil)
+	require.Error(t, err)
+	_, err = NewDB("test", dir, "rocksdb", nil)
+	require.Error(t, err)
+
+	// a database is not opened over an empty one when it only exists with the other backend
+	db, err = NewDB("other", dir, BadgerDBBackend, nil)
+	require.Nil(t, err)
+	require.Nil(t, db.Close())
+	_, err = OpenDB("other", dir, GoLevelDBBackend, nil)
+	require.Error(t, err)
+	require.False(t, DBExists("other", dir, GoLevelDBBackend))
+	db, err = OpenDB("test", dir, GoLevelDBBackend, nil)
+	require.Nil(t, err)
+	require.Nil(t, db.Close())
+}
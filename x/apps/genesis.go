@@ -46,6 +46,10 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			log.Fatal(fmt.Errorf("%s module account total does not equal the amount in each application account", types.StakedPoolName))
 		}
 	}
+	// set the revoked clients from the data
+	for _, revokedClient := range data.RevokedClients {
+		keeper.SetRevokedClient(ctx, revokedClient)
+	}
 	// add coins to the total supply
 	keeper.AccountKeeper.SetSupply(ctx, keeper.AccountKeeper.GetSupply(ctx).Inflate(stakedCoins))
 	// set the params set in the keeper
@@ -57,9 +61,10 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 	applications := keeper.GetAllApplications(ctx)
 	return types.GenesisState{
-		Params:       params,
-		Applications: applications,
-		Exported:     true,
+		Params:         params,
+		Applications:   applications,
+		Exported:       true,
+		RevokedClients: keeper.GetAllRevokedClients(ctx),
 	}
 }
 
@@ -2,6 +2,7 @@ package pos
 
 import (
 	"fmt"
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/apps/keeper"
@@ -23,6 +24,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginUnstake(ctx, msg, k)
 		case types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case types.MsgRevokeClient:
+			if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ScopedTokenKey) {
+				errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgRevokeClient(ctx, msg, k)
+		case types.MsgTransferApplication:
+			if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.AppTransferKey) {
+				errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgTransferApplication(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -114,3 +127,53 @@ func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Resu
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Applications may revoke a client public key to invalidate every AAT issued to that client
+func handleMsgRevokeClient(ctx sdk.Ctx, msg types.MsgRevokeClient, k keeper.Keeper) sdk.Result {
+	if err := k.ValidateRevokeClient(ctx, msg); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("App Revoke Client Validation Not Successful, at height: %d", ctx.BlockHeight()) + msg.AppAddr.String())
+		return err.Result()
+	}
+	k.RevokeClient(ctx, msg.AppAddr, msg.ClientPubKey)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeClient,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AppAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyClientPubKey, msg.ClientPubKey),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AppAddr.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Applications may move their stake, chains and status to a new public key without unstaking
+func handleMsgTransferApplication(ctx sdk.Ctx, msg types.MsgTransferApplication, k keeper.Keeper) sdk.Result {
+	application, newPubKey, err := k.ValidateApplicationTransfer(ctx, msg)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("App Transfer Validation Not Successful, at height: %d", ctx.BlockHeight()) + msg.AppAddr.String())
+		return err.Result()
+	}
+	transferred := k.TransferApplication(ctx, application, newPubKey)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AppAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewApplication, transferred.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, transferred.StakedTokens.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AppAddr.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
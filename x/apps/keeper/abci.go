@@ -11,5 +11,7 @@ func BeginBlocker(ctx sdk.Ctx, _ abci.RequestBeginBlock, k Keeper) {}
 func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	// Unstake all mature applications from the unstakeing queue.
 	k.unstakeAllMatureApplications(ctx)
+	// Move the max relays of the applications toward their claimed relays.
+	k.updateAllAppRelays(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/exported"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+)
+
+// AddRelayUsage - Record relays claimed against the application of the session, only while usage based relays are on.
+// The relays of a session before a transfer are recorded against the address the application was transferred to.
+func (k Keeper) AddRelayUsage(ctx sdk.Ctx, addr sdk.Address, sessionBlockHeight int64, relays int64) {
+	if !k.UsageRelaysOn(ctx) || relays <= 0 {
+		return
+	}
+	// an application transferred back to a former key leaves a cycle of transfers
+	visited := make(map[string]bool)
+	for !visited[addr.String()] {
+		visited[addr.String()] = true
+		to, height, found := k.getTransferredApp(ctx, addr)
+		if !found || sessionBlockHeight >= height {
+			break
+		}
+		addr = to
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyForRelayUsage(addr, sessionBlockHeight)
+	total := relays
+	if bz, _ := store.Get(key); bz != nil {
+		total += int64(binary.BigEndian.Uint64(bz))
+	}
+	_ = store.Set(key, sdk.Uint64ToBigEndian(uint64(total)))
+}
+
+// GetRelayUsage - Retrieve the relays claimed against the application since the session block height
+func (k Keeper) GetRelayUsage(ctx sdk.Ctx, addr sdk.Address, fromSessionBlockHeight int64) (relays int64) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyForRelayUsages(addr)
+	iterator, _ := store.Iterator(types.KeyForRelayUsage(addr, fromSessionBlockHeight), sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		relays += int64(binary.BigEndian.Uint64(iterator.Value()))
+	}
+	return
+}
+
+// pruneRelayUsage - Remove the relays claimed against the application before the session block height
+func (k Keeper) pruneRelayUsage(ctx sdk.Ctx, addr sdk.Address, beforeSessionBlockHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := store.Iterator(types.KeyForRelayUsages(addr), types.KeyForRelayUsage(addr, beforeSessionBlockHeight))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		_ = store.Delete(key)
+	}
+}
+
+// deleteRelayUsage - Remove every relay claimed against the application
+func (k Keeper) deleteRelayUsage(ctx sdk.Ctx, addr sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.KeyForRelayUsages(addr))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		_ = store.Delete(key)
+	}
+}
+
+// transferRelayUsage - Move the relays claimed against an application to a new address
+func (k Keeper) transferRelayUsage(ctx sdk.Ctx, from, to sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyForRelayUsages(from)
+	iterator, _ := sdk.KVStorePrefixIterator(store, prefix)
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+	for i, key := range keys {
+		_ = store.Delete(key)
+		_ = store.Set(append(types.KeyForRelayUsages(to), key[len(prefix):]...), values[i])
+	}
+}
+
+// getUsageStart - Retrieve the height usage based relays were turned on at, the usage being recorded since
+func (k Keeper) getUsageStart(ctx sdk.Ctx) (height int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.UsageStartKey)
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// trackUsageStart - Record the height usage based relays are turned on at, and forget it when they are turned off
+func (k Keeper) trackUsageStart(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	_, found := k.getUsageStart(ctx)
+	switch on := k.UsageRelaysOn(ctx); {
+	case on && !found:
+		_ = store.Set(types.UsageStartKey, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	case !on && found:
+		_ = store.Delete(types.UsageStartKey)
+	}
+}
+
+// usageWindowFilled - Returns whether the usage has been recorded for the whole trailing window. Until then the
+// applications have no history to be adjusted by
+func (k Keeper) usageWindowFilled(ctx sdk.Ctx) bool {
+	start, found := k.getUsageStart(ctx)
+	if !found {
+		return false
+	}
+	return ctx.BlockHeight()-start >= k.UsageWindowSessions(ctx)*k.POSKeeper.BlocksPerSession(ctx)
+}
+
+// usageWindowStart - Retrieve the first session block height of the trailing usage window
+func (k Keeper) usageWindowStart(ctx sdk.Ctx) int64 {
+	blocksPerSession := k.POSKeeper.BlocksPerSession(ctx)
+	if blocksPerSession <= 0 {
+		return 0
+	}
+	// the session block height of the current session
+	sessionBlockHeight := ((ctx.BlockHeight()-1)/blocksPerSession)*blocksPerSession + 1
+	start := sessionBlockHeight - k.UsageWindowSessions(ctx)*blocksPerSession
+	if start < 0 {
+		return 0
+	}
+	return start
+}
+
+// GetRelayAllowance - Compute the max relays of the application along with the inputs used
+func (k Keeper) GetRelayAllowance(ctx sdk.Ctx, application types.Application) types.RelayAllowance {
+	stakeRelays := k.calculateStakeRelays(ctx, application)
+	allowance := types.RelayAllowance{
+		Address:       application.Address,
+		StakedTokens:  application.StakedTokens,
+		StakeRelays:   stakeRelays,
+		UsageRelaysOn: k.UsageRelaysOn(ctx),
+		AverageRelays: sdk.ZeroInt(),
+		MinRelays:     stakeRelays,
+		MaxRelays:     stakeRelays,
+		AllowedRelays: stakeRelays,
+	}
+	if !allowance.UsageRelaysOn {
+		return allowance
+	}
+	allowance.WindowSessions = k.UsageWindowSessions(ctx)
+	if allowance.WindowSessions <= 0 {
+		return allowance
+	}
+	allowance.ClaimedRelays = k.GetRelayUsage(ctx, application.Address, k.usageWindowStart(ctx))
+	allowance.AverageRelays = sdk.NewInt(allowance.ClaimedRelays).QuoRaw(allowance.WindowSessions)
+	// the stake alone decides until the usage of the whole window is recorded
+	allowance.WindowFilled = k.usageWindowFilled(ctx)
+	if !allowance.WindowFilled {
+		return allowance
+	}
+	allowance.MinRelays = stakeRelays.MulRaw(k.UsageMinRelays(ctx)).QuoRaw(100)
+	allowance.MaxRelays = stakeRelays.MulRaw(k.UsageMaxRelays(ctx)).QuoRaw(100)
+	// move toward the real demand with some headroom, within the governance bounds
+	target := allowance.AverageRelays.MulRaw(k.UsageHeadroom(ctx)).QuoRaw(100)
+	switch {
+	case target.LT(allowance.MinRelays):
+		allowance.AllowedRelays = allowance.MinRelays
+	case target.GT(allowance.MaxRelays):
+		allowance.AllowedRelays = allowance.MaxRelays
+	default:
+		allowance.AllowedRelays = target
+	}
+	allowance.AllowedRelays = boundRelays(allowance.AllowedRelays)
+	return allowance
+}
+
+// updateAllAppRelays - Recompute the max relays of every staked application at the end of a session
+func (k Keeper) updateAllAppRelays(ctx sdk.Ctx) {
+	k.trackUsageStart(ctx)
+	if !k.UsageRelaysOn(ctx) {
+		return
+	}
+	blocksPerSession := k.POSKeeper.BlocksPerSession(ctx)
+	if blocksPerSession <= 0 || ctx.BlockHeight()%blocksPerSession != 0 {
+		return
+	}
+	windowStart := k.usageWindowStart(ctx)
+	k.pruneTransferredApps(ctx, windowStart)
+	// collect first, the staked set must not be written while iterating over it
+	var addrs []sdk.Address
+	k.IterateAndExecuteOverStakedApps(ctx, func(_ int64, application exported.ApplicationI) (stop bool) {
+		addrs = append(addrs, application.GetAddress())
+		return false
+	})
+	for _, addr := range addrs {
+		application, found := k.GetApplication(ctx, addr)
+		if !found {
+			ctx.Logger().Error(fmt.Sprintf("staked application %s not found while updating relays", addr.String()))
+			continue
+		}
+		k.pruneRelayUsage(ctx, addr, windowStart)
+		maxRelays := k.CalculateAppRelays(ctx, application)
+		if !maxRelays.Equal(application.MaxRelays) {
+			application.MaxRelays = maxRelays
+			k.SetApplication(ctx, application)
+		}
+	}
+}
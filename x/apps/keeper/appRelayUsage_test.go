@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_RelayUsage(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	// usage is not recorded while usage based relays are off
+	keeper.AddRelayUsage(context, application.Address, 1, 100)
+	assert.Zero(t, keeper.GetRelayUsage(context, application.Address, 0))
+	p := keeper.GetParams(context)
+	p.UsageRelaysOn = true
+	keeper.SetParams(context, p)
+	keeper.AddRelayUsage(context, application.Address, 1, 100)
+	keeper.AddRelayUsage(context, application.Address, 1, 50)
+	keeper.AddRelayUsage(context, application.Address, 5, 25)
+	assert.Equal(t, int64(175), keeper.GetRelayUsage(context, application.Address, 0))
+	assert.Equal(t, int64(25), keeper.GetRelayUsage(context, application.Address, 5))
+	assert.Zero(t, keeper.GetRelayUsage(context, getRandomApplicationAddress(), 0))
+	keeper.pruneRelayUsage(context, application.Address, 5)
+	assert.Equal(t, int64(25), keeper.GetRelayUsage(context, application.Address, 0))
+}
+
+func TestKeeper_GetRelayAllowance(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockHeight(100)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	stakeRelays := keeper.calculateStakeRelays(context, application)
+	// off: the stake alone decides
+	allowance := keeper.GetRelayAllowance(context, application)
+	assert.False(t, allowance.UsageRelaysOn)
+	assert.Equal(t, stakeRelays, allowance.AllowedRelays)
+	p := keeper.GetParams(context)
+	p.UsageRelaysOn = true
+	p.UsageWindowSessions = 2
+	p.UsageHeadroom = 150
+	p.UsageMinRelays = 50
+	p.UsageMaxRelays = 200
+	keeper.SetParams(context, p)
+	// turned on at this height: the stake decides until the usage of the whole window is recorded
+	keeper.trackUsageStart(context)
+	allowance = keeper.GetRelayAllowance(context, application)
+	assert.False(t, allowance.WindowFilled)
+	assert.Equal(t, stakeRelays, allowance.AllowedRelays)
+	context = context.WithBlockHeight(100 + p.UsageWindowSessions*keeper.POSKeeper.BlocksPerSession(context))
+	// no usage: clamped to the lower bound
+	allowance = keeper.GetRelayAllowance(context, application)
+	assert.Equal(t, stakeRelays.QuoRaw(2), allowance.AllowedRelays)
+	// usage within the bounds: average plus headroom
+	start := keeper.usageWindowStart(context)
+	keeper.AddRelayUsage(context, application.Address, start, stakeRelays.Int64())
+	keeper.AddRelayUsage(context, application.Address, start+keeper.POSKeeper.BlocksPerSession(context), stakeRelays.Int64())
+	allowance = keeper.GetRelayAllowance(context, application)
+	assert.Equal(t, 2*stakeRelays.Int64(), allowance.ClaimedRelays)
+	assert.Equal(t, stakeRelays, allowance.AverageRelays)
+	assert.Equal(t, stakeRelays.MulRaw(3).QuoRaw(2), allowance.AllowedRelays)
+	// heavy usage: clamped to the upper bound
+	keeper.AddRelayUsage(context, application.Address, start, 10*stakeRelays.Int64())
+	allowance = keeper.GetRelayAllowance(context, application)
+	assert.Equal(t, stakeRelays.MulRaw(2), allowance.AllowedRelays)
+	// usage before the window is ignored
+	assert.Equal(t, allowance.ClaimedRelays, keeper.GetRelayUsage(context, application.Address, start))
+	keeper.AddRelayUsage(context, application.Address, start-keeper.POSKeeper.BlocksPerSession(context), 1000)
+	assert.Equal(t, allowance, keeper.GetRelayAllowance(context, application))
+}
+
+func TestKeeper_UpdateAllAppRelays(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	blocksPerSession := keeper.POSKeeper.BlocksPerSession(context)
+	context = context.WithBlockHeight(10 * blocksPerSession)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	p := keeper.GetParams(context)
+	p.UsageRelaysOn = true
+	keeper.SetParams(context, p)
+	// no app is cut on the first session end after the turn on, with no usage recorded yet
+	keeper.updateAllAppRelays(context)
+	updated, found := keeper.GetApplication(context, application.Address)
+	assert.True(t, found)
+	stakeRelays := keeper.calculateStakeRelays(context, application)
+	assert.True(t, stakeRelays.Equal(updated.MaxRelays), "got %s want %s", updated.MaxRelays, stakeRelays)
+	// once the window is filled the apps follow their usage
+	context = context.WithBlockHeight(context.BlockHeight() + p.UsageWindowSessions*blocksPerSession)
+	keeper.updateAllAppRelays(context)
+	updated, _ = keeper.GetApplication(context, application.Address)
+	want := stakeRelays.MulRaw(p.UsageMinRelays).QuoRaw(100)
+	assert.True(t, want.Equal(updated.MaxRelays), "got %s want %s", updated.MaxRelays, want)
+	assert.NotEqual(t, sdk.ZeroInt(), updated.MaxRelays)
+	// turning usage off forgets the start
+	p.UsageRelaysOn = false
+	keeper.SetParams(context, p)
+	keeper.updateAllAppRelays(context)
+	_, found = keeper.getUsageStart(context)
+	assert.False(t, found)
+}
+
+func TestKeeper_DeleteRelayUsageOnUnstake(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getUnstakingApplication()
+	keeper.SetApplication(context, application)
+	p := keeper.GetParams(context)
+	p.UsageRelaysOn = true
+	keeper.SetParams(context, p)
+	keeper.AddRelayUsage(context, application.Address, 1, 100)
+	keeper.AddRelayUsage(context, application.Address, 5, 100)
+	other := getRandomApplicationAddress()
+	keeper.AddRelayUsage(context, other, 1, 100)
+	keeper.FinishUnstakingApplication(context, application)
+	assert.Zero(t, keeper.GetRelayUsage(context, application.Address, 0))
+	assert.Equal(t, int64(100), keeper.GetRelayUsage(context, other, 0))
+}
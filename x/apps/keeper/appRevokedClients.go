@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+)
+
+// SetRevokedClient - Store a client public key revoked by an application
+func (k Keeper) SetRevokedClient(ctx sdk.Ctx, revokedClient types.RevokedClient) {
+	clientPubKey, err := crypto.NewPublicKey(revokedClient.ClientPubKey)
+	if err != nil {
+		ctx.Logger().Error("could not decode revoked client public key: " + err.Error())
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.MarshalBinaryLengthPrefixed(&revokedClient, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal revoked client: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForRevokedClient(revokedClient.AppAddr, clientPubKey), bz)
+}
+
+// IsClientRevoked - Returns if the client public key has been revoked by the application, following the transfers of
+// the application so the sessions from before a transfer see the revocations moved to (or made by) the new key
+func (k Keeper) IsClientRevoked(ctx sdk.Ctx, appAddr sdk.Address, clientPubKey string) bool {
+	pk, err := crypto.NewPublicKey(clientPubKey)
+	if err != nil {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	visited := make(map[string]bool)
+	for addr, found := appAddr, true; found && !visited[addr.String()]; addr, _, found = k.getTransferredApp(ctx, addr) {
+		if revoked, _ := store.Has(types.KeyForRevokedClient(addr, pk)); revoked {
+			return true
+		}
+		visited[addr.String()] = true
+	}
+	return false
+}
+
+// GetRevokedClients - Retrieve all of the client public keys revoked by the application
+func (k Keeper) GetRevokedClients(ctx sdk.Ctx, appAddr sdk.Address) (revokedClients []types.RevokedClient) {
+	return k.revokedClientsByPrefix(ctx, types.KeyForRevokedClients(appAddr))
+}
+
+// GetAllRevokedClients - Retrieve every client public key revoked by any application
+func (k Keeper) GetAllRevokedClients(ctx sdk.Ctx) (revokedClients []types.RevokedClient) {
+	return k.revokedClientsByPrefix(ctx, types.RevokedClientsKey)
+}
+
+// revokedClientsByPrefix - Retrieve the revoked clients stored under the prefix
+func (k Keeper) revokedClientsByPrefix(ctx sdk.Ctx, prefix []byte) (revokedClients []types.RevokedClient) {
+	revokedClients = make([]types.RevokedClient, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var revokedClient types.RevokedClient
+		err := k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &revokedClient, ctx.BlockHeight())
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal revoked client at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		revokedClients = append(revokedClients, revokedClient)
+	}
+	return revokedClients
+}
+
+// ValidateRevokeClient - Check if the application is able to revoke the client public key
+func (k Keeper) ValidateRevokeClient(ctx sdk.Ctx, msg types.MsgRevokeClient) sdk.Error {
+	if _, found := k.GetApplication(ctx, msg.AppAddr); !found {
+		return types.ErrNoApplicationFound(k.codespace)
+	}
+	if k.IsClientRevoked(ctx, msg.AppAddr, msg.ClientPubKey) {
+		return types.ErrClientAlreadyRevoked(k.codespace)
+	}
+	return nil
+}
+
+// RevokeClient - Store ops when an application invalidates a client public key
+func (k Keeper) RevokeClient(ctx sdk.Ctx, appAddr sdk.Address, clientPubKey string) {
+	k.SetRevokedClient(ctx, types.RevokedClient{
+		AppAddr:      appAddr,
+		ClientPubKey: clientPubKey,
+		Height:       ctx.BlockHeight(),
+	})
+	ctx.Logger().Info(fmt.Sprintf("Application %s revoked client %s", appAddr.String(), clientPubKey))
+}
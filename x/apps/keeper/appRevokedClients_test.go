@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_RevokeClient(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	client := getRandomPubKey().RawString()
+	msg := types.MsgRevokeClient{AppAddr: application.Address, ClientPubKey: client}
+	// unknown applications cannot revoke
+	assert.Equal(t, types.ErrNoApplicationFound(keeper.Codespace()), keeper.ValidateRevokeClient(context, types.MsgRevokeClient{AppAddr: getRandomApplicationAddress(), ClientPubKey: client}))
+	assert.Nil(t, keeper.ValidateRevokeClient(context, msg))
+	assert.False(t, keeper.IsClientRevoked(context, application.Address, client))
+	keeper.RevokeClient(context, application.Address, client)
+	assert.True(t, keeper.IsClientRevoked(context, application.Address, client))
+	// the revocation is scoped to the application
+	assert.False(t, keeper.IsClientRevoked(context, getRandomApplicationAddress(), client))
+	assert.Equal(t, types.ErrClientAlreadyRevoked(keeper.Codespace()), keeper.ValidateRevokeClient(context, msg))
+	revoked := keeper.GetRevokedClients(context, application.Address)
+	assert.Len(t, revoked, 1)
+	assert.Equal(t, client, revoked[0].ClientPubKey)
+	assert.Equal(t, context.BlockHeight(), revoked[0].Height)
+	assert.Equal(t, revoked, keeper.GetAllRevokedClients(context))
+}
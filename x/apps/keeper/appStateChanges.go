@@ -205,6 +205,8 @@ func (k Keeper) FinishUnstakingApplication(ctx sdk.Ctx, application types.Applic
 	application = application.UpdateStatus(sdk.Unstaked)
 	// reset app relays
 	application.MaxRelays = sdk.ZeroInt()
+	// the usage history of the unstaked application is no longer needed
+	k.deleteRelayUsage(ctx, application.Address)
 	// update the unstaking time
 	application.UnstakingCompletionTime = time.Time{}
 	// update the application in the main store
@@ -293,6 +295,7 @@ func (k Keeper) ForceApplicationUnstake(ctx sdk.Ctx, application types.Applicati
 		// set the validator in store
 		k.SetApplication(ctx, validator)
 	}
+	k.deleteRelayUsage(ctx, application.Address)
 	ctx.Logger().Info("Force Unstaked validator " + application.Address.String())
 	return nil
 }
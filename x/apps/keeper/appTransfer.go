@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/tendermint/tendermint/libs/strings"
+)
+
+// ValidateApplicationTransfer - Check if the application is able to move to the new public key
+func (k Keeper) ValidateApplicationTransfer(ctx sdk.Ctx, msg types.MsgTransferApplication) (application types.Application, newPubKey crypto.PublicKey, err sdk.Error) {
+	application, found := k.GetApplication(ctx, msg.AppAddr)
+	if !found {
+		return application, nil, types.ErrNoApplicationFound(k.codespace)
+	}
+	// only a staked application may be transferred
+	if !application.IsStaked() {
+		return application, nil, types.ErrApplicationStatus(k.codespace)
+	}
+	if application.IsJailed() {
+		return application, nil, types.ErrApplicationJailed(k.codespace)
+	}
+	newPubKey, er := crypto.NewPublicKey(msg.NewPubKey)
+	if er != nil {
+		return application, nil, types.ErrInvalidTransferPubKey(k.codespace, er)
+	}
+	// ensure public key type is supported
+	if ctx.ConsensusParams() != nil {
+		tmPubKey, er := crypto.CheckConsensusPubKey(newPubKey.PubKey())
+		if er != nil {
+			return application, nil, types.ErrApplicationPubKeyTypeNotSupported(k.codespace,
+				er.Error(),
+				ctx.ConsensusParams().Validator.PubKeyTypes)
+		}
+		if !strings.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+			return application, nil, types.ErrApplicationPubKeyTypeNotSupported(k.codespace,
+				tmPubKey.Type,
+				ctx.ConsensusParams().Validator.PubKeyTypes)
+		}
+	}
+	// the new key must not already belong to an application
+	if _, found := k.GetApplication(ctx, sdk.Address(newPubKey.Address())); found {
+		return application, nil, types.ErrApplicationPubKeyExists(k.codespace)
+	}
+	return application, newPubKey, nil
+}
+
+// TransferApplication - Store ops to move the stake, chains and status of an application to a new public key
+func (k Keeper) TransferApplication(ctx sdk.Ctx, application types.Application, newPubKey crypto.PublicKey) types.Application {
+	// remove the application under the old key, the staked tokens stay in the staked pool
+	k.deleteApplicationFromStakingSet(ctx, application)
+	k.DeleteApplication(ctx, application.Address)
+	transferred := application
+	transferred.Address = sdk.Address(newPubKey.Address())
+	transferred.PublicKey = newPubKey
+	// carry the usage history over so the relay allowance is unaffected, along with the usage of the sessions before
+	// the transfer still to be proven
+	k.transferRelayUsage(ctx, application.Address, transferred.Address)
+	k.setTransferredApp(ctx, application.Address, transferred.Address)
+	// the clients revoked under the old key stay revoked
+	k.transferRevokedClients(ctx, application.Address, transferred.Address)
+	k.SetApplication(ctx, transferred)
+	// open sessions are built from the session block height state, where the old key is still staked; the session
+	// cache is global, so it is only cleared when the transfer is delivered
+	if !ctx.IsCheckTx() {
+		k.PocketKeeper.ClearSessionCache()
+	}
+	ctx.Logger().Info("Transferred application " + application.Address.String() + " to " + transferred.Address.String())
+	return transferred
+}
+
+// setTransferredApp - Store the new address of a transferred application along with the height of the transfer
+func (k Keeper) setTransferredApp(ctx sdk.Ctx, from, to sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Set(types.KeyForTransferredApp(from), append(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), to.Bytes()...))
+}
+
+// getTransferredApp - Retrieve the new address of a transferred application along with the height of the transfer
+func (k Keeper) getTransferredApp(ctx sdk.Ctx, from sdk.Address) (to sdk.Address, height int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForTransferredApp(from))
+	if len(bz) < 8 {
+		return nil, 0, false
+	}
+	return sdk.Address(bz[8:]), int64(binary.BigEndian.Uint64(bz[:8])), true
+}
+
+// pruneTransferredApps - Remove the transfers before the height, the usage of their sessions no longer counting
+func (k Keeper) pruneTransferredApps(ctx sdk.Ctx, beforeHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.TransferredAppsKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if bz := iterator.Value(); len(bz) >= 8 && int64(binary.BigEndian.Uint64(bz[:8])) < beforeHeight {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range keys {
+		_ = store.Delete(key)
+	}
+}
+
+// transferRevokedClients - Move the clients revoked by an application to a new address
+func (k Keeper) transferRevokedClients(ctx sdk.Ctx, from, to sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	for _, revokedClient := range k.GetRevokedClients(ctx, from) {
+		clientPubKey, err := crypto.NewPublicKey(revokedClient.ClientPubKey)
+		if err != nil {
+			continue
+		}
+		_ = store.Delete(types.KeyForRevokedClient(from, clientPubKey))
+		revokedClient.AppAddr = to
+		k.SetRevokedClient(ctx, revokedClient)
+	}
+}
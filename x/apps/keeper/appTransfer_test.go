@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_TransferApplication(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	newPubKey := getRandomPubKey()
+	newAddr := sdk.Address(newPubKey.Address())
+	msg := types.MsgTransferApplication{AppAddr: application.Address, NewPubKey: newPubKey.RawString()}
+	// unknown applications cannot transfer
+	_, _, err := keeper.ValidateApplicationTransfer(context, types.MsgTransferApplication{AppAddr: getRandomApplicationAddress(), NewPubKey: newPubKey.RawString()})
+	assert.Equal(t, types.ErrNoApplicationFound(keeper.Codespace()), err)
+	// the new key must not belong to an application
+	existing := getStakedApplication()
+	keeper.SetApplication(context, existing)
+	_, _, err = keeper.ValidateApplicationTransfer(context, types.MsgTransferApplication{AppAddr: application.Address, NewPubKey: existing.PublicKey.RawString()})
+	assert.Equal(t, types.ErrApplicationPubKeyExists(keeper.Codespace()), err)
+	// only staked applications can transfer
+	unstaking := getUnstakingApplication()
+	keeper.SetApplication(context, unstaking)
+	_, _, err = keeper.ValidateApplicationTransfer(context, types.MsgTransferApplication{AppAddr: unstaking.Address, NewPubKey: newPubKey.RawString()})
+	assert.Equal(t, types.ErrApplicationStatus(keeper.Codespace()), err)
+	// usage follows the application
+	p := keeper.GetParams(context)
+	p.UsageRelaysOn = true
+	keeper.SetParams(context, p)
+	keeper.AddRelayUsage(context, application.Address, 1, 100)
+	revokedClient := getRandomPubKey().RawString()
+	keeper.RevokeClient(context, application.Address, revokedClient)
+	app, pubKey, err := keeper.ValidateApplicationTransfer(context, msg)
+	assert.Nil(t, err)
+	transferred := keeper.TransferApplication(context, app, pubKey)
+	_, found := keeper.GetApplication(context, application.Address)
+	assert.False(t, found)
+	got, found := keeper.GetApplication(context, newAddr)
+	assert.True(t, found)
+	assert.Equal(t, transferred, got)
+	assert.Equal(t, newPubKey, got.PublicKey)
+	assert.True(t, application.StakedTokens.Equal(got.StakedTokens))
+	assert.Equal(t, application.Chains, got.Chains)
+	assert.Equal(t, application.Status, got.Status)
+	assert.Zero(t, keeper.GetRelayUsage(context, application.Address, 0))
+	assert.Equal(t, int64(100), keeper.GetRelayUsage(context, newAddr, 0))
+	// the usage of the sessions before the transfer proven afterwards follows the application too
+	keeper.AddRelayUsage(context, application.Address, context.BlockHeight()-1, 50)
+	assert.Zero(t, keeper.GetRelayUsage(context, application.Address, 0))
+	assert.Equal(t, int64(150), keeper.GetRelayUsage(context, newAddr, 0))
+	keeper.AddRelayUsage(context, application.Address, context.BlockHeight(), 10)
+	assert.Equal(t, int64(10), keeper.GetRelayUsage(context, application.Address, 0))
+	// so do the revoked clients, which the sessions of the old key still see
+	assert.True(t, keeper.IsClientRevoked(context, newAddr, revokedClient))
+	assert.Empty(t, keeper.GetRevokedClients(context, application.Address))
+	assert.True(t, keeper.IsClientRevoked(context, application.Address, revokedClient))
+	// as well as the clients revoked by the new key
+	newlyRevokedClient := getRandomPubKey().RawString()
+	keeper.RevokeClient(context, newAddr, newlyRevokedClient)
+	assert.True(t, keeper.IsClientRevoked(context, application.Address, newlyRevokedClient))
+	assert.False(t, keeper.IsClientRevoked(context, application.Address, getRandomPubKey().RawString()))
+	// the staked set holds the new address only
+	var staked []sdk.Address
+	for _, a := range keeper.getStakedApplications(context) {
+		staked = append(staked, a.Address)
+	}
+	assert.Contains(t, staked, newAddr)
+	assert.NotContains(t, staked, application.Address)
+}
+
+type countingPocketKeeper struct {
+	cleared int
+}
+
+func (m *countingPocketKeeper) ClearSessionCache() {
+	m.cleared++
+}
+
+func TestKeeper_TransferApplicationBack(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockHeight(10)
+	pocketKeeper := &countingPocketKeeper{}
+	keeper.PocketKeeper = pocketKeeper
+	p := keeper.GetParams(context)
+	p.UsageRelaysOn = true
+	keeper.SetParams(context, p)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	newPubKey := getRandomPubKey()
+	newAddr := sdk.Address(newPubKey.Address())
+	// the session cache is only cleared when the transfer is delivered
+	transferred := keeper.TransferApplication(context.WithIsCheckTx(true), application, newPubKey)
+	assert.Zero(t, pocketKeeper.cleared)
+	context = context.WithBlockHeight(context.BlockHeight() + 1).WithIsCheckTx(false)
+	keeper.TransferApplication(context, transferred, application.PublicKey)
+	assert.Equal(t, 1, pocketKeeper.cleared)
+	// the transfer back to the former key leaves a cycle of transfers, which the lookups stop at
+	keeper.AddRelayUsage(context, application.Address, 9, 10)
+	assert.Equal(t, int64(10), keeper.GetRelayUsage(context, application.Address, 0))
+	assert.False(t, keeper.IsClientRevoked(context, newAddr, getRandomPubKey().RawString()))
+}
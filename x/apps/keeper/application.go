@@ -142,6 +142,11 @@ func (k Keeper) IterateAndExecuteOverApps(
 }
 
 func (k Keeper) CalculateAppRelays(ctx sdk.Ctx, application types.Application) sdk.BigInt {
+	return k.GetRelayAllowance(ctx, application).AllowedRelays
+}
+
+// calculateStakeRelays - Derive the max relays of the application from its stake
+func (k Keeper) calculateStakeRelays(ctx sdk.Ctx, application types.Application) sdk.BigInt {
 	stakingAdjustment := sdk.NewDec(k.StakingAdjustment(ctx))
 	participationRate := sdk.NewDec(1)
 	baseRate := sdk.NewInt(k.BaselineThroughputStakeRate(ctx))
@@ -154,12 +159,14 @@ func (k Keeper) CalculateAppRelays(ctx sdk.Ctx, application types.Application) s
 	basePercentage := baseRate.ToDec().Quo(sdk.NewDec(100))
 	baselineThroughput := basePercentage.Mul(application.StakedTokens.ToDec().Quo(sdk.NewDec(1000000)))
 	result := participationRate.Mul(baselineThroughput).Add(stakingAdjustment).TruncateInt()
+	return boundRelays(result)
+}
 
-	// bounding Max Amount of relays Value to be 18,446,744,073,709,551,615
+// boundRelays - bounding Max Amount of relays Value to be 18,446,744,073,709,551,615
+func boundRelays(result sdk.BigInt) sdk.BigInt {
 	maxRelays := sdk.NewIntFromBigInt(new(big.Int).SetUint64(math.MaxUint64))
 	if result.GTE(maxRelays) {
 		result = maxRelays
 	}
-
 	return result
 }
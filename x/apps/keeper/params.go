@@ -59,6 +59,36 @@ func (k Keeper) MaxChains(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// UsageRelaysOn - Retrieve if max relays follow the claimed relays of the application
+func (k Keeper) UsageRelaysOn(ctx sdk.Ctx) (isOn bool) {
+	k.Paramstore.GetIfExists(ctx, types.KeyUsageRelaysOn, &isOn)
+	return
+}
+
+// UsageWindowSessions - Retrieve the number of trailing sessions used to average the claimed relays
+func (k Keeper) UsageWindowSessions(ctx sdk.Ctx) (res int64) {
+	k.Paramstore.GetIfExists(ctx, types.KeyUsageWindowSessions, &res)
+	return
+}
+
+// UsageHeadroom - Retrieve the percentage of the average claimed relays an application is allowed
+func (k Keeper) UsageHeadroom(ctx sdk.Ctx) (res int64) {
+	k.Paramstore.GetIfExists(ctx, types.KeyUsageHeadroom, &res)
+	return
+}
+
+// UsageMinRelays - Retrieve the lower bound of the usage based relays as a percentage of the stake based relays
+func (k Keeper) UsageMinRelays(ctx sdk.Ctx) (res int64) {
+	k.Paramstore.GetIfExists(ctx, types.KeyUsageMinRelays, &res)
+	return
+}
+
+// UsageMaxRelays - Retrieve the upper bound of the usage based relays as a percentage of the stake based relays
+func (k Keeper) UsageMaxRelays(ctx sdk.Ctx) (res int64) {
+	k.Paramstore.GetIfExists(ctx, types.KeyUsageMaxRelays, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
@@ -69,6 +99,11 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 		ParticipationRateOn: k.ParticipationRateOn(ctx),
 		StabilityAdjustment: k.StakingAdjustment(ctx),
 		MaxChains:           k.MaxChains(ctx),
+		UsageRelaysOn:       k.UsageRelaysOn(ctx),
+		UsageWindowSessions: k.UsageWindowSessions(ctx),
+		UsageHeadroom:       k.UsageHeadroom(ctx),
+		UsageMinRelays:      k.UsageMinRelays(ctx),
+		UsageMaxRelays:      k.UsageMaxRelays(ctx),
 	}
 }
 
@@ -44,6 +44,32 @@ func UnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, add
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
 }
 
+func RevokeClientTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, clientPubKey string, passphrase string, legacyCodec bool) (*sdk.TxResponse, error) {
+	msg := types.MsgRevokeClient{AppAddr: address, ClientPubKey: clientPubKey}
+	txBuilder, cliCtx, err := newTx(cdc, &msg, address, tmNode, keybase, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
+func TransferApplicationTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, newPubKey string, passphrase string, legacyCodec bool) (*sdk.TxResponse, error) {
+	msg := types.MsgTransferApplication{AppAddr: address, NewPubKey: newPubKey}
+	txBuilder, cliCtx, err := newTx(cdc, &msg, address, tmNode, keybase, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
 func newTx(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, tmNode client.Client, keybase keys.Keybase, passphrase string) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
 	genDoc, err := tmNode.Genesis()
 	if err != nil {
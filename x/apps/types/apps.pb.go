@@ -122,50 +122,97 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// RevokedClient defines a client public key that an application has invalidated for relay servicing
+type RevokedClient struct {
+	AppAddr      github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=AppAddr,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address" yaml:"address"`
+	ClientPubKey string                                            `protobuf:"bytes,2,opt,name=ClientPubKey,proto3" json:"client_pub_key" yaml:"client_pub_key"`
+	Height       int64                                             `protobuf:"varint,3,opt,name=Height,proto3" json:"height" yaml:"height"`
+}
+
+func (m *RevokedClient) Reset()         { *m = RevokedClient{} }
+func (m *RevokedClient) String() string { return proto.CompactTextString(m) }
+func (*RevokedClient) ProtoMessage()    {}
+func (*RevokedClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d5a21b1d350fd62, []int{2}
+}
+func (m *RevokedClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokedClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokedClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokedClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokedClient.Merge(m, src)
+}
+func (m *RevokedClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokedClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokedClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokedClient proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ProtoApplication)(nil), "x.apps.ProtoApplication")
 	proto.RegisterType((*Pool)(nil), "x.apps.Pool")
+	proto.RegisterType((*RevokedClient)(nil), "x.apps.RevokedClient")
 }
 
 func init() { proto.RegisterFile("x/apps/apps.proto", fileDescriptor_5d5a21b1d350fd62) }
 
 var fileDescriptor_5d5a21b1d350fd62 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xd1, 0xd6, 0x4d, 0x8e, 0xb6, 0xa2, 0x16, 0x08, 0x53, 0x24, 0x9f, 0x65, 0x16, 0x2f,
-	0xb5, 0x81, 0x08, 0x09, 0x65, 0xab, 0x99, 0x80, 0x25, 0x72, 0x32, 0xc1, 0x10, 0x5d, 0x9c, 0xc3,
-	0x35, 0xfe, 0x71, 0x56, 0x7c, 0x11, 0x09, 0x7f, 0x01, 0x12, 0x4b, 0xff, 0x84, 0xcc, 0xfc, 0x25,
-	0x1d, 0x3b, 0x22, 0x86, 0x03, 0x25, 0x0b, 0xca, 0x84, 0x32, 0x32, 0xa1, 0xbb, 0x73, 0xea, 0xb2,
-	0x45, 0xb0, 0x44, 0xf7, 0xde, 0x97, 0xf7, 0xde, 0x77, 0x9f, 0x3f, 0x1b, 0x1e, 0x4f, 0x3d, 0x5c,
-	0x14, 0xa5, 0xfc, 0x71, 0x8b, 0x31, 0x65, 0x54, 0xd7, 0xa6, 0xae, 0x40, 0x27, 0x77, 0x23, 0x1a,
-	0x51, 0x49, 0x79, 0xe2, 0xa4, 0xaa, 0x27, 0x28, 0xa2, 0x34, 0x4a, 0x89, 0x27, 0xd1, 0x70, 0xf2,
-	0xce, 0x63, 0x71, 0x46, 0x4a, 0x86, 0xb3, 0x42, 0xfd, 0xc1, 0xfe, 0xa2, 0xc1, 0x3b, 0x5d, 0x71,
-	0x3a, 0x2b, 0x8a, 0x34, 0x0e, 0x31, 0x8b, 0x69, 0xae, 0xa7, 0x70, 0x1f, 0x8f, 0x46, 0x63, 0x52,
-	0x96, 0x06, 0xb0, 0x80, 0x73, 0xe0, 0x07, 0x2b, 0x8e, 0x36, 0xd4, 0x9a, 0xa3, 0xa3, 0x19, 0xce,
-	0xd2, 0x8e, 0x5d, 0x11, 0xf6, 0x6f, 0x8e, 0x9e, 0x44, 0x31, 0x3b, 0x9f, 0x0c, 0xdd, 0x90, 0x66,
-	0x5e, 0x41, 0x13, 0x76, 0x9a, 0x13, 0xf6, 0x81, 0x8e, 0x13, 0xaf, 0xa0, 0x61, 0x42, 0xd8, 0x69,
-	0x48, 0xc7, 0xc4, 0x63, 0xb3, 0x82, 0x94, 0xee, 0x99, 0x52, 0x05, 0x1b, 0x3f, 0xdd, 0x87, 0xb0,
-	0x98, 0x0c, 0xd3, 0x38, 0x1c, 0x24, 0x64, 0x66, 0xdc, 0x92, 0x81, 0x8f, 0x56, 0x1c, 0xdd, 0x60,
-	0xd7, 0x1c, 0x1d, 0xab, 0xcc, 0x9a, 0xb3, 0x83, 0x96, 0x02, 0xaf, 0xc9, 0x4c, 0x6f, 0x43, 0xed,
-	0x3d, 0x8e, 0x53, 0x32, 0x32, 0x76, 0x2c, 0xe0, 0x34, 0xfd, 0x87, 0x2b, 0x8e, 0x2a, 0x66, 0xcd,
-	0xd1, 0xa1, 0xd2, 0x2a, 0x6c, 0x07, 0x55, 0x41, 0x4f, 0xa1, 0x56, 0x32, 0xcc, 0x26, 0xa5, 0xb1,
-	0x6b, 0x01, 0x67, 0xcf, 0xef, 0x0b, 0x91, 0x62, 0x6a, 0x91, 0xc2, 0xe2, 0x8e, 0xcf, 0xb6, 0xbf,
-	0x63, 0x8f, 0xe1, 0x84, 0xf4, 0xa4, 0x32, 0xa8, 0x1c, 0x45, 0x8b, 0xe1, 0x39, 0x8e, 0xf3, 0xd2,
-	0xd8, 0xb3, 0x76, 0x9c, 0x96, 0x6a, 0x51, 0x31, 0x75, 0x9a, 0xc2, 0x76, 0x50, 0x15, 0xf4, 0x29,
-	0x3c, 0x2c, 0x85, 0xd7, 0x68, 0xc0, 0x68, 0x42, 0xf2, 0xd2, 0xd0, 0x2c, 0xe0, 0xb4, 0xfc, 0xde,
-	0x25, 0x47, 0x8d, 0x6f, 0x1c, 0x3d, 0xde, 0xbe, 0x25, 0x3f, 0x8e, 0x5e, 0xe6, 0x4c, 0x64, 0x2a,
-	0xa7, 0x3a, 0x53, 0x61, 0x3b, 0x38, 0x50, 0x49, 0x7d, 0x09, 0xf5, 0x8f, 0x10, 0x66, 0x78, 0x3a,
-	0x18, 0x93, 0x14, 0xcf, 0x4a, 0x63, 0x5f, 0xc6, 0xbe, 0xfd, 0x8f, 0xd8, 0x1b, 0x6e, 0xf5, 0xd3,
-	0xac, 0x39, 0x3b, 0x68, 0x65, 0x78, 0x1a, 0xc8, 0xb3, 0xfe, 0x19, 0xc0, 0x07, 0x93, 0x5c, 0xb4,
-	0x13, 0xe7, 0xd1, 0x20, 0xa4, 0x59, 0x91, 0x12, 0xb1, 0x98, 0x03, 0xb1, 0xbd, 0x46, 0xd3, 0x02,
-	0xce, 0xed, 0xa7, 0x27, 0xae, 0x5a, 0x6d, 0x77, 0xb3, 0xda, 0x6e, 0x7f, 0xb3, 0xda, 0x7e, 0x5b,
-	0xf4, 0xb9, 0xe2, 0xe8, 0xa8, 0x36, 0x11, 0xca, 0x35, 0x47, 0xf7, 0x54, 0xee, 0xdf, 0xbc, 0x7d,
-	0xf1, 0x1d, 0x81, 0xe0, 0xfe, 0x35, 0xf9, 0xe2, 0x3a, 0x50, 0x58, 0x76, 0x9a, 0x9f, 0xe6, 0xa8,
-	0xf1, 0x73, 0x8e, 0x80, 0x3d, 0x84, 0xbb, 0x5d, 0x4a, 0x53, 0xbd, 0x0b, 0xab, 0x21, 0xca, 0xd7,
-	0xa3, 0xe5, 0x3f, 0xff, 0xd7, 0xb9, 0x04, 0x95, 0x4f, 0xa7, 0x29, 0xfc, 0x7f, 0xcd, 0x11, 0xf0,
-	0x5f, 0x5d, 0x2e, 0x4c, 0x70, 0xb5, 0x30, 0xc1, 0x8f, 0x85, 0x09, 0x2e, 0x96, 0x66, 0xe3, 0x6a,
-	0x69, 0x36, 0xbe, 0x2e, 0xcd, 0xc6, 0x9b, 0xad, 0xdc, 0xab, 0x0f, 0x84, 0x0c, 0x19, 0x6a, 0x72,
-	0x36, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x38, 0x85, 0x13, 0xd9, 0x37, 0x04, 0x00, 0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0xf5, 0x23, 0x4d, 0xee, 0x97, 0x56, 0xbf, 0x5a, 0x20, 0x4c, 0x91, 0x72, 0x91, 0x59,
+	0x22, 0xa1, 0xda, 0x40, 0x85, 0x84, 0xb2, 0xd5, 0x5d, 0x80, 0x0e, 0x44, 0xd7, 0x4e, 0x30, 0x44,
+	0xb6, 0x73, 0x38, 0xc6, 0x1f, 0x77, 0x8a, 0xcf, 0x90, 0xf0, 0x17, 0x20, 0xb1, 0x94, 0x8d, 0xb1,
+	0x33, 0x7f, 0x49, 0xc7, 0x8e, 0x88, 0xc1, 0xa0, 0x76, 0x41, 0x99, 0x50, 0x46, 0x26, 0x74, 0x1f,
+	0xa9, 0xdb, 0xad, 0x02, 0xb1, 0x54, 0xf7, 0x3c, 0xe7, 0xf7, 0x79, 0xde, 0x7b, 0xfb, 0xbc, 0x81,
+	0x9b, 0x13, 0xc7, 0x63, 0x2c, 0x97, 0x7f, 0x6c, 0x36, 0xa6, 0x9c, 0x1a, 0xf5, 0x89, 0x2d, 0xd0,
+	0xd6, 0x8d, 0x90, 0x86, 0x54, 0x52, 0x8e, 0x38, 0xa9, 0xdb, 0x2d, 0x14, 0x52, 0x1a, 0x26, 0xc4,
+	0x91, 0xc8, 0x2f, 0x5e, 0x39, 0x3c, 0x4a, 0x49, 0xce, 0xbd, 0x94, 0xa9, 0x0f, 0xac, 0xcf, 0x75,
+	0xf8, 0x7f, 0x5f, 0x9c, 0x76, 0x19, 0x4b, 0xa2, 0xc0, 0xe3, 0x11, 0xcd, 0x8c, 0x04, 0xae, 0x79,
+	0xc3, 0xe1, 0x98, 0xe4, 0xb9, 0x09, 0x3a, 0xa0, 0xdb, 0x72, 0xf1, 0xac, 0x44, 0x0b, 0x6a, 0x5e,
+	0xa2, 0x8d, 0xa9, 0x97, 0x26, 0x3d, 0x4b, 0x13, 0xd6, 0xaf, 0x12, 0x3d, 0x08, 0x23, 0x3e, 0x2a,
+	0x7c, 0x3b, 0xa0, 0xa9, 0xc3, 0x68, 0xcc, 0xb7, 0x33, 0xc2, 0xdf, 0xd2, 0x71, 0xec, 0x30, 0x1a,
+	0xc4, 0x84, 0x6f, 0x07, 0x74, 0x4c, 0x1c, 0x3e, 0x65, 0x24, 0xb7, 0x77, 0x55, 0x15, 0x5e, 0xe8,
+	0x19, 0x2e, 0x84, 0xac, 0xf0, 0x93, 0x28, 0x18, 0xc4, 0x64, 0x6a, 0x2e, 0x49, 0xc3, 0xbb, 0xb3,
+	0x12, 0x5d, 0x62, 0xe7, 0x25, 0xda, 0x54, 0x9e, 0x15, 0x67, 0xe1, 0xa6, 0x02, 0xfb, 0x64, 0x6a,
+	0xec, 0xc0, 0xfa, 0x6b, 0x2f, 0x4a, 0xc8, 0xd0, 0x5c, 0xee, 0x80, 0x6e, 0xc3, 0xbd, 0x33, 0x2b,
+	0x91, 0x66, 0xe6, 0x25, 0x5a, 0x57, 0xb5, 0x0a, 0x5b, 0x58, 0x5f, 0x18, 0x09, 0xac, 0xe7, 0xdc,
+	0xe3, 0x45, 0x6e, 0xae, 0x74, 0x40, 0x77, 0xd5, 0x3d, 0x14, 0x45, 0x8a, 0xa9, 0x8a, 0x14, 0x16,
+	0x6f, 0x7c, 0x74, 0xfd, 0x37, 0x1e, 0x70, 0x2f, 0x26, 0x07, 0xb2, 0x12, 0x6b, 0x45, 0xd1, 0x62,
+	0x30, 0xf2, 0xa2, 0x2c, 0x37, 0x57, 0x3b, 0xcb, 0xdd, 0xa6, 0x6a, 0x51, 0x31, 0x95, 0x9b, 0xc2,
+	0x16, 0xd6, 0x17, 0xc6, 0x04, 0xae, 0xe7, 0x42, 0x6b, 0x38, 0xe0, 0x34, 0x26, 0x59, 0x6e, 0xd6,
+	0x3b, 0xa0, 0xdb, 0x74, 0x0f, 0x4e, 0x4a, 0x54, 0xfb, 0x5a, 0xa2, 0xfb, 0xd7, 0x6f, 0xc9, 0x8d,
+	0xc2, 0xa7, 0x19, 0x17, 0x9e, 0x4a, 0xa9, 0xf2, 0x54, 0xd8, 0xc2, 0x2d, 0xe5, 0x74, 0x28, 0xa1,
+	0xf1, 0x0e, 0xc2, 0xd4, 0x9b, 0x0c, 0xc6, 0x24, 0xf1, 0xa6, 0xb9, 0xb9, 0x26, 0x6d, 0x5f, 0xfe,
+	0x85, 0xed, 0x25, 0xb5, 0xea, 0xbf, 0x59, 0x71, 0x16, 0x6e, 0xa6, 0xde, 0x04, 0xcb, 0xb3, 0xf1,
+	0x01, 0xc0, 0xdb, 0x45, 0x26, 0xda, 0x89, 0xb2, 0x70, 0x10, 0xd0, 0x94, 0x25, 0x44, 0x04, 0x73,
+	0x20, 0xd2, 0x6b, 0x36, 0x3a, 0xa0, 0xfb, 0xdf, 0xc3, 0x2d, 0x5b, 0x45, 0xdb, 0x5e, 0x44, 0xdb,
+	0x3e, 0x5c, 0x44, 0xdb, 0xdd, 0x11, 0x7d, 0xce, 0x4a, 0xb4, 0x51, 0x89, 0x88, 0xca, 0x79, 0x89,
+	0x6e, 0x2a, 0xdf, 0xab, 0xbc, 0x75, 0xf4, 0x0d, 0x01, 0x7c, 0xeb, 0x82, 0xdc, 0xbb, 0x30, 0x14,
+	0x92, 0xbd, 0xc6, 0xfb, 0x63, 0x54, 0xfb, 0x71, 0x8c, 0x80, 0xe5, 0xc3, 0x95, 0x3e, 0xa5, 0x89,
+	0xd1, 0x87, 0x7a, 0x88, 0x72, 0x3d, 0x9a, 0xee, 0xe3, 0x3f, 0x9d, 0x0b, 0xd6, 0x3a, 0xbd, 0x86,
+	0xd0, 0xff, 0x29, 0x3c, 0x3e, 0x2e, 0xc1, 0x75, 0x4c, 0xde, 0xd0, 0x98, 0x0c, 0xf7, 0x92, 0x88,
+	0x64, 0x5c, 0x6c, 0xe3, 0x2e, 0x63, 0x62, 0x6d, 0xfe, 0xe5, 0x36, 0x6a, 0x0b, 0xe3, 0x39, 0x6c,
+	0x29, 0xdf, 0x7e, 0xe1, 0xef, 0xeb, 0x7d, 0x6c, 0xba, 0xf7, 0xc4, 0x34, 0x03, 0xc9, 0x0f, 0x58,
+	0xe1, 0xeb, 0x9d, 0xd4, 0xd3, 0xbc, 0xca, 0x5b, 0xf8, 0x8a, 0x80, 0xc8, 0xfd, 0x13, 0x12, 0x85,
+	0x23, 0x2e, 0x57, 0x73, 0x59, 0xe5, 0x7e, 0x24, 0x99, 0x2a, 0x83, 0x0a, 0x5b, 0x58, 0x7f, 0xda,
+	0x6b, 0x89, 0x99, 0x7f, 0x3a, 0x46, 0x40, 0xcc, 0xc5, 0x7d, 0x76, 0x72, 0xd6, 0x06, 0xa7, 0x67,
+	0x6d, 0xf0, 0xfd, 0xac, 0x0d, 0x8e, 0xce, 0xdb, 0xb5, 0xd3, 0xf3, 0x76, 0xed, 0xcb, 0x79, 0xbb,
+	0xf6, 0xe2, 0x5a, 0x13, 0xd7, 0x3f, 0x9a, 0xf2, 0xc1, 0x7e, 0x5d, 0xe6, 0x65, 0xe7, 0xf7, 0x00,
+	0x25, 0xe1, 0x48, 0xe9, 0x4b, 0x05, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -174,294 +221,398 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func AppsDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 4590 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x5d, 0x70, 0x1b, 0xd7,
-		0x75, 0xd6, 0x82, 0x00, 0x08, 0x1c, 0x80, 0xe0, 0x72, 0x49, 0x49, 0x10, 0x1d, 0x0b, 0x12, 0xfc,
-		0x23, 0xda, 0x8a, 0x48, 0x57, 0x7f, 0x96, 0xc1, 0xc6, 0x2e, 0x40, 0x42, 0x0c, 0x65, 0xfe, 0xc0,
-		0x0b, 0x32, 0xb6, 0x95, 0xc9, 0xec, 0x2c, 0x17, 0x97, 0xe0, 0x8a, 0x8b, 0xdd, 0xcd, 0xee, 0x42,
-		0x12, 0x35, 0x7d, 0x70, 0xc7, 0x69, 0x9b, 0x8c, 0xa7, 0x8d, 0xd3, 0x76, 0xda, 0xc4, 0x75, 0x5c,
-		0xc5, 0x9d, 0xd6, 0xad, 0xfb, 0x9b, 0xfe, 0x24, 0x4d, 0xfb, 0x92, 0x4e, 0xa7, 0xad, 0x9f, 0x3a,
-		0xf1, 0x5b, 0xa7, 0x0f, 0x6c, 0xc6, 0xf6, 0x4c, 0x53, 0xd5, 0x6d, 0x53, 0x55, 0x99, 0xe9, 0xd4,
-		0x2f, 0x99, 0xfb, 0xb7, 0xd8, 0x05, 0x40, 0x2d, 0xe8, 0x8c, 0xec, 0x17, 0x09, 0x7b, 0xee, 0xf9,
-		0xbe, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0xdd, 0x25, 0xfc, 0xdd, 0x2c, 0x1c, 0x6b, 0x5a,
-		0x56, 0xd3, 0x40, 0x33, 0xb6, 0x63, 0x79, 0xd6, 0x46, 0x7b, 0x73, 0xa6, 0x81, 0x5c, 0xcd, 0xd1,
-		0x6d, 0xcf, 0x72, 0xa6, 0x89, 0x4c, 0x1a, 0xa5, 0x1a, 0xd3, 0x5c, 0xa3, 0xb8, 0x0c, 0x63, 0x17,
-		0x75, 0x03, 0xcd, 0xfb, 0x8a, 0x75, 0xe4, 0x49, 0x17, 0x20, 0xbe, 0xa9, 0x1b, 0x28, 0x2f, 0x1c,
-		0x1b, 0x9a, 0xca, 0x9c, 0x7e, 0x70, 0xba, 0x0b, 0x34, 0x1d, 0x46, 0xd4, 0xb0, 0x58, 0x26, 0x88,
-		0xe2, 0x7b, 0x71, 0x18, 0xef, 0xd3, 0x2a, 0x49, 0x10, 0x37, 0xd5, 0x16, 0x66, 0x14, 0xa6, 0xd2,
-		0x32, 0xf9, 0x2d, 0xe5, 0x61, 0xd8, 0x56, 0xb5, 0x6d, 0xb5, 0x89, 0xf2, 0x31, 0x22, 0xe6, 0x8f,
-		0xd2, 0x51, 0x80, 0x06, 0xb2, 0x91, 0xd9, 0x40, 0xa6, 0xb6, 0x93, 0x1f, 0x3a, 0x36, 0x34, 0x95,
-		0x96, 0x03, 0x12, 0xe9, 0x24, 0x8c, 0xd9, 0xed, 0x0d, 0x43, 0xd7, 0x94, 0x80, 0x1a, 0x1c, 0x1b,
-		0x9a, 0x4a, 0xc8, 0x22, 0x6d, 0x98, 0xef, 0x28, 0x9f, 0x80, 0xd1, 0x6b, 0x48, 0xdd, 0x0e, 0xaa,
-		0x66, 0x88, 0x6a, 0x0e, 0x8b, 0x03, 0x8a, 0x73, 0x90, 0x6d, 0x21, 0xd7, 0x55, 0x9b, 0x48, 0xf1,
-		0x76, 0x6c, 0x94, 0x8f, 0x93, 0xd1, 0x1f, 0xeb, 0x19, 0x7d, 0xf7, 0xc8, 0x33, 0x0c, 0xb5, 0xb6,
-		0x63, 0x23, 0xa9, 0x0c, 0x69, 0x64, 0xb6, 0x5b, 0x94, 0x21, 0xb1, 0x87, 0xff, 0xaa, 0x66, 0xbb,
-		0xd5, 0xcd, 0x92, 0xc2, 0x30, 0x46, 0x31, 0xec, 0x22, 0xe7, 0xaa, 0xae, 0xa1, 0x7c, 0x92, 0x10,
-		0x9c, 0xe8, 0x21, 0xa8, 0xd3, 0xf6, 0x6e, 0x0e, 0x8e, 0x93, 0xe6, 0x20, 0x8d, 0xae, 0x7b, 0xc8,
-		0x74, 0x75, 0xcb, 0xcc, 0x0f, 0x13, 0x92, 0x87, 0xfa, 0xcc, 0x22, 0x32, 0x1a, 0xdd, 0x14, 0x1d,
-		0x9c, 0x74, 0x1e, 0x86, 0x2d, 0xdb, 0xd3, 0x2d, 0xd3, 0xcd, 0xa7, 0x8e, 0x09, 0x53, 0x99, 0xd3,
-		0x9f, 0xe8, 0x1b, 0x08, 0xab, 0x54, 0x47, 0xe6, 0xca, 0xd2, 0x22, 0x88, 0xae, 0xd5, 0x76, 0x34,
-		0xa4, 0x68, 0x56, 0x03, 0x29, 0xba, 0xb9, 0x69, 0xe5, 0xd3, 0x84, 0xa0, 0xd0, 0x3b, 0x10, 0xa2,
-		0x38, 0x67, 0x35, 0xd0, 0xa2, 0xb9, 0x69, 0xc9, 0x39, 0x37, 0xf4, 0x2c, 0x1d, 0x82, 0xa4, 0xbb,
-		0x63, 0x7a, 0xea, 0xf5, 0x7c, 0x96, 0x44, 0x08, 0x7b, 0x2a, 0x7e, 0x27, 0x09, 0xa3, 0x83, 0x84,
-		0xd8, 0x2c, 0x24, 0x36, 0xf1, 0x28, 0xf3, 0xb1, 0xfd, 0xf8, 0x80, 0x62, 0xc2, 0x4e, 0x4c, 0x7e,
-		0x48, 0x27, 0x96, 0x21, 0x63, 0x22, 0xd7, 0x43, 0x0d, 0x1a, 0x11, 0x43, 0x03, 0xc6, 0x14, 0x50,
-		0x50, 0x6f, 0x48, 0xc5, 0x3f, 0x54, 0x48, 0x3d, 0x07, 0xa3, 0xbe, 0x49, 0x8a, 0xa3, 0x9a, 0x4d,
-		0x1e, 0x9b, 0x33, 0x51, 0x96, 0x4c, 0x57, 0x39, 0x4e, 0xc6, 0x30, 0x39, 0x87, 0x42, 0xcf, 0xd2,
-		0x3c, 0x80, 0x65, 0x22, 0x6b, 0x53, 0x69, 0x20, 0xcd, 0xc8, 0xa7, 0xf6, 0xf0, 0xd2, 0x2a, 0x56,
-		0xe9, 0xf1, 0x92, 0x45, 0xa5, 0x9a, 0x21, 0x3d, 0xd1, 0x09, 0xb5, 0xe1, 0x3d, 0x22, 0x65, 0x99,
-		0x2e, 0xb2, 0x9e, 0x68, 0x5b, 0x87, 0x9c, 0x83, 0x70, 0xdc, 0xa3, 0x06, 0x1b, 0x59, 0x9a, 0x18,
-		0x31, 0x1d, 0x39, 0x32, 0x99, 0xc1, 0xe8, 0xc0, 0x46, 0x9c, 0xe0, 0xa3, 0xf4, 0x00, 0xf8, 0x02,
-		0x85, 0x84, 0x15, 0x90, 0x2c, 0x94, 0xe5, 0xc2, 0x15, 0xb5, 0x85, 0x26, 0x6f, 0x40, 0x2e, 0xec,
-		0x1e, 0x69, 0x02, 0x12, 0xae, 0xa7, 0x3a, 0x1e, 0x89, 0xc2, 0x84, 0x4c, 0x1f, 0x24, 0x11, 0x86,
-		0x90, 0xd9, 0x20, 0x59, 0x2e, 0x21, 0xe3, 0x9f, 0xd2, 0xcf, 0x74, 0x06, 0x3c, 0x44, 0x06, 0xfc,
-		0x70, 0xef, 0x8c, 0x86, 0x98, 0xbb, 0xc7, 0x3d, 0xf9, 0x38, 0x8c, 0x84, 0x06, 0x30, 0x68, 0xd7,
-		0xc5, 0x9f, 0x85, 0x83, 0x7d, 0xa9, 0xa5, 0xe7, 0x60, 0xa2, 0x6d, 0xea, 0xa6, 0x87, 0x1c, 0xdb,
-		0x41, 0x38, 0x62, 0x69, 0x57, 0xf9, 0x7f, 0x1b, 0xde, 0x23, 0xe6, 0xd6, 0x83, 0xda, 0x94, 0x45,
-		0x1e, 0x6f, 0xf7, 0x0a, 0x1f, 0x4d, 0xa7, 0x7e, 0x30, 0x2c, 0xbe, 0xf0, 0xc2, 0x0b, 0x2f, 0xc4,
-		0x8a, 0x7f, 0x9b, 0x84, 0x89, 0x7e, 0x6b, 0xa6, 0xef, 0xf2, 0x3d, 0x04, 0x49, 0xb3, 0xdd, 0xda,
-		0x40, 0x0e, 0x71, 0x52, 0x42, 0x66, 0x4f, 0x52, 0x19, 0x12, 0x86, 0xba, 0x81, 0x8c, 0x7c, 0xfc,
-		0x98, 0x30, 0x95, 0x3b, 0x7d, 0x72, 0xa0, 0x55, 0x39, 0xbd, 0x84, 0x21, 0x32, 0x45, 0x4a, 0x4f,
-		0x42, 0x9c, 0xa5, 0x68, 0xcc, 0xf0, 0xe8, 0x60, 0x0c, 0x78, 0x2d, 0xc9, 0x04, 0x27, 0xdd, 0x07,
-		0x69, 0xfc, 0x3f, 0x8d, 0x8d, 0x24, 0xb1, 0x39, 0x85, 0x05, 0x38, 0x2e, 0xa4, 0x49, 0x48, 0x91,
-		0x65, 0xd2, 0x40, 0x7c, 0x6b, 0xf3, 0x9f, 0x71, 0x60, 0x35, 0xd0, 0xa6, 0xda, 0x36, 0x3c, 0xe5,
-		0xaa, 0x6a, 0xb4, 0x11, 0x09, 0xf8, 0xb4, 0x9c, 0x65, 0xc2, 0xcf, 0x60, 0x99, 0x54, 0x80, 0x0c,
-		0x5d, 0x55, 0xba, 0xd9, 0x40, 0xd7, 0x49, 0xf6, 0x4c, 0xc8, 0x74, 0xa1, 0x2d, 0x62, 0x09, 0xee,
-		0xfe, 0x8a, 0x6b, 0x99, 0x3c, 0x34, 0x49, 0x17, 0x58, 0x40, 0xba, 0x7f, 0xbc, 0x3b, 0x71, 0xdf,
-		0xdf, 0x7f, 0x78, 0x3d, 0x6b, 0xe9, 0x04, 0x8c, 0x12, 0x8d, 0x33, 0x6c, 0xea, 0x55, 0x23, 0x3f,
-		0x76, 0x4c, 0x98, 0x4a, 0xc9, 0x39, 0x2a, 0x5e, 0x65, 0xd2, 0xe2, 0xb7, 0x62, 0x10, 0x27, 0x89,
-		0x65, 0x14, 0x32, 0x6b, 0xcf, 0xd7, 0xaa, 0xca, 0xfc, 0xea, 0x7a, 0x65, 0xa9, 0x2a, 0x0a, 0x52,
-		0x0e, 0x80, 0x08, 0x2e, 0x2e, 0xad, 0x96, 0xd7, 0xc4, 0x98, 0xff, 0xbc, 0xb8, 0xb2, 0x76, 0xfe,
-		0xac, 0x38, 0xe4, 0x03, 0xd6, 0xa9, 0x20, 0x1e, 0x54, 0x38, 0x73, 0x5a, 0x4c, 0x48, 0x22, 0x64,
-		0x29, 0xc1, 0xe2, 0x73, 0xd5, 0xf9, 0xf3, 0x67, 0xc5, 0x64, 0x58, 0x72, 0xe6, 0xb4, 0x38, 0x2c,
-		0x8d, 0x40, 0x9a, 0x48, 0x2a, 0xab, 0xab, 0x4b, 0x62, 0xca, 0xe7, 0xac, 0xaf, 0xc9, 0x8b, 0x2b,
-		0x0b, 0x62, 0xda, 0xe7, 0x5c, 0x90, 0x57, 0xd7, 0x6b, 0x22, 0xf8, 0x0c, 0xcb, 0xd5, 0x7a, 0xbd,
-		0xbc, 0x50, 0x15, 0x33, 0xbe, 0x46, 0xe5, 0xf9, 0xb5, 0x6a, 0x5d, 0xcc, 0x86, 0xcc, 0x3a, 0x73,
-		0x5a, 0x1c, 0xf1, 0xbb, 0xa8, 0xae, 0xac, 0x2f, 0x8b, 0x39, 0x69, 0x0c, 0x46, 0x68, 0x17, 0xdc,
-		0x88, 0xd1, 0x2e, 0xd1, 0xf9, 0xb3, 0xa2, 0xd8, 0x31, 0x84, 0xb2, 0x8c, 0x85, 0x04, 0xe7, 0xcf,
-		0x8a, 0x52, 0x71, 0x0e, 0x12, 0x24, 0x0c, 0x25, 0x09, 0x72, 0x4b, 0xe5, 0x4a, 0x75, 0x49, 0x59,
-		0xad, 0xad, 0x2d, 0xae, 0xae, 0x94, 0x97, 0x44, 0xa1, 0x23, 0x93, 0xab, 0xcf, 0xac, 0x2f, 0xca,
-		0xd5, 0x79, 0x31, 0x16, 0x94, 0xd5, 0xaa, 0xe5, 0xb5, 0xea, 0xbc, 0x38, 0x54, 0xd4, 0x60, 0xa2,
-		0x5f, 0x42, 0xed, 0xbb, 0x84, 0x02, 0xb1, 0x10, 0xdb, 0x23, 0x16, 0x08, 0x57, 0x77, 0x2c, 0x14,
-		0xdf, 0x8d, 0xc1, 0x78, 0x9f, 0x4d, 0xa5, 0x6f, 0x27, 0x4f, 0x41, 0x82, 0xc6, 0x32, 0xdd, 0x66,
-		0x1f, 0xe9, 0xbb, 0x3b, 0x91, 0xc8, 0xee, 0xd9, 0x6a, 0x09, 0x2e, 0x58, 0x6a, 0x0c, 0xed, 0x51,
-		0x6a, 0x60, 0x8a, 0x9e, 0x80, 0xfd, 0x5c, 0x4f, 0xf2, 0xa7, 0xfb, 0xe3, 0xf9, 0x41, 0xf6, 0x47,
-		0x22, 0xdb, 0xdf, 0x26, 0x90, 0xe8, 0xb3, 0x09, 0xcc, 0xc2, 0x58, 0x0f, 0xd1, 0xc0, 0xc9, 0xf8,
-		0x45, 0x01, 0xf2, 0x7b, 0x39, 0x27, 0x22, 0x25, 0xc6, 0x42, 0x29, 0x71, 0xb6, 0xdb, 0x83, 0xc7,
-		0xf7, 0x9e, 0x84, 0x9e, 0xb9, 0x7e, 0x43, 0x80, 0x43, 0xfd, 0x4b, 0xca, 0xbe, 0x36, 0x3c, 0x09,
-		0xc9, 0x16, 0xf2, 0xb6, 0x2c, 0x5e, 0x56, 0x3d, 0xdc, 0x67, 0xb3, 0xc6, 0xcd, 0xdd, 0x93, 0xcd,
-		0x50, 0xc1, 0xdd, 0x7e, 0x68, 0xaf, 0xba, 0x90, 0x5a, 0xd3, 0x63, 0xe9, 0x97, 0x62, 0x70, 0xb0,
-		0x2f, 0x79, 0x5f, 0x43, 0xef, 0x07, 0xd0, 0x4d, 0xbb, 0xed, 0xd1, 0xd2, 0x89, 0x66, 0xe2, 0x34,
-		0x91, 0x90, 0xe4, 0x85, 0xb3, 0x6c, 0xdb, 0xf3, 0xdb, 0x87, 0x48, 0x3b, 0x50, 0x11, 0x51, 0xb8,
-		0xd0, 0x31, 0x34, 0x4e, 0x0c, 0x3d, 0xba, 0xc7, 0x48, 0x7b, 0x02, 0xf3, 0x31, 0x10, 0x35, 0x43,
-		0x47, 0xa6, 0xa7, 0xb8, 0x9e, 0x83, 0xd4, 0x96, 0x6e, 0x36, 0xc9, 0x56, 0x93, 0x2a, 0x25, 0x36,
-		0x55, 0xc3, 0x45, 0xf2, 0x28, 0x6d, 0xae, 0xf3, 0x56, 0x8c, 0x20, 0x01, 0xe4, 0x04, 0x10, 0xc9,
-		0x10, 0x82, 0x36, 0xfb, 0x88, 0xe2, 0x57, 0xd2, 0x90, 0x09, 0x14, 0xe0, 0xd2, 0x71, 0xc8, 0x5e,
-		0x51, 0xaf, 0xaa, 0x0a, 0x3f, 0x54, 0x51, 0x4f, 0x64, 0xb0, 0xac, 0xc6, 0x0e, 0x56, 0x8f, 0xc1,
-		0x04, 0x51, 0xb1, 0xda, 0x1e, 0x72, 0x14, 0xcd, 0x50, 0x5d, 0x97, 0x38, 0x2d, 0x45, 0x54, 0x25,
-		0xdc, 0xb6, 0x8a, 0x9b, 0xe6, 0x78, 0x8b, 0x74, 0x0e, 0xc6, 0x09, 0xa2, 0xd5, 0x36, 0x3c, 0xdd,
-		0x36, 0x90, 0x82, 0x8f, 0x79, 0x2e, 0xd9, 0x72, 0x7c, 0xcb, 0xc6, 0xb0, 0xc6, 0x32, 0x53, 0xc0,
-		0x16, 0xb9, 0xd2, 0x3c, 0xdc, 0x4f, 0x60, 0x4d, 0x64, 0x22, 0x47, 0xf5, 0x90, 0x82, 0x3e, 0xdf,
-		0x56, 0x0d, 0x57, 0x51, 0xcd, 0x86, 0xb2, 0xa5, 0xba, 0x5b, 0xf9, 0x09, 0x4c, 0x50, 0x89, 0xe5,
-		0x05, 0xf9, 0x08, 0x56, 0x5c, 0x60, 0x7a, 0x55, 0xa2, 0x56, 0x36, 0x1b, 0x9f, 0x56, 0xdd, 0x2d,
-		0xa9, 0x04, 0x87, 0x08, 0x8b, 0xeb, 0x39, 0xba, 0xd9, 0x54, 0xb4, 0x2d, 0xa4, 0x6d, 0x2b, 0x6d,
-		0x6f, 0xf3, 0x42, 0xfe, 0xbe, 0x60, 0xff, 0xc4, 0xc2, 0x3a, 0xd1, 0x99, 0xc3, 0x2a, 0xeb, 0xde,
-		0xe6, 0x05, 0xa9, 0x0e, 0x59, 0x3c, 0x19, 0x2d, 0xfd, 0x06, 0x52, 0x36, 0x2d, 0x87, 0xec, 0xa1,
-		0xb9, 0x3e, 0xa9, 0x29, 0xe0, 0xc1, 0xe9, 0x55, 0x06, 0x58, 0xb6, 0x1a, 0xa8, 0x94, 0xa8, 0xd7,
-		0xaa, 0xd5, 0x79, 0x39, 0xc3, 0x59, 0x2e, 0x5a, 0x0e, 0x0e, 0xa8, 0xa6, 0xe5, 0x3b, 0x38, 0x43,
-		0x03, 0xaa, 0x69, 0x71, 0xf7, 0x9e, 0x83, 0x71, 0x4d, 0xa3, 0x63, 0xd6, 0x35, 0x85, 0x1d, 0xc6,
-		0xdc, 0xbc, 0x18, 0x72, 0x96, 0xa6, 0x2d, 0x50, 0x05, 0x16, 0xe3, 0xae, 0xf4, 0x04, 0x1c, 0xec,
-		0x38, 0x2b, 0x08, 0x1c, 0xeb, 0x19, 0x65, 0x37, 0xf4, 0x1c, 0x8c, 0xdb, 0x3b, 0xbd, 0x40, 0x29,
-		0xd4, 0xa3, 0xbd, 0xd3, 0x0d, 0x7b, 0x1c, 0x26, 0xec, 0x2d, 0xbb, 0x17, 0xf7, 0x68, 0x10, 0x27,
-		0xd9, 0x5b, 0x76, 0x37, 0xf0, 0x21, 0x72, 0x32, 0x77, 0x90, 0xa6, 0x7a, 0xa8, 0x91, 0x3f, 0x1c,
-		0x54, 0x0f, 0x34, 0x48, 0xd3, 0x20, 0x6a, 0x9a, 0x82, 0x4c, 0x75, 0xc3, 0x40, 0x8a, 0xea, 0x20,
-		0x53, 0x75, 0xf3, 0x05, 0xa2, 0x1c, 0xf7, 0x9c, 0x36, 0x92, 0x73, 0x9a, 0x56, 0x25, 0x8d, 0x65,
-		0xd2, 0x26, 0x3d, 0x0a, 0x63, 0xd6, 0xc6, 0x15, 0x8d, 0x46, 0xa4, 0x62, 0x3b, 0x68, 0x53, 0xbf,
-		0x9e, 0x7f, 0x90, 0xb8, 0x77, 0x14, 0x37, 0x90, 0x78, 0xac, 0x11, 0xb1, 0xf4, 0x08, 0x88, 0x9a,
-		0xbb, 0xa5, 0x3a, 0x36, 0x49, 0xc9, 0xae, 0xad, 0x6a, 0x28, 0xff, 0x10, 0x55, 0xa5, 0xf2, 0x15,
-		0x2e, 0xc6, 0x2b, 0xc2, 0xbd, 0xa6, 0x6f, 0x7a, 0x9c, 0xf1, 0x04, 0x5d, 0x11, 0x44, 0xc6, 0xd8,
-		0xa6, 0x40, 0xc4, 0x9e, 0x08, 0x75, 0x3c, 0x45, 0xd4, 0x72, 0xf6, 0x96, 0x1d, 0xec, 0xf7, 0x01,
-		0x18, 0xc1, 0x9a, 0x9d, 0x4e, 0x1f, 0xa1, 0x85, 0x9b, 0xbd, 0x15, 0xe8, 0xf1, 0x2c, 0x1c, 0xc2,
-		0x4a, 0x2d, 0xe4, 0xa9, 0x0d, 0xd5, 0x53, 0x03, 0xda, 0x9f, 0x24, 0xda, 0xd8, 0xed, 0xcb, 0xac,
-		0x31, 0x64, 0xa7, 0xd3, 0xde, 0xd8, 0xf1, 0x03, 0xeb, 0x14, 0xb5, 0x13, 0xcb, 0x78, 0x68, 0xdd,
-		0xb3, 0xe2, 0xbc, 0x58, 0x82, 0x6c, 0x30, 0xee, 0xa5, 0x34, 0xd0, 0xc8, 0x17, 0x05, 0x5c, 0x04,
-		0xcd, 0xad, 0xce, 0xe3, 0xf2, 0xe5, 0x72, 0x55, 0x8c, 0xe1, 0x32, 0x6a, 0x69, 0x71, 0xad, 0xaa,
-		0xc8, 0xeb, 0x2b, 0x6b, 0x8b, 0xcb, 0x55, 0x71, 0x28, 0x50, 0xd8, 0x5f, 0x8a, 0xa7, 0x1e, 0x16,
-		0x4f, 0x14, 0xdf, 0x8e, 0x41, 0x2e, 0x7c, 0x52, 0x93, 0x7e, 0x1a, 0x0e, 0xf3, 0x6b, 0x15, 0x17,
-		0x79, 0xca, 0x35, 0xdd, 0x21, 0x0b, 0xb2, 0xa5, 0xd2, 0xcd, 0xd1, 0x8f, 0x9f, 0x09, 0xa6, 0x55,
-		0x47, 0xde, 0xb3, 0xba, 0x83, 0x97, 0x5b, 0x4b, 0xf5, 0xa4, 0x25, 0x28, 0x98, 0x96, 0xe2, 0x7a,
-		0xaa, 0xd9, 0x50, 0x9d, 0x86, 0xd2, 0xb9, 0xd0, 0x52, 0x54, 0x4d, 0x43, 0xae, 0x6b, 0xd1, 0x8d,
-		0xd0, 0x67, 0xf9, 0x84, 0x69, 0xd5, 0x99, 0x72, 0x67, 0x87, 0x28, 0x33, 0xd5, 0xae, 0xf0, 0x1d,
-		0xda, 0x2b, 0x7c, 0xef, 0x83, 0x74, 0x4b, 0xb5, 0x15, 0x64, 0x7a, 0xce, 0x0e, 0xa9, 0xcf, 0x53,
-		0x72, 0xaa, 0xa5, 0xda, 0x55, 0xfc, 0xfc, 0x91, 0x1c, 0x93, 0x2e, 0xc5, 0x53, 0x29, 0x31, 0x7d,
-		0x29, 0x9e, 0x4a, 0x8b, 0x50, 0x7c, 0x67, 0x08, 0xb2, 0xc1, 0x7a, 0x1d, 0x1f, 0x7f, 0x34, 0xb2,
-		0x63, 0x09, 0x24, 0xa7, 0x3d, 0x70, 0xd7, 0xea, 0x7e, 0x7a, 0x0e, 0x6f, 0x65, 0xa5, 0x24, 0x2d,
-		0x8e, 0x65, 0x8a, 0xc4, 0x65, 0x04, 0x0e, 0x36, 0x44, 0x8b, 0x91, 0x94, 0xcc, 0x9e, 0xa4, 0x05,
-		0x48, 0x5e, 0x71, 0x09, 0x77, 0x92, 0x70, 0x3f, 0x78, 0x77, 0xee, 0x4b, 0x75, 0x42, 0x9e, 0xbe,
-		0x54, 0x57, 0x56, 0x56, 0xe5, 0xe5, 0xf2, 0x92, 0xcc, 0xe0, 0xd2, 0x11, 0x88, 0x1b, 0xea, 0x8d,
-		0x9d, 0xf0, 0xa6, 0x47, 0x44, 0x83, 0x4e, 0xc2, 0x11, 0x88, 0x5f, 0x43, 0xea, 0x76, 0x78, 0xab,
-		0x21, 0xa2, 0x7b, 0xb8, 0x18, 0x66, 0x20, 0x41, 0xfc, 0x25, 0x01, 0x30, 0x8f, 0x89, 0x07, 0xa4,
-		0x14, 0xc4, 0xe7, 0x56, 0x65, 0xbc, 0x20, 0x44, 0xc8, 0x52, 0xa9, 0x52, 0x5b, 0xac, 0xce, 0x55,
-		0xc5, 0x58, 0xf1, 0x1c, 0x24, 0xa9, 0x13, 0xf0, 0x62, 0xf1, 0xdd, 0x20, 0x1e, 0x60, 0x8f, 0x8c,
-		0x43, 0xe0, 0xad, 0xeb, 0xcb, 0x95, 0xaa, 0x2c, 0xc6, 0xc2, 0x53, 0x1d, 0x17, 0x13, 0x45, 0x17,
-		0xb2, 0xc1, 0x3a, 0xfc, 0xa3, 0x39, 0x8c, 0x7f, 0x57, 0x80, 0x4c, 0xa0, 0xae, 0xc6, 0x05, 0x91,
-		0x6a, 0x18, 0xd6, 0x35, 0x45, 0x35, 0x74, 0xd5, 0x65, 0xa1, 0x01, 0x44, 0x54, 0xc6, 0x92, 0x41,
-		0xa7, 0xee, 0x23, 0x5a, 0x22, 0x09, 0x31, 0x59, 0x7c, 0x4d, 0x00, 0xb1, 0xbb, 0xb0, 0xed, 0x32,
-		0x53, 0xf8, 0x38, 0xcd, 0x2c, 0xbe, 0x2a, 0x40, 0x2e, 0x5c, 0xcd, 0x76, 0x99, 0x77, 0xfc, 0x63,
-		0x35, 0xef, 0xfb, 0x31, 0x18, 0x09, 0xd5, 0xb0, 0x83, 0x5a, 0xf7, 0x79, 0x18, 0xd3, 0x1b, 0xa8,
-		0x65, 0x5b, 0x1e, 0x32, 0xb5, 0x1d, 0xc5, 0x40, 0x57, 0x91, 0x91, 0x2f, 0x92, 0xa4, 0x31, 0x73,
-		0xf7, 0x2a, 0x79, 0x7a, 0xb1, 0x83, 0x5b, 0xc2, 0xb0, 0xd2, 0xf8, 0xe2, 0x7c, 0x75, 0xb9, 0xb6,
-		0xba, 0x56, 0x5d, 0x99, 0x7b, 0x5e, 0x59, 0x5f, 0x79, 0x7a, 0x65, 0xf5, 0xd9, 0x15, 0x59, 0xd4,
-		0xbb, 0xd4, 0xee, 0xe1, 0xb2, 0xaf, 0x81, 0xd8, 0x6d, 0x94, 0x74, 0x18, 0xfa, 0x99, 0x25, 0x1e,
-		0x90, 0xc6, 0x61, 0x74, 0x65, 0x55, 0xa9, 0x2f, 0xce, 0x57, 0x95, 0xea, 0xc5, 0x8b, 0xd5, 0xb9,
-		0xb5, 0x3a, 0xbd, 0xf7, 0xf0, 0xb5, 0xd7, 0x42, 0x0b, 0xbc, 0xf8, 0xca, 0x10, 0x8c, 0xf7, 0xb1,
-		0x44, 0x2a, 0xb3, 0x13, 0x0b, 0x3d, 0x44, 0x9d, 0x1a, 0xc4, 0xfa, 0x69, 0x5c, 0x33, 0xd4, 0x54,
-		0xc7, 0x63, 0x07, 0x9c, 0x47, 0x00, 0x7b, 0xc9, 0xf4, 0xf4, 0x4d, 0x1d, 0x39, 0xec, 0x3e, 0x89,
-		0x1e, 0x63, 0x46, 0x3b, 0x72, 0x7a, 0xa5, 0xf4, 0x49, 0x90, 0x6c, 0xcb, 0xd5, 0x3d, 0xfd, 0x2a,
-		0x52, 0x74, 0x93, 0x5f, 0x3e, 0xe1, 0x63, 0x4d, 0x5c, 0x16, 0x79, 0xcb, 0xa2, 0xe9, 0xf9, 0xda,
-		0x26, 0x6a, 0xaa, 0x5d, 0xda, 0x38, 0x99, 0x0f, 0xc9, 0x22, 0x6f, 0xf1, 0xb5, 0x8f, 0x43, 0xb6,
-		0x61, 0xb5, 0x71, 0xad, 0x47, 0xf5, 0xf0, 0xde, 0x21, 0xc8, 0x19, 0x2a, 0xf3, 0x55, 0x58, 0x15,
-		0xdf, 0xb9, 0xf5, 0xca, 0xca, 0x19, 0x2a, 0xa3, 0x2a, 0x27, 0x60, 0x54, 0x6d, 0x36, 0x1d, 0x4c,
-		0xce, 0x89, 0xe8, 0xb9, 0x24, 0xe7, 0x8b, 0x89, 0xe2, 0xe4, 0x25, 0x48, 0x71, 0x3f, 0xe0, 0xad,
-		0x1a, 0x7b, 0x42, 0xb1, 0xe9, 0x61, 0x3b, 0x36, 0x95, 0x96, 0x53, 0x26, 0x6f, 0x3c, 0x0e, 0x59,
-		0xdd, 0x55, 0x3a, 0x97, 0xf8, 0xb1, 0x63, 0xb1, 0xa9, 0x94, 0x9c, 0xd1, 0x5d, 0xff, 0x02, 0xb4,
-		0xf8, 0x46, 0x0c, 0x72, 0xe1, 0x97, 0x10, 0xd2, 0x3c, 0xa4, 0x0c, 0x4b, 0x53, 0x49, 0x68, 0xd1,
-		0x37, 0x60, 0x53, 0x11, 0xef, 0x2d, 0xa6, 0x97, 0x98, 0xbe, 0xec, 0x23, 0x27, 0xff, 0x49, 0x80,
-		0x14, 0x17, 0x4b, 0x87, 0x20, 0x6e, 0xab, 0xde, 0x16, 0xa1, 0x4b, 0x54, 0x62, 0xa2, 0x20, 0x93,
-		0x67, 0x2c, 0x77, 0x6d, 0xd5, 0x24, 0x21, 0xc0, 0xe4, 0xf8, 0x19, 0xcf, 0xab, 0x81, 0xd4, 0x06,
-		0x39, 0xf4, 0x58, 0xad, 0x16, 0x32, 0x3d, 0x97, 0xcf, 0x2b, 0x93, 0xcf, 0x31, 0xb1, 0x74, 0x12,
-		0xc6, 0x3c, 0x47, 0xd5, 0x8d, 0x90, 0x6e, 0x9c, 0xe8, 0x8a, 0xbc, 0xc1, 0x57, 0x2e, 0xc1, 0x11,
-		0xce, 0xdb, 0x40, 0x9e, 0xaa, 0x6d, 0xa1, 0x46, 0x07, 0x94, 0x24, 0x97, 0x1b, 0x87, 0x99, 0xc2,
-		0x3c, 0x6b, 0xe7, 0xd8, 0xe2, 0xdb, 0x02, 0x8c, 0xf1, 0x63, 0x5a, 0xc3, 0x77, 0xd6, 0x32, 0x80,
-		0x6a, 0x9a, 0x96, 0x17, 0x74, 0x57, 0x6f, 0x28, 0xf7, 0xe0, 0xa6, 0xcb, 0x3e, 0x48, 0x0e, 0x10,
-		0x4c, 0xb6, 0x00, 0x3a, 0x2d, 0x7b, 0xba, 0xad, 0x00, 0x19, 0xf6, 0x86, 0x89, 0xbc, 0xa6, 0xa4,
-		0x07, 0x7b, 0xa0, 0x22, 0x7c, 0x9e, 0x93, 0x26, 0x20, 0xb1, 0x81, 0x9a, 0xba, 0xc9, 0xee, 0x8d,
-		0xe9, 0x03, 0xbf, 0x7e, 0x89, 0xfb, 0xd7, 0x2f, 0x95, 0x2f, 0x0b, 0x30, 0xae, 0x59, 0xad, 0x6e,
-		0x7b, 0x2b, 0x62, 0xd7, 0xed, 0x82, 0xfb, 0x69, 0xe1, 0xf2, 0x93, 0x4d, 0xdd, 0xdb, 0x6a, 0x6f,
-		0x4c, 0x6b, 0x56, 0x6b, 0xa6, 0x69, 0x19, 0xaa, 0xd9, 0xec, 0xbc, 0x67, 0x25, 0x3f, 0xb4, 0x53,
-		0x4d, 0x64, 0x9e, 0x6a, 0x5a, 0x81, 0xb7, 0xae, 0xb3, 0x9d, 0x9f, 0xff, 0x27, 0x08, 0xaf, 0xc7,
-		0x86, 0x16, 0x6a, 0x95, 0x37, 0x63, 0x93, 0x0b, 0xb4, 0xbb, 0x1a, 0x77, 0x8f, 0x8c, 0x36, 0x0d,
-		0xa4, 0xe1, 0x21, 0xc3, 0xad, 0x93, 0x30, 0xd1, 0xb4, 0x9a, 0x16, 0x61, 0x9c, 0xc1, 0xbf, 0xd8,
-		0x9b, 0xdb, 0xb4, 0x2f, 0x9d, 0x8c, 0x7c, 0xcd, 0x5b, 0x5a, 0x81, 0x71, 0xa6, 0xac, 0x90, 0x57,
-		0x47, 0xf4, 0x60, 0x23, 0xdd, 0xf5, 0x56, 0x2d, 0xff, 0xcd, 0xf7, 0xc8, 0x86, 0x2e, 0x8f, 0x31,
-		0x28, 0x6e, 0xa3, 0x67, 0x9f, 0x92, 0x0c, 0x07, 0x43, 0x7c, 0x74, 0xd9, 0x22, 0x27, 0x82, 0xf1,
-		0xef, 0x19, 0xe3, 0x78, 0x80, 0xb1, 0xce, 0xa0, 0xa5, 0x39, 0x18, 0xd9, 0x0f, 0xd7, 0x3f, 0x30,
-		0xae, 0x2c, 0x0a, 0x92, 0x2c, 0xc0, 0x28, 0x21, 0xd1, 0xda, 0xae, 0x67, 0xb5, 0x48, 0x4e, 0xbc,
-		0x3b, 0xcd, 0x3f, 0xbe, 0x47, 0xd7, 0x51, 0x0e, 0xc3, 0xe6, 0x7c, 0x54, 0xa9, 0x04, 0xe4, 0x6d,
-		0x59, 0x03, 0x69, 0x46, 0x04, 0xc3, 0x5b, 0xcc, 0x10, 0x5f, 0xbf, 0xf4, 0x19, 0x98, 0xc0, 0xbf,
-		0x49, 0xca, 0x0a, 0x5a, 0x12, 0x7d, 0x05, 0x97, 0x7f, 0xfb, 0x45, 0xba, 0x54, 0xc7, 0x7d, 0x82,
-		0x80, 0x4d, 0x81, 0x59, 0x6c, 0x22, 0xcf, 0x43, 0x8e, 0xab, 0xa8, 0x46, 0x3f, 0xf3, 0x02, 0x77,
-		0x18, 0xf9, 0xaf, 0xbd, 0x1f, 0x9e, 0xc5, 0x05, 0x8a, 0x2c, 0x1b, 0x46, 0x69, 0x1d, 0x0e, 0xf7,
-		0x89, 0x8a, 0x01, 0x38, 0x5f, 0x61, 0x9c, 0x13, 0x3d, 0x91, 0x81, 0x69, 0x6b, 0xc0, 0xe5, 0xfe,
-		0x5c, 0x0e, 0xc0, 0xf9, 0x9b, 0x8c, 0x53, 0x62, 0x58, 0x3e, 0xa5, 0x98, 0xf1, 0x12, 0x8c, 0x5d,
-		0x45, 0xce, 0x86, 0xe5, 0xb2, 0x7b, 0xa3, 0x01, 0xe8, 0x5e, 0x65, 0x74, 0xa3, 0x0c, 0x48, 0x2e,
-		0x92, 0x30, 0xd7, 0x13, 0x90, 0xda, 0x54, 0x35, 0x34, 0x00, 0xc5, 0xd7, 0x19, 0xc5, 0x30, 0xd6,
-		0xc7, 0xd0, 0x32, 0x64, 0x9b, 0x16, 0xdb, 0xb5, 0xa2, 0xe1, 0xaf, 0x31, 0x78, 0x86, 0x63, 0x18,
-		0x85, 0x6d, 0xd9, 0x6d, 0x03, 0x6f, 0x69, 0xd1, 0x14, 0xbf, 0xc5, 0x29, 0x38, 0x86, 0x51, 0xec,
-		0xc3, 0xad, 0x37, 0x39, 0x85, 0x1b, 0xf0, 0xe7, 0x53, 0x90, 0xb1, 0x4c, 0x63, 0xc7, 0x32, 0x07,
-		0x31, 0xe2, 0x1b, 0x8c, 0x01, 0x18, 0x04, 0x13, 0xcc, 0x42, 0x7a, 0xd0, 0x89, 0xf8, 0x9d, 0xf7,
-		0xf9, 0xf2, 0xe0, 0x33, 0xb0, 0x00, 0xa3, 0x3c, 0x41, 0xe9, 0x96, 0x39, 0x00, 0xc5, 0xef, 0x32,
-		0x8a, 0x5c, 0x00, 0xc6, 0x86, 0xe1, 0x21, 0xd7, 0x6b, 0xa2, 0x41, 0x48, 0xde, 0xe0, 0xc3, 0x60,
-		0x10, 0xe6, 0xca, 0x0d, 0x64, 0x6a, 0x5b, 0x83, 0x31, 0xfc, 0x1e, 0x77, 0x25, 0xc7, 0x60, 0x8a,
-		0x39, 0x18, 0x69, 0xa9, 0x8e, 0xbb, 0xa5, 0x1a, 0x03, 0x4d, 0xc7, 0xef, 0x33, 0x8e, 0xac, 0x0f,
-		0x62, 0x1e, 0x69, 0x9b, 0xfb, 0xa1, 0x79, 0x93, 0x7b, 0x24, 0x00, 0x63, 0x4b, 0xcf, 0xf5, 0xc8,
-		0x25, 0xdb, 0x7e, 0xd8, 0xfe, 0x80, 0x2f, 0x3d, 0x8a, 0x5d, 0x0e, 0x32, 0xce, 0x42, 0xda, 0xd5,
-		0x6f, 0x0c, 0x44, 0xf3, 0x87, 0x7c, 0xa6, 0x09, 0x00, 0x83, 0x9f, 0x87, 0x23, 0x7d, 0xb7, 0x89,
-		0x01, 0xc8, 0xfe, 0x88, 0x91, 0x1d, 0xea, 0xb3, 0x55, 0xb0, 0x94, 0xb0, 0x5f, 0xca, 0x3f, 0xe6,
-		0x29, 0x01, 0x75, 0x71, 0xd5, 0xf0, 0x39, 0xc2, 0x55, 0x37, 0xf7, 0xe7, 0xb5, 0x3f, 0xe1, 0x5e,
-		0xa3, 0xd8, 0x90, 0xd7, 0xd6, 0xe0, 0x10, 0x63, 0xdc, 0xdf, 0xbc, 0xfe, 0x29, 0x4f, 0xac, 0x14,
-		0xbd, 0x1e, 0x9e, 0xdd, 0xcf, 0xc2, 0xa4, 0xef, 0x4e, 0x5e, 0xb0, 0xba, 0x4a, 0x4b, 0xb5, 0x07,
-		0x60, 0xfe, 0x26, 0x63, 0xe6, 0x19, 0xdf, 0xaf, 0x78, 0xdd, 0x65, 0xd5, 0xc6, 0xe4, 0xcf, 0x41,
-		0x9e, 0x93, 0xb7, 0x4d, 0x07, 0x69, 0x56, 0xd3, 0xd4, 0x6f, 0xa0, 0xc6, 0x00, 0xd4, 0x7f, 0xd6,
-		0x35, 0x55, 0xeb, 0x01, 0x38, 0x66, 0x5e, 0x04, 0xd1, 0xaf, 0x55, 0x14, 0xbd, 0x65, 0x5b, 0x8e,
-		0x17, 0xc1, 0xf8, 0xe7, 0x7c, 0xa6, 0x7c, 0xdc, 0x22, 0x81, 0x95, 0xaa, 0x40, 0xdf, 0x3c, 0x0f,
-		0x1a, 0x92, 0x7f, 0xc1, 0x88, 0x46, 0x3a, 0x28, 0x96, 0x38, 0x34, 0xab, 0x65, 0xab, 0xce, 0x20,
-		0xf9, 0xef, 0x2f, 0x79, 0xe2, 0x60, 0x10, 0x96, 0x38, 0xbc, 0x1d, 0x1b, 0xe1, 0xdd, 0x7e, 0x00,
-		0x86, 0x6f, 0xf1, 0xc4, 0xc1, 0x31, 0x8c, 0x82, 0x17, 0x0c, 0x03, 0x50, 0x7c, 0x9b, 0x53, 0x70,
-		0x0c, 0xa6, 0x78, 0xa6, 0xb3, 0xd1, 0x3a, 0xa8, 0xa9, 0xbb, 0x9e, 0x43, 0xcb, 0xe4, 0xbb, 0x53,
-		0xfd, 0xd5, 0xfb, 0xe1, 0x22, 0x4c, 0x0e, 0x40, 0x71, 0x26, 0x62, 0xd7, 0xae, 0xe4, 0x14, 0x15,
-		0x6d, 0xd8, 0x77, 0x78, 0x26, 0x0a, 0xc0, 0xb0, 0x6d, 0x81, 0x0a, 0x11, 0xbb, 0x5d, 0xc3, 0x67,
-		0x87, 0x01, 0xe8, 0xfe, 0xba, 0xcb, 0xb8, 0x3a, 0xc7, 0x62, 0xce, 0x40, 0xfd, 0xd3, 0x36, 0xb7,
-		0xd1, 0xce, 0x40, 0xd1, 0xf9, 0x37, 0x5d, 0xf5, 0xcf, 0x3a, 0x45, 0xd2, 0x1c, 0x32, 0xda, 0x55,
-		0x4f, 0x49, 0x51, 0xdf, 0x19, 0xe5, 0x7f, 0xee, 0x0e, 0x1b, 0x6f, 0xb8, 0x9c, 0x2a, 0x2d, 0xe1,
-		0x20, 0x0f, 0x17, 0x3d, 0xd1, 0x64, 0x2f, 0xde, 0xf1, 0xe3, 0x3c, 0x54, 0xf3, 0x94, 0x2e, 0xc2,
-		0x48, 0xa8, 0xe0, 0x89, 0xa6, 0xfa, 0x02, 0xa3, 0xca, 0x06, 0xeb, 0x9d, 0xd2, 0x39, 0x88, 0xe3,
-		0xe2, 0x25, 0x1a, 0xfe, 0xf3, 0x0c, 0x4e, 0xd4, 0x4b, 0x9f, 0x82, 0x14, 0x2f, 0x5a, 0xa2, 0xa1,
-		0xbf, 0xc0, 0xa0, 0x3e, 0x04, 0xc3, 0x79, 0xc1, 0x12, 0x0d, 0xff, 0x45, 0x0e, 0xe7, 0x10, 0x0c,
-		0x1f, 0xdc, 0x85, 0xdf, 0x7d, 0x29, 0xce, 0x36, 0x1d, 0xee, 0xbb, 0x59, 0x18, 0x66, 0x95, 0x4a,
-		0x34, 0xfa, 0x4b, 0xac, 0x73, 0x8e, 0x28, 0x3d, 0x0e, 0x89, 0x01, 0x1d, 0xfe, 0x4b, 0x0c, 0x4a,
-		0xf5, 0x4b, 0x73, 0x90, 0x09, 0x54, 0x27, 0xd1, 0xf0, 0x5f, 0x66, 0xf0, 0x20, 0x0a, 0x9b, 0xce,
-		0xaa, 0x93, 0x68, 0x82, 0x2f, 0x73, 0xd3, 0x19, 0x02, 0xbb, 0x8d, 0x17, 0x26, 0xd1, 0xe8, 0x97,
-		0xb9, 0xd7, 0x39, 0xa4, 0xf4, 0x14, 0xa4, 0xfd, 0xcd, 0x26, 0x1a, 0xff, 0x15, 0x86, 0xef, 0x60,
-		0xb0, 0x07, 0x02, 0x9b, 0x5d, 0x34, 0xc5, 0xaf, 0x70, 0x0f, 0x04, 0x50, 0x78, 0x19, 0x75, 0x17,
-		0x30, 0xd1, 0x4c, 0xbf, 0xca, 0x97, 0x51, 0x57, 0xfd, 0x82, 0x67, 0x93, 0xe4, 0xfc, 0x68, 0x8a,
-		0x5f, 0xe3, 0xb3, 0x49, 0xf4, 0xb1, 0x19, 0xdd, 0x15, 0x41, 0x34, 0xc7, 0x6f, 0x70, 0x33, 0xba,
-		0x0a, 0x82, 0x52, 0x0d, 0xa4, 0xde, 0x6a, 0x20, 0x9a, 0xef, 0xab, 0x8c, 0x6f, 0xac, 0xa7, 0x18,
-		0x28, 0x3d, 0x0b, 0x87, 0xfa, 0x57, 0x02, 0xd1, 0xac, 0x5f, 0xbb, 0xd3, 0x75, 0x76, 0x0b, 0x16,
-		0x02, 0xa5, 0xb5, 0xce, 0x96, 0x12, 0xac, 0x02, 0xa2, 0x69, 0x5f, 0xb9, 0x13, 0x4e, 0xdc, 0xc1,
-		0x22, 0xa0, 0x54, 0x06, 0xe8, 0x6c, 0xc0, 0xd1, 0x5c, 0xaf, 0x32, 0xae, 0x00, 0x08, 0x2f, 0x0d,
-		0xb6, 0xff, 0x46, 0xe3, 0xbf, 0xce, 0x97, 0x06, 0x43, 0xe0, 0xa5, 0xc1, 0xb7, 0xde, 0x68, 0xf4,
-		0x6b, 0x7c, 0x69, 0x70, 0x08, 0x8e, 0xec, 0xc0, 0xee, 0x16, 0xcd, 0xf0, 0x0d, 0x1e, 0xd9, 0x01,
-		0x54, 0x69, 0x05, 0xc6, 0x7a, 0x36, 0xc4, 0x68, 0xaa, 0xd7, 0x19, 0x95, 0xd8, 0xbd, 0x1f, 0x06,
-		0x37, 0x2f, 0xb6, 0x19, 0x46, 0xb3, 0xfd, 0x76, 0xd7, 0xe6, 0xc5, 0xf6, 0xc2, 0xd2, 0x2c, 0xa4,
-		0xcc, 0xb6, 0x61, 0xe0, 0xc5, 0x23, 0xdd, 0xfd, 0xdb, 0xc0, 0xfc, 0xbf, 0x7f, 0xc0, 0xbc, 0xc3,
-		0x01, 0xa5, 0x73, 0x90, 0x40, 0xad, 0x0d, 0xd4, 0x88, 0x42, 0xde, 0xfa, 0x80, 0x27, 0x4c, 0xac,
-		0x5d, 0x7a, 0x0a, 0x80, 0x5e, 0x8d, 0x90, 0xd7, 0x83, 0x11, 0xd8, 0xff, 0xf8, 0x80, 0x7d, 0x8c,
-		0xd3, 0x81, 0x74, 0x08, 0xe8, 0xa7, 0x3d, 0x77, 0x27, 0x78, 0x3f, 0x4c, 0x40, 0x66, 0xe4, 0x09,
-		0x18, 0xbe, 0xe2, 0x5a, 0xa6, 0xa7, 0x36, 0xa3, 0xd0, 0xff, 0xc9, 0xd0, 0x5c, 0x1f, 0x3b, 0xac,
-		0x65, 0x39, 0xc8, 0x53, 0x9b, 0x6e, 0x14, 0xf6, 0xbf, 0x18, 0xd6, 0x07, 0x60, 0xb0, 0xa6, 0xba,
-		0xde, 0x20, 0xe3, 0xfe, 0x6f, 0x0e, 0xe6, 0x00, 0x6c, 0x34, 0xfe, 0xbd, 0x8d, 0x76, 0xa2, 0xb0,
-		0x3f, 0xe4, 0x46, 0x33, 0xfd, 0xd2, 0xa7, 0x20, 0x8d, 0x7f, 0xd2, 0x2f, 0xec, 0x22, 0xc0, 0xff,
-		0xc3, 0xc0, 0x1d, 0x04, 0xee, 0xd9, 0xf5, 0x1a, 0x9e, 0x1e, 0xed, 0xec, 0xdb, 0x6c, 0xa6, 0xb9,
-		0x7e, 0xa9, 0x0c, 0x19, 0xd7, 0x6b, 0x34, 0xda, 0xac, 0x3e, 0x8d, 0x80, 0xff, 0xef, 0x07, 0xfe,
-		0x95, 0x85, 0x8f, 0xc1, 0xb3, 0x7d, 0x6d, 0xdb, 0xb3, 0x2d, 0xf2, 0x0a, 0x24, 0x8a, 0xe1, 0x0e,
-		0x63, 0x08, 0x40, 0x4a, 0x73, 0x90, 0xc5, 0x63, 0x71, 0x90, 0x8d, 0xc8, 0xfb, 0xaa, 0x08, 0x8a,
-		0x1f, 0x31, 0x07, 0x84, 0x40, 0x95, 0xcf, 0xbd, 0xf5, 0xce, 0x51, 0xe1, 0x7b, 0xef, 0x1c, 0x15,
-		0xbe, 0xff, 0xce, 0x51, 0xe1, 0xe5, 0x77, 0x8f, 0x1e, 0xf8, 0xde, 0xbb, 0x47, 0x0f, 0xfc, 0xf3,
-		0xbb, 0x47, 0x0f, 0xf4, 0xbf, 0x36, 0x86, 0x05, 0x6b, 0xc1, 0xa2, 0x17, 0xc6, 0x97, 0x8b, 0xa1,
-		0xeb, 0xe2, 0xa6, 0xd5, 0xb9, 0xad, 0xf5, 0x0f, 0x39, 0xf0, 0x72, 0x0c, 0x0a, 0xdd, 0x77, 0xb9,
-		0xd8, 0x81, 0xae, 0xa7, 0xb6, 0xec, 0xbd, 0xfe, 0x62, 0x67, 0x16, 0xd2, 0x6b, 0x5c, 0x47, 0xca,
-		0xc3, 0xb0, 0x8b, 0x34, 0xcb, 0x6c, 0xb8, 0xe4, 0x35, 0xe7, 0x90, 0xcc, 0x1f, 0xa5, 0x09, 0x48,
-		0x98, 0xaa, 0x69, 0xb9, 0xec, 0x3b, 0x41, 0xfa, 0x50, 0xf9, 0x75, 0x61, 0x7f, 0x23, 0xca, 0xf9,
-		0x5d, 0x91, 0x61, 0xd5, 0x84, 0xcb, 0x27, 0xef, 0x76, 0x0d, 0x8e, 0x43, 0xd6, 0xed, 0x0c, 0x21,
-		0x70, 0xe7, 0x7d, 0xb4, 0xfb, 0xce, 0xfb, 0x59, 0x64, 0x18, 0x4f, 0x9b, 0xd6, 0x35, 0x73, 0x0d,
-		0x63, 0x36, 0x92, 0xf4, 0x0b, 0x63, 0xf8, 0x76, 0x0a, 0xc6, 0xae, 0xcf, 0xa8, 0xb6, 0xed, 0x92,
-		0x7f, 0x98, 0x13, 0x92, 0xd7, 0xa7, 0xf1, 0xd3, 0x64, 0xdf, 0xab, 0xf1, 0xc9, 0x28, 0x1f, 0x16,
-		0xdf, 0x4c, 0x82, 0x48, 0x3a, 0x2e, 0xdb, 0xb6, 0xa1, 0xb3, 0x77, 0x32, 0x06, 0x0c, 0xab, 0x8d,
-		0x86, 0x83, 0x5c, 0xea, 0xba, 0x6c, 0x45, 0xbe, 0xb5, 0x5b, 0xe0, 0xa2, 0xdb, 0xbb, 0x85, 0xdc,
-		0x8e, 0xda, 0x32, 0x4a, 0x45, 0x26, 0x28, 0xfe, 0xff, 0x6e, 0xe1, 0xa7, 0x02, 0x43, 0xb7, 0xad,
-		0x6d, 0xef, 0x94, 0x89, 0xbc, 0x6b, 0x96, 0xb3, 0x3d, 0x63, 0x5b, 0xda, 0x36, 0xf2, 0x4e, 0x69,
-		0x96, 0x83, 0x66, 0x88, 0x0b, 0xa6, 0xcb, 0x14, 0x25, 0x73, 0x3e, 0xa9, 0x02, 0xc0, 0xfe, 0x64,
-		0x69, 0x1b, 0xed, 0x90, 0x39, 0xc9, 0x56, 0x1e, 0xb8, 0xb5, 0x5b, 0x08, 0x48, 0x6f, 0xef, 0x16,
-		0xc6, 0x68, 0x9f, 0x1d, 0x59, 0x51, 0x4e, 0xd3, 0x87, 0xa7, 0xd1, 0x8e, 0x74, 0x06, 0x92, 0x57,
-		0x54, 0xdd, 0xe0, 0x6f, 0xde, 0x2b, 0xf7, 0xdd, 0xda, 0x2d, 0x30, 0xc9, 0xed, 0xdd, 0xc2, 0x08,
-		0xc5, 0xd2, 0xe7, 0xa2, 0xcc, 0x1a, 0x24, 0x03, 0x92, 0xae, 0xa7, 0x7a, 0x6d, 0xfa, 0x52, 0x28,
-		0x51, 0x59, 0xc3, 0x20, 0x2a, 0xe9, 0x80, 0xe8, 0x33, 0x1e, 0xe3, 0xb9, 0xc1, 0xc7, 0x58, 0xf7,
-		0xd4, 0x6d, 0x54, 0x27, 0x48, 0x99, 0x31, 0x62, 0x13, 0xb5, 0x2d, 0x55, 0x37, 0x5d, 0xfa, 0xa9,
-		0x2c, 0x35, 0x91, 0x4a, 0x3a, 0xbd, 0xd1, 0xe7, 0xa2, 0xcc, 0x1a, 0xa4, 0xeb, 0x30, 0xe2, 0x62,
-		0xae, 0x86, 0xe2, 0x59, 0xdb, 0xc8, 0x74, 0xe9, 0xf7, 0xf4, 0x95, 0xfa, 0x5b, 0xbb, 0x85, 0x03,
-		0xff, 0xb2, 0x5b, 0x78, 0x6c, 0x70, 0x93, 0x2a, 0x7a, 0x73, 0xd1, 0xf4, 0x70, 0x9f, 0x94, 0xa9,
-		0xd3, 0x27, 0x7d, 0x2e, 0xca, 0x59, 0xda, 0xd3, 0x1a, 0x79, 0x94, 0x6e, 0x00, 0xb4, 0xd4, 0xeb,
-		0x8a, 0x83, 0x0c, 0x75, 0x87, 0xfe, 0xe9, 0x49, 0xba, 0xf2, 0xd9, 0x9f, 0xa0, 0xdb, 0x00, 0x5b,
-		0x67, 0x36, 0x3b, 0xb2, 0x22, 0xae, 0x9f, 0xaf, 0xcb, 0xe4, 0xb7, 0xf4, 0x92, 0x00, 0x47, 0xda,
-		0x26, 0x36, 0x87, 0xbd, 0xba, 0xb3, 0x0d, 0x44, 0x2e, 0x48, 0x71, 0xf4, 0xb2, 0x0f, 0xf7, 0x27,
-		0x7b, 0x92, 0x96, 0xbf, 0x1e, 0x2b, 0x67, 0xb0, 0x9d, 0xb7, 0x76, 0x0b, 0xb9, 0x0e, 0x09, 0x46,
-		0xde, 0xde, 0x2d, 0x1c, 0xa4, 0xfd, 0x86, 0xe5, 0xc5, 0x97, 0xff, 0xb5, 0x20, 0xc8, 0x87, 0x7d,
-		0xe1, 0x9c, 0xdf, 0x21, 0xa6, 0x2c, 0xa5, 0xbe, 0x78, 0xb3, 0x70, 0xe0, 0x07, 0x37, 0x0b, 0x42,
-		0x71, 0x03, 0xe2, 0x35, 0xcb, 0x32, 0xa4, 0x1a, 0x30, 0x27, 0xd2, 0x0f, 0x49, 0x2b, 0x17, 0x3e,
-		0xac, 0x5f, 0x64, 0xc6, 0x53, 0x4a, 0x61, 0xfe, 0x1f, 0xde, 0x2c, 0x08, 0x95, 0x4b, 0x7b, 0x65,
-		0xa1, 0xcb, 0x03, 0xb1, 0xb3, 0x04, 0xe1, 0x85, 0x33, 0x47, 0x02, 0x44, 0xd6, 0xd0, 0x72, 0x9b,
-		0x83, 0x24, 0x8e, 0xe2, 0x8f, 0x04, 0x18, 0x59, 0x76, 0x9b, 0x35, 0x7a, 0x16, 0x57, 0xb7, 0x91,
-		0x74, 0x16, 0x86, 0xed, 0xf6, 0x06, 0x59, 0xa3, 0x34, 0x29, 0x90, 0x00, 0xb6, 0xdb, 0x1b, 0x74,
-		0x7d, 0x8e, 0xf8, 0xeb, 0x93, 0xac, 0x4d, 0xdc, 0xc0, 0x16, 0x26, 0x8b, 0xfa, 0xd8, 0xe0, 0x51,
-		0xdf, 0xe4, 0x1f, 0xcd, 0x93, 0x8d, 0xa7, 0xf2, 0xcc, 0x4f, 0x10, 0x76, 0x94, 0xe8, 0xf6, 0x6e,
-		0x21, 0x4b, 0xbb, 0x22, 0x8f, 0x45, 0xf6, 0x71, 0x7d, 0x49, 0xc4, 0x53, 0xfb, 0xd5, 0x9b, 0x05,
-		0x01, 0xbb, 0xff, 0x8b, 0xaf, 0x17, 0x84, 0xe2, 0x9b, 0x02, 0x8c, 0x2e, 0xbb, 0xcd, 0x0a, 0x6a,
-		0xea, 0xe6, 0x3a, 0x09, 0x08, 0x24, 0x7d, 0x41, 0x80, 0xe1, 0x72, 0x28, 0x1f, 0x5e, 0xb9, 0xb5,
-		0x5b, 0x18, 0x57, 0x3b, 0x19, 0x53, 0xe9, 0xe4, 0xc6, 0x49, 0x96, 0x1b, 0x7b, 0x1b, 0x3f, 0x6c,
-		0x9e, 0x64, 0x3f, 0xfa, 0x18, 0xfb, 0x92, 0x00, 0xe9, 0x65, 0xb7, 0xb9, 0x6e, 0xe2, 0x84, 0x86,
-		0xb3, 0x76, 0xd9, 0xb6, 0xb1, 0xf6, 0xbd, 0xcc, 0xda, 0xac, 0x8b, 0x5e, 0x6b, 0xee, 0x45, 0xe4,
-		0xfe, 0x38, 0x00, 0x00, 0xff, 0xff, 0x18, 0x05, 0x79, 0x57, 0xba, 0x3b, 0x00, 0x00,
+		// 6245 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7c, 0x6f, 0x8c, 0x23, 0xc7,
+		0x75, 0xe7, 0x36, 0xff, 0x0d, 0xf9, 0xc8, 0xe1, 0xf4, 0xd4, 0xcc, 0xee, 0x52, 0xb3, 0xd6, 0xce,
+		0x8a, 0xb2, 0xa4, 0x95, 0x64, 0xcd, 0xca, 0xb3, 0xfa, 0xb3, 0x4b, 0xd9, 0xd6, 0x91, 0x9c, 0xde,
+		0x11, 0x47, 0x33, 0x24, 0xdd, 0xe4, 0xac, 0x76, 0xe5, 0x33, 0x1a, 0x3d, 0x64, 0x0d, 0x87, 0x1a,
+		0xb2, 0x9b, 0xee, 0x6e, 0xee, 0xee, 0xe8, 0x83, 0xa1, 0x3b, 0xfb, 0xee, 0x64, 0xe8, 0x7c, 0x27,
+		0xdd, 0x19, 0x67, 0xd9, 0x67, 0xd9, 0xb2, 0x82, 0x44, 0x89, 0x62, 0x27, 0xb1, 0x93, 0x38, 0x71,
+		0xf2, 0x25, 0xf9, 0x90, 0xc4, 0x40, 0x82, 0xc0, 0xfe, 0x10, 0xc0, 0x08, 0x90, 0x4d, 0x20, 0x19,
+		0x96, 0xbc, 0x56, 0x62, 0x47, 0x91, 0x01, 0x23, 0x0a, 0xa0, 0xa0, 0xfe, 0xf5, 0x1f, 0x92, 0xb3,
+		0xe4, 0xc8, 0x90, 0x21, 0xe4, 0x8b, 0x34, 0xf5, 0xea, 0xfd, 0x7e, 0x55, 0xf5, 0xea, 0xd5, 0xab,
+		0x57, 0xd5, 0xc5, 0x85, 0xd7, 0x35, 0x38, 0xd1, 0x32, 0xcd, 0x56, 0x07, 0x9f, 0xea, 0x59, 0xa6,
+		0x63, 0x6e, 0xf5, 0xb7, 0x4f, 0x35, 0xb1, 0xdd, 0xb0, 0xda, 0x3d, 0xc7, 0xb4, 0x96, 0xa8, 0x0c,
+		0xcd, 0x30, 0x8d, 0x25, 0xa1, 0x91, 0xdd, 0x80, 0xd9, 0x73, 0xed, 0x0e, 0x5e, 0x71, 0x15, 0x6b,
+		0xd8, 0x41, 0x67, 0x20, 0xb2, 0xdd, 0xee, 0xe0, 0x8c, 0x74, 0x22, 0x7c, 0x32, 0xb9, 0xfc, 0xfe,
+		0xa5, 0x01, 0xd0, 0x52, 0x10, 0x51, 0x25, 0x62, 0x95, 0x22, 0xb2, 0xcf, 0x46, 0x61, 0x6e, 0x44,
+		0x2d, 0x42, 0x10, 0x31, 0xf4, 0x2e, 0x61, 0x94, 0x4e, 0x26, 0x54, 0xfa, 0x37, 0xca, 0xc0, 0x54,
+		0x4f, 0x6f, 0xec, 0xea, 0x2d, 0x9c, 0x09, 0x51, 0xb1, 0x28, 0xa2, 0xe3, 0x00, 0x4d, 0xdc, 0xc3,
+		0x46, 0x13, 0x1b, 0x8d, 0xbd, 0x4c, 0xf8, 0x44, 0xf8, 0x64, 0x42, 0xf5, 0x49, 0xd0, 0x9d, 0x30,
+		0xdb, 0xeb, 0x6f, 0x75, 0xda, 0x0d, 0xcd, 0xa7, 0x06, 0x27, 0xc2, 0x27, 0xa3, 0xaa, 0xcc, 0x2a,
+		0x56, 0x3c, 0xe5, 0xdb, 0x60, 0xe6, 0x32, 0xd6, 0x77, 0xfd, 0xaa, 0x49, 0xaa, 0x9a, 0x26, 0x62,
+		0x9f, 0x62, 0x11, 0x52, 0x5d, 0x6c, 0xdb, 0x7a, 0x0b, 0x6b, 0xce, 0x5e, 0x0f, 0x67, 0x22, 0x74,
+		0xf4, 0x27, 0x86, 0x46, 0x3f, 0x38, 0xf2, 0x24, 0x47, 0xd5, 0xf7, 0x7a, 0x18, 0xe5, 0x21, 0x81,
+		0x8d, 0x7e, 0x97, 0x31, 0x44, 0xf7, 0xb1, 0x9f, 0x62, 0xf4, 0xbb, 0x83, 0x2c, 0x71, 0x02, 0xe3,
+		0x14, 0x53, 0x36, 0xb6, 0x2e, 0xb5, 0x1b, 0x38, 0x13, 0xa3, 0x04, 0xb7, 0x0d, 0x11, 0xd4, 0x58,
+		0xfd, 0x20, 0x87, 0xc0, 0xa1, 0x22, 0x24, 0xf0, 0x15, 0x07, 0x1b, 0x76, 0xdb, 0x34, 0x32, 0x53,
+		0x94, 0xe4, 0x96, 0x11, 0xb3, 0x88, 0x3b, 0xcd, 0x41, 0x0a, 0x0f, 0x87, 0xee, 0x83, 0x29, 0xb3,
+		0xe7, 0xb4, 0x4d, 0xc3, 0xce, 0xc4, 0x4f, 0x48, 0x27, 0x93, 0xcb, 0xef, 0x1b, 0xe9, 0x08, 0x15,
+		0xa6, 0xa3, 0x0a, 0x65, 0x54, 0x02, 0xd9, 0x36, 0xfb, 0x56, 0x03, 0x6b, 0x0d, 0xb3, 0x89, 0xb5,
+		0xb6, 0xb1, 0x6d, 0x66, 0x12, 0x94, 0x60, 0x71, 0x78, 0x20, 0x54, 0xb1, 0x68, 0x36, 0x71, 0xc9,
+		0xd8, 0x36, 0xd5, 0xb4, 0x1d, 0x28, 0xa3, 0x23, 0x10, 0xb3, 0xf7, 0x0c, 0x47, 0xbf, 0x92, 0x49,
+		0x51, 0x0f, 0xe1, 0x25, 0xb4, 0x0c, 0x53, 0xb8, 0xd9, 0x26, 0xcd, 0x65, 0xd2, 0x27, 0xa4, 0x93,
+		0xe9, 0xe5, 0xcc, 0xb0, 0x8d, 0x59, 0xbd, 0x2a, 0x14, 0xb3, 0xdf, 0x8e, 0xc1, 0xcc, 0x24, 0x6e,
+		0xf9, 0x00, 0x44, 0xb7, 0x89, 0x65, 0x32, 0xa1, 0x83, 0xd8, 0x8d, 0x61, 0x82, 0x86, 0x8f, 0xbd,
+		0x43, 0xc3, 0xe7, 0x21, 0x69, 0x60, 0xdb, 0xc1, 0x4d, 0xe6, 0x45, 0xe1, 0x09, 0xfd, 0x10, 0x18,
+		0x68, 0xd8, 0x0d, 0x23, 0xef, 0xc8, 0x0d, 0x2f, 0xc0, 0x8c, 0xdb, 0x25, 0xcd, 0xd2, 0x8d, 0x96,
+		0xf0, 0xe7, 0x53, 0xe3, 0x7a, 0xb2, 0xa4, 0x08, 0x9c, 0x4a, 0x60, 0x6a, 0x1a, 0x07, 0xca, 0x68,
+		0x05, 0xc0, 0x34, 0xb0, 0xb9, 0xad, 0x35, 0x71, 0xa3, 0x93, 0x89, 0xef, 0x63, 0xa5, 0x0a, 0x51,
+		0x19, 0xb2, 0x92, 0xc9, 0xa4, 0x8d, 0x0e, 0x3a, 0xeb, 0xb9, 0xe7, 0xd4, 0x3e, 0xde, 0xb5, 0xc1,
+		0x16, 0xe6, 0x90, 0x87, 0x6e, 0x42, 0xda, 0xc2, 0x64, 0xad, 0xe0, 0x26, 0x1f, 0x59, 0x82, 0x76,
+		0x62, 0x69, 0xec, 0xc8, 0x54, 0x0e, 0x63, 0x03, 0x9b, 0xb6, 0xfc, 0x45, 0x74, 0x33, 0xb8, 0x02,
+		0x8d, 0xba, 0x15, 0xd0, 0xc8, 0x95, 0x12, 0xc2, 0xb2, 0xde, 0xc5, 0x0b, 0x8f, 0x43, 0x3a, 0x68,
+		0x1e, 0x34, 0x0f, 0x51, 0xdb, 0xd1, 0x2d, 0x87, 0x7a, 0x61, 0x54, 0x65, 0x05, 0x24, 0x43, 0x18,
+		0x1b, 0x4d, 0x1a, 0x19, 0xa3, 0x2a, 0xf9, 0x13, 0xfd, 0x27, 0x6f, 0xc0, 0x61, 0x3a, 0xe0, 0x5b,
+		0x87, 0x67, 0x34, 0xc0, 0x3c, 0x38, 0xee, 0x85, 0xfb, 0x61, 0x3a, 0x30, 0x80, 0x49, 0x9b, 0xce,
+		0xfe, 0x55, 0x04, 0x0e, 0x8f, 0xe4, 0x46, 0x17, 0x60, 0xbe, 0x6f, 0xb4, 0x0d, 0x07, 0x5b, 0x3d,
+		0x0b, 0x13, 0x97, 0x65, 0x6d, 0x65, 0x5e, 0x9d, 0xda, 0xc7, 0xe9, 0x36, 0xfd, 0xda, 0x8c, 0x45,
+		0x9d, 0xeb, 0x0f, 0x0b, 0xd1, 0x45, 0x48, 0x12, 0xff, 0xd0, 0x2d, 0x9d, 0x12, 0xb2, 0xd5, 0xb8,
+		0x3c, 0xd9, 0x90, 0x97, 0x56, 0x3c, 0x64, 0x21, 0xfc, 0xa4, 0x14, 0x52, 0xfd, 0x5c, 0xe8, 0x7e,
+		0x88, 0x6f, 0x63, 0xdd, 0xe9, 0x5b, 0xd8, 0xce, 0x2c, 0x53, 0x53, 0x1e, 0x1b, 0x5e, 0xa4, 0x4c,
+		0xa1, 0x86, 0x1d, 0xd5, 0x55, 0x46, 0x5d, 0x48, 0x5d, 0xc2, 0x56, 0x7b, 0xbb, 0xdd, 0x60, 0x9d,
+		0x0a, 0xd3, 0xe0, 0x73, 0x66, 0xc2, 0x4e, 0x9d, 0xf7, 0x41, 0x6b, 0x8e, 0xee, 0xe0, 0x1c, 0x6c,
+		0x96, 0xcf, 0x2b, 0x6a, 0xe9, 0x5c, 0x49, 0x59, 0x61, 0xdd, 0x0c, 0xd0, 0x2f, 0x7c, 0x4e, 0x82,
+		0xa4, 0x6f, 0x24, 0x24, 0x1c, 0x1a, 0xfd, 0xee, 0x16, 0xb6, 0xf8, 0x7c, 0xf1, 0x12, 0x3a, 0x06,
+		0x89, 0xed, 0x7e, 0xa7, 0xc3, 0x9c, 0x8e, 0xed, 0xa5, 0x71, 0x22, 0x20, 0x0e, 0x47, 0x62, 0x1c,
+		0x0f, 0x23, 0x34, 0xc6, 0x91, 0xbf, 0xd1, 0x02, 0xc4, 0x85, 0x53, 0x66, 0xa2, 0x27, 0xa4, 0x93,
+		0x71, 0xd5, 0x2d, 0xb3, 0xba, 0x1e, 0xd6, 0x1d, 0xdc, 0xcc, 0xc4, 0x44, 0x1d, 0x2b, 0xaf, 0x45,
+		0xe2, 0x11, 0x39, 0x9a, 0xbd, 0x07, 0x66, 0x87, 0x86, 0x82, 0x66, 0x20, 0xb9, 0xa2, 0x14, 0xd7,
+		0xf3, 0x6a, 0xbe, 0x5e, 0xaa, 0x94, 0xe5, 0x43, 0x28, 0x0d, 0xbe, 0xd1, 0xc9, 0xd2, 0x1d, 0x89,
+		0xf8, 0x6b, 0x53, 0xf2, 0x13, 0x4f, 0x3c, 0xf1, 0x44, 0x28, 0xfb, 0xa7, 0x31, 0x98, 0x1f, 0x15,
+		0x04, 0x47, 0xc6, 0x63, 0x6f, 0xd0, 0xe1, 0xc0, 0xa0, 0xf3, 0x10, 0xed, 0xe8, 0x5b, 0xb8, 0x93,
+		0x89, 0xd0, 0x49, 0xb8, 0x73, 0xa2, 0x30, 0xbb, 0xb4, 0x4e, 0x20, 0x2a, 0x43, 0xa2, 0x8f, 0x70,
+		0xd3, 0x44, 0x29, 0xc3, 0x1d, 0x93, 0x31, 0x90, 0xe0, 0xc8, 0xcd, 0x78, 0x0c, 0x12, 0xe4, 0xff,
+		0xcc, 0xee, 0x31, 0x66, 0x77, 0x22, 0xa0, 0x76, 0x5f, 0x80, 0x38, 0x8d, 0x7b, 0x4d, 0xec, 0xce,
+		0x89, 0x28, 0x93, 0x48, 0xd1, 0xc4, 0xdb, 0x7a, 0xbf, 0xe3, 0x68, 0x97, 0xf4, 0x4e, 0x1f, 0xd3,
+		0x08, 0x96, 0x50, 0x53, 0x5c, 0x78, 0x9e, 0xc8, 0xd0, 0x22, 0x24, 0x59, 0x98, 0x6c, 0x1b, 0x4d,
+		0x7c, 0x85, 0x6e, 0xa1, 0x51, 0x95, 0x45, 0xce, 0x12, 0x91, 0x90, 0xe6, 0x1f, 0xb3, 0x4d, 0x43,
+		0xc4, 0x1a, 0xda, 0x04, 0x11, 0xd0, 0xe6, 0xef, 0x1f, 0xdc, 0xbd, 0x6f, 0x1c, 0x3d, 0xbc, 0xa1,
+		0xe0, 0x78, 0x1b, 0xcc, 0x50, 0x8d, 0xd3, 0x7c, 0x29, 0xeb, 0x9d, 0xcc, 0x2c, 0x75, 0x83, 0x34,
+		0x13, 0x57, 0xb8, 0x34, 0xfb, 0xad, 0x10, 0x44, 0xe8, 0x4e, 0x31, 0x03, 0xc9, 0xfa, 0xc5, 0xaa,
+		0xa2, 0xad, 0x54, 0x36, 0x0b, 0xeb, 0x8a, 0x2c, 0x91, 0xa9, 0xa7, 0x82, 0x73, 0xeb, 0x95, 0x7c,
+		0x5d, 0x0e, 0xb9, 0xe5, 0x52, 0xb9, 0x7e, 0xdf, 0x3d, 0x72, 0xd8, 0x05, 0x6c, 0x32, 0x41, 0xc4,
+		0xaf, 0x70, 0x7a, 0x59, 0x8e, 0x22, 0x19, 0x52, 0x8c, 0xa0, 0x74, 0x41, 0x59, 0xb9, 0xef, 0x1e,
+		0x39, 0x16, 0x94, 0x9c, 0x5e, 0x96, 0xa7, 0xd0, 0x34, 0x24, 0xa8, 0xa4, 0x50, 0xa9, 0xac, 0xcb,
+		0x71, 0x97, 0xb3, 0x56, 0x57, 0x4b, 0xe5, 0x55, 0x39, 0xe1, 0x72, 0xae, 0xaa, 0x95, 0xcd, 0xaa,
+		0x0c, 0x2e, 0xc3, 0x86, 0x52, 0xab, 0xe5, 0x57, 0x15, 0x39, 0xe9, 0x6a, 0x14, 0x2e, 0xd6, 0x95,
+		0x9a, 0x9c, 0x0a, 0x74, 0xeb, 0xf4, 0xb2, 0x3c, 0xed, 0x36, 0xa1, 0x94, 0x37, 0x37, 0xe4, 0x34,
+		0x9a, 0x85, 0x69, 0xd6, 0x84, 0xe8, 0xc4, 0xcc, 0x80, 0xe8, 0xbe, 0x7b, 0x64, 0xd9, 0xeb, 0x08,
+		0x63, 0x99, 0x0d, 0x08, 0xee, 0xbb, 0x47, 0x46, 0xd9, 0x22, 0x44, 0xa9, 0x1b, 0x22, 0x04, 0xe9,
+		0xf5, 0x7c, 0x41, 0x59, 0xd7, 0x2a, 0x55, 0xb2, 0x68, 0xf2, 0xeb, 0xb2, 0xe4, 0xc9, 0x54, 0xa5,
+		0xaa, 0xe4, 0xeb, 0xca, 0x8a, 0x1c, 0xf6, 0xcb, 0x3e, 0xba, 0x59, 0x52, 0x95, 0x15, 0x39, 0x94,
+		0x6d, 0xc0, 0xfc, 0xa8, 0x1d, 0x72, 0xe4, 0x12, 0xf2, 0xf9, 0x42, 0x68, 0x1f, 0x5f, 0xa0, 0x5c,
+		0x83, 0xbe, 0x90, 0x7d, 0x25, 0x04, 0x73, 0x23, 0xb2, 0x84, 0x91, 0x8d, 0x3c, 0x08, 0x51, 0xe6,
+		0xcb, 0x2c, 0x52, 0xdf, 0x3e, 0x32, 0xdd, 0xa0, 0x9e, 0x3d, 0x94, 0x3b, 0x51, 0x9c, 0x3f, 0xdf,
+		0x0c, 0xef, 0x93, 0x6f, 0x12, 0x8a, 0x21, 0x87, 0xfd, 0xf8, 0xd0, 0x6e, 0xce, 0x12, 0x9e, 0xfb,
+		0x26, 0x49, 0x78, 0xa8, 0xec, 0x60, 0xbb, 0x7a, 0x74, 0xc4, 0xae, 0xfe, 0x00, 0xcc, 0x0e, 0x11,
+		0x4d, 0xbc, 0xbb, 0x7e, 0x4a, 0x82, 0xcc, 0x7e, 0xc6, 0x19, 0x13, 0x12, 0x43, 0x81, 0x90, 0xf8,
+		0xc0, 0xa0, 0x05, 0x6f, 0xda, 0x7f, 0x12, 0x86, 0xe6, 0xfa, 0x45, 0x09, 0x8e, 0x8c, 0x3e, 0x57,
+		0x8c, 0xec, 0xc3, 0x47, 0x20, 0xd6, 0xc5, 0xce, 0x8e, 0x29, 0xf2, 0xe4, 0x5b, 0x47, 0x64, 0x5f,
+		0xa4, 0x7a, 0x70, 0xb2, 0x39, 0x0a, 0x9d, 0x1d, 0xec, 0xeb, 0xe2, 0x7e, 0xa7, 0x9c, 0xa1, 0x9e,
+		0x7e, 0x26, 0x04, 0x87, 0x47, 0x92, 0x8f, 0xec, 0xe8, 0x8d, 0x00, 0x6d, 0xa3, 0xd7, 0x77, 0x58,
+		0x2e, 0xcc, 0x22, 0x71, 0x82, 0x4a, 0x68, 0xf0, 0x22, 0x51, 0xb6, 0xef, 0xb8, 0xf5, 0x6c, 0x97,
+		0x04, 0x26, 0xa2, 0x0a, 0x67, 0xbc, 0x8e, 0x46, 0x68, 0x47, 0x8f, 0xef, 0x33, 0xd2, 0x21, 0xc7,
+		0xbc, 0x1b, 0xe4, 0x46, 0xa7, 0x8d, 0x0d, 0x47, 0xb3, 0x1d, 0x0b, 0xeb, 0xdd, 0xb6, 0xd1, 0x62,
+		0xbb, 0x6d, 0x2e, 0xba, 0xad, 0x77, 0x6c, 0xac, 0xce, 0xb0, 0xea, 0x9a, 0xa8, 0x25, 0x08, 0xea,
+		0x40, 0x96, 0x0f, 0x11, 0x0b, 0x20, 0x58, 0xb5, 0x8b, 0xc8, 0x7e, 0x3d, 0x01, 0x49, 0xdf, 0x29,
+		0x0c, 0xdd, 0x04, 0xa9, 0xc7, 0xf4, 0x4b, 0xba, 0x26, 0x4e, 0xd6, 0xcc, 0x12, 0x49, 0x22, 0xab,
+		0x32, 0x11, 0xba, 0x1b, 0xe6, 0xa9, 0x8a, 0xd9, 0x77, 0xb0, 0xa5, 0x35, 0x3a, 0xba, 0x6d, 0x53,
+		0xa3, 0xc5, 0xa9, 0x2a, 0x22, 0x75, 0x15, 0x52, 0x55, 0x14, 0x35, 0xe8, 0x5e, 0x98, 0xa3, 0x88,
+		0x6e, 0xbf, 0xe3, 0xb4, 0x7b, 0x1d, 0xac, 0x91, 0xb3, 0xbe, 0x9d, 0x01, 0x7f, 0xcf, 0x66, 0x89,
+		0xc6, 0x06, 0x57, 0x20, 0x3d, 0xb2, 0xd1, 0x0a, 0xdc, 0x48, 0x61, 0x2d, 0x6c, 0x60, 0x4b, 0x77,
+		0xb0, 0x86, 0x3f, 0xd1, 0xd7, 0x3b, 0xb6, 0xa6, 0x1b, 0x4d, 0x6d, 0x47, 0xb7, 0x77, 0x32, 0xf3,
+		0x84, 0xa0, 0x10, 0xca, 0x48, 0xea, 0x0d, 0x44, 0x71, 0x95, 0xeb, 0x29, 0x54, 0x2d, 0x6f, 0x34,
+		0x1f, 0xd2, 0xed, 0x1d, 0x94, 0x83, 0x23, 0x94, 0xc5, 0x76, 0xac, 0xb6, 0xd1, 0xd2, 0x1a, 0x3b,
+		0xb8, 0xb1, 0xab, 0xf5, 0x9d, 0xed, 0x33, 0x99, 0x63, 0xfe, 0xf6, 0x69, 0x0f, 0x6b, 0x54, 0xa7,
+		0x48, 0x54, 0x36, 0x9d, 0xed, 0x33, 0xa8, 0x06, 0x29, 0x32, 0x19, 0xdd, 0xf6, 0xe3, 0x58, 0xdb,
+		0x36, 0x2d, 0xba, 0x87, 0xa6, 0x47, 0x84, 0x26, 0x9f, 0x05, 0x97, 0x2a, 0x1c, 0xb0, 0x61, 0x36,
+		0x71, 0x2e, 0x5a, 0xab, 0x2a, 0xca, 0x8a, 0x9a, 0x14, 0x2c, 0xe7, 0x4c, 0x8b, 0x38, 0x54, 0xcb,
+		0x74, 0x0d, 0x9c, 0x64, 0x0e, 0xd5, 0x32, 0x85, 0x79, 0xef, 0x85, 0xb9, 0x46, 0x83, 0x8d, 0xb9,
+		0xdd, 0xd0, 0xf8, 0x89, 0xdc, 0xce, 0xc8, 0x01, 0x63, 0x35, 0x1a, 0xab, 0x4c, 0x81, 0xfb, 0xb8,
+		0x8d, 0xce, 0xc2, 0x61, 0xcf, 0x58, 0x7e, 0xe0, 0xec, 0xd0, 0x28, 0x07, 0xa1, 0xf7, 0xc2, 0x5c,
+		0x6f, 0x6f, 0x18, 0x88, 0x02, 0x2d, 0xf6, 0xf6, 0x06, 0x61, 0xb7, 0xd0, 0x5b, 0x16, 0x0b, 0x37,
+		0x68, 0xaa, 0x77, 0xd4, 0xaf, 0xed, 0xab, 0x40, 0x4b, 0x20, 0x37, 0x1a, 0x1a, 0x36, 0xf4, 0xad,
+		0x0e, 0xd6, 0x74, 0x0b, 0x1b, 0xba, 0x9d, 0x59, 0xa4, 0xca, 0x11, 0xc7, 0xea, 0x63, 0x35, 0xdd,
+		0x68, 0x28, 0xb4, 0x32, 0x4f, 0xeb, 0xd0, 0x1d, 0x30, 0x6b, 0x6e, 0x3d, 0xd6, 0x60, 0x8e, 0xa5,
+		0xf5, 0x2c, 0xbc, 0xdd, 0xbe, 0x92, 0x79, 0x3f, 0xb5, 0xd2, 0x0c, 0xa9, 0xa0, 0x6e, 0x55, 0xa5,
+		0x62, 0x74, 0x3b, 0xc8, 0x0d, 0x7b, 0x47, 0xb7, 0x7a, 0x34, 0xb2, 0xda, 0x3d, 0xbd, 0x81, 0x33,
+		0xb7, 0x30, 0x55, 0x26, 0x2f, 0x0b, 0x31, 0x71, 0x6c, 0xfb, 0x72, 0x7b, 0xdb, 0x11, 0x8c, 0xb7,
+		0x31, 0xc7, 0xa6, 0x32, 0xce, 0x76, 0x12, 0xe4, 0xde, 0x4e, 0x2f, 0xd8, 0xf0, 0x49, 0xaa, 0x96,
+		0xee, 0xed, 0xf4, 0xfc, 0xed, 0xde, 0x0c, 0xd3, 0xbd, 0x1d, 0x7f, 0xa3, 0xb7, 0xb3, 0xfc, 0xab,
+		0xb7, 0xe3, 0x6b, 0xf1, 0x1e, 0x38, 0x42, 0x94, 0xba, 0xd8, 0xd1, 0x9b, 0xba, 0xa3, 0xfb, 0xb4,
+		0x3f, 0x40, 0xb5, 0xe7, 0x7b, 0x3b, 0xbd, 0x0d, 0x5e, 0x19, 0xe8, 0xa7, 0xd5, 0xdf, 0xda, 0x73,
+		0xfd, 0xe3, 0x2e, 0xd6, 0x4f, 0x22, 0x13, 0x1e, 0xf2, 0x8e, 0x8f, 0x1f, 0xef, 0xda, 0x61, 0x2b,
+		0x9b, 0x83, 0x94, 0xdf, 0xef, 0x51, 0x02, 0x98, 0xe7, 0xcb, 0x12, 0x49, 0x82, 0x8a, 0x95, 0x15,
+		0x92, 0xbe, 0x3c, 0xaa, 0xc8, 0x21, 0x92, 0x46, 0xad, 0x97, 0xea, 0x8a, 0xa6, 0x6e, 0x96, 0xeb,
+		0xa5, 0x0d, 0x45, 0x0e, 0xfb, 0x12, 0xfb, 0xb5, 0x48, 0xfc, 0x0e, 0xf9, 0xce, 0xb5, 0x48, 0xfc,
+		0x56, 0xf9, 0x36, 0x6a, 0x9e, 0x21, 0xa7, 0xcc, 0xbe, 0x19, 0x86, 0x74, 0xf0, 0x58, 0x8e, 0x3e,
+		0x04, 0x47, 0xc5, 0xbd, 0x9b, 0x8d, 0x1d, 0xed, 0x72, 0xdb, 0xa2, 0x8b, 0xb5, 0xab, 0xb3, 0x8d,
+		0xd3, 0x75, 0xca, 0x79, 0xae, 0x55, 0xc3, 0xce, 0x23, 0x6d, 0x8b, 0x2c, 0xc5, 0xae, 0xee, 0xa0,
+		0x75, 0x58, 0x34, 0x4c, 0xcd, 0x76, 0x74, 0xa3, 0xa9, 0x5b, 0x4d, 0xcd, 0xbb, 0xf1, 0xd4, 0xf4,
+		0x46, 0x03, 0xdb, 0xb6, 0xc9, 0x36, 0x49, 0x97, 0xe5, 0x7d, 0x86, 0x59, 0xe3, 0xca, 0xde, 0xee,
+		0x91, 0xe7, 0xaa, 0x03, 0x6b, 0x22, 0xbc, 0xdf, 0x9a, 0x38, 0x06, 0x89, 0xae, 0xde, 0xd3, 0xb0,
+		0xe1, 0x58, 0x7b, 0x34, 0x77, 0x8f, 0xab, 0xf1, 0xae, 0xde, 0x53, 0x48, 0x19, 0x9d, 0x87, 0x5b,
+		0x3d, 0x55, 0xad, 0x83, 0x5b, 0x7a, 0x63, 0x4f, 0xa3, 0x89, 0x3a, 0xbd, 0x23, 0xd2, 0x1a, 0xa6,
+		0xb1, 0xdd, 0x69, 0x37, 0x1c, 0x3b, 0x93, 0x74, 0xe3, 0x5f, 0xd6, 0x43, 0xac, 0x53, 0xc0, 0x9a,
+		0x6d, 0x1a, 0x34, 0x3f, 0x2f, 0x0a, 0xed, 0x80, 0xdb, 0xa4, 0xde, 0x13, 0x6e, 0x13, 0x9c, 0xfa,
+		0x88, 0x1c, 0x5d, 0x8b, 0xc4, 0xa3, 0x72, 0x6c, 0x2d, 0x12, 0x8f, 0xc9, 0x53, 0x6b, 0x91, 0x78,
+		0x5c, 0x4e, 0xac, 0x45, 0xe2, 0x09, 0x19, 0xb2, 0xcf, 0x4d, 0x43, 0xca, 0x7f, 0xdc, 0x20, 0xa7,
+		0xb7, 0x06, 0xdd, 0x70, 0x25, 0x1a, 0x92, 0x6f, 0xbe, 0xee, 0xe1, 0x64, 0xa9, 0x48, 0x76, 0xe2,
+		0x5c, 0x8c, 0xe5, 0xf6, 0x2a, 0x43, 0x92, 0x2c, 0x88, 0x2c, 0x32, 0xcc, 0x72, 0xa9, 0xb8, 0xca,
+		0x4b, 0x68, 0x15, 0x62, 0x8f, 0xd9, 0x94, 0x3b, 0x46, 0xb9, 0xdf, 0x7f, 0x7d, 0xee, 0xb5, 0x1a,
+		0x25, 0x4f, 0xac, 0xd5, 0xb4, 0x72, 0x45, 0xdd, 0xc8, 0xaf, 0xab, 0x1c, 0x8e, 0x6e, 0x80, 0x48,
+		0x47, 0x7f, 0x7c, 0x2f, 0xb8, 0x67, 0x53, 0x11, 0x5a, 0x82, 0x99, 0xbe, 0xc1, 0xce, 0xea, 0x64,
+		0x8e, 0x89, 0xd6, 0x8c, 0x5f, 0x2b, 0xed, 0xd5, 0xae, 0x13, 0xfd, 0x09, 0xfd, 0xea, 0x06, 0x88,
+		0x90, 0x4b, 0xe9, 0xe0, 0xce, 0x4a, 0x45, 0xe8, 0x24, 0xa4, 0x9a, 0x78, 0xab, 0xdf, 0xd2, 0x2c,
+		0xdc, 0xd4, 0x1b, 0x4e, 0x70, 0x3f, 0x49, 0xd2, 0x2a, 0x95, 0xd6, 0xa0, 0x87, 0x21, 0x41, 0xe6,
+		0xc8, 0xa0, 0x73, 0x3c, 0x4b, 0x4d, 0x70, 0xd7, 0xf5, 0x4d, 0xc0, 0xa7, 0x58, 0x80, 0x54, 0x0f,
+		0x8f, 0x1e, 0x82, 0x29, 0x47, 0xb7, 0x5a, 0xd8, 0xb1, 0x33, 0x73, 0x27, 0xc2, 0x27, 0xd3, 0xcb,
+		0x4b, 0x93, 0x50, 0xd5, 0x29, 0x84, 0x9e, 0x94, 0x05, 0x1c, 0x3d, 0x02, 0x32, 0xbf, 0x8a, 0xd5,
+		0xf8, 0x31, 0xd7, 0xce, 0xcc, 0x53, 0x07, 0xfc, 0xc0, 0xf5, 0x29, 0xf9, 0x4d, 0xee, 0x0a, 0x03,
+		0xa9, 0x33, 0x38, 0x50, 0x0e, 0xae, 0x8b, 0xc3, 0x07, 0x59, 0x17, 0x9b, 0x30, 0xc3, 0xff, 0xd6,
+		0xec, 0x7e, 0xaf, 0x67, 0x5a, 0x4e, 0xe6, 0xc8, 0x09, 0x69, 0x7c, 0x87, 0x04, 0x19, 0xc3, 0xa8,
+		0xe9, 0xed, 0x40, 0xf9, 0xdd, 0x5b, 0x6e, 0x0b, 0x8f, 0x42, 0x3a, 0x68, 0x0c, 0xff, 0x45, 0x78,
+		0x78, 0xc2, 0x8b, 0x70, 0x72, 0x2c, 0x11, 0x07, 0x35, 0xb2, 0x35, 0xb1, 0xc2, 0xc2, 0xff, 0x0b,
+		0x41, 0x3a, 0x38, 0x30, 0xb4, 0x0a, 0x48, 0xcc, 0x58, 0xdb, 0x70, 0x2c, 0xb3, 0xd9, 0x6f, 0xe0,
+		0x66, 0x46, 0x1a, 0xd3, 0xce, 0x2c, 0xc7, 0x94, 0x5c, 0x88, 0x9f, 0xc8, 0xb7, 0x0a, 0x42, 0x13,
+		0x12, 0xad, 0x78, 0xeb, 0xe3, 0x14, 0xcc, 0x09, 0x02, 0x42, 0x76, 0x59, 0xb7, 0x0c, 0x92, 0x22,
+		0xb3, 0xa4, 0x1d, 0xf9, 0xaa, 0x1e, 0x61, 0x35, 0x28, 0x0f, 0xc2, 0x5d, 0x34, 0x0b, 0x77, 0x4d,
+		0x72, 0xdf, 0x15, 0x19, 0xd3, 0x6c, 0x9a, 0x03, 0x54, 0xa6, 0x9f, 0x3d, 0x05, 0x51, 0x1a, 0x7e,
+		0x10, 0x00, 0x0f, 0x40, 0xf2, 0x21, 0x14, 0x87, 0x48, 0xb1, 0xa2, 0x92, 0xed, 0x51, 0x86, 0x14,
+		0x93, 0x6a, 0xd5, 0x92, 0x52, 0x54, 0xe4, 0x50, 0xf6, 0x5e, 0x88, 0xb1, 0x98, 0x42, 0xb6, 0x4e,
+		0x37, 0xaa, 0xc8, 0x87, 0x78, 0x91, 0x73, 0x48, 0xa2, 0x76, 0x73, 0xa3, 0xa0, 0xa8, 0x72, 0x28,
+		0xbb, 0x09, 0x33, 0x03, 0xeb, 0x10, 0x1d, 0x86, 0x59, 0x55, 0xa9, 0x2b, 0x65, 0x72, 0x39, 0xa0,
+		0x6d, 0x96, 0x1f, 0x2e, 0x57, 0x1e, 0x21, 0x37, 0x6b, 0x01, 0xb1, 0xd8, 0x87, 0x25, 0x34, 0x0f,
+		0xb2, 0x27, 0xae, 0x55, 0x36, 0x55, 0xda, 0x9b, 0xff, 0x19, 0x02, 0x79, 0x70, 0x51, 0xa2, 0xa3,
+		0x30, 0x57, 0xcf, 0xab, 0xab, 0x4a, 0x5d, 0x63, 0x17, 0x1e, 0x2e, 0xf5, 0x3c, 0xc8, 0xfe, 0x8a,
+		0x73, 0x25, 0x7a, 0x9f, 0xb3, 0x08, 0xc7, 0xfc, 0x52, 0xe5, 0x42, 0x5d, 0x29, 0xd7, 0x68, 0xe3,
+		0xf9, 0xf2, 0x2a, 0x49, 0x0a, 0x06, 0xf8, 0xc4, 0x15, 0x4b, 0x98, 0x74, 0x35, 0xc8, 0xa7, 0xac,
+		0xaf, 0xc8, 0x91, 0x41, 0x71, 0xa5, 0xac, 0x54, 0xce, 0xc9, 0xd1, 0xc1, 0xd6, 0xe9, 0xb5, 0x4b,
+		0x0c, 0x2d, 0xc0, 0x91, 0x41, 0xa9, 0xa6, 0x94, 0xeb, 0xea, 0x45, 0x79, 0x6a, 0xb0, 0xe1, 0x9a,
+		0xa2, 0x9e, 0x2f, 0x15, 0x15, 0x39, 0x8e, 0x8e, 0x00, 0x0a, 0xf6, 0xa8, 0xfe, 0x50, 0x65, 0x45,
+		0x4e, 0x8c, 0xda, 0xb1, 0x90, 0x3c, 0x97, 0xfd, 0x9a, 0x04, 0x29, 0xff, 0x15, 0x48, 0x20, 0xa8,
+		0x48, 0xef, 0xb5, 0xcd, 0x36, 0xfb, 0xbd, 0x10, 0x24, 0x7d, 0x77, 0x21, 0xe4, 0x10, 0xab, 0x77,
+		0x3a, 0xe6, 0x65, 0x4d, 0xef, 0xb4, 0x75, 0x9b, 0xef, 0x87, 0x40, 0x45, 0x79, 0x22, 0x99, 0x74,
+		0xff, 0x99, 0x3c, 0x75, 0x89, 0xbd, 0xe3, 0xd4, 0x65, 0xea, 0x3d, 0x98, 0xba, 0x44, 0xe5, 0x58,
+		0xf6, 0xfb, 0x21, 0x90, 0x07, 0x6f, 0x47, 0x06, 0xec, 0x26, 0xed, 0x67, 0x37, 0xff, 0xf8, 0x42,
+		0x07, 0x19, 0xdf, 0xe0, 0xae, 0x1e, 0xde, 0x77, 0x57, 0x1f, 0xb1, 0x59, 0x45, 0xde, 0xcb, 0x9b,
+		0x95, 0xdf, 0x5d, 0xff, 0x46, 0x82, 0x74, 0xf0, 0x32, 0x27, 0x60, 0xb1, 0xec, 0x41, 0x2c, 0x16,
+		0x9c, 0x91, 0x9b, 0xf6, 0x9b, 0x91, 0x5f, 0xca, 0xb8, 0xbe, 0x10, 0x86, 0xe9, 0xc0, 0xdd, 0xcf,
+		0xa4, 0xbd, 0xfb, 0x04, 0xcc, 0xb6, 0x9b, 0xb8, 0xdb, 0x33, 0x1d, 0xf2, 0xf2, 0x40, 0xeb, 0xe0,
+		0x4b, 0xb8, 0x43, 0xcd, 0x90, 0x1e, 0xf1, 0x75, 0x35, 0xd0, 0xc2, 0x52, 0xc9, 0xc3, 0xad, 0x13,
+		0x58, 0x6e, 0xae, 0xb4, 0xa2, 0x6c, 0x54, 0x2b, 0x75, 0xa5, 0x5c, 0xbc, 0x28, 0x22, 0xb9, 0x2a,
+		0xb7, 0x07, 0xd4, 0x02, 0x06, 0xbf, 0xf9, 0xbd, 0x71, 0xe8, 0xac, 0x82, 0x3c, 0x38, 0x1a, 0x12,
+		0xd0, 0x47, 0x8c, 0x47, 0x3e, 0x84, 0xe6, 0x60, 0xa6, 0x5c, 0xd1, 0x6a, 0xa5, 0x15, 0x45, 0x53,
+		0xce, 0x9d, 0x53, 0x8a, 0xf5, 0x1a, 0xfb, 0xd0, 0xe0, 0x6a, 0xd7, 0xe5, 0x90, 0x7f, 0x6e, 0xbe,
+		0x18, 0x86, 0xb9, 0x11, 0x3d, 0x41, 0x79, 0x7e, 0x45, 0xc8, 0x6e, 0x2d, 0xef, 0x9a, 0xa4, 0xf7,
+		0x4b, 0xe4, 0x74, 0x5f, 0xd5, 0x2d, 0x87, 0xdf, 0x28, 0xde, 0x0e, 0xc4, 0xbc, 0x86, 0x43, 0xd2,
+		0x7b, 0x8b, 0x7f, 0xc0, 0x61, 0x29, 0xc8, 0x8c, 0x27, 0x67, 0xdf, 0x70, 0x3e, 0x00, 0xa8, 0x67,
+		0xda, 0x6d, 0xa7, 0x7d, 0x89, 0x3c, 0x84, 0x10, 0x5f, 0x7b, 0xc8, 0xc2, 0x8d, 0xa8, 0xb2, 0xa8,
+		0x29, 0x19, 0x8e, 0xab, 0x6d, 0xe0, 0x96, 0x3e, 0xa0, 0x4d, 0x8e, 0x1f, 0x61, 0x55, 0x16, 0x35,
+		0xae, 0xf6, 0x4d, 0x90, 0x6a, 0x9a, 0x7d, 0x72, 0x2b, 0xc3, 0xf4, 0x48, 0x48, 0x96, 0xd4, 0x24,
+		0x93, 0xb9, 0x2a, 0xfc, 0xda, 0xcc, 0xfb, 0xcc, 0x94, 0x52, 0x93, 0x4c, 0xc6, 0x54, 0x6e, 0x83,
+		0x19, 0xbd, 0xd5, 0xb2, 0x08, 0xb9, 0x20, 0x62, 0x17, 0x81, 0x69, 0x57, 0x4c, 0x15, 0x17, 0xd6,
+		0x20, 0x2e, 0xec, 0x40, 0xce, 0xbf, 0xc4, 0x12, 0x5a, 0x8f, 0xdd, 0x6e, 0x87, 0xc8, 0x97, 0x27,
+		0x43, 0x54, 0xde, 0x04, 0xa9, 0xb6, 0xad, 0x79, 0xcf, 0x20, 0x42, 0x27, 0x42, 0x27, 0xe3, 0x6a,
+		0xb2, 0x6d, 0xbb, 0x5f, 0x45, 0xb3, 0x2f, 0x02, 0x80, 0xe7, 0x6c, 0xe8, 0x19, 0x09, 0xd2, 0x6c,
+		0x83, 0xe9, 0x59, 0xd8, 0xc6, 0x46, 0x43, 0x1c, 0x0b, 0x6f, 0xbf, 0x8e, 0x8b, 0xb2, 0x30, 0x57,
+		0xe5, 0x80, 0xc2, 0x83, 0x4f, 0x4a, 0xd2, 0xb3, 0x52, 0xe4, 0x59, 0x49, 0x7a, 0x41, 0x9a, 0x46,
+		0x71, 0xe5, 0x42, 0x75, 0xbd, 0x54, 0x2c, 0xd5, 0x33, 0x3f, 0x9c, 0xa2, 0xe5, 0xd2, 0x06, 0x2f,
+		0xbf, 0x3a, 0x15, 0xac, 0x7f, 0x6d, 0xea, 0x9b, 0x52, 0x38, 0xfe, 0xda, 0x94, 0x3a, 0xbd, 0xed,
+		0xe7, 0x43, 0x1d, 0xff, 0x0b, 0x8a, 0xd0, 0x7e, 0x07, 0x49, 0xaf, 0x37, 0x0a, 0x7f, 0x37, 0x51,
+		0xb8, 0x9d, 0x76, 0x24, 0x46, 0x3b, 0x92, 0x44, 0xb1, 0xe2, 0x7a, 0xa5, 0xa6, 0xac, 0xd0, 0x6e,
+		0x24, 0x50, 0xa4, 0x52, 0x55, 0xca, 0x99, 0x57, 0x45, 0x93, 0xde, 0x63, 0x8b, 0x67, 0x25, 0x38,
+		0x2a, 0xbe, 0xb2, 0xf2, 0xbd, 0x16, 0x1b, 0x0d, 0xb3, 0x29, 0xb2, 0xdb, 0xf4, 0xf2, 0x07, 0xaf,
+		0xd7, 0xb8, 0xca, 0xa1, 0xd4, 0x24, 0x0a, 0x07, 0x16, 0xee, 0x1a, 0x32, 0x49, 0xbe, 0xbc, 0xc2,
+		0xfb, 0x92, 0x44, 0xb1, 0x6a, 0xbe, 0xf8, 0xb0, 0xb2, 0xe2, 0xf5, 0xe6, 0xb0, 0x35, 0x8a, 0x05,
+		0x7d, 0x12, 0x66, 0xc8, 0x6d, 0x2b, 0xf1, 0x8d, 0x76, 0x93, 0x7d, 0xf6, 0x8e, 0xec, 0xf7, 0xbd,
+		0xd4, 0xeb, 0x11, 0xb9, 0x7e, 0x3d, 0xef, 0x22, 0x0a, 0xb7, 0xfb, 0xba, 0x92, 0x40, 0x91, 0x72,
+		0xa5, 0xac, 0x88, 0x6e, 0xd0, 0x4f, 0xc4, 0x17, 0xbd, 0x6e, 0xa4, 0xfb, 0x01, 0x28, 0xfa, 0x24,
+		0xc8, 0xe2, 0x7a, 0xc8, 0x35, 0x49, 0x74, 0xbf, 0x4f, 0xbe, 0x5e, 0x07, 0xf8, 0x25, 0x93, 0x6b,
+		0x8c, 0x5b, 0x7d, 0x3d, 0x98, 0x47, 0x33, 0xeb, 0x4a, 0x79, 0xb5, 0xfe, 0x90, 0x56, 0x55, 0x15,
+		0xfa, 0xe5, 0x2e, 0xf3, 0x43, 0xd1, 0xfc, 0x4c, 0x37, 0x08, 0x44, 0xff, 0x55, 0x82, 0x24, 0x4b,
+		0x81, 0xd8, 0x9d, 0x14, 0xbb, 0x54, 0xb8, 0xf5, 0x7a, 0x6d, 0xd3, 0x0c, 0x88, 0x6a, 0x17, 0xce,
+		0xd2, 0x66, 0xc3, 0xc2, 0x21, 0x8e, 0x22, 0xb4, 0xae, 0xac, 0xe6, 0x8b, 0x17, 0xb5, 0x82, 0x52,
+		0xab, 0x93, 0x48, 0x56, 0x51, 0x99, 0x8f, 0x02, 0x8a, 0xe6, 0xd7, 0xd7, 0x2b, 0x8f, 0x78, 0x86,
+		0x80, 0xc7, 0x5c, 0x9a, 0xec, 0x7f, 0x86, 0xe9, 0x80, 0xbb, 0x93, 0xa4, 0x98, 0x26, 0xd3, 0x64,
+		0x04, 0x35, 0xa5, 0x5c, 0xf4, 0x27, 0xf1, 0x29, 0x70, 0xdd, 0x5b, 0x96, 0x48, 0x49, 0x38, 0xbf,
+		0x1c, 0x22, 0x61, 0x94, 0x77, 0xc0, 0xfd, 0x96, 0x18, 0xce, 0xde, 0x0f, 0x71, 0xe1, 0xbe, 0x24,
+		0x35, 0xa7, 0x19, 0xf6, 0xc0, 0xc1, 0x20, 0x0e, 0xd4, 0x77, 0x65, 0x89, 0x1c, 0x83, 0x98, 0x4f,
+		0xcb, 0xa1, 0xec, 0x79, 0x38, 0x3c, 0xd2, 0xf5, 0xd0, 0xcd, 0xb0, 0x28, 0xbe, 0x5f, 0xb2, 0xa4,
+		0x5f, 0x53, 0xca, 0xc5, 0xca, 0x0a, 0x39, 0x26, 0x79, 0x9c, 0x00, 0xdc, 0x07, 0x59, 0x2f, 0x85,
+		0x7f, 0xca, 0xa1, 0x6c, 0x09, 0xd2, 0x41, 0x07, 0x42, 0xc7, 0xe0, 0xe8, 0x66, 0xfd, 0xdc, 0x19,
+		0xed, 0x7c, 0x7e, 0xbd, 0xb4, 0x92, 0x1f, 0x38, 0x10, 0x01, 0x70, 0x2f, 0x92, 0x43, 0xa4, 0xa3,
+		0xc4, 0xbb, 0xe4, 0x70, 0x36, 0x12, 0x97, 0x64, 0x29, 0x5b, 0x83, 0x99, 0x01, 0x57, 0x40, 0xef,
+		0x83, 0x0c, 0x3f, 0xa1, 0x8c, 0xea, 0xd5, 0x1c, 0x0c, 0x3a, 0x07, 0x3b, 0xab, 0xad, 0x28, 0xeb,
+		0xa5, 0x8d, 0x52, 0x9d, 0xf6, 0xef, 0x21, 0x00, 0x6f, 0x8e, 0xc9, 0x9e, 0xb5, 0x56, 0xab, 0x94,
+		0xb5, 0x73, 0xe4, 0xa0, 0x57, 0xf7, 0x51, 0x25, 0x80, 0xcd, 0xa9, 0x2c, 0x91, 0xf3, 0xc8, 0xf0,
+		0xc4, 0xcb, 0xa1, 0x3b, 0x62, 0x64, 0xc7, 0x7a, 0xaa, 0x7c, 0x47, 0x2c, 0xfe, 0x54, 0x59, 0x7e,
+		0x9a, 0xfc, 0xff, 0xe9, 0xb2, 0xfc, 0x4c, 0x79, 0x2d, 0x16, 0x7f, 0x75, 0x4a, 0x7e, 0x6d, 0x2a,
+		0xfb, 0x93, 0x30, 0x20, 0xcf, 0xb3, 0xdc, 0x3b, 0x8f, 0x0b, 0x10, 0x77, 0x2f, 0x51, 0xd8, 0x2b,
+		0xcd, 0x0f, 0x5d, 0xc7, 0x21, 0x05, 0xcc, 0x27, 0x1a, 0xb8, 0x54, 0x71, 0xd9, 0xc8, 0x89, 0xb9,
+		0xdb, 0x36, 0xda, 0xdd, 0x7e, 0x57, 0x13, 0x37, 0x0b, 0x63, 0x4f, 0xcc, 0x1c, 0xc0, 0xcb, 0x94,
+		0x42, 0xbf, 0x12, 0xa0, 0x88, 0x8e, 0xa5, 0x60, 0x00, 0x5e, 0x5e, 0xf8, 0xb9, 0x04, 0x99, 0xfd,
+		0x3a, 0xfb, 0x8e, 0x2e, 0x3d, 0xca, 0x30, 0x6f, 0x5e, 0xc2, 0x96, 0xd5, 0x6e, 0xd2, 0xef, 0x18,
+		0x6e, 0x2a, 0x14, 0x19, 0x9f, 0x0a, 0xcd, 0xf9, 0x80, 0x5c, 0x6c, 0xa3, 0x02, 0xd9, 0xb1, 0xae,
+		0x90, 0x60, 0x2d, 0x98, 0xa2, 0xe3, 0x99, 0xa6, 0x29, 0x44, 0x70, 0xac, 0x11, 0x07, 0x25, 0xa7,
+		0x8f, 0x90, 0x1c, 0xf6, 0xf2, 0xad, 0xec, 0x8b, 0x21, 0x48, 0x07, 0x9f, 0x45, 0xa2, 0x15, 0x88,
+		0x77, 0x4c, 0xfe, 0xe4, 0x88, 0xcd, 0xf6, 0xc9, 0x31, 0x2f, 0x29, 0x97, 0xd6, 0xb9, 0xbe, 0xea,
+		0x22, 0x17, 0xfe, 0x5a, 0x82, 0xb8, 0x10, 0xa3, 0x23, 0x10, 0xe9, 0xe9, 0xce, 0x0e, 0xa5, 0x8b,
+		0x16, 0x42, 0xb2, 0xa4, 0xd2, 0x32, 0x91, 0xdb, 0x3d, 0x9d, 0x3d, 0xb7, 0xe2, 0x72, 0x52, 0x26,
+		0x39, 0x4f, 0x07, 0xeb, 0x4d, 0xfa, 0x05, 0xce, 0xec, 0x76, 0xb1, 0xe1, 0xd8, 0x22, 0xe7, 0xe1,
+		0xf2, 0x22, 0x17, 0x93, 0xd7, 0xb9, 0x8e, 0xa5, 0xb7, 0x3b, 0x01, 0xdd, 0x08, 0xd5, 0x95, 0x45,
+		0x85, 0xab, 0x9c, 0x83, 0x1b, 0x04, 0x6f, 0x13, 0x3b, 0x7a, 0x63, 0x07, 0x37, 0x3d, 0x50, 0x8c,
+		0x7e, 0x69, 0x3f, 0xca, 0x15, 0x56, 0x78, 0xbd, 0xc0, 0x66, 0xbf, 0x1b, 0x82, 0x59, 0xf1, 0xcd,
+		0xb0, 0xe9, 0x1a, 0x6b, 0x03, 0x40, 0x37, 0x0c, 0xd3, 0xf1, 0x9b, 0x6b, 0x38, 0xcd, 0x1b, 0xc2,
+		0x2d, 0xe5, 0x5d, 0x90, 0xea, 0x23, 0x58, 0xf8, 0xb1, 0x04, 0xe0, 0x55, 0xed, 0x6b, 0xb7, 0x45,
+		0x48, 0xf2, 0x47, 0xaf, 0xf4, 0xe5, 0x34, 0xbb, 0x5a, 0x03, 0x26, 0x22, 0x5f, 0x17, 0xc9, 0xad,
+		0xdb, 0x16, 0x6e, 0xb5, 0x0d, 0xfe, 0x8a, 0x89, 0x15, 0xc4, 0x63, 0x80, 0x88, 0xf7, 0xca, 0x4f,
+		0x85, 0xb8, 0x8d, 0xbb, 0xba, 0xe1, 0xb4, 0x1b, 0x7c, 0xd5, 0xdc, 0x77, 0xa0, 0xce, 0x2f, 0xd5,
+		0x38, 0x5a, 0x75, 0x79, 0xb2, 0x27, 0x21, 0x2e, 0xa4, 0x6e, 0x7c, 0x3c, 0x84, 0xa6, 0x20, 0x5c,
+		0x53, 0xc8, 0x0e, 0x41, 0xc3, 0x54, 0x29, 0x5f, 0x93, 0x43, 0x77, 0xbc, 0x18, 0x82, 0x29, 0xb1,
+		0x8c, 0xe7, 0x60, 0x46, 0x59, 0x29, 0x0d, 0x84, 0xda, 0x39, 0x48, 0x0b, 0x21, 0x8b, 0x67, 0xf2,
+		0xa7, 0xa7, 0xfc, 0xc2, 0xaa, 0x5a, 0xa9, 0x57, 0x96, 0xe5, 0x1f, 0x0e, 0x0b, 0x4f, 0xcb, 0xaf,
+		0x4e, 0xa1, 0x59, 0x48, 0x09, 0xe1, 0xf2, 0xdd, 0xcb, 0xa7, 0xe5, 0xd7, 0x06, 0x45, 0xf7, 0xc8,
+		0x3f, 0xa2, 0xb7, 0x3a, 0x42, 0xf4, 0x41, 0xad, 0x4e, 0xe2, 0x65, 0xa5, 0xbc, 0x7e, 0x51, 0x96,
+		0xfc, 0x15, 0xcb, 0xbe, 0x8a, 0x10, 0xba, 0x11, 0x8e, 0x8a, 0x8a, 0xb3, 0x67, 0xcf, 0x9e, 0xbd,
+		0xdf, 0x57, 0xf9, 0xdc, 0x67, 0x63, 0x83, 0xd5, 0x67, 0x7c, 0xd5, 0x5f, 0x1e, 0xae, 0x3e, 0xeb,
+		0xab, 0xfe, 0xca, 0x67, 0x63, 0x68, 0x0e, 0x92, 0xa2, 0x7a, 0x23, 0x7f, 0x41, 0x7e, 0xfb, 0xed,
+		0xb7, 0xdf, 0x9e, 0x2a, 0x7c, 0x12, 0xe6, 0x1a, 0x66, 0x77, 0x70, 0x6a, 0x0a, 0xf2, 0xc0, 0x93,
+		0x04, 0xfb, 0x21, 0xe9, 0xd1, 0xbb, 0xb8, 0x52, 0xcb, 0xec, 0xe8, 0x46, 0x6b, 0xc9, 0xb4, 0x5a,
+		0xde, 0x0b, 0x7d, 0x92, 0x5e, 0xda, 0xbe, 0x77, 0xfa, 0xbd, 0xad, 0x9f, 0x4b, 0xd2, 0x0b, 0xa1,
+		0xf0, 0x6a, 0xb5, 0xf0, 0x52, 0x68, 0x61, 0x95, 0x01, 0xab, 0x62, 0xe2, 0x55, 0xbc, 0xdd, 0xc1,
+		0x0d, 0x32, 0x3b, 0x70, 0xed, 0x4e, 0x98, 0x6f, 0x99, 0x2d, 0x93, 0x32, 0x9d, 0x22, 0x7f, 0xb1,
+		0x4e, 0xa0, 0x84, 0x2b, 0x5d, 0x18, 0xfb, 0x7b, 0x80, 0x5c, 0x19, 0xe6, 0xb8, 0xb2, 0x46, 0xb3,
+		0x5d, 0xf6, 0xd5, 0x14, 0x5d, 0xf7, 0xe5, 0x4d, 0xe6, 0x1b, 0x3f, 0xa0, 0xd7, 0x14, 0xea, 0x2c,
+		0x87, 0x92, 0x3a, 0xf6, 0x61, 0x35, 0xa7, 0xc2, 0xe1, 0x00, 0x1f, 0x3b, 0x69, 0x60, 0x6b, 0x0c,
+		0xe3, 0x9f, 0x71, 0xc6, 0x39, 0x1f, 0x63, 0x8d, 0x43, 0x73, 0x45, 0x98, 0x3e, 0x08, 0xd7, 0x9f,
+		0x73, 0xae, 0x14, 0xf6, 0x93, 0xac, 0xc2, 0x0c, 0x25, 0x69, 0xf4, 0x6d, 0xc7, 0xec, 0xd2, 0x63,
+		0xdc, 0xf5, 0x69, 0xfe, 0xe2, 0x07, 0x2c, 0xbc, 0xa5, 0x09, 0xac, 0xe8, 0xa2, 0x72, 0x39, 0xa0,
+		0x59, 0x3b, 0x79, 0x4e, 0x3a, 0x86, 0xe1, 0x3b, 0xbc, 0x23, 0xae, 0x7e, 0xee, 0x3c, 0xcc, 0x93,
+		0xbf, 0xe9, 0x29, 0xcb, 0xdf, 0x93, 0xf1, 0xcf, 0x74, 0x32, 0xdf, 0xfb, 0x14, 0x8b, 0xa0, 0x73,
+		0x2e, 0x81, 0xaf, 0x4f, 0xbe, 0x59, 0x6c, 0x61, 0xc7, 0xc1, 0x96, 0xad, 0xe9, 0x9d, 0x51, 0xdd,
+		0xf3, 0xbd, 0x73, 0xc8, 0x7c, 0xe1, 0xf5, 0xe0, 0x2c, 0xae, 0x32, 0x64, 0xbe, 0xd3, 0xc9, 0x6d,
+		0xc2, 0xd1, 0x11, 0x5e, 0x31, 0x01, 0xe7, 0x17, 0x39, 0xe7, 0xfc, 0x90, 0x67, 0x10, 0xda, 0x2a,
+		0x08, 0xb9, 0x3b, 0x97, 0x13, 0x70, 0xfe, 0x7f, 0xce, 0x89, 0x38, 0x56, 0x4c, 0x29, 0x61, 0x5c,
+		0x83, 0xd9, 0x4b, 0xd8, 0xda, 0x32, 0x6d, 0xfe, 0xb6, 0x64, 0x02, 0xba, 0x2f, 0x71, 0xba, 0x19,
+		0x0e, 0xa4, 0x8f, 0x4d, 0x08, 0xd7, 0x59, 0x88, 0x6f, 0xeb, 0x0d, 0x3c, 0x01, 0xc5, 0x73, 0x9c,
+		0x62, 0x8a, 0xe8, 0x13, 0x68, 0x1e, 0x52, 0x2d, 0x93, 0x1f, 0xb4, 0xc7, 0xc3, 0xbf, 0xcc, 0xe1,
+		0x49, 0x81, 0xe1, 0x14, 0x3d, 0xb3, 0xd7, 0xef, 0x90, 0x53, 0xf8, 0x78, 0x8a, 0xaf, 0x08, 0x0a,
+		0x81, 0xe1, 0x14, 0x07, 0x30, 0xeb, 0xf3, 0x82, 0xc2, 0xf6, 0xd9, 0xf3, 0x41, 0xf2, 0xe4, 0xb4,
+		0xb3, 0x67, 0x1a, 0x93, 0x74, 0xe2, 0xab, 0x9c, 0x01, 0x38, 0x84, 0x10, 0x3c, 0x00, 0x89, 0x49,
+		0x27, 0xe2, 0x57, 0x5f, 0x17, 0xcb, 0x43, 0xcc, 0xc0, 0x2a, 0xcc, 0x88, 0x00, 0x45, 0x3e, 0xd8,
+		0x8c, 0xa7, 0xf8, 0x35, 0x4e, 0x91, 0xf6, 0xc1, 0xf8, 0x30, 0x1c, 0x6c, 0x3b, 0x2d, 0x3c, 0x09,
+		0xc9, 0x8b, 0x62, 0x18, 0x1c, 0xc2, 0x4d, 0xb9, 0x85, 0x8d, 0xc6, 0xce, 0x64, 0x0c, 0xbf, 0x2e,
+		0x4c, 0x29, 0x30, 0x84, 0xa2, 0x08, 0xd3, 0x5d, 0xdd, 0xb2, 0x77, 0xf4, 0xce, 0x44, 0xd3, 0xf1,
+		0x1b, 0x9c, 0x23, 0xe5, 0x82, 0xb8, 0x45, 0xfa, 0xc6, 0x41, 0x68, 0x5e, 0x12, 0x16, 0xe9, 0x1b,
+		0x01, 0xa2, 0x2a, 0xcc, 0xdb, 0x0e, 0xcd, 0x7c, 0x0f, 0xc2, 0xf6, 0x9b, 0x62, 0xe9, 0x31, 0xec,
+		0x86, 0x9f, 0xf1, 0x01, 0x48, 0xd8, 0xed, 0xc7, 0x27, 0xa2, 0xf9, 0x9a, 0x98, 0x69, 0x0a, 0x20,
+		0xe0, 0x8b, 0x70, 0xc3, 0xc8, 0x6d, 0x62, 0x02, 0xb2, 0xaf, 0x73, 0xb2, 0x23, 0x23, 0xb6, 0x0a,
+		0x1e, 0x12, 0x0e, 0x4a, 0xf9, 0x5b, 0x22, 0x24, 0xe0, 0x01, 0xae, 0x2a, 0xb9, 0xfa, 0xb4, 0xf5,
+		0xed, 0x83, 0x59, 0xed, 0xb7, 0x85, 0xd5, 0x18, 0x36, 0x60, 0xb5, 0x3a, 0x1c, 0xe1, 0x8c, 0x07,
+		0x9b, 0xd7, 0xdf, 0x11, 0x81, 0x95, 0xa1, 0x37, 0x83, 0xb3, 0xfb, 0x31, 0x58, 0x70, 0xcd, 0x29,
+		0xee, 0xd8, 0x6c, 0x8d, 0xbc, 0x50, 0x19, 0xcf, 0xfc, 0x0d, 0xce, 0x2c, 0x22, 0xbe, 0x7b, 0x49,
+		0x67, 0x6f, 0xe8, 0x3d, 0x42, 0x7e, 0x01, 0x32, 0x82, 0xbc, 0x6f, 0x58, 0xb8, 0x61, 0xb6, 0x8c,
+		0xf6, 0xe3, 0xb8, 0x39, 0x01, 0xf5, 0x37, 0x07, 0xa6, 0x6a, 0xd3, 0x07, 0x27, 0xcc, 0x25, 0x90,
+		0xdd, 0x5c, 0x45, 0x6b, 0x77, 0xe9, 0xf7, 0x88, 0xeb, 0x33, 0xfe, 0xae, 0x98, 0x29, 0x17, 0x57,
+		0xa2, 0xb0, 0x9c, 0x02, 0xec, 0x75, 0xfa, 0xa4, 0x2e, 0xf9, 0x7b, 0x9c, 0x68, 0xda, 0x43, 0xf1,
+		0xc0, 0xd1, 0x30, 0xbb, 0x3d, 0xdd, 0x9a, 0x24, 0xfe, 0xfd, 0xbe, 0x08, 0x1c, 0x1c, 0xc2, 0x03,
+		0x07, 0xc9, 0xe8, 0xc8, 0x6e, 0x3f, 0x01, 0xc3, 0xb7, 0x44, 0xe0, 0x10, 0x18, 0x4e, 0x21, 0x12,
+		0x86, 0x09, 0x28, 0xfe, 0x40, 0x50, 0x08, 0x0c, 0xa1, 0xf8, 0xa8, 0xb7, 0xd1, 0x5a, 0xb8, 0xd5,
+		0xb6, 0x1d, 0xfe, 0xfb, 0x91, 0xeb, 0x53, 0xfd, 0xe1, 0xeb, 0xc1, 0x24, 0x4c, 0xf5, 0x41, 0x49,
+		0x24, 0xe2, 0x17, 0x63, 0xf4, 0xe2, 0x77, 0x7c, 0xc7, 0xbe, 0x2d, 0x22, 0x91, 0x0f, 0x46, 0xfa,
+		0xe6, 0xcb, 0x10, 0x89, 0xd9, 0x1b, 0xe4, 0x48, 0x37, 0x01, 0xdd, 0x1f, 0x0d, 0x74, 0xae, 0x26,
+		0xb0, 0x84, 0xd3, 0x97, 0xff, 0xf4, 0x8d, 0x5d, 0xbc, 0x37, 0x91, 0x77, 0xfe, 0xf1, 0x40, 0xfe,
+		0xb3, 0xc9, 0x90, 0x2c, 0x86, 0xcc, 0x0c, 0xe4, 0x53, 0x68, 0xdc, 0x8f, 0xcb, 0x32, 0xff, 0xe5,
+		0x4d, 0x3e, 0xde, 0x60, 0x3a, 0x95, 0x5b, 0x07, 0x99, 0x4b, 0xbc, 0x04, 0x76, 0x2c, 0xd9, 0xa7,
+		0xde, 0x74, 0xfd, 0x3c, 0x90, 0xf3, 0xe4, 0xce, 0xc1, 0x74, 0x20, 0xe1, 0x19, 0x4f, 0xf5, 0x69,
+		0x4e, 0x95, 0xf2, 0xe7, 0x3b, 0xb9, 0x7b, 0x21, 0x42, 0x92, 0x97, 0xf1, 0xf0, 0xff, 0xc6, 0xe1,
+		0x54, 0x3d, 0xf7, 0x61, 0x88, 0x8b, 0xa4, 0x65, 0x3c, 0xf4, 0xbf, 0x73, 0xa8, 0x0b, 0x21, 0x70,
+		0x91, 0xb0, 0x8c, 0x87, 0xff, 0x0f, 0x01, 0x17, 0x10, 0x02, 0x9f, 0xdc, 0x84, 0x7f, 0xf2, 0x54,
+		0x84, 0xc1, 0x05, 0x24, 0x47, 0x5e, 0xc7, 0xb3, 0x4c, 0x65, 0x3c, 0xfa, 0x33, 0xbc, 0x71, 0x81,
+		0xc8, 0xdd, 0x0f, 0xd1, 0x09, 0x0d, 0xfe, 0x59, 0x0e, 0x65, 0xfa, 0xb9, 0x22, 0x24, 0x7d, 0xd9,
+		0xc9, 0x78, 0xf8, 0xff, 0xe2, 0x70, 0x3f, 0x8a, 0x74, 0x9d, 0x67, 0x27, 0xe3, 0x09, 0xfe, 0xb7,
+		0xe8, 0x3a, 0x47, 0x10, 0xb3, 0x89, 0xc4, 0x64, 0x3c, 0xfa, 0x69, 0x61, 0x75, 0x01, 0xc9, 0x3d,
+		0x08, 0x09, 0x77, 0xb3, 0x19, 0x8f, 0x7f, 0x86, 0xe3, 0x3d, 0x0c, 0xb1, 0x40, 0xdf, 0x38, 0x00,
+		0xc5, 0xff, 0x11, 0x16, 0xf0, 0xa1, 0xc8, 0x32, 0x1a, 0x4c, 0x60, 0xc6, 0x33, 0xfd, 0x5f, 0xb1,
+		0x8c, 0x06, 0xf2, 0x17, 0x32, 0x9b, 0x34, 0xe6, 0x8f, 0xa7, 0xf8, 0x9c, 0x98, 0x4d, 0xaa, 0x4f,
+		0xba, 0x31, 0x98, 0x11, 0x8c, 0xe7, 0xf8, 0xbc, 0xe8, 0xc6, 0x40, 0x42, 0x90, 0xab, 0x02, 0x1a,
+		0xce, 0x06, 0xc6, 0xf3, 0x3d, 0xcb, 0xf9, 0x66, 0x87, 0x92, 0x81, 0xdc, 0x23, 0x70, 0x64, 0x74,
+		0x26, 0x30, 0x9e, 0xf5, 0x0b, 0x6f, 0x0e, 0x9c, 0xdd, 0xfc, 0x89, 0x40, 0xae, 0x0e, 0xf3, 0xa3,
+		0xb2, 0x80, 0xf1, 0xb4, 0x5f, 0x7c, 0x33, 0x18, 0xb8, 0xfd, 0x49, 0x40, 0x2e, 0x0f, 0xe0, 0x6d,
+		0xc0, 0xe3, 0xb9, 0xbe, 0xc4, 0xb9, 0x7c, 0x20, 0xb2, 0x34, 0xf8, 0xfe, 0x3b, 0x1e, 0xff, 0x9c,
+		0x58, 0x1a, 0x1c, 0x41, 0x96, 0x86, 0xd8, 0x7a, 0xc7, 0xa3, 0xbf, 0x2c, 0x96, 0x86, 0x80, 0x10,
+		0xcf, 0xf6, 0xed, 0x6e, 0xe3, 0x19, 0xbe, 0x2a, 0x3c, 0xdb, 0x87, 0xca, 0x95, 0x61, 0x76, 0x68,
+		0x43, 0x1c, 0x4f, 0xf5, 0x02, 0xa7, 0x92, 0x07, 0xf7, 0x43, 0xff, 0xe6, 0xc5, 0x37, 0xc3, 0xf1,
+		0x6c, 0xbf, 0x32, 0xb0, 0x79, 0xf1, 0xbd, 0x30, 0xf7, 0x00, 0xc4, 0x8d, 0x7e, 0xa7, 0x43, 0x16,
+		0x0f, 0xba, 0xfe, 0xef, 0x07, 0x33, 0x3f, 0x7a, 0x8b, 0x5b, 0x47, 0x00, 0x72, 0xf7, 0x42, 0x14,
+		0x77, 0xb7, 0x70, 0x73, 0x1c, 0xf2, 0xda, 0x5b, 0x22, 0x60, 0x12, 0xed, 0xdc, 0x83, 0x00, 0xec,
+		0x6a, 0x84, 0xbe, 0xc1, 0x1d, 0x83, 0xfd, 0xf1, 0x5b, 0xfc, 0x07, 0x3b, 0x1e, 0xc4, 0x23, 0x60,
+		0x3f, 0xff, 0xb9, 0x3e, 0xc1, 0xeb, 0x41, 0x02, 0x3a, 0x23, 0x67, 0x61, 0x8a, 0x7c, 0x7a, 0x73,
+		0xf4, 0xd6, 0x38, 0xf4, 0x3f, 0x72, 0xb4, 0xd0, 0x27, 0x06, 0xeb, 0x9a, 0x16, 0x76, 0xf4, 0x96,
+		0x3d, 0x0e, 0xfb, 0x4f, 0x1c, 0xeb, 0x02, 0x08, 0xb8, 0xa1, 0xdb, 0xce, 0x24, 0xe3, 0xfe, 0x89,
+		0x00, 0x0b, 0x00, 0xe9, 0x34, 0xf9, 0x7b, 0x17, 0xef, 0x8d, 0xc3, 0xfe, 0x54, 0x74, 0x9a, 0xeb,
+		0xe7, 0x3e, 0x0c, 0x09, 0xf2, 0x27, 0xfb, 0x15, 0xde, 0x18, 0xf0, 0x3f, 0x73, 0xb0, 0x87, 0x20,
+		0x2d, 0xdb, 0x4e, 0xd3, 0x69, 0x8f, 0x37, 0xf6, 0x1b, 0x7c, 0xa6, 0x85, 0x7e, 0x2e, 0x0f, 0x49,
+		0xdb, 0x69, 0x36, 0xfb, 0x3c, 0x3f, 0x1d, 0x03, 0xff, 0x97, 0xb7, 0xdc, 0x2b, 0x0b, 0x17, 0x43,
+		0x66, 0xfb, 0xf2, 0xae, 0xd3, 0x33, 0xe9, 0xab, 0x8d, 0x71, 0x0c, 0x6f, 0x72, 0x06, 0x1f, 0x24,
+		0x57, 0x84, 0x14, 0x19, 0x8b, 0xf8, 0xf8, 0x3d, 0x8e, 0xe2, 0x67, 0xdc, 0x00, 0x01, 0x50, 0xe1,
+		0xe3, 0xdf, 0x79, 0xf9, 0xb8, 0xf4, 0xdd, 0x97, 0x8f, 0x4b, 0xff, 0xf0, 0xf2, 0x71, 0xe9, 0xe9,
+		0x57, 0x8e, 0x1f, 0xfa, 0xee, 0x2b, 0xc7, 0x0f, 0x7d, 0xff, 0x95, 0xe3, 0x87, 0x46, 0xdf, 0x12,
+		0xc3, 0xaa, 0xb9, 0x6a, 0xb2, 0xfb, 0xe1, 0x47, 0xb3, 0xad, 0xb6, 0xb3, 0xd3, 0xdf, 0x5a, 0x6a,
+		0x98, 0x5d, 0x7a, 0x8d, 0xeb, 0xdd, 0xd6, 0xba, 0x87, 0x1c, 0xf8, 0x7c, 0x08, 0x16, 0x07, 0xef,
+		0x72, 0x89, 0x01, 0x6d, 0x47, 0xef, 0xf6, 0xf6, 0xfb, 0xa7, 0x5d, 0x1e, 0x80, 0x44, 0x5d, 0xe8,
+		0x90, 0x7f, 0x6c, 0xc5, 0xc6, 0x0d, 0xd3, 0x68, 0xb2, 0xe7, 0x8c, 0x61, 0x55, 0x14, 0xc9, 0x87,
+		0x09, 0x43, 0x37, 0x4c, 0x9b, 0xff, 0x96, 0x90, 0x15, 0x0a, 0xcf, 0x49, 0x07, 0x1b, 0x51, 0xda,
+		0x6d, 0x8a, 0x0e, 0xab, 0x2a, 0x3d, 0xba, 0x3c, 0xf6, 0xd6, 0x7b, 0xd7, 0x30, 0x2f, 0x1b, 0xde,
+		0x38, 0x02, 0x57, 0xdf, 0xc7, 0x07, 0xaf, 0xbe, 0x1f, 0xc1, 0x9d, 0xce, 0xc3, 0x04, 0x40, 0xbe,
+		0x52, 0xdb, 0x5b, 0x31, 0xf6, 0x63, 0x64, 0xf8, 0x4b, 0x80, 0xd9, 0x2b, 0xa7, 0xf4, 0x5e, 0xcf,
+		0xa6, 0xff, 0xe1, 0xb6, 0x88, 0x5d, 0x59, 0x22, 0xa5, 0x85, 0x91, 0x37, 0xe4, 0x0b, 0xe3, 0x4c,
+		0x99, 0x7d, 0x29, 0x06, 0x32, 0x6d, 0x38, 0xdf, 0xeb, 0x75, 0xf8, 0xaf, 0xdc, 0x51, 0x07, 0xa6,
+		0xf4, 0x66, 0xd3, 0xc2, 0x36, 0xb3, 0x60, 0xaa, 0xa0, 0x5e, 0xbb, 0xba, 0x28, 0x44, 0x6f, 0x5c,
+		0x5d, 0x4c, 0xef, 0xe9, 0xdd, 0x4e, 0x2e, 0xcb, 0x05, 0xd9, 0x7f, 0xbd, 0xba, 0xf8, 0x41, 0xdf,
+		0xcc, 0xf6, 0xcc, 0x5d, 0xe7, 0x2e, 0x03, 0x3b, 0x97, 0x4d, 0x6b, 0xf7, 0x54, 0xcf, 0x6c, 0xec,
+		0x62, 0xe7, 0xae, 0x86, 0x69, 0x61, 0x66, 0x8a, 0xa5, 0x3c, 0x43, 0xa9, 0x82, 0x0f, 0x15, 0x00,
+		0xf8, 0x3f, 0x71, 0xb3, 0x8b, 0xf7, 0xe8, 0xd4, 0xa4, 0x0a, 0x37, 0x5f, 0xbb, 0xba, 0xe8, 0x93,
+		0xbe, 0x71, 0x75, 0x71, 0x96, 0xb5, 0xe9, 0xc9, 0xb2, 0x6a, 0x82, 0x15, 0x1e, 0xc6, 0x7b, 0xe8,
+		0x34, 0xc4, 0x1e, 0xd3, 0xdb, 0x1d, 0xf1, 0xe0, 0xb3, 0x70, 0xec, 0xda, 0xd5, 0x45, 0x2e, 0x79,
+		0xe3, 0xea, 0xe2, 0x34, 0xc3, 0xb2, 0x72, 0x56, 0xe5, 0x15, 0xa8, 0x03, 0x31, 0xdb, 0xd1, 0x9d,
+		0x3e, 0xfb, 0x64, 0x17, 0x2d, 0xd4, 0x09, 0x88, 0x49, 0x3c, 0x10, 0x2b, 0x93, 0x31, 0xde, 0x3b,
+		0xf9, 0x18, 0x6b, 0x8e, 0xbe, 0x8b, 0x6b, 0x14, 0xa9, 0x72, 0x46, 0xd2, 0xc5, 0xc6, 0x8e, 0xde,
+		0x36, 0x6c, 0xf6, 0xab, 0x5a, 0xd6, 0x45, 0x26, 0xf1, 0x5a, 0x63, 0xe5, 0xac, 0xca, 0x2b, 0xd0,
+		0x15, 0x98, 0xb6, 0x09, 0x57, 0x53, 0x73, 0xcc, 0x5d, 0x6c, 0xb0, 0xc7, 0xa8, 0x89, 0x42, 0xed,
+		0x3b, 0x57, 0x17, 0x0f, 0xfd, 0xed, 0xd5, 0xc5, 0xbb, 0x27, 0xef, 0x52, 0xa1, 0xdd, 0x2a, 0x19,
+		0x0e, 0x69, 0x93, 0x31, 0x79, 0x6d, 0xb2, 0x72, 0x56, 0x4d, 0xb1, 0x96, 0xea, 0xb4, 0x88, 0x1e,
+		0x07, 0xe8, 0xea, 0x57, 0x34, 0x0b, 0x77, 0xf4, 0x3d, 0xf6, 0x92, 0x35, 0x51, 0xf8, 0xd8, 0x2f,
+		0xd0, 0xac, 0x8f, 0xcd, 0x9b, 0x4d, 0x4f, 0x96, 0x25, 0x69, 0xf4, 0x15, 0x95, 0xfe, 0x8d, 0x9e,
+		0x92, 0xe0, 0x86, 0xbe, 0x41, 0xba, 0xc3, 0x3f, 0xac, 0xf6, 0x3a, 0x98, 0xde, 0x93, 0x12, 0xef,
+		0xe5, 0xbf, 0xf1, 0x5f, 0x18, 0x8a, 0x5d, 0xee, 0xb2, 0x2c, 0x9c, 0x26, 0xfd, 0xbc, 0x76, 0x75,
+		0x31, 0xed, 0x91, 0x10, 0xe4, 0x1b, 0x57, 0x17, 0x0f, 0xb3, 0x76, 0x83, 0xf2, 0xec, 0xd3, 0x7f,
+		0xbf, 0x28, 0xa9, 0x47, 0x5d, 0x61, 0xd1, 0x6d, 0x90, 0x50, 0xe6, 0xe2, 0x4f, 0x3e, 0xbf, 0x78,
+		0xe8, 0xb5, 0xe7, 0x17, 0xa5, 0xec, 0x16, 0x44, 0xaa, 0xa6, 0xd9, 0x41, 0x55, 0xe0, 0x46, 0x64,
+		0xbf, 0x39, 0x2d, 0x9c, 0x79, 0xa7, 0x76, 0x51, 0x39, 0x4f, 0x2e, 0x4e, 0xf8, 0x7f, 0x4a, 0xda,
+		0x78, 0x26, 0x44, 0xfe, 0xe5, 0x92, 0x4b, 0xe6, 0x2e, 0x6e, 0x16, 0xe9, 0x4f, 0x66, 0xc9, 0x6a,
+		0xcc, 0xf7, 0x7a, 0x64, 0xd9, 0xbc, 0x9b, 0xab, 0x91, 0x37, 0x81, 0x2a, 0x90, 0x62, 0xed, 0x56,
+		0xfb, 0x5b, 0x0f, 0xf3, 0xf5, 0x98, 0x28, 0xdc, 0x49, 0xac, 0xc9, 0x7f, 0xe1, 0xdb, 0xeb, 0x6f,
+		0xf1, 0x35, 0xc9, 0xad, 0x19, 0x94, 0x67, 0xd5, 0x00, 0x01, 0xf1, 0xfb, 0x87, 0x70, 0xbb, 0xb5,
+		0xc3, 0x5e, 0xfc, 0x86, 0x99, 0xdf, 0xef, 0x50, 0x89, 0xe7, 0x83, 0xac, 0x9c, 0x55, 0xb9, 0x6a,
+		0x2e, 0x45, 0x6c, 0xfe, 0xec, 0xf3, 0x8b, 0x12, 0xb1, 0x4b, 0x61, 0x6d, 0xbf, 0x00, 0xfd, 0xe8,
+		0x44, 0x16, 0xe7, 0x41, 0xd3, 0x09, 0x44, 0xd3, 0x7f, 0x8b, 0x83, 0xcc, 0x2b, 0xba, 0x76, 0x6b,
+		0x92, 0x60, 0x9a, 0xfd, 0x99, 0x04, 0xd3, 0x1b, 0x76, 0xab, 0xca, 0xae, 0x29, 0xf4, 0x5d, 0xf2,
+		0xbb, 0xc9, 0x29, 0x3e, 0x6a, 0x3e, 0x35, 0x74, 0x70, 0xbd, 0xfe, 0x16, 0xb3, 0xcf, 0xb4, 0x1b,
+		0xb3, 0xa8, 0x5d, 0x62, 0x3d, 0xd7, 0x22, 0x3c, 0x12, 0x84, 0x26, 0x8f, 0x04, 0x2d, 0xf1, 0x53,
+		0x16, 0xba, 0x27, 0x17, 0x3e, 0xfa, 0x0b, 0x2c, 0x45, 0x46, 0xf4, 0xc6, 0xd5, 0xc5, 0x14, 0x6b,
+		0x8a, 0x16, 0xb3, 0xfc, 0xd7, 0x31, 0x39, 0xd9, 0x6f, 0xfa, 0x27, 0x5f, 0x58, 0x94, 0xb2, 0x2f,
+		0x49, 0x30, 0xb3, 0x61, 0xb7, 0x0a, 0xe4, 0x33, 0xfe, 0x26, 0x5d, 0x24, 0x18, 0x7d, 0x5a, 0x82,
+		0xa9, 0x7c, 0x60, 0x8f, 0x78, 0xec, 0xda, 0xd5, 0xc5, 0x39, 0xdd, 0xdb, 0x45, 0x34, 0xcf, 0x43,
+		0x17, 0xb8, 0x87, 0x0e, 0x57, 0xbe, 0x63, 0x6f, 0x65, 0x7f, 0x8c, 0xe8, 0xec, 0x53, 0x12, 0x24,
+		0x36, 0xec, 0xd6, 0xa6, 0x41, 0x82, 0xfc, 0x2f, 0x77, 0xed, 0x8c, 0xe8, 0xcd, 0xcb, 0xcc, 0x74,
+		0x6c, 0x41, 0xff, 0x87, 0x58, 0xcf, 0x23, 0x06, 0xf9, 0x77, 0x12, 0x1c, 0xd9, 0xb0, 0x5b, 0x75,
+		0x4b, 0x37, 0xec, 0x6d, 0x6c, 0x0d, 0x64, 0x12, 0xbf, 0xc4, 0xb1, 0x16, 0x21, 0x51, 0xc6, 0x97,
+		0x03, 0x03, 0xbd, 0xe5, 0xda, 0xd5, 0xc5, 0xa4, 0x81, 0x2f, 0xfb, 0x46, 0x89, 0x58, 0x9b, 0x3e,
+		0x61, 0x56, 0xf5, 0x70, 0xc3, 0xe3, 0x7b, 0x37, 0xc2, 0xcf, 0xbf, 0x0f, 0x00, 0x05, 0x2f, 0x31,
+		0xcf, 0xc3, 0x50, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -551,6 +702,36 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RevokedClient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RevokedClient)
+	if !ok {
+		that2, ok := that.(RevokedClient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppAddr, that1.AppAddr) {
+		return false
+	}
+	if this.ClientPubKey != that1.ClientPubKey {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
 func (m *ProtoApplication) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -673,6 +854,48 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RevokedClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokedClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokedClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintApps(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientPubKey) > 0 {
+		i -= len(m.ClientPubKey)
+		copy(dAtA[i:], m.ClientPubKey)
+		i = encodeVarintApps(dAtA, i, uint64(len(m.ClientPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppAddr) > 0 {
+		i -= len(m.AppAddr)
+		copy(dAtA[i:], m.AppAddr)
+		i = encodeVarintApps(dAtA, i, uint64(len(m.AppAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApps(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApps(v)
 	base := offset
@@ -730,6 +953,26 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *RevokedClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppAddr)
+	if l > 0 {
+		n += 1 + l + sovApps(uint64(l))
+	}
+	l = len(m.ClientPubKey)
+	if l > 0 {
+		n += 1 + l + sovApps(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovApps(uint64(m.Height))
+	}
+	return n
+}
+
 func sovApps(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1116,6 +1359,144 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RevokedClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokedClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokedClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApps
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAddr = append(m.AppAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppAddr == nil {
+				m.AppAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApps(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"github.com/pokt-network/pocket-core/codec/types"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 )
 
 // RegisterCodec registers concrete types on the codec
@@ -13,8 +14,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgStake{}, "apps/MsgAppStake")
 	cdc.RegisterStructure(MsgBeginUnstake{}, "apps/MsgAppBeginUnstake")
 	cdc.RegisterStructure(MsgUnjail{}, "apps/MsgAppUnjail")
-	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{})
-	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{})
+	cdc.RegisterStructure(MsgRevokeClient{}, "apps/MsgAppRevokeClient")
+	cdc.RegisterStructure(MsgTransferApplication{}, "apps/MsgTransferApplication")
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{}, &MsgRevokeClient{}, &MsgTransferApplication{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{}, &MsgRevokeClient{}, &MsgTransferApplication{})
 	ModuleCdc = cdc
 }
 
@@ -25,4 +28,6 @@ func init() {
 	ModuleCdc = codec.NewCodec(types.NewInterfaceRegistry())
 	RegisterCodec(ModuleCdc)
 	crypto.RegisterAmino(ModuleCdc.AminoCodec().Amino)
+	// the stake and the transfer move the tokens of the application out of the reach of the spend limit
+	authTypes.RegisterUnscopedMsgTypes(MsgAppStakeName, MsgAppTransferName)
 }
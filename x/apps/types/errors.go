@@ -26,6 +26,9 @@ const (
 	CodeTooManyChains         CodeType          = 118
 	CodeMaxApplications       CodeType          = 119
 	CodeMinimumEditStake      CodeType          = 120
+	CodeInvalidClientPubKey   CodeType          = 121
+	CodeClientAlreadyRevoked  CodeType          = 122
+	CodeInvalidTransferPubKey CodeType          = 123
 )
 
 func ErrTooManyChains(Codespace sdk.CodespaceType) sdk.Error {
@@ -101,3 +104,15 @@ func ErrInvalidNetworkIdentifier(codespace sdk.CodespaceType, err error) sdk.Err
 func ErrMinimumEditStake(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMinimumEditStake, "application must edit stake with a stake greater than or equal to current stake")
 }
+
+func ErrInvalidClientPubKey(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClientPubKey, "the client public key is not valid: "+err.Error())
+}
+
+func ErrClientAlreadyRevoked(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeClientAlreadyRevoked, "the client public key is already revoked for this application")
+}
+
+func ErrInvalidTransferPubKey(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTransferPubKey, "the new application public key is not valid: "+err.Error())
+}
@@ -7,6 +7,10 @@ const (
 	EventTypeStake             = "stake"
 	EventTypeBeginUnstake      = "begin_unstake"
 	EventTypeUnstake           = "unstake"
+	EventTypeRevokeClient      = "revoke_client"
+	EventTypeTransfer          = "transfer_application"
 	AttributeKeyApplication    = "application"
+	AttributeKeyClientPubKey   = "client_pub_key"
+	AttributeKeyNewApplication = "new_application"
 	AttributeValueCategory     = ModuleName
 )
@@ -10,6 +10,8 @@ type PosKeeper interface {
 	StakeDenom(ctx sdk.Ctx) (res string)
 	// GetStakedTokens total staking tokens supply which is staked
 	GetStakedTokens(ctx sdk.Ctx) sdk.BigInt
+	// BlocksPerSession the number of blocks in a session
+	BlocksPerSession(ctx sdk.Ctx) (res int64)
 }
 
 type PocketKeeper interface {
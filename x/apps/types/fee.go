@@ -1,15 +1,19 @@
 package types
 
 const (
-	StakeFee   = 10000
-	UnstakeFee = 10000
-	UnjailFee  = 10000
+	StakeFee    = 10000
+	UnstakeFee  = 10000
+	UnjailFee   = 10000
+	RevokeFee   = 10000
+	TransferFee = 10000
 )
 
 var (
 	AppFeeMap = map[string]int64{
-		MsgAppStakeName:   StakeFee,
-		MsgAppUnstakeName: UnstakeFee,
-		MsgAppUnjailName:  UnjailFee,
+		MsgAppStakeName:    StakeFee,
+		MsgAppUnstakeName:  UnstakeFee,
+		MsgAppUnjailName:   UnjailFee,
+		MsgAppRevokeName:   RevokeFee,
+		MsgAppTransferName: TransferFee,
 	}
 )
@@ -2,15 +2,17 @@ package types
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Params       Params       `json:"params" yaml:"params"`
-	Applications Applications `json:"applications" yaml:"applications"`
-	Exported     bool         `json:"exported" yaml:"exported"`
+	Params         Params          `json:"params" yaml:"params"`
+	Applications   Applications    `json:"applications" yaml:"applications"`
+	Exported       bool            `json:"exported" yaml:"exported"`
+	RevokedClients []RevokedClient `json:"revoked_clients,omitempty" yaml:"revoked_clients"`
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:       DefaultParams(),
-		Applications: make(Applications, 0),
+		Params:         DefaultParams(),
+		Applications:   make(Applications, 0),
+		RevokedClients: make([]RevokedClient, 0),
 	}
 }
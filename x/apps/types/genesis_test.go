@@ -10,8 +10,9 @@ func TestDefaultGenesisState(t *testing.T) {
 		name string
 		want GenesisState
 	}{{"defaultState", GenesisState{
-		Params:       DefaultParams(),
-		Applications: make(Applications, 0),
+		Params:         DefaultParams(),
+		Applications:   make(Applications, 0),
+		RevokedClients: make([]RevokedClient, 0),
 	}},
 	}
 	for _, tt := range tests {
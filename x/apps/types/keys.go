@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"time"
 )
@@ -19,6 +20,10 @@ var (
 	StakedAppsKey      = []byte{0x02} // prefix for each key to a staked application index, sorted by power
 	UnstakingAppsKey   = []byte{0x03} // prefix for unstaking application
 	BurnApplicationKey = []byte{0x04} // prefix for awarding applications
+	RevokedClientsKey  = []byte{0x05} // prefix for client public keys revoked by an application
+	RelayUsageKey      = []byte{0x06} // prefix for the relays claimed against an application per session
+	UsageStartKey      = []byte{0x07} // key for the height usage based relays were turned on at
+	TransferredAppsKey = []byte{0x08} // prefix for the new address of each transferred application
 )
 
 // Removes the prefix bytes from a key to expose true address
@@ -26,6 +31,11 @@ func AddressFromKey(key []byte) []byte {
 	return key[1:] // remove prefix bytes
 }
 
+// generates the key for the new address of the application transferred from the address
+func KeyForTransferredApp(address sdk.Address) []byte {
+	return append(TransferredAppsKey, address.Bytes()...)
+}
+
 // generates the key for the application with address
 func KeyForAppByAllApps(addr sdk.Address) []byte {
 	return append(AllApplicationsKey, addr.Bytes()...)
@@ -47,6 +57,26 @@ func KeyForAppBurn(address sdk.Address) []byte {
 	return append(BurnApplicationKey, address...)
 }
 
+// generates the key for the revoked clients of the application with address
+func KeyForRevokedClients(address sdk.Address) []byte {
+	return append(RevokedClientsKey, address.Bytes()...)
+}
+
+// generates the key for a client public key revoked by the application with address
+func KeyForRevokedClient(address sdk.Address, clientPubKey crypto.PublicKey) []byte {
+	return append(KeyForRevokedClients(address), clientPubKey.RawBytes()...)
+}
+
+// generates the key for the claimed relays of the application with address
+func KeyForRelayUsages(address sdk.Address) []byte {
+	return append(RelayUsageKey, address.Bytes()...)
+}
+
+// generates the key for the claimed relays of the application with address in the session
+func KeyForRelayUsage(address sdk.Address, sessionBlockHeight int64) []byte {
+	return append(KeyForRelayUsages(address), sdk.Uint64ToBigEndian(uint64(sessionBlockHeight))...)
+}
+
 // get the power ranking key of a application
 // NOTE the larger values are of higher value
 func getStakedValPowerRankKey(application Application) []byte {
@@ -14,12 +14,16 @@ var (
 	_ codec.ProtoMarshaler = &MsgStake{}
 	_ sdk.ProtoMsg         = &MsgBeginUnstake{}
 	_ sdk.ProtoMsg         = &MsgUnjail{}
+	_ sdk.ProtoMsg         = &MsgRevokeClient{}
+	_ sdk.ProtoMsg         = &MsgTransferApplication{}
 )
 
 const (
-	MsgAppStakeName   = "app_stake"
-	MsgAppUnstakeName = "app_begin_unstake"
-	MsgAppUnjailName  = "app_unjail"
+	MsgAppStakeName    = "app_stake"
+	MsgAppUnstakeName  = "app_begin_unstake"
+	MsgAppUnjailName   = "app_unjail"
+	MsgAppRevokeName   = "app_revoke_client"
+	MsgAppTransferName = "app_transfer"
 )
 
 type MsgStake struct {
@@ -211,3 +215,87 @@ func (msg MsgUnjail) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+// Route provides router key for msg
+func (msg MsgRevokeClient) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRevokeClient) Type() string { return MsgAppRevokeName }
+
+// GetFee get fee for msg
+func (msg MsgRevokeClient) GetFee() sdk.BigInt {
+	return sdk.NewInt(AppFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeClient) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.AppAddr}
+}
+
+func (msg MsgRevokeClient) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for revoking a client of an application
+func (msg MsgRevokeClient) ValidateBasic() sdk.Error {
+	if msg.AppAddr.Empty() {
+		return ErrBadApplicationAddr(DefaultCodespace)
+	}
+	if _, err := crypto.NewPublicKey(msg.ClientPubKey); err != nil {
+		return ErrInvalidClientPubKey(DefaultCodespace, err)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// Route provides router key for msg
+func (msg MsgTransferApplication) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgTransferApplication) Type() string { return MsgAppTransferName }
+
+// GetFee get fee for msg
+func (msg MsgTransferApplication) GetFee() sdk.BigInt {
+	return sdk.NewInt(AppFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgTransferApplication) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.AppAddr}
+}
+
+func (msg MsgTransferApplication) GetRecipient() sdk.Address {
+	pk, err := crypto.NewPublicKey(msg.NewPubKey)
+	if err != nil {
+		return nil
+	}
+	return sdk.Address(pk.Address())
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgTransferApplication) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for transferring an application to a new public key
+func (msg MsgTransferApplication) ValidateBasic() sdk.Error {
+	if msg.AppAddr.Empty() {
+		return ErrBadApplicationAddr(DefaultCodespace)
+	}
+	pk, err := crypto.NewPublicKey(msg.NewPubKey)
+	if err != nil {
+		return ErrInvalidTransferPubKey(DefaultCodespace, err)
+	}
+	if sdk.Address(pk.Address()).Equals(msg.AppAddr) {
+		return ErrApplicationPubKeyExists(DefaultCodespace)
+	}
+	return nil
+}
@@ -149,42 +149,135 @@ var xxx_messageInfo_MsgUnjail proto.InternalMessageInfo
 func (*MsgUnjail) XXX_MessageName() string {
 	return "x.apps.MsgUnjail"
 }
+
+type MsgRevokeClient struct {
+	AppAddr      github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=AppAddr,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address" yaml:"address"`
+	ClientPubKey string                                            `protobuf:"bytes,2,opt,name=ClientPubKey,proto3" json:"client_pub_key" yaml:"client_pub_key"`
+}
+
+func (m *MsgRevokeClient) Reset()         { *m = MsgRevokeClient{} }
+func (m *MsgRevokeClient) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeClient) ProtoMessage()    {}
+func (*MsgRevokeClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd58e5eb64f87460, []int{3}
+}
+func (m *MsgRevokeClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeClient.Merge(m, src)
+}
+func (m *MsgRevokeClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeClient proto.InternalMessageInfo
+
+func (*MsgRevokeClient) XXX_MessageName() string {
+	return "x.apps.MsgRevokeClient"
+}
+
+type MsgTransferApplication struct {
+	AppAddr   github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=AppAddr,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address" yaml:"address"`
+	NewPubKey string                                            `protobuf:"bytes,2,opt,name=NewPubKey,proto3" json:"new_pub_key" yaml:"new_pub_key"`
+}
+
+func (m *MsgTransferApplication) Reset()         { *m = MsgTransferApplication{} }
+func (m *MsgTransferApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferApplication) ProtoMessage()    {}
+func (*MsgTransferApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd58e5eb64f87460, []int{4}
+}
+func (m *MsgTransferApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferApplication.Merge(m, src)
+}
+func (m *MsgTransferApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferApplication proto.InternalMessageInfo
+
+func (*MsgTransferApplication) XXX_MessageName() string {
+	return "x.apps.MsgTransferApplication"
+}
 func init() {
 	proto.RegisterType((*MsgProtoStake)(nil), "x.apps.MsgProtoStake")
 	proto.RegisterType((*MsgBeginUnstake)(nil), "x.apps.MsgBeginUnstake")
 	proto.RegisterType((*MsgUnjail)(nil), "x.apps.MsgUnjail")
+	proto.RegisterType((*MsgRevokeClient)(nil), "x.apps.MsgRevokeClient")
+	proto.RegisterType((*MsgTransferApplication)(nil), "x.apps.MsgTransferApplication")
 }
 
 func init() { proto.RegisterFile("x/apps/msg.proto", fileDescriptor_fd58e5eb64f87460) }
 
 var fileDescriptor_fd58e5eb64f87460 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x31, 0x8f, 0xd3, 0x30,
-	0x18, 0x8d, 0x39, 0x91, 0xa8, 0xd6, 0x1d, 0x9c, 0x02, 0x43, 0x75, 0x48, 0x71, 0x95, 0xa9, 0xcb,
-	0x25, 0xa0, 0x63, 0xba, 0xed, 0xb2, 0x01, 0xaa, 0x04, 0x41, 0xb7, 0xb0, 0x9c, 0x9c, 0x9c, 0xe5,
-	0xa6, 0x49, 0x63, 0x2b, 0x76, 0xa0, 0xd9, 0x19, 0x2a, 0xb1, 0x30, 0x32, 0x56, 0x8c, 0xfc, 0x92,
-	0x8e, 0x1d, 0x11, 0x83, 0x85, 0xda, 0x05, 0x65, 0xac, 0xc4, 0xc2, 0x84, 0x12, 0x07, 0x75, 0xa0,
-	0x43, 0xa5, 0xdb, 0xfc, 0xde, 0xf3, 0xe7, 0xf7, 0x9e, 0xfc, 0xc1, 0xd3, 0x99, 0x8f, 0x39, 0x17,
-	0xfe, 0x54, 0x50, 0x8f, 0x17, 0x4c, 0x32, 0xdb, 0x9c, 0x79, 0x0d, 0x73, 0xf6, 0x98, 0x32, 0xca,
-	0x5a, 0xca, 0x6f, 0x4e, 0x5a, 0x75, 0x7f, 0x03, 0x78, 0x32, 0x12, 0xf4, 0x75, 0x03, 0xde, 0x4a,
-	0x9c, 0x12, 0xfb, 0x39, 0xb4, 0x78, 0x19, 0xdd, 0xa4, 0xa4, 0xea, 0x83, 0x01, 0x18, 0x1e, 0x07,
-	0x4f, 0x6a, 0x85, 0x4c, 0x5e, 0x46, 0x29, 0xa9, 0xb6, 0x0a, 0x9d, 0x54, 0x78, 0x9a, 0x5d, 0xba,
-	0x1a, 0xbb, 0x61, 0x23, 0xbc, 0x22, 0x95, 0x7d, 0x01, 0xcd, 0x78, 0x8c, 0x93, 0x5c, 0xf4, 0xef,
-	0x0d, 0x8e, 0x86, 0x3d, 0x3d, 0xa4, 0x99, 0xdd, 0x90, 0xc6, 0x6e, 0xd8, 0x09, 0x36, 0x85, 0xf7,
-	0xdf, 0xe3, 0xac, 0x24, 0xfd, 0xa3, 0x01, 0x18, 0xf6, 0x82, 0x37, 0x4b, 0x85, 0x8c, 0x1f, 0x0a,
-	0x3d, 0xa5, 0x89, 0x1c, 0x97, 0x91, 0x17, 0xb3, 0xa9, 0xcf, 0x59, 0x2a, 0xcf, 0x73, 0x22, 0x3f,
-	0xb0, 0x22, 0xf5, 0x39, 0x8b, 0x53, 0x22, 0xcf, 0x63, 0x56, 0x10, 0x5f, 0x56, 0x9c, 0x08, 0x2f,
-	0x48, 0xe8, 0x8b, 0x5c, 0xd6, 0x0a, 0xe9, 0x87, 0xb6, 0x0a, 0x1d, 0x6b, 0xab, 0x16, 0xba, 0xa1,
-	0xa6, 0x2f, 0x4f, 0xe7, 0x0b, 0x64, 0x7c, 0x59, 0x20, 0xf0, 0x6b, 0x81, 0xc0, 0xfc, 0x2b, 0x02,
-	0xee, 0x37, 0x00, 0x1f, 0x8e, 0x04, 0x0d, 0x08, 0x4d, 0xf2, 0xeb, 0x5c, 0xb4, 0xcd, 0x3f, 0x02,
-	0x68, 0x5d, 0xdd, 0xde, 0x16, 0x44, 0x88, 0xae, 0xfa, 0xa4, 0x56, 0xe8, 0x11, 0xe6, 0x3c, 0x4b,
-	0x62, 0x2c, 0x13, 0x96, 0xdf, 0x60, 0x2d, 0x6f, 0x15, 0x3a, 0xd3, 0x3e, 0x7b, 0x44, 0xf7, 0x8f,
-	0x42, 0xcf, 0x0e, 0xaf, 0xd0, 0x39, 0x86, 0xff, 0xac, 0xf7, 0x84, 0xfd, 0x04, 0x60, 0x6f, 0x24,
-	0xe8, 0x75, 0x3e, 0xc1, 0x49, 0x66, 0x67, 0xd0, 0xba, 0xe2, 0xbc, 0xb9, 0xdd, 0xa5, 0x0c, 0x6b,
-	0x85, 0xac, 0x5d, 0xb2, 0x07, 0x5d, 0xb2, 0x3b, 0xa6, 0xd1, 0x16, 0xff, 0xa7, 0x09, 0x5e, 0x2e,
-	0xd7, 0x0e, 0x58, 0xad, 0x1d, 0xf0, 0x73, 0xed, 0x80, 0xcf, 0x1b, 0xc7, 0x58, 0x6d, 0x1c, 0xe3,
-	0xfb, 0xc6, 0x31, 0xde, 0x1d, 0xf4, 0x71, 0xdd, 0x82, 0xb6, 0x76, 0x91, 0xd9, 0x6e, 0xe1, 0xc5,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x7e, 0x3d, 0x78, 0xb7, 0x02, 0x00, 0x00,
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0xb5, 0x22, 0x51, 0x8e, 0xb6, 0x54, 0xe6, 0x87, 0xa2, 0x22, 0xf9, 0x22, 0x4b, 0x48,
+	0x91, 0x50, 0x63, 0x50, 0x99, 0xba, 0xc5, 0x9d, 0x00, 0x05, 0x8a, 0xa1, 0x0b, 0x4b, 0x74, 0x71,
+	0x8f, 0xab, 0x6b, 0xe7, 0xee, 0xe4, 0xbb, 0x34, 0xf5, 0xce, 0x50, 0x89, 0x85, 0x91, 0x31, 0x62,
+	0xe4, 0x2f, 0xe9, 0xd8, 0x11, 0x21, 0x71, 0x42, 0xc9, 0x82, 0x3c, 0x46, 0x62, 0x61, 0x42, 0xf6,
+	0x19, 0xa5, 0x11, 0x19, 0x2a, 0x21, 0x75, 0xf3, 0xf7, 0x9e, 0xbf, 0x7b, 0xef, 0xd9, 0xdf, 0x7d,
+	0x70, 0xf3, 0xd4, 0xc5, 0x42, 0x48, 0x77, 0x20, 0x69, 0x5b, 0x24, 0x5c, 0x71, 0xab, 0x7a, 0xda,
+	0xce, 0x91, 0xad, 0x3b, 0x94, 0x53, 0x5e, 0x40, 0x6e, 0xfe, 0x64, 0x58, 0xe7, 0x17, 0x80, 0xeb,
+	0x5d, 0x49, 0xf7, 0xf3, 0xe2, 0xb5, 0xc2, 0x11, 0xb1, 0x9e, 0xc0, 0x9a, 0x18, 0xf6, 0x7b, 0x11,
+	0x49, 0x1b, 0xa0, 0x09, 0x5a, 0x6b, 0xde, 0xfd, 0x4c, 0xa3, 0xaa, 0x18, 0xf6, 0x23, 0x92, 0xce,
+	0x34, 0x5a, 0x4f, 0xf1, 0x20, 0xde, 0x75, 0x4c, 0xed, 0xf8, 0x39, 0xf1, 0x9c, 0xa4, 0xd6, 0x0e,
+	0xac, 0x06, 0x47, 0x38, 0x64, 0xb2, 0xb1, 0xd2, 0x5c, 0x6d, 0xd5, 0x4d, 0x93, 0x41, 0xe6, 0x4d,
+	0xa6, 0x76, 0xfc, 0x92, 0xb0, 0x28, 0xbc, 0x71, 0x82, 0xe3, 0x21, 0x69, 0xac, 0x36, 0x41, 0xab,
+	0xee, 0xbd, 0x3a, 0xd7, 0xa8, 0xf2, 0x4d, 0xa3, 0x47, 0x34, 0x54, 0x47, 0xc3, 0x7e, 0x3b, 0xe0,
+	0x03, 0x57, 0xf0, 0x48, 0x6d, 0x33, 0xa2, 0x46, 0x3c, 0x89, 0x5c, 0xc1, 0x83, 0x88, 0xa8, 0xed,
+	0x80, 0x27, 0xc4, 0x55, 0xa9, 0x20, 0xb2, 0xed, 0x85, 0xf4, 0x29, 0x53, 0x99, 0x46, 0xe6, 0xa0,
+	0x99, 0x46, 0x6b, 0x46, 0xaa, 0x28, 0x1d, 0xdf, 0xc0, 0xbb, 0x9b, 0x67, 0x63, 0x54, 0xf9, 0x34,
+	0x46, 0xe0, 0xe7, 0x18, 0x81, 0xb3, 0xcf, 0x08, 0x38, 0x5f, 0x00, 0xbc, 0xd5, 0x95, 0xd4, 0x23,
+	0x34, 0x64, 0x07, 0x4c, 0x16, 0xc9, 0xdf, 0x03, 0x58, 0xeb, 0x1c, 0x1e, 0x26, 0x44, 0xca, 0x32,
+	0xfa, 0x71, 0xa6, 0xd1, 0x6d, 0x2c, 0x44, 0x1c, 0x06, 0x58, 0x85, 0x9c, 0xf5, 0xb0, 0xa1, 0x67,
+	0x1a, 0x6d, 0x19, 0x9d, 0x25, 0xa4, 0xf3, 0x5b, 0xa3, 0xc7, 0x57, 0x8f, 0x50, 0x2a, 0xfa, 0x7f,
+	0xa5, 0x97, 0x98, 0xfd, 0x00, 0x60, 0xbd, 0x2b, 0xe9, 0x01, 0x3b, 0xc6, 0x61, 0x6c, 0xc5, 0xb0,
+	0xd6, 0x11, 0x22, 0x7f, 0xbb, 0x74, 0xe9, 0x67, 0x1a, 0xd5, 0xe6, 0xce, 0x36, 0x4a, 0x67, 0xff,
+	0xe9, 0xc6, 0x48, 0x2c, 0x71, 0x33, 0x31, 0x9f, 0xce, 0x27, 0x27, 0x3c, 0x22, 0x7b, 0x71, 0x48,
+	0x98, 0xba, 0x5e, 0x4f, 0xd6, 0x4b, 0xb8, 0x66, 0x74, 0xf7, 0x8b, 0xe1, 0x6b, 0xac, 0x14, 0xe3,
+	0xf3, 0x30, 0xd3, 0x68, 0x23, 0x28, 0xf0, 0x5e, 0x39, 0xc1, 0x33, 0x8d, 0xee, 0x96, 0xa3, 0xb7,
+	0x80, 0x3b, 0xfe, 0xc2, 0x01, 0x4b, 0x42, 0x7e, 0x07, 0xf0, 0x5e, 0x57, 0xd2, 0x37, 0x09, 0x66,
+	0xf2, 0x1d, 0x49, 0x3a, 0xf3, 0xdf, 0x7c, 0xcd, 0x59, 0xf7, 0x60, 0xfd, 0x05, 0x19, 0x2d, 0x04,
+	0x7d, 0x90, 0x69, 0x74, 0x93, 0x91, 0xd1, 0xa5, 0x94, 0x96, 0xd1, 0xbc, 0x04, 0x3a, 0xfe, 0xbc,
+	0xef, 0xdf, 0x7c, 0xde, 0xb3, 0xf3, 0x89, 0x0d, 0x2e, 0x26, 0x36, 0xf8, 0x31, 0xb1, 0xc1, 0xc7,
+	0xa9, 0x5d, 0xb9, 0x98, 0xda, 0x95, 0xaf, 0x53, 0xbb, 0xf2, 0xf6, 0x4a, 0xb7, 0xaf, 0xdc, 0x32,
+	0x85, 0xe7, 0x7e, 0xb5, 0x58, 0x25, 0x3b, 0x7f, 0x06, 0x00, 0xf1, 0x11, 0x70, 0xd0, 0x7c, 0x04,
+	0x00, 0x00,
 }
 
 func (this *MsgProtoStake) Equal(that interface{}) bool {
@@ -270,6 +363,60 @@ func (this *MsgUnjail) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgRevokeClient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevokeClient)
+	if !ok {
+		that2, ok := that.(MsgRevokeClient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppAddr, that1.AppAddr) {
+		return false
+	}
+	if this.ClientPubKey != that1.ClientPubKey {
+		return false
+	}
+	return true
+}
+func (this *MsgTransferApplication) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTransferApplication)
+	if !ok {
+		that2, ok := that.(MsgTransferApplication)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppAddr, that1.AppAddr) {
+		return false
+	}
+	if this.NewPubKey != that1.NewPubKey {
+		return false
+	}
+	return true
+}
 func (m *MsgProtoStake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -379,6 +526,80 @@ func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientPubKey) > 0 {
+		i -= len(m.ClientPubKey)
+		copy(dAtA[i:], m.ClientPubKey)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.ClientPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppAddr) > 0 {
+		i -= len(m.AppAddr)
+		copy(dAtA[i:], m.AppAddr)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.AppAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewPubKey) > 0 {
+		i -= len(m.NewPubKey)
+		copy(dAtA[i:], m.NewPubKey)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.NewPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppAddr) > 0 {
+		i -= len(m.AppAddr)
+		copy(dAtA[i:], m.AppAddr)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.AppAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsg(v)
 	base := offset
@@ -437,6 +658,40 @@ func (m *MsgUnjail) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppAddr)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.ClientPubKey)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppAddr)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.NewPubKey)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
 func sovMsg(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -770,6 +1025,244 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAddr = append(m.AppAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppAddr == nil {
+				m.AppAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAddr = append(m.AppAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppAddr == nil {
+				m.AppAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsg(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,7 @@ import (
 	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 )
 
 var msgAppStake MsgStake
@@ -435,3 +436,56 @@ func TestMsgAppUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRevokeClient_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     MsgRevokeClient
+		wantErr bool
+	}{
+		{"valid revocation", MsgRevokeClient{AppAddr: sdk.Address(pk.Address()), ClientPubKey: pk.RawString()}, false},
+		{"empty app address", MsgRevokeClient{ClientPubKey: pk.RawString()}, true},
+		{"invalid client public key", MsgRevokeClient{AppAddr: sdk.Address(pk.Address()), ClientPubKey: "bad"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgTransferApplication_ValidateBasic(t *testing.T) {
+	var newPK crypto.Ed25519PublicKey
+	_, _ = rand.Read(newPK[:])
+	tests := []struct {
+		name    string
+		msg     MsgTransferApplication
+		wantErr bool
+	}{
+		{"valid transfer", MsgTransferApplication{AppAddr: sdk.Address(pk.Address()), NewPubKey: newPK.RawString()}, false},
+		{"empty app address", MsgTransferApplication{NewPubKey: newPK.RawString()}, true},
+		{"invalid new public key", MsgTransferApplication{AppAddr: sdk.Address(pk.Address()), NewPubKey: "bad"}, true},
+		{"transfer to the same key", MsgTransferApplication{AppAddr: sdk.Address(pk.Address()), NewPubKey: pk.RawString()}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgTransferApplication_SessionKeyScope(t *testing.T) {
+	// a session key cannot stake or transfer the application, the spend limit does not count either
+	for _, msgType := range []string{MsgAppStakeName, MsgAppTransferName} {
+		if err := authTypes.ValidateSessionKeyScope([]string{msgType}, sdk.ZeroInt()); err == nil {
+			t.Errorf("ValidateSessionKeyScope() of %s = nil, want an error", msgType)
+		}
+	}
+	if err := authTypes.ValidateSessionKeyScope([]string{MsgAppRevokeName}, sdk.ZeroInt()); err != nil {
+		t.Errorf("ValidateSessionKeyScope() of %s = %v, want nil", MsgAppRevokeName, err)
+	}
+}
@@ -19,6 +19,11 @@ const (
 	DefaultStabilityAdjustment int64 = 0
 	DefaultParticipationRateOn bool  = false
 	DefaultMaxChains           int64 = 15
+	DefaultUsageRelaysOn       bool  = false
+	DefaultUsageWindowSessions int64 = 24
+	DefaultUsageHeadroom       int64 = 150
+	DefaultUsageMinRelays      int64 = 50
+	DefaultUsageMaxRelays      int64 = 200
 )
 
 // Keys for parameter access
@@ -30,19 +35,29 @@ var (
 	StabilityAdjustment    = []byte("StabilityAdjustment")
 	ParticipationRateOn    = []byte("ParticipationRateOn")
 	KeyMaximumChains       = []byte("MaximumChains")
+	KeyUsageRelaysOn       = []byte("UsageRelaysOn")
+	KeyUsageWindowSessions = []byte("UsageWindowSessions")
+	KeyUsageHeadroom       = []byte("UsageHeadroomPercentage")
+	KeyUsageMinRelays      = []byte("UsageMinRelaysPercentage")
+	KeyUsageMaxRelays      = []byte("UsageMaxRelaysPercentage")
 )
 
 var _ types.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for pos module
 type Params struct {
-	UnstakingTime       time.Duration `json:"unstaking_time" yaml:"unstaking_time"`               // duration of unstaking
-	MaxApplications     int64         `json:"max_applications" yaml:"max_applications"`           // maximum number of applications
-	AppStakeMin         int64         `json:"app_stake_minimum" yaml:"app_stake_minimum"`         // minimum amount needed to stake as an application
-	BaseRelaysPerPOKT   int64         `json:"base_relays_per_pokt" yaml:"base_relays_per_pokt"`   // base relays per POKT coin staked
-	StabilityAdjustment int64         `json:"stability_adjustment" yaml:"stability_adjustment"`   // the stability adjustment from the governance
-	ParticipationRateOn bool          `json:"participation_rate_on" yaml:"participation_rate_on"` // the participation rate affects the amount minted based on staked ratio
-	MaxChains           int64         `json:"maximum_chains" yaml:"maximum_chains"`               // the maximum number of chains an app can stake for
+	UnstakingTime       time.Duration `json:"unstaking_time" yaml:"unstaking_time"`                           // duration of unstaking
+	MaxApplications     int64         `json:"max_applications" yaml:"max_applications"`                       // maximum number of applications
+	AppStakeMin         int64         `json:"app_stake_minimum" yaml:"app_stake_minimum"`                     // minimum amount needed to stake as an application
+	BaseRelaysPerPOKT   int64         `json:"base_relays_per_pokt" yaml:"base_relays_per_pokt"`               // base relays per POKT coin staked
+	StabilityAdjustment int64         `json:"stability_adjustment" yaml:"stability_adjustment"`               // the stability adjustment from the governance
+	ParticipationRateOn bool          `json:"participation_rate_on" yaml:"participation_rate_on"`             // the participation rate affects the amount minted based on staked ratio
+	MaxChains           int64         `json:"maximum_chains" yaml:"maximum_chains"`                           // the maximum number of chains an app can stake for
+	UsageRelaysOn       bool          `json:"usage_relays_on" yaml:"usage_relays_on"`                         // the claimed relays of the app move its max relays toward real demand
+	UsageWindowSessions int64         `json:"usage_window_sessions" yaml:"usage_window_sessions"`             // the number of trailing sessions the claimed relays are averaged over
+	UsageHeadroom       int64         `json:"usage_headroom_percentage" yaml:"usage_headroom_percentage"`     // the percentage of the average claimed relays an app is allowed
+	UsageMinRelays      int64         `json:"usage_min_relays_percentage" yaml:"usage_min_relays_percentage"` // the lower bound as a percentage of the stake based max relays
+	UsageMaxRelays      int64         `json:"usage_max_relays_percentage" yaml:"usage_max_relays_percentage"` // the upper bound as a percentage of the stake based max relays
 }
 
 // Implements params.ParamSet
@@ -55,6 +70,11 @@ func (p *Params) ParamSetPairs() types.ParamSetPairs {
 		{Key: StabilityAdjustment, Value: &p.StabilityAdjustment},
 		{Key: ParticipationRateOn, Value: &p.ParticipationRateOn},
 		{Key: KeyMaximumChains, Value: &p.MaxChains},
+		{Key: KeyUsageRelaysOn, Value: &p.UsageRelaysOn},
+		{Key: KeyUsageWindowSessions, Value: &p.UsageWindowSessions},
+		{Key: KeyUsageHeadroom, Value: &p.UsageHeadroom},
+		{Key: KeyUsageMinRelays, Value: &p.UsageMinRelays},
+		{Key: KeyUsageMaxRelays, Value: &p.UsageMaxRelays},
 	}
 }
 
@@ -68,6 +88,11 @@ func DefaultParams() Params {
 		StabilityAdjustment: DefaultStabilityAdjustment,
 		ParticipationRateOn: DefaultParticipationRateOn,
 		MaxChains:           DefaultMaxChains,
+		UsageRelaysOn:       DefaultUsageRelaysOn,
+		UsageWindowSessions: DefaultUsageWindowSessions,
+		UsageHeadroom:       DefaultUsageHeadroom,
+		UsageMinRelays:      DefaultUsageMinRelays,
+		UsageMaxRelays:      DefaultUsageMaxRelays,
 	}
 }
 
@@ -82,6 +107,14 @@ func (p Params) Validate() error {
 	if p.BaseRelaysPerPOKT < 0 {
 		return fmt.Errorf("invalid baseline throughput stake rate, must be above 0")
 	}
+	if p.UsageRelaysOn {
+		if p.UsageWindowSessions <= 0 {
+			return fmt.Errorf("invalid usage window, must be above 0")
+		}
+		if p.UsageHeadroom < 0 || p.UsageMinRelays < 0 || p.UsageMinRelays > p.UsageMaxRelays {
+			return fmt.Errorf("invalid usage bounds, must be positive with a minimum below the maximum")
+		}
+	}
 	// todo
 	return nil
 }
@@ -100,12 +133,22 @@ func (p Params) String() string {
   BaseRelaysPerPOKT            %d
   Stability Adjustment         %d
   Participation Rate On        %v
-  MaxChains                    %d,`,
+  MaxChains                    %d
+  Usage Relays On              %v
+  Usage Window Sessions        %d
+  Usage Headroom Percentage    %d
+  Usage Min Relays Percentage  %d
+  Usage Max Relays Percentage  %d,`,
 		p.UnstakingTime,
 		p.MaxApplications,
 		p.AppStakeMin,
 		p.BaseRelaysPerPOKT,
 		p.StabilityAdjustment,
 		p.ParticipationRateOn,
-		p.MaxChains)
+		p.MaxChains,
+		p.UsageRelaysOn,
+		p.UsageWindowSessions,
+		p.UsageHeadroom,
+		p.UsageMinRelays,
+		p.UsageMaxRelays)
 }
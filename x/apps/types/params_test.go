@@ -21,6 +21,11 @@ func TestDefaultParams(t *testing.T) {
 				StabilityAdjustment: DefaultStabilityAdjustment,
 				ParticipationRateOn: DefaultParticipationRateOn,
 				MaxChains:           DefaultMaxChains,
+				UsageRelaysOn:       DefaultUsageRelaysOn,
+				UsageWindowSessions: DefaultUsageWindowSessions,
+				UsageHeadroom:       DefaultUsageHeadroom,
+				UsageMinRelays:      DefaultUsageMinRelays,
+				UsageMaxRelays:      DefaultUsageMaxRelays,
 			},
 		}}
 	for _, tt := range tests {
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// RelayAllowance - the max relays of an application along with the inputs they were computed from
+type RelayAllowance struct {
+	Address        sdk.Address `json:"address" yaml:"address"`
+	StakedTokens   sdk.BigInt  `json:"staked_tokens" yaml:"staked_tokens"`
+	StakeRelays    sdk.BigInt  `json:"stake_relays" yaml:"stake_relays"`                             // the max relays derived from the stake alone
+	UsageRelaysOn  bool        `json:"usage_relays_on" yaml:"usage_relays_on"`                       // whether the claimed relays are taken into account
+	WindowSessions int64       `json:"window_sessions" yaml:"window_sessions"`                       // the number of trailing sessions considered
+	WindowFilled   bool        `json:"window_filled" yaml:"window_filled"`                           // whether the usage of the whole window is recorded
+	ClaimedRelays  int64       `json:"claimed_relays" yaml:"claimed_relays"`                         // the relays claimed in the trailing window
+	AverageRelays  sdk.BigInt  `json:"average_relays_per_session" yaml:"average_relays_per_session"` // the claimed relays per session in the window
+	MinRelays      sdk.BigInt  `json:"min_relays" yaml:"min_relays"`                                 // the governance lower bound
+	MaxRelays      sdk.BigInt  `json:"max_relays" yaml:"max_relays"`                                 // the governance upper bound
+	AllowedRelays  sdk.BigInt  `json:"allowed_relays" yaml:"allowed_relays"`                         // the resulting max relays of the application
+}
+
+// String returns a human readable string representation of the relay allowance
+func (r RelayAllowance) String() string {
+	bz, _ := json.MarshalIndent(r, "", "  ")
+	return string(bz)
+}
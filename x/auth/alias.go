@@ -30,6 +30,7 @@ var (
 	RegisterCodec             = types.RegisterCodec
 	CountSubKeys              = types.CountSubKeys
 	StdSignBytes              = types.StdSignBytes
+	StdSignBytesWithFeePayer  = types.StdSignBytesWithFeePayer
 	DefaultTxDecoder          = types.DefaultTxDecoder
 	DefaultTxEncoder          = types.DefaultTxEncoder
 	NewTxBuilder              = types.NewTxBuilder
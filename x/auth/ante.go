@@ -14,8 +14,9 @@ import (
 	"os"
 )
 
-// NewAnteHandler returns an AnteHandler that checks signatures and deducts fees from the first signer.
-func NewAnteHandler(ak keeper.Keeper) sdk.AnteHandler {
+// NewAnteHandler returns an AnteHandler that checks signatures and deducts fees from the first signer. With the fee
+// market enabled, the txs checked for the mempool must tip at least minTip above the base fee.
+func NewAnteHandler(ak keeper.Keeper, minTip int64) sdk.AnteHandler {
 	return func(ctx sdk.Ctx, tx sdk.Tx, txBz []byte, txIndexer txindex.TxIndexer, simulate bool) (newCtx sdk.Ctx, res sdk.Result, signer posCrypto.PublicKey, abort bool) {
 		if addr := ak.GetModuleAddress(types.FeeCollectorName); addr == nil {
 			ctx.Logger().Error(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
@@ -33,6 +34,12 @@ func NewAnteHandler(ak keeper.Keeper) sdk.AnteHandler {
 		if err != nil {
 			return newCtx, err.Result(), signer, true
 		}
+		// the tip is local mempool policy, the delivery of a tx in a block does not check it
+		if ctx.IsCheckTx() && !simulate {
+			if err := ValidateTip(ak, ctx, stdTx, minTip); err != nil {
+				return newCtx, err.Result(), signer, true
+			}
+		}
 		err = DeductFees(ak, ctx, stdTx, signer)
 		if err != nil {
 			return newCtx, err.Result(), signer, true
@@ -54,6 +61,10 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 		return nil, types.ErrNilTxIndexer(ModuleName)
 	}
 	res, err := (txIndexer).Get(txHash)
+	if _, ok := err.(sdk.TxNotRetainedError); ok {
+		// the indexer keeps only the hash of the tx, which is still a replay
+		return nil, types.ErrDuplicateTx(ModuleName, hex.EncodeToString(txHash))
+	}
 	if err != nil {
 		ctx.Logger().Error(err.Error())
 		return nil, sdk.ErrInternal(err.Error())
@@ -82,7 +93,17 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 		}
 		//patch sync fix : add Verify against after codec upgrade chainhalt height
 		if !bytes.Equal(pk.Address(), signer) && ctx.BlockHeight() != codec.CodecChainHaltHeight {
-			continue
+			// a session key granted by the signer may sign the message types it is scoped to
+			if !k.IsSessionKeysActivated(ctx) {
+				continue
+			}
+			sk, found := k.GetSessionKey(ctx, signer, sdk.Address(pk.Address()))
+			if !found {
+				continue
+			}
+			if err := sk.Authorize(stdTx.GetMsg(), ctx.BlockHeight()); err != nil {
+				return nil, err
+			}
 		}
 		// get the sign bytes from the tx
 		signBytes, err := GetSignBytes(ctx.ChainID(), stdTx)
@@ -90,7 +111,7 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 			return nil, sdk.ErrInternal(err.Error())
 		}
 		// get the fees from the tx
-		expectedFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, k.GetParams(ctx).FeeMultiplier.GetFee(stdTx.GetMsg())))
+		expectedFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, k.GetMinFee(ctx, stdTx.GetMsg())))
 		// test for public key type
 		p, ok := pk.(posCrypto.PublicKeyMultiSig)
 		// if standard public key
@@ -170,20 +191,49 @@ func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCry
 	if !fees.IsValid() {
 		return sdk.ErrInsufficientFee(fmt.Sprintf("invalid fee amount: %s", fees))
 	}
-	var acc Account
-	var err sdk.Error
-
-	if keeper.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
-		acc, err = GetSignerAcc(ctx, keeper, sdk.Address(signer.Address()))
-		if err != nil {
+	var payer sdk.Address
+	// the signer is a session key only if it is not one of the signers of the message
+	sk, isSessionKey := keeper.GetSignerSessionKey(ctx, tx.GetMsg(), signer)
+	if isSessionKey {
+		if err := sk.Authorize(tx.GetMsg(), ctx.BlockHeight()); err != nil {
 			return err
 		}
-	} else {
-		acc, err = GetSignerAcc(ctx, keeper, tx.GetSigners()[0])
-		if err != nil {
+	}
+	switch {
+	case isSessionKey:
+		// the granter of a session key pays the fees
+		payer = sk.Granter
+	case keeper.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()):
+		payer = sdk.Address(signer.Address())
+	default:
+		payer = tx.GetSigners()[0]
+	}
+	// the fees paid by the granter of a session key count with the tokens sent against the spend limit
+	sessionKeySpend := fees.AmountOf(sdk.DefaultStakeDenom)
+	if len(tx.GetFeePayer()) != 0 {
+		sessionKeySpend = sdk.ZeroInt()
+	}
+	if isSessionKey {
+		if err := keeper.SpendSessionKey(ctx, sk, sessionKeySpend.Add(types.MsgSpend(tx.GetMsg()))); err != nil {
 			return err
 		}
 	}
+	// the fee payer pays the fees under the fee allowance it granted to the payer
+	if feePayer := tx.GetFeePayer(); len(feePayer) != 0 {
+		if err := keeper.UseFeeAllowance(ctx, feePayer, payer, tx.GetMsg(), fees.AmountOf(sdk.DefaultStakeDenom)); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, payer.String()),
+		))
+		payer = feePayer
+	}
+	acc, err := GetSignerAcc(ctx, keeper, payer)
+	if err != nil {
+		return err
+	}
 
 	coins := acc.GetCoins()
 	// verify the account has enough funds to pay for fees
@@ -195,13 +245,44 @@ func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCry
 	if err != nil {
 		return err
 	}
+	if keeper.IsFeeMarketEnabled(ctx) {
+		return CollectBaseFee(keeper, ctx, tx)
+	}
+	return nil
+}
+
+// ValidateTip checks the tip of the tx above the base fee is at least minTip, so a node under load admits to its
+// mempool the txs tipping above its minimum and evicts, on recheck, those a rise of the base fee left under it
+func ValidateTip(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, minTip int64) sdk.Error {
+	if minTip <= 0 || !keeper.IsFeeMarketEnabled(ctx) {
+		return nil
+	}
+	tip := tx.GetFee().AmountOf(sdk.DefaultStakeDenom).Sub(keeper.GetMinFee(ctx, tx.GetMsg()))
+	if tip.LT(sdk.NewInt(minTip)) {
+		return types.ErrInsufficientTip(ModuleName, sdk.NewInt(minTip), tip)
+	}
+	return nil
+}
+
+// CollectBaseFee takes the base fee out of the deducted fees, leaving the tip above it in the fee collector
+func CollectBaseFee(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx) sdk.Error {
+	paid := tx.GetFee().AmountOf(sdk.DefaultStakeDenom)
+	baseFee := sdk.MinInt(keeper.GetMinFee(ctx, tx.GetMsg()), paid)
+	if err := keeper.CollectBaseFee(ctx, baseFee); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFee,
+		sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+		sdk.NewAttribute(types.AttributeKeyTip, paid.Sub(baseFee).String()),
+	))
 	return nil
 }
 
 // GetSignBytes returns a slice of bytes to sign over for a given transaction
 // and an account.
 func GetSignBytes(chainID string, stdTx types.StdTx) ([]byte, error) {
-	return StdSignBytes(
-		chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsg(), stdTx.GetMemo(),
+	return StdSignBytesWithFeePayer(
+		chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsg(), stdTx.GetMemo(), stdTx.GetFeePayer(),
 	)
 }
@@ -1,8 +1,12 @@
 package auth
 
 import (
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/stretchr/testify/assert"
+	dbm "github.com/tendermint/tm-db"
 	"testing"
 )
 
@@ -28,3 +32,150 @@ func TestSignatureDepth(t *testing.T) {
 	assert.True(t, ValidateSignatureDepth(5, mspk))
 	assert.False(t, ValidateSignatureDepth(4, mspk))
 }
+
+func TestAnteHandler_FeeMarket(t *testing.T) {
+	ctx, k := createTestAnteInput(t)
+	pk, addr := createTestAnteAccount(t, ctx, k, 1000000)
+	msg := &types.MsgRevokeSessionKey{Granter: addr, Address: addr}
+	params := k.GetParams(ctx)
+	params.FeeMarket.Enabled = true
+	k.SetParams(ctx, params)
+	k.SetBaseFee(ctx, 2*types.BaseFeeDenominator)
+	minFee := k.GetMinFee(ctx, msg).Int64()
+	assert.Equal(t, 2*k.GetParams(ctx).FeeMultiplier.GetFee(msg).Int64(), minFee)
+	// below the base fee
+	tx, bz := newTestAnteTx(t, ctx, msg, pk, minFee-1, nil)
+	assert.Equal(t, types.CodeInsufficientFee, runTestAnte(ctx, k, tx, bz).Code)
+	// the base fee is burned and the tip is left in the fee collector
+	supply := k.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultStakeDenom)
+	tx, bz = newTestAnteTx(t, ctx, msg, pk, minFee+100, nil)
+	assert.True(t, runTestAnte(ctx, k, tx, bz).IsOK())
+	assert.True(t, stakeBalance(ctx, k, addr).Equal(sdk.NewInt(1000000-minFee-100)))
+	assert.True(t, stakeBalance(ctx, k, k.GetModuleAddress(types.FeeCollectorName)).Equal(sdk.NewInt(100)))
+	assert.True(t, k.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultStakeDenom).Equal(supply.SubRaw(minFee)))
+	// the fee of the automatic txs covers the base fee after a full block
+	assert.True(t, k.GetFee(ctx, msg).Equal(types.MinFee(k.GetParams(ctx).FeeMultiplier.GetFee(msg), 22500)))
+	// the mempool of a node with a minimum tip only admits the txs tipping at least that much above the base fee
+	anteHandler := NewAnteHandler(k, 50)
+	checkTx := func(ctx sdk.Ctx, fee int64) sdk.Result {
+		tx, bz := newTestAnteTx(t, ctx, msg, pk, fee, nil)
+		_, res, _, _ := anteHandler(ctx, tx, bz, sdk.NewTransactionIndexer(dbm.NewMemDB()), false)
+		return res
+	}
+	assert.Equal(t, types.CodeInsufficientTip, checkTx(ctx.WithIsCheckTx(true), minFee+49).Code)
+	assert.True(t, checkTx(ctx.WithIsCheckTx(true), minFee+50).IsOK())
+	// while a block delivers the txs tipping under it
+	assert.True(t, checkTx(ctx, minFee+1).IsOK())
+	// and it has no minimum tip without the fee market
+	params.FeeMarket.Enabled = false
+	k.SetParams(ctx, params)
+	assert.True(t, checkTx(ctx.WithIsCheckTx(true), k.GetMinFee(ctx, msg).Int64()).IsOK())
+}
+
+func TestAnteHandler_SessionKey(t *testing.T) {
+	ctx, k := createTestAnteInput(t)
+	codec.UpgradeFeatureMap[codec.SessionKeysKey] = 10
+	defer delete(codec.UpgradeFeatureMap, codec.SessionKeysKey)
+	_, granter := createTestAnteAccount(t, ctx, k, 1000000)
+	sessionKey := crypto.GenerateEd25519PrivKey()
+	msg := &types.MsgRevokeSessionKey{Granter: granter, Address: granter}
+	fee := k.GetMinFee(ctx, msg).Int64()
+	_, err := k.GrantSessionKey(ctx, types.MsgGrantSessionKey{
+		Granter:          granter,
+		PublicKey:        sessionKey.PublicKey().RawString(),
+		MsgTypes:         []string{types.MsgRevokeSessionKeyName},
+		SpendLimit:       sdk.NewInt(2*fee + 1),
+		ExpirationHeight: 20,
+	})
+	assert.Nil(t, err)
+	// the granter pays the fees of the txs signed by the session key
+	tx, bz := newTestAnteTx(t, ctx, msg, sessionKey, fee, nil)
+	assert.True(t, runTestAnte(ctx, k, tx, bz).IsOK())
+	assert.True(t, stakeBalance(ctx, k, granter).Equal(sdk.NewInt(1000000-fee)))
+	// out of scope
+	outOfScope := &types.MsgRevokeFeeAllowance{Granter: granter, Grantee: granter}
+	tx, bz = newTestAnteTx(t, ctx, outOfScope, sessionKey, k.GetMinFee(ctx, outOfScope).Int64(), nil)
+	assert.Equal(t, types.CodeUnauthorizedSession, runTestAnte(ctx, k, tx, bz).Code)
+	assert.NotNil(t, DeductFees(k, ctx, tx, sessionKey.PublicKey()))
+	// expired
+	tx, bz = newTestAnteTx(t, ctx, msg, sessionKey, fee, nil)
+	assert.Equal(t, types.CodeUnauthorizedSession, runTestAnte(ctx.WithBlockHeight(20), k, tx, bz).Code)
+	assert.NotNil(t, DeductFees(k, ctx.WithBlockHeight(20), tx, sessionKey.PublicKey()))
+	// above the spend limit
+	tx, bz = newTestAnteTx(t, ctx, msg, sessionKey, fee+2, nil)
+	assert.Equal(t, types.CodeUnauthorizedSession, runTestAnte(ctx, k, tx, bz).Code)
+	tx, bz = newTestAnteTx(t, ctx, msg, sessionKey, fee+1, nil)
+	assert.True(t, runTestAnte(ctx, k, tx, bz).IsOK())
+	sk, _ := k.GetSessionKey(ctx, granter, sdk.Address(sessionKey.PublicKey().Address()))
+	assert.True(t, sk.Spent.Equal(sk.SpendLimit))
+	assert.True(t, stakeBalance(ctx, k, granter).Equal(sdk.NewInt(1000000-2*fee-1)))
+}
+
+func TestAnteHandler_FeePayer(t *testing.T) {
+	ctx, k := createTestAnteInput(t)
+	codec.UpgradeFeatureMap[codec.FeeGrantKey] = 10
+	defer delete(codec.UpgradeFeatureMap, codec.FeeGrantKey)
+	pk, grantee := createTestAnteAccount(t, ctx, k, 1000000)
+	_, feePayer := createTestAnteAccount(t, ctx, k, 1000000)
+	msg := &types.MsgRevokeSessionKey{Granter: grantee, Address: grantee}
+	fee := k.GetMinFee(ctx, msg).Int64()
+	// no fee allowance
+	tx, bz := newTestAnteTx(t, ctx, msg, pk, fee, feePayer)
+	assert.Equal(t, types.CodeFeeAllowanceNotFound, runTestAnte(ctx, k, tx, bz).Code)
+	_, err := k.GrantFeeAllowance(ctx, types.MsgGrantFeeAllowance{
+		Granter:    feePayer,
+		Grantee:    grantee,
+		SpendLimit: sdk.NewInt(fee),
+	})
+	assert.Nil(t, err)
+	// the fee payer pays the fees of the grantee under the allowance
+	assert.True(t, runTestAnte(ctx, k, tx, bz).IsOK())
+	assert.True(t, stakeBalance(ctx, k, grantee).Equal(sdk.NewInt(1000000)))
+	assert.True(t, stakeBalance(ctx, k, feePayer).Equal(sdk.NewInt(1000000-fee)))
+	fa, _ := k.GetFeeAllowance(ctx, feePayer, grantee)
+	assert.True(t, fa.Spent.Equal(sdk.NewInt(fee)))
+	// above the spend limit
+	tx, bz = newTestAnteTx(t, ctx, msg, pk, fee+1, feePayer)
+	assert.Equal(t, types.CodeUnauthorizedFeeAllowance, runTestAnte(ctx, k, tx, bz).Code)
+	// the fee payer is part of the signed bytes
+	tx.FeePayer = grantee
+	assert.Equal(t, sdk.CodeUnauthorized, runTestAnte(ctx, k, tx, bz).Code)
+}
+
+func TestAnteHandler_FeePayerWithSessionKey(t *testing.T) {
+	ctx, k := createTestAnteInput(t)
+	codec.UpgradeFeatureMap[codec.FeeGrantKey] = 10
+	codec.UpgradeFeatureMap[codec.SessionKeysKey] = 10
+	defer delete(codec.UpgradeFeatureMap, codec.FeeGrantKey)
+	defer delete(codec.UpgradeFeatureMap, codec.SessionKeysKey)
+	_, granter := createTestAnteAccount(t, ctx, k, 1000000)
+	_, feePayer := createTestAnteAccount(t, ctx, k, 1000000)
+	sessionKey := crypto.GenerateEd25519PrivKey()
+	msg := &types.MsgRevokeSessionKey{Granter: granter, Address: granter}
+	fee := k.GetMinFee(ctx, msg).Int64()
+	_, err := k.GrantSessionKey(ctx, types.MsgGrantSessionKey{
+		Granter:    granter,
+		PublicKey:  sessionKey.PublicKey().RawString(),
+		MsgTypes:   []string{types.MsgRevokeSessionKeyName},
+		SpendLimit: sdk.NewInt(1),
+	})
+	assert.Nil(t, err)
+	// the allowance is granted to the granter of the session key, not to the session key
+	_, err = k.GrantFeeAllowance(ctx, types.MsgGrantFeeAllowance{
+		Granter: feePayer,
+		Grantee: sdk.Address(sessionKey.PublicKey().Address()),
+	})
+	assert.Nil(t, err)
+	tx, bz := newTestAnteTx(t, ctx, msg, sessionKey, fee, feePayer)
+	assert.Equal(t, types.CodeFeeAllowanceNotFound, runTestAnte(ctx, k, tx, bz).Code)
+	_, err = k.GrantFeeAllowance(ctx, types.MsgGrantFeeAllowance{Granter: feePayer, Grantee: granter})
+	assert.Nil(t, err)
+	// the fees paid by the fee payer do not count against the spend limit of the session key
+	assert.True(t, runTestAnte(ctx, k, tx, bz).IsOK())
+	assert.True(t, stakeBalance(ctx, k, granter).Equal(sdk.NewInt(1000000)))
+	assert.True(t, stakeBalance(ctx, k, feePayer).Equal(sdk.NewInt(1000000-fee)))
+	sk, _ := k.GetSessionKey(ctx, granter, sdk.Address(sessionKey.PublicKey().Address()))
+	assert.True(t, sk.Spent.IsZero())
+	fa, _ := k.GetFeeAllowance(ctx, feePayer, granter)
+	assert.True(t, fa.Spent.Equal(sdk.NewInt(fee)))
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	cdcTypes "github.com/pokt-network/pocket-core/codec/types"
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/store"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/keeper"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// createTestAnteInput - Returns a context at height 10 and a keeper with the fee collector and dao module accounts
+func createTestAnteInput(t *testing.T) (sdk.Context, keeper.Keeper) {
+	keyAcc := sdk.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db, false, 5000000)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(sdk.ParamsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(sdk.ParamsTKey, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "ante-chain", Height: 10}, false, log.NewNopLogger()).WithAppVersion("0.0.0")
+	cdc := codec.NewCodec(cdcTypes.NewInterfaceRegistry())
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	crypto.RegisterAmino(cdc.AminoCodec().Amino)
+	maccPerms := map[string][]string{
+		types.FeeCollectorName: {types.Burner},
+		types.DAOAccountName:   {types.Burner},
+	}
+	k := keeper.NewKeeper(cdc, keyAcc, sdk.NewSubspace(types.StoreKey), maccPerms)
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetSupply(ctx, types.NewSupply(sdk.NewCoins()))
+	k.GetModuleAccount(ctx, types.FeeCollectorName)
+	k.GetModuleAccount(ctx, types.DAOAccountName)
+	return ctx, k
+}
+
+// createTestAnteAccount - Creates an account holding the amount of stake tokens
+func createTestAnteAccount(t *testing.T, ctx sdk.Ctx, k keeper.Keeper, amount int64) (crypto.PrivateKey, sdk.Address) {
+	pk := crypto.GenerateEd25519PrivKey()
+	addr := sdk.Address(pk.PublicKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(amount)))
+	acc := types.NewBaseAccountWithAddress(addr)
+	require.Nil(t, acc.SetCoins(coins))
+	k.SetAccount(ctx, &acc)
+	supply := k.GetSupply(ctx)
+	k.SetSupply(ctx, supply.Inflate(coins))
+	return pk, addr
+}
+
+// newTestAnteTx - Returns the tx signed by the key and its bytes
+func newTestAnteTx(t *testing.T, ctx sdk.Ctx, msg sdk.ProtoMsg, signer crypto.PrivateKey, fee int64, feePayer sdk.Address) (types.StdTx, []byte) {
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
+	entropy := int64(len(ctx.ChainID())) + fee
+	signBytes, err := types.StdSignBytesWithFeePayer(ctx.ChainID(), entropy, fees, msg, "", feePayer)
+	require.Nil(t, err)
+	sig, err := signer.Sign(signBytes)
+	require.Nil(t, err)
+	tx := types.StdTx{
+		Msg:       msg,
+		Fee:       fees,
+		Signature: types.StdSignature{PublicKey: signer.PublicKey(), Signature: sig},
+		Entropy:   entropy,
+		FeePayer:  feePayer,
+	}
+	bz, err := tx.Marshal()
+	require.Nil(t, err)
+	return tx, bz
+}
+
+// runTestAnte - Runs the ante handler over the tx, returning its result
+func runTestAnte(ctx sdk.Ctx, k keeper.Keeper, tx types.StdTx, txBz []byte) sdk.Result {
+	_, res, _, _ := NewAnteHandler(k, 0)(ctx, tx, txBz, sdk.NewTransactionIndexer(dbm.NewMemDB()), false)
+	return res
+}
+
+// stakeBalance - Returns the stake tokens of the account
+func stakeBalance(ctx sdk.Ctx, k keeper.Keeper, addr sdk.Address) sdk.BigInt {
+	return k.GetCoins(ctx, addr).AmountOf(sdk.DefaultStakeDenom)
+}
@@ -13,7 +13,10 @@ func ExportGenesis(ctx sdk.Ctx, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	accounts := k.GetAllAccountsExport(ctx)
 	supply := k.GetSupply(ctx)
-	return types.NewGenesisState(params, accounts, supply.GetTotal())
+	gs := types.NewGenesisState(params, accounts, supply.GetTotal())
+	gs.SessionKeys = k.GetAllSessionKeys(ctx)
+	gs.FeeAllowances = k.GetAllFeeAllowances(ctx)
+	return gs
 }
 
 // InitGenesis sets supply information for genesis.
@@ -45,4 +48,10 @@ func InitGenesis(ctx sdk.Ctx, k keeper.Keeper, data types.GenesisState) {
 		data.Supply = totalSupply
 	}
 	k.SetSupply(ctx, types.NewSupply(data.Supply))
+	for _, sk := range data.SessionKeys {
+		k.SetSessionKey(ctx, sk)
+	}
+	for _, fa := range data.FeeAllowances {
+		k.SetFeeAllowance(ctx, fa)
+	}
 }
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/keeper"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+// NewHandler returns a handler for "auth" type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Ctx, msg sdk.Msg, _ crypto.PublicKey) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		// convert to value for switch consistency
+		if reflect.ValueOf(msg).Kind() == reflect.Ptr {
+			msg = reflect.Indirect(reflect.ValueOf(msg)).Interface().(sdk.Msg)
+		}
+		errMsg := fmt.Sprintf("unrecognized auth message type: %T", msg)
+		switch msg := msg.(type) {
+		case types.MsgGrantSessionKey:
+			if !k.IsSessionKeysActivated(ctx) {
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgGrantSessionKey(ctx, msg, k)
+		case types.MsgRevokeSessionKey:
+			if !k.IsSessionKeysActivated(ctx) {
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgRevokeSessionKey(ctx, msg, k)
+		case types.MsgGrantFeeAllowance:
+			if !k.IsFeeGrantActivated(ctx) {
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgGrantFeeAllowance(ctx, msg, k)
+		case types.MsgRevokeFeeAllowance:
+			if !k.IsFeeGrantActivated(ctx) {
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgRevokeFeeAllowance(ctx, msg, k)
+		default:
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrantSessionKey(ctx sdk.Ctx, msg types.MsgGrantSessionKey, k keeper.Keeper) sdk.Result {
+	sk, err := k.GrantSessionKey(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	return sessionKeyResult(ctx, types.MsgGrantSessionKeyName, sk.Granter, sk.Address)
+}
+
+func handleMsgRevokeSessionKey(ctx sdk.Ctx, msg types.MsgRevokeSessionKey, k keeper.Keeper) sdk.Result {
+	if err := k.RevokeSessionKey(ctx, msg.Granter, msg.Address); err != nil {
+		return err.Result()
+	}
+	return sessionKeyResult(ctx, types.MsgRevokeSessionKeyName, msg.Granter, msg.Address)
+}
+
+func sessionKeyResult(ctx sdk.Ctx, action string, granter, addr sdk.Address) sdk.Result {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSessionKey,
+			sdk.NewAttribute(sdk.AttributeKeyAction, action),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, granter.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgGrantFeeAllowance(ctx sdk.Ctx, msg types.MsgGrantFeeAllowance, k keeper.Keeper) sdk.Result {
+	fa, err := k.GrantFeeAllowance(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	return feeAllowanceResult(ctx, types.MsgGrantFeeAllowanceName, fa.Granter, fa.Grantee)
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Ctx, msg types.MsgRevokeFeeAllowance, k keeper.Keeper) sdk.Result {
+	if err := k.RevokeFeeAllowance(ctx, msg.Granter, msg.Grantee); err != nil {
+		return err.Result()
+	}
+	return feeAllowanceResult(ctx, types.MsgRevokeFeeAllowanceName, msg.Granter, msg.Grantee)
+}
+
+func feeAllowanceResult(ctx sdk.Ctx, action string, granter, grantee sdk.Address) sdk.Result {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeeAllowance,
+			sdk.NewAttribute(sdk.AttributeKeyAction, action),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, granter.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -223,6 +223,13 @@ func (k Keeper) HasCoins(ctx sdk.Ctx, addr sdk.Address, amt sdk.Coins) bool {
 	return k.GetCoins(ctx, addr).IsAllGTE(amt)
 }
 
+// GetFee returns the fee to pay for the message. With the fee market enabled it is scaled by the base fee
+// after a full block, so a tx broadcast now still covers the base fee of the block that includes it.
 func (k Keeper) GetFee(ctx sdk.Ctx, msg sdk.Msg) sdk.BigInt {
-	return k.GetParams(ctx).FeeMultiplier.GetFee(msg)
+	if !k.IsFeeMarketEnabled(ctx) {
+		return k.GetMinFee(ctx, msg)
+	}
+	p := k.GetParams(ctx).FeeMarket
+	baseFee := p.NextBaseFee(k.GetBaseFee(ctx), 2*p.TargetBlockTxs)
+	return types.MinFee(k.GetParams(ctx).FeeMultiplier.GetFee(msg), baseFee)
 }
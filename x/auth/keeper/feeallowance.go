@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+// IsFeeGrantActivated - Returns true if accounts may pay the fees of other accounts
+func (k Keeper) IsFeeGrantActivated(ctx sdk.Ctx) bool {
+	return k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.FeeGrantKey)
+}
+
+// GrantFeeAllowance - Allows the grantee to pay fees from the account of the granter, replacing any previous
+// allowance to the grantee
+func (k Keeper) GrantFeeAllowance(ctx sdk.Ctx, msg types.MsgGrantFeeAllowance) (types.FeeAllowance, sdk.Error) {
+	if k.GetAccount(ctx, msg.Granter) == nil {
+		return types.FeeAllowance{}, types.ErrAccountNotFound(types.ModuleName)
+	}
+	if msg.ExpirationHeight != 0 && msg.ExpirationHeight <= ctx.BlockHeight() {
+		return types.FeeAllowance{}, types.ErrInvalidFeeAllowance(types.ModuleName, fmt.Sprintf("expiration height %d is not after the current height", msg.ExpirationHeight))
+	}
+	fa := types.FeeAllowance{
+		Granter:          msg.Granter,
+		Grantee:          msg.Grantee,
+		SpendLimit:       msg.SpendLimit,
+		Spent:            sdk.ZeroInt(),
+		ExpirationHeight: msg.ExpirationHeight,
+		MsgTypes:         msg.MsgTypes,
+	}
+	if fa.SpendLimit.IsZero() {
+		fa.SpendLimit = sdk.ZeroInt()
+	}
+	k.SetFeeAllowance(ctx, fa)
+	return fa, nil
+}
+
+// RevokeFeeAllowance - Removes the fee allowance granted by the account to the grantee
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) sdk.Error {
+	if _, found := k.GetFeeAllowance(ctx, granter, grantee); !found {
+		return types.ErrFeeAllowanceNotFound(types.ModuleName, granter, grantee)
+	}
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.KeyForFeeAllowance(granter, grantee))
+	return nil
+}
+
+// UseFeeAllowance - Counts the fee of the message against the fee allowance granted by the fee payer to the grantee
+func (k Keeper) UseFeeAllowance(ctx sdk.Ctx, feePayer, grantee sdk.Address, msg sdk.Msg, fee sdk.BigInt) sdk.Error {
+	if !k.IsFeeGrantActivated(ctx) {
+		return types.ErrUnauthorizedFeeAllowance(types.ModuleName, "fee grants are not activated")
+	}
+	fa, found := k.GetFeeAllowance(ctx, feePayer, grantee)
+	if !found {
+		return types.ErrFeeAllowanceNotFound(types.ModuleName, feePayer, grantee)
+	}
+	if err := fa.Authorize(msg, ctx.BlockHeight()); err != nil {
+		return err
+	}
+	fa, err := fa.Spend(fee)
+	if err != nil {
+		return err
+	}
+	k.SetFeeAllowance(ctx, fa)
+	return nil
+}
+
+// SetFeeAllowance - Store the fee allowance
+func (k Keeper) SetFeeAllowance(ctx sdk.Ctx, fa types.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.MarshalBinaryLengthPrefixed(&fa, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal fee allowance: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForFeeAllowance(fa.Granter, fa.Grantee), bz)
+}
+
+// GetFeeAllowance - Retrieve the fee allowance granted by the account to the grantee
+func (k Keeper) GetFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) (fa types.FeeAllowance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForFeeAllowance(granter, grantee))
+	if bz == nil {
+		return
+	}
+	if err := k.Cdc.UnmarshalBinaryLengthPrefixed(bz, &fa, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal fee allowance at height %d: %s", ctx.BlockHeight(), err.Error()))
+		return
+	}
+	return fa, true
+}
+
+// GetFeeAllowances - Retrieve the fee allowances granted by the account
+func (k Keeper) GetFeeAllowances(ctx sdk.Ctx, granter sdk.Address) []types.FeeAllowance {
+	return k.iterateFeeAllowances(ctx, types.KeyForFeeAllowances(granter))
+}
+
+// GetAllFeeAllowances - Retrieve the fee allowances granted by every account
+func (k Keeper) GetAllFeeAllowances(ctx sdk.Ctx) []types.FeeAllowance {
+	return k.iterateFeeAllowances(ctx, types.FeeAllowancesPrefix)
+}
+
+func (k Keeper) iterateFeeAllowances(ctx sdk.Ctx, prefix []byte) (feeAllowances []types.FeeAllowance) {
+	feeAllowances = make([]types.FeeAllowance, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fa types.FeeAllowance
+		if err := k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &fa, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal fee allowance at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		feeAllowances = append(feeAllowances, fa)
+	}
+	return
+}
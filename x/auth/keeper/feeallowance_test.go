@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_FeeAllowances(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, 10, 2)
+	ctx = ctx.WithBlockHeight(10)
+	codec.UpgradeFeatureMap[codec.FeeGrantKey] = 10
+	defer delete(codec.UpgradeFeatureMap, codec.FeeGrantKey)
+	accs := keeper.GetAllAccounts(ctx)
+	granter := accs[0].GetAddress()
+	grantee := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	msg := types.MsgGrantFeeAllowance{
+		Granter:          granter,
+		Grantee:          grantee,
+		SpendLimit:       sdk.NewInt(100),
+		ExpirationHeight: 20,
+		MsgTypes:         []string{"claim"},
+	}
+	// unknown granter
+	unknown := msg
+	unknown.Granter = sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	_, err := keeper.GrantFeeAllowance(ctx, unknown)
+	assert.NotNil(t, err)
+	// already expired
+	expired := msg
+	expired.ExpirationHeight = 10
+	_, err = keeper.GrantFeeAllowance(ctx, expired)
+	assert.NotNil(t, err)
+	_, err = keeper.GrantFeeAllowance(ctx, msg)
+	assert.Nil(t, err)
+	assert.Len(t, keeper.GetFeeAllowances(ctx, granter), 1)
+	assert.Len(t, keeper.GetFeeAllowances(ctx, accs[1].GetAddress()), 0)
+	claim := testMsg{signers: []sdk.Address{grantee}}
+	// not before the activation of the feature
+	assert.NotNil(t, keeper.UseFeeAllowance(ctx.WithBlockHeight(9), granter, grantee, claim, sdk.NewInt(60)))
+	// not for another grantee or fee payer
+	assert.NotNil(t, keeper.UseFeeAllowance(ctx, granter, accs[1].GetAddress(), claim, sdk.NewInt(60)))
+	assert.NotNil(t, keeper.UseFeeAllowance(ctx, accs[1].GetAddress(), grantee, claim, sdk.NewInt(60)))
+	// not after the expiration
+	assert.NotNil(t, keeper.UseFeeAllowance(ctx.WithBlockHeight(20), granter, grantee, claim, sdk.NewInt(60)))
+	// spend limit
+	assert.Nil(t, keeper.UseFeeAllowance(ctx, granter, grantee, claim, sdk.NewInt(60)))
+	fa, found := keeper.GetFeeAllowance(ctx, granter, grantee)
+	assert.True(t, found)
+	assert.True(t, sdk.NewInt(60).Equal(fa.Spent))
+	assert.NotNil(t, keeper.UseFeeAllowance(ctx, granter, grantee, claim, sdk.NewInt(41)))
+	assert.Nil(t, keeper.UseFeeAllowance(ctx, granter, grantee, claim, sdk.NewInt(40)))
+	// revoke
+	assert.Nil(t, keeper.RevokeFeeAllowance(ctx, granter, grantee))
+	assert.NotNil(t, keeper.RevokeFeeAllowance(ctx, granter, grantee))
+	assert.Len(t, keeper.GetAllFeeAllowances(ctx), 0)
+}
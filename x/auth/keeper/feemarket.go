@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+// IsFeeMarketEnabled - Returns true if the base fee scales the fees of the messages
+func (k Keeper) IsFeeMarketEnabled(ctx sdk.Ctx) bool {
+	p := k.GetParams(ctx).FeeMarket
+	return p.Enabled && p.Validate() == nil
+}
+
+// GetBaseFee - Returns the current base fee, in basis points of the FeeMultipliers fee,
+// within the bounds of the params
+func (k Keeper) GetBaseFee(ctx sdk.Ctx) int64 {
+	p := k.GetParams(ctx).FeeMarket
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return p.MinBaseFee
+	}
+	baseFee := int64(binary.BigEndian.Uint64(bz))
+	switch {
+	case baseFee < p.MinBaseFee:
+		return p.MinBaseFee
+	case p.MaxBaseFee >= p.MinBaseFee && baseFee > p.MaxBaseFee:
+		return p.MaxBaseFee
+	}
+	return baseFee
+}
+
+// SetBaseFee - Stores the current base fee
+func (k Keeper) SetBaseFee(ctx sdk.Ctx, baseFee int64) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Set(types.BaseFeeKey, sdk.Uint64ToBigEndian(uint64(baseFee)))
+}
+
+// GetMinFee - Returns the minimum fee of the message, scaled by the base fee if the fee market is enabled
+func (k Keeper) GetMinFee(ctx sdk.Ctx, msg sdk.Msg) sdk.BigInt {
+	fee := k.GetParams(ctx).FeeMultiplier.GetFee(msg)
+	if !k.IsFeeMarketEnabled(ctx) {
+		return fee
+	}
+	return types.MinFee(fee, k.GetBaseFee(ctx))
+}
+
+// GetFeeMarket - Returns the fee market params and the current base fee
+func (k Keeper) GetFeeMarket(ctx sdk.Ctx) types.FeeMarket {
+	return types.FeeMarket{
+		Params:  k.GetParams(ctx).FeeMarket,
+		BaseFee: k.GetBaseFee(ctx),
+	}
+}
+
+// UpdateBaseFee - Adjusts the base fee for the next block from the number of txs in the current block
+func (k Keeper) UpdateBaseFee(ctx sdk.Ctx) {
+	if !k.IsFeeMarketEnabled(ctx) {
+		return
+	}
+	p := k.GetParams(ctx).FeeMarket
+	k.SetBaseFee(ctx, p.NextBaseFee(k.GetBaseFee(ctx), ctx.BlockHeader().NumTxs))
+}
+
+// CollectBaseFee - Burns the base fee portion of the fees in the fee collector, or sends it to the DAO
+func (k Keeper) CollectBaseFee(ctx sdk.Ctx, baseFee sdk.BigInt) sdk.Error {
+	if !baseFee.IsPositive() {
+		return nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, baseFee))
+	if k.GetParams(ctx).FeeMarket.BurnBaseFee {
+		if err := k.BurnCoins(ctx, types.FeeCollectorName, coins); err != nil {
+			return err
+		}
+	} else if err := k.SendCoinsFromModuleToModule(ctx, types.FeeCollectorName, types.DAOAccountName, coins); err != nil {
+		return err
+	}
+	ctx.Logger().Debug(fmt.Sprintf("collected base fee %s at height %d", baseFee.String(), ctx.BlockHeight()))
+	return nil
+}
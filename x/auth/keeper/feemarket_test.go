@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestKeeper_UpdateBaseFee(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, 10, 1)
+	// disabled by default
+	keeper.UpdateBaseFee(ctx.WithBlockHeader(abci.Header{NumTxs: 1000}))
+	assert.False(t, keeper.IsFeeMarketEnabled(ctx))
+	assert.Equal(t, types.DefaultFeeMarket.MinBaseFee, keeper.GetBaseFee(ctx))
+	params := keeper.GetParams(ctx)
+	params.FeeMarket.Enabled = true
+	params.FeeMarket.TargetBlockTxs = 100
+	keeper.SetParams(ctx, params)
+	assert.True(t, keeper.IsFeeMarketEnabled(ctx))
+	keeper.UpdateBaseFee(ctx.WithBlockHeader(abci.Header{NumTxs: 200}))
+	assert.Equal(t, int64(11250), keeper.GetBaseFee(ctx))
+	keeper.UpdateBaseFee(ctx.WithBlockHeader(abci.Header{NumTxs: 0}))
+	assert.Equal(t, int64(10000), keeper.GetBaseFee(ctx))
+	// the stored base fee is kept within bounds lowered by governance
+	keeper.SetBaseFee(ctx, 90000)
+	params.FeeMarket.MaxBaseFee = 20000
+	keeper.SetParams(ctx, params)
+	assert.Equal(t, int64(20000), keeper.GetBaseFee(ctx))
+	assert.Equal(t, int64(20000), keeper.GetFeeMarket(ctx).BaseFee)
+}
+
+func TestKeeper_CollectBaseFee(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, 10, 1)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1000)))
+	assert.Nil(t, keeper.MintCoins(ctx, types.FeeCollectorName, coins))
+	supply := keeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultStakeDenom)
+	// burned by default
+	assert.Nil(t, keeper.CollectBaseFee(ctx, sdk.NewInt(300)))
+	assert.True(t, keeper.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().AmountOf(sdk.DefaultStakeDenom).Equal(sdk.NewInt(700)))
+	assert.True(t, keeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultStakeDenom).Equal(supply.Sub(sdk.NewInt(300))))
+	// or sent to the dao
+	params := keeper.GetParams(ctx)
+	params.FeeMarket.BurnBaseFee = false
+	keeper.SetParams(ctx, params)
+	assert.Nil(t, keeper.CollectBaseFee(ctx, sdk.NewInt(200)))
+	assert.True(t, keeper.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().AmountOf(sdk.DefaultStakeDenom).Equal(sdk.NewInt(500)))
+	assert.True(t, keeper.GetModuleAccount(ctx, types.DAOAccountName).GetCoins().AmountOf(sdk.DefaultStakeDenom).Equal(sdk.NewInt(200)))
+	assert.True(t, keeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultStakeDenom).Equal(supply.Sub(sdk.NewInt(300))))
+	// more than collected
+	assert.NotNil(t, keeper.CollectBaseFee(ctx, sdk.NewInt(501)))
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+// IsSessionKeysActivated - Returns true if accounts may sign with session keys
+func (k Keeper) IsSessionKeysActivated(ctx sdk.Ctx) bool {
+	return k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.SessionKeysKey)
+}
+
+// GrantSessionKey - Authorizes the public key to sign the message types for the granter, replacing any previous
+// grant to the same key
+func (k Keeper) GrantSessionKey(ctx sdk.Ctx, msg types.MsgGrantSessionKey) (types.SessionKey, sdk.Error) {
+	if k.GetAccount(ctx, msg.Granter) == nil {
+		return types.SessionKey{}, types.ErrAccountNotFound(types.ModuleName)
+	}
+	if msg.ExpirationHeight != 0 && msg.ExpirationHeight <= ctx.BlockHeight() {
+		return types.SessionKey{}, types.ErrInvalidSessionKey(types.ModuleName, fmt.Sprintf("expiration height %d is not after the current height", msg.ExpirationHeight))
+	}
+	pk, err := crypto.NewPublicKey(msg.PublicKey)
+	if err != nil {
+		return types.SessionKey{}, sdk.ErrInvalidPubKey(err.Error())
+	}
+	sk := types.SessionKey{
+		Granter:          msg.Granter,
+		Address:          sdk.Address(pk.Address()),
+		PublicKey:        pk.RawString(),
+		MsgTypes:         msg.MsgTypes,
+		SpendLimit:       msg.SpendLimit,
+		Spent:            sdk.ZeroInt(),
+		ExpirationHeight: msg.ExpirationHeight,
+	}
+	if sk.SpendLimit.IsZero() {
+		sk.SpendLimit = sdk.ZeroInt()
+	}
+	k.SetSessionKey(ctx, sk)
+	return sk, nil
+}
+
+// RevokeSessionKey - Removes the session key with the address granted by the account
+func (k Keeper) RevokeSessionKey(ctx sdk.Ctx, granter, addr sdk.Address) sdk.Error {
+	if _, found := k.GetSessionKey(ctx, granter, addr); !found {
+		return types.ErrSessionKeyNotFound(types.ModuleName, granter, addr)
+	}
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.KeyForSessionKey(granter, addr))
+	return nil
+}
+
+// GetSignerSessionKey - Returns the session key granted to the public key by one of the signers of the message,
+// if the public key is not itself one of the signers
+func (k Keeper) GetSignerSessionKey(ctx sdk.Ctx, msg sdk.Msg, pk crypto.PublicKey) (sk types.SessionKey, found bool) {
+	if pk == nil || !k.IsSessionKeysActivated(ctx) {
+		return
+	}
+	for _, signer := range msg.GetSigners() {
+		if bytes.Equal(signer, pk.Address()) {
+			return
+		}
+	}
+	for _, signer := range msg.GetSigners() {
+		if sk, found = k.GetSessionKey(ctx, signer, sdk.Address(pk.Address())); found {
+			return
+		}
+	}
+	return
+}
+
+// SpendSessionKey - Counts the amount against the spend limit of the session key
+func (k Keeper) SpendSessionKey(ctx sdk.Ctx, sk types.SessionKey, amount sdk.BigInt) sdk.Error {
+	sk, err := sk.Spend(amount)
+	if err != nil {
+		return err
+	}
+	k.SetSessionKey(ctx, sk)
+	return nil
+}
+
+// SetSessionKey - Store the session key
+func (k Keeper) SetSessionKey(ctx sdk.Ctx, sk types.SessionKey) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.MarshalBinaryLengthPrefixed(&sk, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal session key: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForSessionKey(sk.Granter, sk.Address), bz)
+}
+
+// GetSessionKey - Retrieve the session key with the address granted by the account
+func (k Keeper) GetSessionKey(ctx sdk.Ctx, granter, addr sdk.Address) (sk types.SessionKey, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForSessionKey(granter, addr))
+	if bz == nil {
+		return
+	}
+	if err := k.Cdc.UnmarshalBinaryLengthPrefixed(bz, &sk, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal session key at height %d: %s", ctx.BlockHeight(), err.Error()))
+		return
+	}
+	return sk, true
+}
+
+// GetSessionKeys - Retrieve the session keys granted by the account
+func (k Keeper) GetSessionKeys(ctx sdk.Ctx, granter sdk.Address) []types.SessionKey {
+	return k.iterateSessionKeys(ctx, types.KeyForSessionKeys(granter))
+}
+
+// GetAllSessionKeys - Retrieve the session keys granted by every account
+func (k Keeper) GetAllSessionKeys(ctx sdk.Ctx) []types.SessionKey {
+	return k.iterateSessionKeys(ctx, types.SessionKeysPrefix)
+}
+
+func (k Keeper) iterateSessionKeys(ctx sdk.Ctx, prefix []byte) (sessionKeys []types.SessionKey) {
+	sessionKeys = make([]types.SessionKey, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sk types.SessionKey
+		if err := k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &sk, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal session key at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		sessionKeys = append(sessionKeys, sk)
+	}
+	return
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_SessionKeys(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, 10, 2)
+	ctx = ctx.WithBlockHeight(10)
+	codec.UpgradeFeatureMap[codec.SessionKeysKey] = 10
+	defer delete(codec.UpgradeFeatureMap, codec.SessionKeysKey)
+	accs := keeper.GetAllAccounts(ctx)
+	granter := accs[0].GetAddress()
+	sessionKey := crypto.GenerateEd25519PrivKey().PublicKey()
+	msg := types.MsgGrantSessionKey{
+		Granter:          granter,
+		PublicKey:        sessionKey.RawString(),
+		MsgTypes:         []string{"claim", "proof"},
+		SpendLimit:       sdk.NewInt(100),
+		ExpirationHeight: 20,
+	}
+	// unknown granter
+	unknown := msg
+	unknown.Granter = sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	_, err := keeper.GrantSessionKey(ctx, unknown)
+	assert.NotNil(t, err)
+	// already expired
+	expired := msg
+	expired.ExpirationHeight = 10
+	_, err = keeper.GrantSessionKey(ctx, expired)
+	assert.NotNil(t, err)
+	sk, err := keeper.GrantSessionKey(ctx, msg)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.Address(sessionKey.Address()), sk.Address)
+	assert.Len(t, keeper.GetSessionKeys(ctx, granter), 1)
+	assert.Len(t, keeper.GetSessionKeys(ctx, accs[1].GetAddress()), 0)
+	// found for a message signed by the granter
+	claim := testMsg{signers: []sdk.Address{granter}}
+	found, ok := keeper.GetSignerSessionKey(ctx, claim, sessionKey)
+	assert.True(t, ok)
+	assert.Equal(t, granter, found.Granter)
+	_, ok = keeper.GetSignerSessionKey(ctx, testMsg{signers: []sdk.Address{accs[1].GetAddress()}}, sessionKey)
+	assert.False(t, ok)
+	// not before the activation of the feature
+	_, ok = keeper.GetSignerSessionKey(ctx.WithBlockHeight(9), claim, sessionKey)
+	assert.False(t, ok)
+	// spend limit
+	assert.Nil(t, keeper.SpendSessionKey(ctx, found, sdk.NewInt(60)))
+	found, _ = keeper.GetSessionKey(ctx, granter, sk.Address)
+	assert.True(t, sdk.NewInt(60).Equal(found.Spent))
+	assert.NotNil(t, keeper.SpendSessionKey(ctx, found, sdk.NewInt(41)))
+	assert.Nil(t, keeper.SpendSessionKey(ctx, found, sdk.NewInt(40)))
+	// revoke
+	assert.Nil(t, keeper.RevokeSessionKey(ctx, granter, sk.Address))
+	assert.NotNil(t, keeper.RevokeSessionKey(ctx, granter, sk.Address))
+	assert.Len(t, keeper.GetAllSessionKeys(ctx), 0)
+	// the key of a signer of the message is never one of its session keys
+	self := msg
+	self.PublicKey = accs[0].GetPubKey().RawString()
+	_, err = keeper.GrantSessionKey(ctx, self)
+	assert.Nil(t, err)
+	_, ok = keeper.GetSignerSessionKey(ctx, claim, accs[0].GetPubKey())
+	assert.False(t, ok)
+}
+
+type testMsg struct {
+	signers []sdk.Address
+}
+
+func (msg testMsg) Route() string             { return "pocketcore" }
+func (msg testMsg) Type() string              { return "claim" }
+func (msg testMsg) ValidateBasic() sdk.Error  { return nil }
+func (msg testMsg) GetSignBytes() []byte      { return nil }
+func (msg testMsg) GetSigners() []sdk.Address { return msg.signers }
+func (msg testMsg) GetRecipient() sdk.Address { return nil }
+func (msg testMsg) GetFee() sdk.BigInt        { return sdk.NewInt(10000) }
@@ -61,11 +61,13 @@ func createTestInput(t *testing.T, isCheckTx bool, initPower int64, nAccs int64)
 	)
 	cdc := makeTestCodec()
 	maccPerms := map[string][]string{
-		holder:       nil,
-		types.Minter: {types.Minter},
-		types.Burner: {types.Burner},
-		multiPerm:    {types.Minter, types.Burner, types.Staking},
-		randomPerm:   {"random"},
+		holder:                 nil,
+		types.Minter:           {types.Minter},
+		types.Burner:           {types.Burner},
+		multiPerm:              {types.Minter, types.Burner, types.Staking},
+		randomPerm:             {"random"},
+		types.FeeCollectorName: {types.Minter, types.Burner},
+		types.DAOAccountName:   {types.Minter, types.Burner},
 	}
 	keeper := NewKeeper(cdc, keyAcc, sdk.NewSubspace(types.StoreKey), maccPerms)
 	valTokens := sdk.TokensFromConsensusPower(initPower)
@@ -68,14 +68,14 @@ func (AppModule) Name() string {
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // Route module message route name
-func (AppModule) Route() string { return "" }
+func (AppModule) Route() string { return types.RouterKey }
 
 func (am AppModule) UpgradeCodec(ctx sdk.Ctx) {
 	am.accountKeeper.UpgradeCodec(ctx)
 }
 
 // NewHandler module handler
-func (AppModule) NewHandler() sdk.Handler { return nil }
+func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.accountKeeper) }
 
 // QuerierRoute module querier route name
 func (AppModule) QuerierRoute() string {
@@ -110,6 +110,7 @@ func (am AppModule) BeginBlock(ctx sdk.Ctx, _ abci.RequestBeginBlock) {
 }
 
 // EndBlock module end-block
-func (AppModule) EndBlock(_ sdk.Ctx, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Ctx, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.accountKeeper.UpdateBaseFee(ctx)
 	return []abci.ValidatorUpdate{}
 }
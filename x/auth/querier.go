@@ -17,6 +17,12 @@ func NewQuerier(keeper keeper.Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryAccount:
 			return queryAccount(ctx, req, keeper)
+		case types.QueryFeeMarket:
+			return queryFeeMarket(ctx, keeper)
+		case types.QuerySessionKeys:
+			return querySessionKeys(ctx, req, keeper)
+		case types.QueryFeeAllowances:
+			return queryFeeAllowances(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -43,3 +49,35 @@ func queryAccount(ctx sdk.Ctx, req abci.RequestQuery, keeper keeper.Keeper) ([]b
 
 	return bz, nil
 }
+
+func queryFeeMarket(ctx sdk.Ctx, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetFeeMarket(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySessionKeys(ctx sdk.Ctx, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetSessionKeys(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryFeeAllowances(ctx sdk.Ctx, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetFeeAllowances(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/pokt-network/pocket-core/x/auth/exported"
 )
 
-//-----------------------------------------------------------------------------
+// -----------------------------------------------------------------------------
 // BaseAccount
 var _ exported.Account = (*BaseAccount)(nil)
 var _ codec.ProtoMarshaler = &BaseAccount{}
@@ -302,11 +302,12 @@ func (m *Supply) XXX_DiscardUnknown() {
 var xxx_messageInfo_Supply proto.InternalMessageInfo
 
 type ProtoStdTx struct {
-	Msg       types1.Any                                      `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg" yaml:"msg"`
-	Fee       github_com_pokt_network_pocket_core_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"fee" yaml:"fee"`
-	Signature ProtoStdSignature                               `protobuf:"bytes,3,opt,name=signature,proto3,casttype=ProtoStdSignature" json:"signature" yaml:"signature"`
-	Memo      string                                          `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo" yaml:"memo"`
-	Entropy   int64                                           `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	Msg       types1.Any                                        `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg" yaml:"msg"`
+	Fee       github_com_pokt_network_pocket_core_types.Coins   `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"fee" yaml:"fee"`
+	Signature ProtoStdSignature                                 `protobuf:"bytes,3,opt,name=signature,proto3,casttype=ProtoStdSignature" json:"signature" yaml:"signature"`
+	Memo      string                                            `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo" yaml:"memo"`
+	Entropy   int64                                             `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	FeePayer  github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,6,opt,name=feePayer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 func (m *ProtoStdTx) Reset()         { *m = ProtoStdTx{} }
@@ -403,11 +404,12 @@ func (*ProtoStdSignature) XXX_MessageName() string {
 }
 
 type StdSignDoc struct {
-	ChainID string                                        `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"chain_id" yaml:"chain_id"`
-	Fee     github_com_pokt_network_pocket_core_types.Raw `protobuf:"bytes,2,opt,name=fee,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"fee" yaml:"fee"`
-	Memo    string                                        `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo" yaml:"memo"`
-	Msg     github_com_pokt_network_pocket_core_types.Raw `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"msg" yaml:"msg"`
-	Entropy int64                                         `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	ChainID  string                                            `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"chain_id" yaml:"chain_id"`
+	Fee      github_com_pokt_network_pocket_core_types.Raw     `protobuf:"bytes,2,opt,name=fee,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"fee" yaml:"fee"`
+	Memo     string                                            `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo" yaml:"memo"`
+	Msg      github_com_pokt_network_pocket_core_types.Raw     `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"msg" yaml:"msg"`
+	Entropy  int64                                             `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	FeePayer github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,6,opt,name=feePayer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 func (m *StdSignDoc) Reset()         { *m = StdSignDoc{} }
@@ -446,6 +448,412 @@ var xxx_messageInfo_StdSignDoc proto.InternalMessageInfo
 func (*StdSignDoc) XXX_MessageName() string {
 	return "x.auth.StdSignDoc"
 }
+
+type SessionKey struct {
+	Granter          github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=granter,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"granter"`
+	Address          github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	PublicKey        string                                            `protobuf:"bytes,3,opt,name=publicKey,proto3" json:"public_key"`
+	MsgTypes         []string                                          `protobuf:"bytes,4,rep,name=msgTypes,proto3" json:"msg_types"`
+	SpendLimit       github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,5,opt,name=spendLimit,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"spend_limit"`
+	Spent            github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,6,opt,name=spent,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"spent"`
+	ExpirationHeight int64                                             `protobuf:"varint,7,opt,name=expirationHeight,proto3" json:"expiration_height"`
+}
+
+func (m *SessionKey) Reset()         { *m = SessionKey{} }
+func (m *SessionKey) String() string { return proto.CompactTextString(m) }
+func (*SessionKey) ProtoMessage()    {}
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{9}
+}
+func (m *SessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKey.Merge(m, src)
+}
+func (m *SessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKey proto.InternalMessageInfo
+
+func (m *SessionKey) GetGranter() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
+func (m *SessionKey) GetAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *SessionKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *SessionKey) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *SessionKey) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+type MsgGrantSessionKey struct {
+	Granter          github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=granter,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"granter"`
+	PublicKey        string                                            `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"public_key"`
+	MsgTypes         []string                                          `protobuf:"bytes,3,rep,name=msgTypes,proto3" json:"msg_types"`
+	SpendLimit       github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,4,opt,name=spendLimit,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"spend_limit"`
+	ExpirationHeight int64                                             `protobuf:"varint,5,opt,name=expirationHeight,proto3" json:"expiration_height"`
+}
+
+func (m *MsgGrantSessionKey) Reset()         { *m = MsgGrantSessionKey{} }
+func (m *MsgGrantSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSessionKey) ProtoMessage()    {}
+func (*MsgGrantSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{10}
+}
+func (m *MsgGrantSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSessionKey.Merge(m, src)
+}
+func (m *MsgGrantSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSessionKey proto.InternalMessageInfo
+
+func (m *MsgGrantSessionKey) GetGranter() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
+func (m *MsgGrantSessionKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *MsgGrantSessionKey) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *MsgGrantSessionKey) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (*MsgGrantSessionKey) XXX_MessageName() string {
+	return "x.auth.MsgGrantSessionKey"
+}
+
+type MsgRevokeSessionKey struct {
+	Granter github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=granter,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"granter"`
+	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+}
+
+func (m *MsgRevokeSessionKey) Reset()         { *m = MsgRevokeSessionKey{} }
+func (m *MsgRevokeSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKey) ProtoMessage()    {}
+func (*MsgRevokeSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{11}
+}
+func (m *MsgRevokeSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKey.Merge(m, src)
+}
+func (m *MsgRevokeSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSessionKey proto.InternalMessageInfo
+
+func (m *MsgRevokeSessionKey) GetGranter() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
+func (m *MsgRevokeSessionKey) GetAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (*MsgRevokeSessionKey) XXX_MessageName() string {
+	return "x.auth.MsgRevokeSessionKey"
+}
+
+type FeeAllowance struct {
+	Granter          github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=granter,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"granter"`
+	Grantee          github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=grantee,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"grantee"`
+	SpendLimit       github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=spendLimit,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"spend_limit"`
+	Spent            github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,4,opt,name=spent,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"spent"`
+	ExpirationHeight int64                                             `protobuf:"varint,5,opt,name=expirationHeight,proto3" json:"expiration_height"`
+	MsgTypes         []string                                          `protobuf:"bytes,6,rep,name=msgTypes,proto3" json:"msg_types"`
+}
+
+func (m *FeeAllowance) Reset()         { *m = FeeAllowance{} }
+func (m *FeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*FeeAllowance) ProtoMessage()    {}
+func (*FeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{12}
+}
+func (m *FeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAllowance.Merge(m, src)
+}
+func (m *FeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeAllowance proto.InternalMessageInfo
+
+func (m *FeeAllowance) GetGranter() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
+func (m *FeeAllowance) GetGrantee() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Grantee
+	}
+	return nil
+}
+
+func (m *FeeAllowance) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *FeeAllowance) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+type MsgGrantFeeAllowance struct {
+	Granter          github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=granter,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"granter"`
+	Grantee          github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=grantee,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"grantee"`
+	SpendLimit       github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=spendLimit,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"spend_limit"`
+	ExpirationHeight int64                                             `protobuf:"varint,4,opt,name=expirationHeight,proto3" json:"expiration_height"`
+	MsgTypes         []string                                          `protobuf:"bytes,5,rep,name=msgTypes,proto3" json:"msg_types"`
+}
+
+func (m *MsgGrantFeeAllowance) Reset()         { *m = MsgGrantFeeAllowance{} }
+func (m *MsgGrantFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantFeeAllowance) ProtoMessage()    {}
+func (*MsgGrantFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{13}
+}
+func (m *MsgGrantFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantFeeAllowance.Merge(m, src)
+}
+func (m *MsgGrantFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantFeeAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantFeeAllowance) GetGranter() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
+func (m *MsgGrantFeeAllowance) GetGrantee() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Grantee
+	}
+	return nil
+}
+
+func (m *MsgGrantFeeAllowance) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *MsgGrantFeeAllowance) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (*MsgGrantFeeAllowance) XXX_MessageName() string {
+	return "x.auth.MsgGrantFeeAllowance"
+}
+
+type MsgRevokeFeeAllowance struct {
+	Granter github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=granter,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"granter"`
+	Grantee github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=grantee,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"grantee"`
+}
+
+func (m *MsgRevokeFeeAllowance) Reset()         { *m = MsgRevokeFeeAllowance{} }
+func (m *MsgRevokeFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeFeeAllowance) ProtoMessage()    {}
+func (*MsgRevokeFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{14}
+}
+func (m *MsgRevokeFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeFeeAllowance.Merge(m, src)
+}
+func (m *MsgRevokeFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeFeeAllowance proto.InternalMessageInfo
+
+func (m *MsgRevokeFeeAllowance) GetGranter() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
+func (m *MsgRevokeFeeAllowance) GetGrantee() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Grantee
+	}
+	return nil
+}
+
+func (*MsgRevokeFeeAllowance) XXX_MessageName() string {
+	return "x.auth.MsgRevokeFeeAllowance"
+}
 func init() {
 	proto.RegisterType((*ProtoBaseAccount)(nil), "x.auth.ProtoBaseAccount")
 	proto.RegisterType((*ProtoModuleAccount)(nil), "x.auth.ProtoModuleAccount")
@@ -456,73 +864,100 @@ func init() {
 	proto.RegisterType((*ProtoStdTx)(nil), "x.auth.ProtoStdTx")
 	proto.RegisterType((*ProtoStdSignature)(nil), "x.auth.ProtoStdSignature")
 	proto.RegisterType((*StdSignDoc)(nil), "x.auth.StdSignDoc")
+	proto.RegisterType((*SessionKey)(nil), "x.auth.SessionKey")
+	proto.RegisterType((*MsgGrantSessionKey)(nil), "x.auth.MsgGrantSessionKey")
+	proto.RegisterType((*MsgRevokeSessionKey)(nil), "x.auth.MsgRevokeSessionKey")
+	proto.RegisterType((*FeeAllowance)(nil), "x.auth.FeeAllowance")
+	proto.RegisterType((*MsgGrantFeeAllowance)(nil), "x.auth.MsgGrantFeeAllowance")
+	proto.RegisterType((*MsgRevokeFeeAllowance)(nil), "x.auth.MsgRevokeFeeAllowance")
 }
 
 func init() { proto.RegisterFile("x/auth/auth.proto", fileDescriptor_840f82faebe7fabc) }
 
 var fileDescriptor_840f82faebe7fabc = []byte{
-	// 967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc6, 0x49, 0x1c, 0x8f, 0xdb, 0x90, 0x0c, 0xa6, 0xb5, 0x8b, 0xea, 0x29, 0x83, 0x2a,
-	0x55, 0x42, 0xd9, 0x85, 0x72, 0x88, 0x30, 0x48, 0x34, 0xdb, 0x52, 0x04, 0x01, 0xa9, 0x5a, 0x73,
-	0xa8, 0x72, 0xb1, 0xf6, 0xc7, 0x78, 0xb3, 0xf2, 0xee, 0xce, 0xca, 0xbb, 0xab, 0xc4, 0xff, 0x41,
-	0x4f, 0x88, 0x1b, 0x88, 0x53, 0xca, 0x91, 0x33, 0x17, 0xfe, 0x83, 0x1e, 0x23, 0x4e, 0x9c, 0x06,
-	0x94, 0x5c, 0x90, 0xb9, 0xed, 0x31, 0x27, 0x34, 0x3f, 0x36, 0x6b, 0x27, 0x48, 0x44, 0x41, 0x82,
-	0x8b, 0x35, 0xef, 0xdb, 0xf7, 0xde, 0x7c, 0xf3, 0xcd, 0xf7, 0x46, 0x06, 0x9b, 0x87, 0x86, 0x9d,
-	0x67, 0xfb, 0xe2, 0x47, 0x4f, 0x26, 0x34, 0xa3, 0x70, 0xf5, 0x50, 0xe7, 0xd1, 0x9d, 0xae, 0x4b,
-	0xd3, 0x88, 0xa6, 0x43, 0x81, 0x1a, 0x32, 0x90, 0x29, 0x77, 0xda, 0x3e, 0xf5, 0xa9, 0xc4, 0xf9,
-	0x4a, 0xa1, 0x1b, 0xd9, 0x34, 0x21, 0xa9, 0xe1, 0xd2, 0x20, 0x56, 0x48, 0xd7, 0xa7, 0xd4, 0x0f,
-	0x89, 0x21, 0x22, 0x27, 0x1f, 0x19, 0x76, 0x3c, 0x95, 0x9f, 0xf0, 0xcb, 0x25, 0xb0, 0xf1, 0x8c,
-	0xaf, 0x4c, 0x3b, 0x25, 0x3b, 0xae, 0x4b, 0xf3, 0x38, 0x83, 0x7b, 0xa0, 0x61, 0x7b, 0xde, 0x84,
-	0xa4, 0x69, 0x47, 0xbb, 0xa7, 0x3d, 0xb8, 0x61, 0x3e, 0x9a, 0x31, 0x54, 0x42, 0x67, 0x0c, 0xbd,
-	0xe7, 0x07, 0xd9, 0x7e, 0xee, 0xe8, 0x2e, 0x8d, 0x8c, 0x84, 0x8e, 0xb3, 0xad, 0x98, 0x64, 0x07,
-	0x74, 0x32, 0x36, 0x12, 0xea, 0x8e, 0x49, 0xb6, 0xe5, 0xd2, 0x09, 0x31, 0x04, 0x0b, 0x7d, 0x47,
-	0x16, 0x59, 0x65, 0x35, 0xfc, 0x08, 0x34, 0x92, 0xdc, 0x19, 0x8e, 0xc9, 0xb4, 0xb3, 0x24, 0x7a,
-	0xbf, 0x3d, 0x63, 0x08, 0x24, 0xb9, 0x13, 0x06, 0x2e, 0x47, 0x0b, 0x86, 0x36, 0xa7, 0x76, 0x14,
-	0xf6, 0x71, 0x85, 0x61, 0x6b, 0x35, 0xc9, 0x9d, 0x5d, 0x32, 0x85, 0x7b, 0x60, 0x85, 0x9f, 0x2b,
-	0xed, 0xd4, 0xef, 0xd5, 0x1f, 0xb4, 0x1e, 0xb6, 0x74, 0xb9, 0xcb, 0x63, 0x1a, 0xc4, 0xe6, 0xf6,
-	0x2b, 0x86, 0x6a, 0x3f, 0xfe, 0x86, 0x8c, 0xab, 0xb3, 0xe3, 0x75, 0xa9, 0x25, 0x5b, 0xf6, 0x6f,
-	0xbf, 0x38, 0x42, 0xb5, 0xef, 0x8e, 0x90, 0xf6, 0xe2, 0x07, 0xa4, 0xfd, 0xf2, 0xd3, 0x56, 0x43,
-	0xc9, 0x81, 0xbf, 0x5f, 0x02, 0x50, 0x68, 0xf4, 0x25, 0xf5, 0xf2, 0xf0, 0x5c, 0x25, 0x0a, 0x6e,
-	0x39, 0x76, 0x4a, 0x86, 0xb6, 0x8c, 0x87, 0x24, 0x76, 0xa9, 0x67, 0x3b, 0x21, 0x11, 0xa2, 0xb5,
-	0x1e, 0x76, 0x74, 0x79, 0x83, 0xfa, 0x45, 0x7d, 0x4d, 0xc4, 0x99, 0x1e, 0x33, 0xa4, 0x15, 0x0c,
-	0xbd, 0x2e, 0x0f, 0x3b, 0xdf, 0x09, 0x5b, 0x6d, 0xa7, 0xca, 0xfe, 0xa4, 0x6c, 0x0b, 0xdf, 0x01,
-	0xcb, 0xb1, 0x1d, 0x11, 0xa1, 0x5b, 0xd3, 0xbc, 0x3d, 0x63, 0x48, 0xc4, 0x05, 0x43, 0x2d, 0xd9,
-	0x84, 0x47, 0xd8, 0x12, 0x20, 0xfc, 0x14, 0xb4, 0x12, 0x32, 0x89, 0x82, 0x34, 0x0d, 0xa8, 0xd2,
-	0xab, 0x69, 0xde, 0x9f, 0x31, 0x34, 0x0f, 0x17, 0x0c, 0x41, 0x25, 0x76, 0x05, 0x62, 0x6b, 0x3e,
-	0xa5, 0x7f, 0xf7, 0x82, 0x2c, 0x37, 0x17, 0x54, 0xc0, 0x3f, 0x2f, 0x81, 0xb6, 0x14, 0x27, 0x0f,
-	0xb3, 0x60, 0x10, 0xf8, 0xff, 0x85, 0x89, 0x9e, 0x5d, 0x34, 0xd1, 0xf6, 0x8c, 0xa1, 0x76, 0x65,
-	0x98, 0x61, 0xc4, 0xc9, 0x0c, 0xd3, 0xc0, 0x2f, 0x18, 0x7a, 0xf3, 0xa2, 0x9d, 0xaa, 0xaf, 0xff,
-	0xb3, 0xb1, 0x3c, 0x70, 0xf3, 0x29, 0x21, 0x42, 0xb8, 0x24, 0x0c, 0xc8, 0x04, 0x76, 0x41, 0x9d,
-	0x9f, 0x49, 0x13, 0x17, 0xdc, 0x98, 0x31, 0xc4, 0x43, 0x8b, 0xff, 0x40, 0x1d, 0x80, 0xe8, 0x3c,
-	0x51, 0x9c, 0xba, 0x6e, 0xae, 0xf3, 0xd1, 0xa9, 0x50, 0x6b, 0x6e, 0xdd, 0x5f, 0xe3, 0x1b, 0xfe,
-	0x71, 0x84, 0x34, 0xfc, 0xb5, 0x06, 0xd6, 0x17, 0xb6, 0x49, 0xe1, 0x2e, 0x68, 0x8e, 0x14, 0xc2,
-	0x6f, 0x87, 0x9f, 0xf8, 0x8d, 0xd2, 0xad, 0x0b, 0xa9, 0xe6, 0x2d, 0x7e, 0xf6, 0x19, 0x43, 0xeb,
-	0x23, 0x42, 0x86, 0x73, 0x5b, 0x55, 0xf5, 0xf0, 0x3e, 0x68, 0x78, 0x64, 0x64, 0xe7, 0x61, 0xa6,
-	0x68, 0xb5, 0xf8, 0x45, 0x2b, 0xc8, 0x2a, 0x17, 0x73, 0x84, 0x0e, 0xc0, 0xea, 0x20, 0x4f, 0x92,
-	0x70, 0x0a, 0x5d, 0xb0, 0x92, 0xd1, 0xcc, 0x0e, 0x15, 0x87, 0x05, 0xd5, 0x1f, 0xa9, 0x9d, 0x65,
-	0xc6, 0xb5, 0xe4, 0x17, 0x95, 0xfd, 0x35, 0x25, 0x7f, 0x0d, 0xbf, 0xac, 0x03, 0x20, 0xbc, 0x3a,
-	0xc8, 0xbc, 0xaf, 0x0e, 0xe1, 0x0e, 0xa8, 0x47, 0xa9, 0xaf, 0xa6, 0xb5, 0xad, 0xcb, 0x47, 0x52,
-	0x2f, 0x1f, 0x49, 0x7d, 0x27, 0x9e, 0x9a, 0x5d, 0x45, 0x82, 0x27, 0x16, 0x0c, 0x01, 0x69, 0xa5,
-	0x28, 0xf5, 0xb1, 0xc5, 0x21, 0x38, 0x06, 0xf5, 0x11, 0xe1, 0x13, 0x79, 0x89, 0xfe, 0x17, 0x65,
-	0xe5, 0x88, 0x90, 0xaa, 0x72, 0x44, 0x08, 0xbe, 0xce, 0x51, 0x78, 0x17, 0x98, 0x82, 0x66, 0x1a,
-	0xf8, 0xb1, 0x9d, 0xe5, 0x13, 0xd2, 0xa9, 0x0b, 0xd6, 0xdd, 0x85, 0x37, 0x66, 0x90, 0x79, 0x83,
-	0x32, 0xc1, 0xec, 0x2b, 0x02, 0x55, 0x4d, 0xc1, 0xd0, 0x86, 0xa4, 0x71, 0x0e, 0xe1, 0x33, 0x86,
-	0x36, 0x2f, 0xd5, 0x5a, 0x55, 0x0d, 0x7f, 0x74, 0x22, 0x12, 0xd1, 0xce, 0x72, 0xf5, 0xe8, 0xf0,
-	0xb8, 0x7a, 0x74, 0x78, 0x84, 0x2d, 0x01, 0xc2, 0x6d, 0xd0, 0x20, 0x71, 0x36, 0xa1, 0xc9, 0xb4,
-	0xb3, 0x22, 0xac, 0x70, 0x97, 0x5b, 0x41, 0x41, 0x05, 0x43, 0xeb, 0xb2, 0x44, 0x01, 0xd8, 0x2a,
-	0x3f, 0xc9, 0x3b, 0xe2, 0xe3, 0x81, 0xbf, 0xd5, 0xc0, 0x65, 0x42, 0xf0, 0x43, 0xd0, 0x94, 0xf3,
-	0xbb, 0xab, 0xc6, 0xe3, 0x86, 0x6c, 0xad, 0x5e, 0x81, 0xaa, 0xb5, 0x02, 0xb0, 0x55, 0xe5, 0xc3,
-	0x8f, 0x41, 0xf3, 0xbc, 0x93, 0x7a, 0x2f, 0xde, 0xfa, 0x47, 0x61, 0xac, 0xaa, 0xa6, 0xbf, 0x2c,
-	0x98, 0xfd, 0xb9, 0x04, 0x80, 0x22, 0xf5, 0x84, 0xba, 0xf0, 0x03, 0xd0, 0x78, 0xbc, 0x6f, 0x07,
-	0xf1, 0x67, 0x4f, 0xd4, 0xbc, 0xa2, 0x19, 0x43, 0x6b, 0x2e, 0x87, 0x86, 0x81, 0x57, 0x30, 0xf4,
-	0x9a, 0x6c, 0x59, 0x22, 0xd8, 0x2a, 0xf3, 0xe1, 0xf3, 0xd2, 0x35, 0x9c, 0xca, 0xd3, 0xbf, 0x35,
-	0xc9, 0x19, 0x43, 0x5b, 0x57, 0x37, 0x89, 0x65, 0x1f, 0x48, 0x8b, 0x94, 0xb7, 0x55, 0xbf, 0xca,
-	0x6d, 0x3d, 0x97, 0xfe, 0x5f, 0xae, 0x68, 0x5c, 0x72, 0xf9, 0x35, 0x68, 0xf0, 0xb1, 0xf8, 0xf7,
-	0x3e, 0x30, 0x3f, 0x7f, 0x75, 0xd2, 0xd3, 0x8e, 0x4f, 0x7a, 0xda, 0xef, 0x27, 0x3d, 0xed, 0x9b,
-	0xd3, 0x5e, 0xed, 0xf8, 0xb4, 0x57, 0xfb, 0xf5, 0xb4, 0x57, 0xdb, 0x7b, 0xf7, 0x2a, 0x84, 0xd4,
-	0xff, 0x29, 0xc1, 0xcb, 0x59, 0x15, 0x33, 0xfd, 0xfe, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf,
-	0x08, 0xe4, 0xc3, 0x66, 0x09, 0x00, 0x00,
+	// 1303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x7a, 0x9d, 0x38, 0x9e, 0xb4, 0x21, 0x99, 0xa6, 0xad, 0x53, 0xd4, 0x4c, 0x59, 0x54,
+	0xa9, 0x88, 0xc6, 0x86, 0x72, 0xa8, 0x30, 0x48, 0xd4, 0xdb, 0xd2, 0x52, 0xda, 0x4a, 0xd5, 0xa6,
+	0x12, 0x55, 0x2f, 0x66, 0xbd, 0x1e, 0x6f, 0x56, 0xd9, 0xdd, 0x59, 0xed, 0xce, 0x92, 0x58, 0xe2,
+	0x03, 0xf4, 0x84, 0xb8, 0x81, 0xb8, 0x50, 0x7a, 0x84, 0x2b, 0x17, 0xbe, 0x41, 0x8f, 0x15, 0x08,
+	0x09, 0x71, 0x18, 0x50, 0x7b, 0x01, 0x1f, 0x7d, 0xe0, 0x50, 0x2e, 0x68, 0xfe, 0xac, 0x77, 0x9d,
+	0x54, 0x25, 0x71, 0x69, 0x50, 0x11, 0x17, 0x77, 0xe6, 0x37, 0xef, 0xbd, 0x79, 0x7f, 0x7e, 0xef,
+	0xcd, 0x36, 0x60, 0x71, 0xab, 0x61, 0xa7, 0x74, 0x5d, 0xfc, 0xd4, 0xa3, 0x98, 0x50, 0x02, 0x67,
+	0xb6, 0xea, 0x7c, 0x77, 0x6c, 0xd9, 0x21, 0x49, 0x40, 0x92, 0xb6, 0x40, 0x1b, 0x72, 0x23, 0x45,
+	0x8e, 0x2d, 0xb9, 0xc4, 0x25, 0x12, 0xe7, 0x2b, 0x85, 0x2e, 0xd0, 0x7e, 0x84, 0x93, 0x86, 0x43,
+	0xbc, 0x50, 0x21, 0xcb, 0x2e, 0x21, 0xae, 0x8f, 0x1b, 0x62, 0xd7, 0x49, 0x7b, 0x0d, 0x3b, 0xec,
+	0xcb, 0x23, 0xe3, 0xab, 0x12, 0x58, 0xb8, 0xce, 0x57, 0xa6, 0x9d, 0xe0, 0x96, 0xe3, 0x90, 0x34,
+	0xa4, 0xf0, 0x16, 0xa8, 0xd8, 0xdd, 0x6e, 0x8c, 0x93, 0xa4, 0xa6, 0x9d, 0xd0, 0x4e, 0x1d, 0x30,
+	0xcf, 0x0d, 0x18, 0xca, 0xa0, 0x47, 0x0c, 0xbd, 0xee, 0x7a, 0x74, 0x3d, 0xed, 0xd4, 0x1d, 0x12,
+	0x34, 0x22, 0xb2, 0x41, 0x57, 0x43, 0x4c, 0x37, 0x49, 0xbc, 0xd1, 0x88, 0x88, 0xb3, 0x81, 0xe9,
+	0xaa, 0x43, 0x62, 0xdc, 0x10, 0x5e, 0xd4, 0x5b, 0x52, 0xc9, 0xca, 0xb4, 0xe1, 0xdb, 0xa0, 0x12,
+	0xa5, 0x9d, 0xf6, 0x06, 0xee, 0xd7, 0x4a, 0xc2, 0xf6, 0xcb, 0x03, 0x86, 0x40, 0x94, 0x76, 0x7c,
+	0xcf, 0xe1, 0xe8, 0x90, 0xa1, 0xc5, 0xbe, 0x1d, 0xf8, 0x4d, 0x23, 0xc7, 0x0c, 0x6b, 0x26, 0x4a,
+	0x3b, 0x57, 0x70, 0x1f, 0xde, 0x02, 0xd3, 0x3c, 0xae, 0xa4, 0xa6, 0x9f, 0xd0, 0x4f, 0xcd, 0x9d,
+	0x99, 0xab, 0xcb, 0x5b, 0xce, 0x13, 0x2f, 0x34, 0xcf, 0xde, 0x63, 0x68, 0xea, 0xeb, 0x5f, 0x50,
+	0x63, 0xf7, 0xde, 0x71, 0xbd, 0xc4, 0x92, 0x26, 0x9b, 0x47, 0x6f, 0xdf, 0x41, 0x53, 0x9f, 0xdf,
+	0x41, 0xda, 0xed, 0xbb, 0x48, 0xfb, 0xfe, 0xdb, 0xd5, 0x8a, 0x4a, 0x87, 0xf1, 0x45, 0x09, 0x40,
+	0x91, 0xa3, 0x6b, 0xa4, 0x9b, 0xfa, 0xa3, 0x2c, 0x11, 0x70, 0xa4, 0x63, 0x27, 0xb8, 0x6d, 0xcb,
+	0x7d, 0x1b, 0x87, 0x0e, 0xe9, 0xda, 0x1d, 0x1f, 0x8b, 0xa4, 0xcd, 0x9d, 0xa9, 0xd5, 0x65, 0x05,
+	0xeb, 0xdb, 0xf3, 0x6b, 0x22, 0xee, 0xe9, 0x7d, 0x86, 0xb4, 0x21, 0x43, 0x87, 0x64, 0xb0, 0x45,
+	0x4b, 0x86, 0xb5, 0xd4, 0xc9, 0xa5, 0xdf, 0xcd, 0xcc, 0xc2, 0x57, 0x41, 0x39, 0xb4, 0x03, 0x2c,
+	0xf2, 0x56, 0x35, 0x8f, 0x0e, 0x18, 0x12, 0xfb, 0x21, 0x43, 0x73, 0xd2, 0x08, 0xdf, 0x19, 0x96,
+	0x00, 0xe1, 0x25, 0x30, 0x17, 0xe1, 0x38, 0xf0, 0x92, 0xc4, 0x23, 0x2a, 0x5f, 0x55, 0xf3, 0xe4,
+	0x80, 0xa1, 0x22, 0x3c, 0x64, 0x08, 0xaa, 0x64, 0xe7, 0xa0, 0x61, 0x15, 0x45, 0x9a, 0xc7, 0xb7,
+	0xa5, 0xe5, 0xe0, 0x58, 0x16, 0x8c, 0xef, 0x4a, 0x60, 0x49, 0x26, 0x27, 0xf5, 0xa9, 0xb7, 0xe6,
+	0xb9, 0xfb, 0x41, 0xa2, 0xeb, 0xdb, 0x49, 0x74, 0x76, 0xc0, 0xd0, 0x52, 0x4e, 0x98, 0x76, 0xc0,
+	0x9d, 0x69, 0x27, 0x9e, 0x3b, 0x64, 0xe8, 0xc5, 0xed, 0x74, 0xca, 0x4f, 0xff, 0x65, 0x62, 0x75,
+	0xc1, 0xc1, 0x8b, 0x18, 0x8b, 0xc4, 0x45, 0xbe, 0x87, 0x63, 0xb8, 0x0c, 0x74, 0x1e, 0x93, 0x26,
+	0x0a, 0x5c, 0x19, 0x30, 0xc4, 0xb7, 0x16, 0xff, 0x81, 0x75, 0x00, 0x82, 0x91, 0xa0, 0x88, 0x5a,
+	0x37, 0xe7, 0x79, 0xeb, 0xe4, 0xa8, 0x55, 0x58, 0x37, 0x67, 0xf9, 0x85, 0xbf, 0xdd, 0x41, 0x9a,
+	0xf1, 0x89, 0x06, 0xe6, 0xc7, 0xae, 0x49, 0xe0, 0x15, 0x50, 0xed, 0x29, 0x84, 0x57, 0x87, 0x47,
+	0x7c, 0x38, 0x63, 0xeb, 0x98, 0xa8, 0x79, 0x84, 0xc7, 0x3e, 0x60, 0x68, 0xbe, 0x87, 0x71, 0xbb,
+	0x70, 0x55, 0xae, 0x0f, 0x4f, 0x82, 0x4a, 0x17, 0xf7, 0xec, 0xd4, 0xa7, 0xca, 0xad, 0x39, 0x5e,
+	0x68, 0x05, 0x59, 0xd9, 0xa2, 0xe0, 0xd0, 0x26, 0x98, 0x59, 0x4b, 0xa3, 0xc8, 0xef, 0x43, 0x07,
+	0x4c, 0x53, 0x42, 0x6d, 0xbf, 0xa6, 0xed, 0xcc, 0xfa, 0x39, 0x75, 0xb3, 0x94, 0x98, 0x28, 0xfd,
+	0x42, 0xb3, 0x39, 0xab, 0xd2, 0x3f, 0x65, 0x7c, 0x59, 0x06, 0x40, 0x70, 0x75, 0x8d, 0x76, 0x6f,
+	0x6c, 0xc1, 0x16, 0xd0, 0x83, 0xc4, 0x55, 0xdd, 0xba, 0x54, 0x97, 0x43, 0xb2, 0x9e, 0x0d, 0xc9,
+	0x7a, 0x2b, 0xec, 0x9b, 0xcb, 0xca, 0x09, 0x2e, 0x38, 0x64, 0x08, 0x48, 0x2a, 0x05, 0x89, 0x6b,
+	0x58, 0x1c, 0x82, 0x1b, 0x40, 0xef, 0x61, 0xde, 0x91, 0x3b, 0xdc, 0xbf, 0x9a, 0x69, 0xf6, 0x30,
+	0xce, 0x35, 0x7b, 0x18, 0x1b, 0x93, 0x84, 0xc2, 0xad, 0xc0, 0x04, 0x54, 0x13, 0xcf, 0x0d, 0x6d,
+	0x9a, 0xc6, 0xb8, 0xa6, 0x0b, 0xaf, 0x97, 0xc7, 0x66, 0xcc, 0x1a, 0xed, 0xae, 0x65, 0x02, 0x66,
+	0x53, 0x39, 0x90, 0xeb, 0x0c, 0x19, 0x5a, 0x90, 0x6e, 0x8c, 0x20, 0xe3, 0x11, 0x43, 0x8b, 0x3b,
+	0x74, 0xad, 0x5c, 0x87, 0x0f, 0x9d, 0x00, 0x07, 0xa4, 0x56, 0xce, 0x87, 0x0e, 0xdf, 0xe7, 0x43,
+	0x87, 0xef, 0x0c, 0x4b, 0x80, 0xf0, 0x2c, 0xa8, 0xe0, 0x90, 0xc6, 0x24, 0xea, 0xd7, 0xa6, 0x05,
+	0x15, 0x8e, 0x73, 0x2a, 0x28, 0x68, 0xc8, 0xd0, 0xbc, 0x54, 0x51, 0x80, 0x61, 0x65, 0x47, 0xf0,
+	0x63, 0x30, 0xdb, 0xc3, 0xf8, 0xba, 0xdd, 0xc7, 0x71, 0x6d, 0x46, 0x74, 0xf4, 0x87, 0x03, 0x86,
+	0x0e, 0x71, 0xd2, 0x45, 0x1c, 0x3c, 0x4d, 0x02, 0x8f, 0xe2, 0x20, 0xa2, 0xfd, 0x3c, 0x88, 0xd1,
+	0xa1, 0x31, 0xd9, 0x34, 0x19, 0xdd, 0x28, 0x19, 0xc2, 0x9b, 0xd3, 0xf8, 0x4c, 0x03, 0x3b, 0xd3,
+	0x01, 0xdf, 0x02, 0x55, 0x39, 0x3d, 0xae, 0xa8, 0xe6, 0x3c, 0x20, 0x03, 0x53, 0x33, 0x28, 0x0f,
+	0x4c, 0x01, 0x86, 0x95, 0xcb, 0xc3, 0x77, 0x40, 0x75, 0x64, 0x49, 0x4d, 0xab, 0x97, 0xfe, 0xb6,
+	0x2c, 0x56, 0xae, 0xd3, 0x2c, 0x0b, 0xcf, 0x7e, 0xd7, 0x01, 0x50, 0x4e, 0x5d, 0x20, 0x0e, 0x7c,
+	0x13, 0x54, 0xce, 0xaf, 0xdb, 0x5e, 0x78, 0xf9, 0x82, 0x9a, 0x16, 0x68, 0xc0, 0xd0, 0xac, 0xc3,
+	0xa1, 0xb6, 0xd7, 0x1d, 0x32, 0xf4, 0x82, 0x34, 0x99, 0x21, 0x86, 0x95, 0xc9, 0xc3, 0x9b, 0x19,
+	0x67, 0xb9, 0x2b, 0x17, 0x1f, 0x4b, 0xd1, 0x47, 0x0c, 0xad, 0xee, 0x3e, 0xa1, 0x96, 0xbd, 0x29,
+	0x09, 0x9a, 0x71, 0x45, 0xdf, 0x0d, 0x57, 0x6e, 0xca, 0xee, 0x2b, 0xe7, 0x6e, 0xec, 0xe8, 0xb1,
+	0x09, 0xdc, 0xe0, 0x4d, 0xf9, 0xdc, 0xb3, 0xf0, 0x6e, 0x19, 0x80, 0x35, 0x2c, 0xde, 0x5f, 0xf9,
+	0x36, 0x55, 0xdc, 0xd8, 0x0e, 0x29, 0x8e, 0x8b, 0x2f, 0xa9, 0x82, 0x26, 0x7c, 0x49, 0x95, 0x76,
+	0xf1, 0x95, 0x2e, 0xfd, 0xd3, 0xaf, 0xf4, 0xe9, 0x62, 0xdb, 0x48, 0x4e, 0xcc, 0x8f, 0x7f, 0xec,
+	0x15, 0xfb, 0xe4, 0x15, 0x30, 0x1b, 0x24, 0xee, 0x0d, 0x6e, 0xaa, 0x56, 0x16, 0x5f, 0x2b, 0x07,
+	0x79, 0x9b, 0x04, 0x89, 0xdb, 0x16, 0xf6, 0xad, 0xd1, 0x31, 0x74, 0x01, 0x48, 0x22, 0x1c, 0x76,
+	0xaf, 0x7a, 0x81, 0x47, 0x45, 0x8d, 0xab, 0xe6, 0x25, 0x3e, 0xee, 0x7e, 0x66, 0xe8, 0xb5, 0xdd,
+	0x3b, 0x6c, 0x7a, 0xee, 0xe5, 0x90, 0xf2, 0x4f, 0x22, 0x61, 0xad, 0xed, 0x73, 0x73, 0x56, 0xc1,
+	0x34, 0xfc, 0x00, 0x4c, 0xf3, 0x1d, 0x15, 0x6c, 0xa8, 0x9a, 0xad, 0xa7, 0xb8, 0x43, 0x1a, 0xb2,
+	0xe4, 0x3f, 0xb0, 0x05, 0x16, 0xf0, 0x56, 0xe4, 0xc5, 0x36, 0xf5, 0x48, 0xf8, 0x1e, 0xf6, 0xdc,
+	0x75, 0x5a, 0xab, 0x08, 0xae, 0x1e, 0x1e, 0x30, 0xb4, 0x98, 0x9f, 0xb5, 0xd7, 0xc5, 0xa1, 0xb5,
+	0x43, 0xdc, 0xf8, 0xb3, 0x04, 0xe0, 0xb5, 0xc4, 0xbd, 0xc4, 0x0b, 0xb9, 0x4f, 0x64, 0x19, 0x2b,
+	0x68, 0x69, 0x2f, 0x05, 0xd5, 0xf7, 0x52, 0xd0, 0xf2, 0xb3, 0x2b, 0xe8, 0xe3, 0xf2, 0x3e, 0xbd,
+	0xa7, 0xbc, 0xab, 0x71, 0xfc, 0x83, 0x06, 0x0e, 0x5d, 0x4b, 0x5c, 0x0b, 0x7f, 0x44, 0x36, 0xf0,
+	0xf3, 0xdf, 0xab, 0x2a, 0xaa, 0x3f, 0x74, 0x70, 0xe0, 0x22, 0xc6, 0x2d, 0xdf, 0x27, 0x9b, 0x76,
+	0xe8, 0xe0, 0x67, 0x1d, 0x8e, 0x5c, 0xe2, 0x62, 0x38, 0x0a, 0x7a, 0x2a, 0xdb, 0x78, 0x1b, 0xa1,
+	0xf4, 0x7d, 0x98, 0x10, 0xe5, 0x7d, 0x98, 0x10, 0x7b, 0x63, 0xea, 0x58, 0x03, 0xce, 0x3c, 0xb1,
+	0x01, 0x8d, 0x6f, 0x74, 0xb0, 0x94, 0x0d, 0x93, 0xff, 0x09, 0xf0, 0xb4, 0x13, 0xa5, 0x3c, 0x79,
+	0x9d, 0xa6, 0x9f, 0x58, 0x27, 0xd5, 0xa6, 0x3f, 0x6a, 0xe0, 0xf0, 0x68, 0xf8, 0xfc, 0x17, 0xca,
+	0x25, 0xe3, 0x32, 0xdf, 0xbf, 0xf7, 0x60, 0x45, 0xbb, 0xff, 0x60, 0x45, 0xfb, 0xf5, 0xc1, 0x8a,
+	0xf6, 0xe9, 0xc3, 0x95, 0xa9, 0xfb, 0x0f, 0x57, 0xa6, 0x7e, 0x7a, 0xb8, 0x32, 0x75, 0x6b, 0x57,
+	0x25, 0x53, 0x7f, 0x43, 0x13, 0x57, 0x74, 0x66, 0xc4, 0xff, 0xe3, 0xde, 0xf8, 0x6b, 0x00, 0x59,
+	0x81, 0xb9, 0x41, 0x5a, 0x13, 0x00, 0x00,
 }
 
 func (this *FeeMultiplier) Equal(that interface{}) bool {
@@ -869,6 +1304,13 @@ func (m *ProtoStdTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Entropy != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Entropy))
 		i--
@@ -975,6 +1417,13 @@ func (m *StdSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Entropy != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Entropy))
 		i--
@@ -1011,17 +1460,362 @@ func (m *StdSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuth(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *SessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *ProtoBaseAccount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1161,6 +1955,10 @@ func (m *ProtoStdTx) Size() (n int) {
 	if m.Entropy != 0 {
 		n += 1 + sovAuth(uint64(m.Entropy))
 	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1206,6 +2004,164 @@ func (m *StdSignDoc) Size() (n int) {
 	if m.Entropy != 0 {
 		n += 1 + sovAuth(uint64(m.Entropy))
 	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovAuth(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgGrantSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovAuth(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgRevokeSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovAuth(uint64(m.ExpirationHeight))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovAuth(uint64(m.ExpirationHeight))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1380,30 +2336,1329 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowAuth
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtoBaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoMultiSigAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoMultiSigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			m.Multiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Multiplier |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMultipliers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMultipliers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMultipliers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeMultis = append(m.FeeMultis, FeeMultiplier{})
+			if err := m.FeeMultis[len(m.FeeMultis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			m.Default = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Default |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoStdTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoStdTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			m.Entropy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entropy |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = append(m.FeePayer[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayer == nil {
+				m.FeePayer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoStdSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoStdSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoStdSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Fee == nil {
+				m.Fee = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			m.Entropy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entropy |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = append(m.FeePayer[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayer == nil {
+				m.FeePayer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoModuleAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1413,28 +3668,27 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProtoBaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1462,11 +3716,13 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1494,8 +3750,29 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -1520,7 +3797,7 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantSessionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1543,15 +3820,15 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoMultiSigAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantSessionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoMultiSigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1578,16 +3855,16 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1597,31 +3874,29 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
-			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1631,82 +3906,27 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FeeMultiplier) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FeeMultiplier: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1734,13 +3954,15 @@ func (m *FeeMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
 			}
-			m.Multiplier = 0
+			m.ExpirationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1750,7 +3972,7 @@ func (m *FeeMultiplier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Multiplier |= int64(b&0x7F) << shift
+				m.ExpirationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1779,7 +4001,7 @@ func (m *FeeMultiplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeMultipliers) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeSessionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1802,17 +4024,17 @@ func (m *FeeMultipliers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeMultipliers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeSessionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeMultipliers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1822,103 +4044,31 @@ func (m *FeeMultipliers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeMultis = append(m.FeeMultis, FeeMultiplier{})
-			if err := m.FeeMultis[len(m.FeeMultis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
-			}
-			m.Default = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Default |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Supply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1928,24 +4078,24 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.Coin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1972,7 +4122,7 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
+func (m *FeeAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1995,17 +4145,17 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoStdTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoStdTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -2015,30 +4165,31 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -2048,31 +4199,31 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -2082,28 +4233,29 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2131,13 +4283,15 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
 			}
-			m.Entropy = 0
+			m.ExpirationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -2147,11 +4301,43 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Entropy |= int64(b&0x7F) << shift
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -2176,7 +4362,7 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoStdSignature) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantFeeAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2199,15 +4385,15 @@ func (m *ProtoStdSignature) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoStdSignature: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantFeeAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoStdSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2234,14 +4420,14 @@ func (m *ProtoStdSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2268,67 +4454,14 @@ func (m *ProtoStdSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StdSignDoc: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StdSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2356,13 +4489,15 @@ func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
 			}
-			var byteLen int
+			m.ExpirationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -2372,29 +4507,14 @@ func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ExpirationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fee = append(m.Fee[:0], dAtA[iNdEx:postIndex]...)
-			if m.Fee == nil {
-				m.Fee = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2422,11 +4542,64 @@ func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeFeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeFeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2453,16 +4626,16 @@ func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			m.Entropy = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -2472,11 +4645,26 @@ func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Entropy |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
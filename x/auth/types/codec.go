@@ -17,7 +17,15 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(StdTx{}, "posmint/StdTx")
 	cdc.RegisterStructure(&Supply{}, "posmint/Supply")
 	cdc.RegisterStructure(&ModuleAccount{}, "posmint/ModuleAccount")
+	cdc.RegisterStructure(MsgGrantSessionKey{}, "auth/msg_grant_session_key")
+	cdc.RegisterStructure(MsgRevokeSessionKey{}, "auth/msg_revoke_session_key")
+	cdc.RegisterStructure(MsgGrantFeeAllowance{}, "auth/msg_grant_fee_allowance")
+	cdc.RegisterStructure(MsgRevokeFeeAllowance{}, "auth/msg_revoke_fee_allowance")
 	cdc.RegisterImplementation((*sdk.Tx)(nil), &StdTx{})
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgGrantSessionKey{}, &MsgRevokeSessionKey{},
+		&MsgGrantFeeAllowance{}, &MsgRevokeFeeAllowance{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgGrantSessionKey{}, &MsgRevokeSessionKey{},
+		&MsgGrantFeeAllowance{}, &MsgRevokeFeeAllowance{})
 	ModuleCdc = cdc
 }
 
@@ -7,14 +7,21 @@ import (
 
 // Param module codespace constants
 const (
-	CodeInvalidMemo         sdk.CodeType = 1
-	CodeEmptyPublicKey      sdk.CodeType = 2
-	CodeAccNotFound         sdk.CodeType = 3
-	CodeInsufficientFee     sdk.CodeType = 4
-	CodeSignatureLimit      sdk.CodeType = 5
-	CodeDupTx               sdk.CodeType = 6
-	CodeInsufficientBalance sdk.CodeType = 7
-	CodeTxIndexerNil        sdk.CodeType = 8
+	CodeInvalidMemo              sdk.CodeType = 1
+	CodeEmptyPublicKey           sdk.CodeType = 2
+	CodeAccNotFound              sdk.CodeType = 3
+	CodeInsufficientFee          sdk.CodeType = 4
+	CodeSignatureLimit           sdk.CodeType = 5
+	CodeDupTx                    sdk.CodeType = 6
+	CodeInsufficientBalance      sdk.CodeType = 7
+	CodeTxIndexerNil             sdk.CodeType = 8
+	CodeInvalidSessionKey        sdk.CodeType = 9
+	CodeSessionKeyNotFound       sdk.CodeType = 10
+	CodeUnauthorizedSession      sdk.CodeType = 11
+	CodeInvalidFeeAllowance      sdk.CodeType = 12
+	CodeFeeAllowanceNotFound     sdk.CodeType = 13
+	CodeUnauthorizedFeeAllowance sdk.CodeType = 14
+	CodeInsufficientTip          sdk.CodeType = 15
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -49,3 +56,31 @@ func ErrDuplicateTx(codespace sdk.CodespaceType, txHash string) sdk.Error {
 func ErrInsufficientBalance(codespace sdk.CodespaceType, signer sdk.Address, neededFee sdk.Coins) sdk.Error {
 	return sdk.NewError(codespace, CodeDupTx, fmt.Sprintf("the signer account : %s, does not have enough coins for the tx. Need %s", signer, neededFee.String()))
 }
+
+func ErrInvalidSessionKey(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSessionKey, fmt.Sprintf("the session key is invalid: %s", reason))
+}
+
+func ErrSessionKeyNotFound(codespace sdk.CodespaceType, granter, addr sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeSessionKeyNotFound, fmt.Sprintf("the account %s has no session key with the address %s", granter, addr))
+}
+
+func ErrUnauthorizedSessionKey(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedSession, fmt.Sprintf("the session key is not authorized for the transaction: %s", reason))
+}
+
+func ErrInvalidFeeAllowance(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFeeAllowance, fmt.Sprintf("the fee allowance is invalid: %s", reason))
+}
+
+func ErrFeeAllowanceNotFound(codespace sdk.CodespaceType, granter, grantee sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeAllowanceNotFound, fmt.Sprintf("the account %s has no fee allowance for the account %s", granter, grantee))
+}
+
+func ErrUnauthorizedFeeAllowance(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedFeeAllowance, fmt.Sprintf("the fee allowance does not cover the transaction: %s", reason))
+}
+
+func ErrInsufficientTip(codespace sdk.CodespaceType, minTip, actualTip sdk.BigInt) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientTip, fmt.Sprintf("the tip above the base fee is under the minimum tip of the node. \nMinimum: %s\nActual: %s", minTip.String(), actualTip.String()))
+}
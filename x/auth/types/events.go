@@ -5,4 +5,13 @@ var (
 	EventTypeTransfer     = "transfer"
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
+	EventTypeFee          = "fee"
+	AttributeKeyBaseFee   = "base_fee"
+	AttributeKeyTip       = "tip"
+	EventTypeSessionKey   = "session_key"
+	AttributeKeyGranter   = "granter"
+	AttributeKeyAddress   = "address"
+	EventTypeFeeAllowance = "fee_allowance"
+	AttributeKeyGrantee   = "grantee"
+	AttributeKeyFeePayer  = "fee_payer"
 )
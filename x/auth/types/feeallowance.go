@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// MaxFeeAllowanceMsgTypes is the maximum number of message types a fee allowance is scoped to
+const MaxFeeAllowanceMsgTypes = 16
+
+// FeeAllowancesPrefix is the prefix for the fee allowances granted by each account
+var FeeAllowancesPrefix = []byte{0x04}
+
+// KeyForFeeAllowances - Returns the prefix of the fee allowances granted by the account
+func KeyForFeeAllowances(granter sdk.Address) []byte {
+	return append(append([]byte{}, FeeAllowancesPrefix...), granter.Bytes()...)
+}
+
+// KeyForFeeAllowance - Returns the store key of the fee allowance granted by the account to the grantee
+func KeyForFeeAllowance(granter, grantee sdk.Address) []byte {
+	return append(KeyForFeeAllowances(granter), grantee.Bytes()...)
+}
+
+// IsExpired - Returns true if the fee allowance can no longer pay fees at the height
+func (fa FeeAllowance) IsExpired(height int64) bool {
+	return fa.ExpirationHeight != 0 && height >= fa.ExpirationHeight
+}
+
+// Allows - Returns true if the fee allowance pays for the message type.
+// An empty message type list pays for every message type
+func (fa FeeAllowance) Allows(msgType string) bool {
+	if len(fa.MsgTypes) == 0 {
+		return true
+	}
+	for _, t := range fa.MsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize - Returns an error if the fee allowance cannot pay the fees of the message at the height
+func (fa FeeAllowance) Authorize(msg sdk.Msg, height int64) sdk.Error {
+	if fa.IsExpired(height) {
+		return ErrUnauthorizedFeeAllowance(ModuleName, fmt.Sprintf("expired at height %d", fa.ExpirationHeight))
+	}
+	if !fa.Allows(msg.Type()) {
+		return ErrUnauthorizedFeeAllowance(ModuleName, fmt.Sprintf("message type %s is not in scope", msg.Type()))
+	}
+	return nil
+}
+
+// Spend - Returns the fee allowance after paying the fee, or an error if it exceeds the spend limit.
+// A zero spend limit leaves the fee allowance uncapped
+func (fa FeeAllowance) Spend(fee sdk.BigInt) (FeeAllowance, sdk.Error) {
+	spent := fee
+	if !fa.Spent.IsZero() {
+		spent = fa.Spent.Add(fee)
+	}
+	if !fa.SpendLimit.IsZero() && spent.GT(fa.SpendLimit) {
+		return fa, ErrUnauthorizedFeeAllowance(ModuleName, fmt.Sprintf("paying %s exceeds the spend limit %s, already spent %s", fee, fa.SpendLimit, fa.Spent))
+	}
+	fa.Spent = spent
+	return fa, nil
+}
+
+// ValidateFeeAllowanceScope - stateless validation of the message types and spend limit of a fee allowance
+func ValidateFeeAllowanceScope(msgTypes []string, spendLimit sdk.BigInt) sdk.Error {
+	if len(msgTypes) > MaxFeeAllowanceMsgTypes {
+		return ErrInvalidFeeAllowance(ModuleName, fmt.Sprintf("more than %d message types", MaxFeeAllowanceMsgTypes))
+	}
+	seen := make(map[string]struct{}, len(msgTypes))
+	for _, t := range msgTypes {
+		if t == "" {
+			return ErrInvalidFeeAllowance(ModuleName, "empty message type")
+		}
+		if _, ok := seen[t]; ok {
+			return ErrInvalidFeeAllowance(ModuleName, fmt.Sprintf("duplicate message type %s", t))
+		}
+		seen[t] = struct{}{}
+	}
+	if !spendLimit.IsZero() && spendLimit.IsNegative() {
+		return ErrInvalidFeeAllowance(ModuleName, "negative spend limit")
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeAllowance_Authorize(t *testing.T) {
+	fa := FeeAllowance{MsgTypes: []string{MsgRevokeFeeAllowanceName}, ExpirationHeight: 10}
+	msg := MsgRevokeFeeAllowance{}
+	assert.Nil(t, fa.Authorize(msg, 9))
+	assert.NotNil(t, fa.Authorize(msg, 10))
+	assert.NotNil(t, fa.Authorize(MsgGrantFeeAllowance{}, 9))
+	// every message type, never expires
+	fa = FeeAllowance{}
+	assert.Nil(t, fa.Authorize(MsgGrantFeeAllowance{}, 1000000))
+}
+
+func TestFeeAllowance_Spend(t *testing.T) {
+	fa := FeeAllowance{SpendLimit: sdk.NewInt(100)}
+	fa, err := fa.Spend(sdk.NewInt(100))
+	assert.Nil(t, err)
+	_, err = fa.Spend(sdk.OneInt())
+	assert.NotNil(t, err)
+	// uncapped
+	fa = FeeAllowance{SpendLimit: sdk.ZeroInt(), Spent: sdk.ZeroInt()}
+	fa, err = fa.Spend(sdk.NewInt(1000000))
+	assert.Nil(t, err)
+	assert.True(t, sdk.NewInt(1000000).Equal(fa.Spent))
+}
+
+func TestMsgGrantFeeAllowance_ValidateBasic(t *testing.T) {
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	grantee := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	msg := MsgGrantFeeAllowance{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: sdk.ZeroInt(),
+	}
+	assert.Nil(t, msg.ValidateBasic())
+	msg.MsgTypes = []string{"claim", "proof"}
+	assert.Nil(t, msg.ValidateBasic())
+	invalid := msg
+	invalid.MsgTypes = []string{"claim", "claim"}
+	assert.NotNil(t, invalid.ValidateBasic())
+	invalid = msg
+	invalid.SpendLimit = sdk.NewInt(-1)
+	assert.NotNil(t, invalid.ValidateBasic())
+	invalid = msg
+	invalid.ExpirationHeight = -1
+	assert.NotNil(t, invalid.ValidateBasic())
+	invalid = msg
+	invalid.Grantee = granter
+	assert.NotNil(t, invalid.ValidateBasic())
+}
+
+func TestStdSignBytesWithFeePayer(t *testing.T) {
+	msg := MsgRevokeFeeAllowance{
+		Granter: sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()),
+		Grantee: sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()),
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10000)))
+	signBz, err := StdSignBytes("test", 1, fee, msg, "")
+	assert.Nil(t, err)
+	// an empty fee payer leaves the sign bytes unchanged
+	noPayerBz, err := StdSignBytesWithFeePayer("test", 1, fee, msg, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, signBz, noPayerBz)
+	payerBz, err := StdSignBytesWithFeePayer("test", 1, fee, msg, "", msg.Granter)
+	assert.Nil(t, err)
+	assert.NotEqual(t, signBz, payerBz)
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	// BaseFeeDenominator is the base fee charging exactly the FeeMultipliers fee of a message (basis points)
+	BaseFeeDenominator int64 = 10000
+	// DAOAccountName is the gov module DAO account receiving the base fees when they are not burned
+	DAOAccountName = "dao"
+)
+
+var (
+	// KeyFeeMarket is the param key of the fee market
+	KeyFeeMarket = []byte("FeeMarket")
+	// BaseFeeKey is the store key of the current base fee
+	BaseFeeKey = []byte{0x02}
+	// DefaultFeeMarket is disabled, bounded between 1x and 10x of the FeeMultipliers fee
+	DefaultFeeMarket = FeeMarketParams{
+		Enabled:           false,
+		MinBaseFee:        BaseFeeDenominator,
+		MaxBaseFee:        10 * BaseFeeDenominator,
+		TargetBlockTxs:    500,
+		ChangeDenominator: 8,
+		BurnBaseFee:       true,
+	}
+)
+
+// FeeMarketParams configures the EIP-1559 style base fee scaling the FeeMultipliers fee of every message
+type FeeMarketParams struct {
+	Enabled           bool  `json:"enabled"`
+	MinBaseFee        int64 `json:"min_base_fee"`       // lower bound of the base fee, in basis points
+	MaxBaseFee        int64 `json:"max_base_fee"`       // upper bound of the base fee, in basis points
+	TargetBlockTxs    int64 `json:"target_block_txs"`   // txs per block keeping the base fee constant
+	ChangeDenominator int64 `json:"change_denominator"` // bounds the change of the base fee per block to 1/denominator
+	BurnBaseFee       bool  `json:"burn_base_fee"`      // burn the base fee, or send it to the DAO
+}
+
+// FeeMarket is the current state of the fee market
+type FeeMarket struct {
+	Params  FeeMarketParams `json:"params"`
+	BaseFee int64           `json:"base_fee"`
+}
+
+// Validate - Returns an error if an enabled fee market is misconfigured
+func (p FeeMarketParams) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+	if p.MinBaseFee <= 0 || p.MaxBaseFee < p.MinBaseFee {
+		return fmt.Errorf("invalid base fee bounds: min %d, max %d", p.MinBaseFee, p.MaxBaseFee)
+	}
+	if p.TargetBlockTxs <= 0 {
+		return fmt.Errorf("invalid target block txs: %d", p.TargetBlockTxs)
+	}
+	if p.ChangeDenominator <= 0 {
+		return fmt.Errorf("invalid change denominator: %d", p.ChangeDenominator)
+	}
+	return nil
+}
+
+// NextBaseFee - Returns the base fee after a block with blockTxs transactions,
+// raised when the block is above the target and lowered when below; a block counts
+// as at most twice the target, so the change per block is bounded by 1/denominator
+func (p FeeMarketParams) NextBaseFee(baseFee, blockTxs int64) int64 {
+	excess := blockTxs - p.TargetBlockTxs
+	if excess > p.TargetBlockTxs {
+		excess = p.TargetBlockTxs
+	}
+	if excess < -p.TargetBlockTxs {
+		excess = -p.TargetBlockTxs
+	}
+	delta := sdk.NewInt(baseFee).Mul(sdk.NewInt(excess)).
+		Quo(sdk.NewInt(p.TargetBlockTxs)).Quo(sdk.NewInt(p.ChangeDenominator)).Int64()
+	if delta == 0 && excess > 0 {
+		delta = 1
+	}
+	next := baseFee + delta
+	if next < p.MinBaseFee {
+		return p.MinBaseFee
+	}
+	if next > p.MaxBaseFee {
+		return p.MaxBaseFee
+	}
+	return next
+}
+
+// MinFee - Returns the fee scaled by the base fee, rounded up
+func MinFee(fee sdk.BigInt, baseFee int64) sdk.BigInt {
+	denominator := sdk.NewInt(BaseFeeDenominator)
+	scaled := fee.Mul(sdk.NewInt(baseFee))
+	return scaled.Add(denominator).Sub(sdk.OneInt()).Quo(denominator)
+}
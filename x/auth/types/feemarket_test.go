@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeMarketParams_NextBaseFee(t *testing.T) {
+	p := FeeMarketParams{
+		Enabled:           true,
+		MinBaseFee:        BaseFeeDenominator,
+		MaxBaseFee:        2 * BaseFeeDenominator,
+		TargetBlockTxs:    100,
+		ChangeDenominator: 8,
+	}
+	// at the target the base fee is constant
+	assert.Equal(t, int64(12000), p.NextBaseFee(12000, 100))
+	// a full block raises the base fee by at most 1/8
+	assert.Equal(t, int64(13500), p.NextBaseFee(12000, 200))
+	// an empty block lowers it by at most 1/8
+	assert.Equal(t, int64(10500), p.NextBaseFee(12000, 0))
+	// a block far above the target counts as a full block
+	unbounded := p
+	unbounded.MaxBaseFee = 100 * BaseFeeDenominator
+	assert.Equal(t, int64(13500), unbounded.NextBaseFee(12000, 100000))
+	// small excesses still raise it
+	assert.Equal(t, int64(12015), p.NextBaseFee(12000, 101))
+	large := p
+	large.TargetBlockTxs = 10000
+	assert.Equal(t, int64(10001), large.NextBaseFee(10000, 10001))
+	// bounded by the params
+	assert.Equal(t, p.MinBaseFee, p.NextBaseFee(p.MinBaseFee, 0))
+	assert.Equal(t, p.MaxBaseFee, p.NextBaseFee(19000, 1000))
+}
+
+func TestFeeMarketParams_Validate(t *testing.T) {
+	assert.Nil(t, DefaultFeeMarket.Validate())
+	p := DefaultFeeMarket
+	p.Enabled = true
+	assert.Nil(t, p.Validate())
+	p.MaxBaseFee = p.MinBaseFee - 1
+	assert.NotNil(t, p.Validate())
+	p.MaxBaseFee = p.MinBaseFee
+	p.TargetBlockTxs = 0
+	assert.NotNil(t, p.Validate())
+	p.TargetBlockTxs = 1
+	p.ChangeDenominator = 0
+	assert.NotNil(t, p.Validate())
+}
+
+func TestMinFee(t *testing.T) {
+	assert.True(t, sdk.NewInt(10000).Equal(MinFee(sdk.NewInt(10000), BaseFeeDenominator)))
+	assert.True(t, sdk.NewInt(15000).Equal(MinFee(sdk.NewInt(10000), 15000)))
+	// rounded up
+	assert.True(t, sdk.NewInt(2).Equal(MinFee(sdk.NewInt(1), 10001)))
+}
@@ -7,9 +7,11 @@ import (
 
 // GenesisState - all auth state that must be provided at genesis
 type GenesisState struct {
-	Params   Params    `json:"params" yaml:"params"`
-	Accounts Accounts  `json:"accounts" yaml:"accounts"`
-	Supply   sdk.Coins `json:"supply" yaml:"supply"`
+	Params        Params         `json:"params" yaml:"params"`
+	Accounts      Accounts       `json:"accounts" yaml:"accounts"`
+	Supply        sdk.Coins      `json:"supply" yaml:"supply"`
+	SessionKeys   []SessionKey   `json:"session_keys,omitempty" yaml:"session_keys"`
+	FeeAllowances []FeeAllowance `json:"fee_allowances,omitempty" yaml:"fee_allowances"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -40,8 +42,27 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.TxSigLimit == 0 {
 		return fmt.Errorf("invalid tx signature limit: %d", data.Params.TxSigLimit)
 	}
+	if err := data.Params.FeeMarket.Validate(); err != nil {
+		return err
+	}
 	if err := NewSupply(data.Supply).ValidateBasic(); err != nil {
 		return err
 	}
+	for _, sk := range data.SessionKeys {
+		if sk.Granter.Empty() || sk.Address.Empty() {
+			return fmt.Errorf("invalid session key: empty granter or address")
+		}
+		if err := ValidateSessionKeyScope(sk.MsgTypes, sk.SpendLimit); err != nil {
+			return err
+		}
+	}
+	for _, fa := range data.FeeAllowances {
+		if fa.Granter.Empty() || fa.Grantee.Empty() {
+			return fmt.Errorf("invalid fee allowance: empty granter or grantee")
+		}
+		if err := ValidateFeeAllowanceScope(fa.MsgTypes, fa.SpendLimit); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,239 @@
+package types
+
+import (
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// ensure ProtoMsg interface compatibility at compile time
+var (
+	_ sdk.ProtoMsg = &MsgGrantSessionKey{}
+	_ sdk.ProtoMsg = &MsgRevokeSessionKey{}
+	_ sdk.ProtoMsg = &MsgGrantFeeAllowance{}
+	_ sdk.ProtoMsg = &MsgRevokeFeeAllowance{}
+)
+
+const (
+	MsgGrantSessionKeyName    = "grant_session_key"
+	MsgRevokeSessionKeyName   = "revoke_session_key"
+	MsgGrantFeeAllowanceName  = "grant_fee_allowance"
+	MsgRevokeFeeAllowanceName = "revoke_fee_allowance"
+)
+
+const (
+	MsgGrantSessionKeyFee    = 10000
+	MsgRevokeSessionKeyFee   = 10000
+	MsgGrantFeeAllowanceFee  = 10000
+	MsgRevokeFeeAllowanceFee = 10000
+)
+
+var (
+	AuthFeeMap = map[string]int64{
+		MsgGrantSessionKeyName:    MsgGrantSessionKeyFee,
+		MsgRevokeSessionKeyName:   MsgRevokeSessionKeyFee,
+		MsgGrantFeeAllowanceName:  MsgGrantFeeAllowanceFee,
+		MsgRevokeFeeAllowanceName: MsgRevokeFeeAllowanceFee,
+	}
+)
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgGrantSessionKey structure for an account to authorize a secondary public key to sign some message types
+// type MsgGrantSessionKey struct {
+// 	Granter          sdk.Address `json:"granter"`
+// 	PublicKey        string      `json:"public_key"`
+// 	MsgTypes         []string    `json:"msg_types"`
+// 	SpendLimit       sdk.BigInt  `json:"spend_limit"`
+// 	ExpirationHeight int64       `json:"expiration_height"`
+// }
+
+// Route provides router key for msg
+func (msg MsgGrantSessionKey) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgGrantSessionKey) Type() string { return MsgGrantSessionKeyName }
+
+// GetFee get fee for msg
+func (msg MsgGrantSessionKey) GetFee() sdk.BigInt {
+	return sdk.NewInt(AuthFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgGrantSessionKey) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// GetRecipient return the recipient of the msg, if any
+func (msg MsgGrantSessionKey) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgGrantSessionKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgGrantSessionKey) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("nil granter address")
+	}
+	pk, err := crypto.NewPublicKey(msg.PublicKey)
+	if err != nil {
+		return sdk.ErrInvalidPubKey(err.Error())
+	}
+	if _, ok := pk.(crypto.PublicKeyMultiSig); ok {
+		return ErrInvalidSessionKey(ModuleName, "multisig public keys cannot be session keys")
+	}
+	if sdk.Address(pk.Address()).Equals(msg.Granter) {
+		return ErrInvalidSessionKey(ModuleName, "an account cannot be its own session key")
+	}
+	if msg.ExpirationHeight < 0 {
+		return ErrInvalidSessionKey(ModuleName, "negative expiration height")
+	}
+	return ValidateSessionKeyScope(msg.MsgTypes, msg.SpendLimit)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgRevokeSessionKey structure for an account to remove one of its session keys
+// type MsgRevokeSessionKey struct {
+// 	Granter sdk.Address `json:"granter"`
+// 	Address sdk.Address `json:"address"`
+// }
+
+// Route provides router key for msg
+func (msg MsgRevokeSessionKey) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRevokeSessionKey) Type() string { return MsgRevokeSessionKeyName }
+
+// GetFee get fee for msg
+func (msg MsgRevokeSessionKey) GetFee() sdk.BigInt {
+	return sdk.NewInt(AuthFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeSessionKey) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// GetRecipient return the recipient of the msg, if any
+func (msg MsgRevokeSessionKey) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeSessionKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgRevokeSessionKey) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("nil granter address")
+	}
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("nil session key address")
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgGrantFeeAllowance structure for an account to pay the fees of the transactions of another account
+// type MsgGrantFeeAllowance struct {
+// 	Granter          sdk.Address `json:"granter"`
+// 	Grantee          sdk.Address `json:"grantee"`
+// 	SpendLimit       sdk.BigInt  `json:"spend_limit"`
+// 	ExpirationHeight int64       `json:"expiration_height"`
+// 	MsgTypes         []string    `json:"msg_types"`
+// }
+
+// Route provides router key for msg
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgGrantFeeAllowance) Type() string { return MsgGrantFeeAllowanceName }
+
+// GetFee get fee for msg
+func (msg MsgGrantFeeAllowance) GetFee() sdk.BigInt {
+	return sdk.NewInt(AuthFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// GetRecipient return the recipient of the msg, if any
+func (msg MsgGrantFeeAllowance) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("nil granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("nil grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return ErrInvalidFeeAllowance(ModuleName, "an account cannot grant itself a fee allowance")
+	}
+	if msg.ExpirationHeight < 0 {
+		return ErrInvalidFeeAllowance(ModuleName, "negative expiration height")
+	}
+	return ValidateFeeAllowanceScope(msg.MsgTypes, msg.SpendLimit)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgRevokeFeeAllowance structure for an account to stop paying the fees of another account
+// type MsgRevokeFeeAllowance struct {
+// 	Granter sdk.Address `json:"granter"`
+// 	Grantee sdk.Address `json:"grantee"`
+// }
+
+// Route provides router key for msg
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRevokeFeeAllowance) Type() string { return MsgRevokeFeeAllowanceName }
+
+// GetFee get fee for msg
+func (msg MsgRevokeFeeAllowance) GetFee() sdk.BigInt {
+	return sdk.NewInt(AuthFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// GetRecipient return the recipient of the msg, if any
+func (msg MsgRevokeFeeAllowance) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("nil granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("nil grantee address")
+	}
+	return nil
+}
@@ -27,9 +27,10 @@ var _ sdk.ParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
-	MaxMemoCharacters uint64         `json:"max_memo_characters" yaml:"max_memo_characters"`
-	TxSigLimit        uint64         `json:"tx_sig_limit" yaml:"tx_sig_limit"`
-	FeeMultiplier     FeeMultipliers `json:"fee_multipliers"`
+	MaxMemoCharacters uint64          `json:"max_memo_characters" yaml:"max_memo_characters"`
+	TxSigLimit        uint64          `json:"tx_sig_limit" yaml:"tx_sig_limit"`
+	FeeMultiplier     FeeMultipliers  `json:"fee_multipliers"`
+	FeeMarket         FeeMarketParams `json:"fee_market"`
 }
 
 // ParamKeyTable for auth module
@@ -45,6 +46,7 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: KeyMaxMemoCharacters, Value: &p.MaxMemoCharacters},
 		{Key: KeyTxSigLimit, Value: &p.TxSigLimit},
 		{Key: KeyFeeMultiplier, Value: &p.FeeMultiplier},
+		{Key: KeyFeeMarket, Value: &p.FeeMarket},
 	}
 }
 
@@ -59,6 +61,7 @@ func DefaultParams() Params {
 		MaxMemoCharacters: DefaultMaxMemoCharacters,
 		TxSigLimit:        DefaultTxSigLimit,
 		FeeMultiplier:     DefaultFeeMultiplier,
+		FeeMarket:         DefaultFeeMarket,
 	}
 }
 
@@ -69,5 +72,6 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("MaxMemoCharacters: %d\n", p.MaxMemoCharacters))
 	sb.WriteString(fmt.Sprintf("TxSigLimit: %d\n", p.TxSigLimit))
 	sb.WriteString(fmt.Sprintf("FeeMultiplier: %v\n", p.FeeMultiplier))
+	sb.WriteString(fmt.Sprintf("FeeMarket: %+v\n", p.FeeMarket))
 	return sb.String()
 }
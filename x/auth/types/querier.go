@@ -6,7 +6,10 @@ import (
 
 // query endpoints supported by the auth Querier
 const (
-	QueryAccount = "account"
+	QueryAccount       = "account"
+	QueryFeeMarket     = "feeMarket"
+	QuerySessionKeys   = "sessionKeys"
+	QueryFeeAllowances = "feeAllowances"
 )
 
 // QueryAccountParams defines the params for querying accounts.
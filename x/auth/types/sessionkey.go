@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// MaxSessionKeyMsgTypes is the maximum number of message types a session key is scoped to
+const MaxSessionKeyMsgTypes = 16
+
+// SessionKeysPrefix is the prefix for the session keys granted by each account
+var SessionKeysPrefix = []byte{0x03}
+
+// KeyForSessionKeys - Returns the prefix of the session keys granted by the account
+func KeyForSessionKeys(granter sdk.Address) []byte {
+	return append(append([]byte{}, SessionKeysPrefix...), granter.Bytes()...)
+}
+
+// KeyForSessionKey - Returns the store key of the session key with the address granted by the account
+func KeyForSessionKey(granter, addr sdk.Address) []byte {
+	return append(KeyForSessionKeys(granter), addr.Bytes()...)
+}
+
+// unscopedMsgTypes are the message types a session key cannot be scoped to: those managing the session keys and fee
+// allowances of the granter, and those moving its value without a spend the spend limit counts
+var unscopedMsgTypes = map[string]struct{}{
+	MsgGrantSessionKeyName:    {},
+	MsgRevokeSessionKeyName:   {},
+	MsgGrantFeeAllowanceName:  {},
+	MsgRevokeFeeAllowanceName: {},
+}
+
+// RegisterUnscopedMsgTypes - Excludes message types of other modules from the scope of session keys, see
+// unscopedMsgTypes. Called from the init of the module types.
+func RegisterUnscopedMsgTypes(msgTypes ...string) {
+	for _, t := range msgTypes {
+		unscopedMsgTypes[t] = struct{}{}
+	}
+}
+
+// IsUnscopedMsgType - Returns true if a session key cannot be scoped to the message type
+func IsUnscopedMsgType(msgType string) bool {
+	_, ok := unscopedMsgTypes[msgType]
+	return ok
+}
+
+// SpendMsg is a message moving tokens out of the account of its signer,
+// counted with the fee against the spend limit of a session key
+type SpendMsg interface {
+	GetSpend() sdk.BigInt
+}
+
+// MsgSpend - Returns the tokens the message moves out of the account of its signer, besides the fee
+func MsgSpend(msg sdk.Msg) sdk.BigInt {
+	if m, ok := msg.(SpendMsg); ok {
+		return m.GetSpend()
+	}
+	return sdk.ZeroInt()
+}
+
+// IsExpired - Returns true if the session key can no longer sign at the height
+func (sk SessionKey) IsExpired(height int64) bool {
+	return sk.ExpirationHeight != 0 && height >= sk.ExpirationHeight
+}
+
+// Allows - Returns true if the session key is scoped to the message type
+func (sk SessionKey) Allows(msgType string) bool {
+	for _, t := range sk.MsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize - Returns an error if the session key cannot sign the message at the height
+func (sk SessionKey) Authorize(msg sdk.Msg, height int64) sdk.Error {
+	if sk.IsExpired(height) {
+		return ErrUnauthorizedSessionKey(ModuleName, fmt.Sprintf("expired at height %d", sk.ExpirationHeight))
+	}
+	if !sk.Allows(msg.Type()) {
+		return ErrUnauthorizedSessionKey(ModuleName, fmt.Sprintf("message type %s is not in scope", msg.Type()))
+	}
+	return nil
+}
+
+// Spend - Returns the session key after spending the amount, or an error if it exceeds the spend limit.
+// A zero spend limit leaves the session key uncapped
+func (sk SessionKey) Spend(amount sdk.BigInt) (SessionKey, sdk.Error) {
+	spent := amount
+	if !sk.Spent.IsZero() {
+		spent = sk.Spent.Add(amount)
+	}
+	if !sk.SpendLimit.IsZero() && spent.GT(sk.SpendLimit) {
+		return sk, ErrUnauthorizedSessionKey(ModuleName, fmt.Sprintf("spending %s exceeds the spend limit %s, already spent %s", amount, sk.SpendLimit, sk.Spent))
+	}
+	sk.Spent = spent
+	return sk, nil
+}
+
+// ValidateSessionKeyScope - stateless validation of the message types and spend limit of a session key
+func ValidateSessionKeyScope(msgTypes []string, spendLimit sdk.BigInt) sdk.Error {
+	if len(msgTypes) == 0 {
+		return ErrInvalidSessionKey(ModuleName, "no message types")
+	}
+	if len(msgTypes) > MaxSessionKeyMsgTypes {
+		return ErrInvalidSessionKey(ModuleName, fmt.Sprintf("more than %d message types", MaxSessionKeyMsgTypes))
+	}
+	seen := make(map[string]struct{}, len(msgTypes))
+	for _, t := range msgTypes {
+		if t == "" {
+			return ErrInvalidSessionKey(ModuleName, "empty message type")
+		}
+		if IsUnscopedMsgType(t) {
+			return ErrInvalidSessionKey(ModuleName, fmt.Sprintf("session keys cannot be scoped to %s", t))
+		}
+		if _, ok := seen[t]; ok {
+			return ErrInvalidSessionKey(ModuleName, fmt.Sprintf("duplicate message type %s", t))
+		}
+		seen[t] = struct{}{}
+	}
+	if !spendLimit.IsZero() && spendLimit.IsNegative() {
+		return ErrInvalidSessionKey(ModuleName, "negative spend limit")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionKey_Authorize(t *testing.T) {
+	sk := SessionKey{MsgTypes: []string{MsgRevokeSessionKeyName}, ExpirationHeight: 10}
+	msg := MsgRevokeSessionKey{}
+	assert.Nil(t, sk.Authorize(msg, 9))
+	assert.NotNil(t, sk.Authorize(msg, 10))
+	assert.NotNil(t, sk.Authorize(MsgGrantSessionKey{}, 9))
+	// never expires
+	sk.ExpirationHeight = 0
+	assert.Nil(t, sk.Authorize(msg, 1000000))
+}
+
+func TestSessionKey_Spend(t *testing.T) {
+	sk := SessionKey{SpendLimit: sdk.NewInt(100)}
+	sk, err := sk.Spend(sdk.NewInt(100))
+	assert.Nil(t, err)
+	_, err = sk.Spend(sdk.OneInt())
+	assert.NotNil(t, err)
+	// uncapped
+	sk = SessionKey{SpendLimit: sdk.ZeroInt(), Spent: sdk.ZeroInt()}
+	sk, err = sk.Spend(sdk.NewInt(1000000))
+	assert.Nil(t, err)
+	assert.True(t, sdk.NewInt(1000000).Equal(sk.Spent))
+}
+
+func TestMsgGrantSessionKey_ValidateBasic(t *testing.T) {
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	msg := MsgGrantSessionKey{
+		Granter:    granter,
+		PublicKey:  pk.RawString(),
+		MsgTypes:   []string{"claim", "proof"},
+		SpendLimit: sdk.ZeroInt(),
+	}
+	assert.Nil(t, msg.ValidateBasic())
+	invalid := msg
+	invalid.MsgTypes = nil
+	assert.NotNil(t, invalid.ValidateBasic())
+	invalid.MsgTypes = []string{"claim", "claim"}
+	assert.NotNil(t, invalid.ValidateBasic())
+	// a session key cannot grant session keys
+	invalid.MsgTypes = []string{MsgGrantSessionKeyName}
+	assert.NotNil(t, invalid.ValidateBasic())
+	// nor grant fee allowances
+	invalid.MsgTypes = []string{MsgGrantFeeAllowanceName}
+	assert.NotNil(t, invalid.ValidateBasic())
+	invalid.MsgTypes = []string{"claim", MsgRevokeFeeAllowanceName}
+	assert.NotNil(t, invalid.ValidateBasic())
+	// nor sign the value moving messages of the other modules
+	RegisterUnscopedMsgTypes("test_stake")
+	defer delete(unscopedMsgTypes, "test_stake")
+	invalid.MsgTypes = []string{"test_stake"}
+	assert.NotNil(t, invalid.ValidateBasic())
+	invalid = msg
+	invalid.SpendLimit = sdk.NewInt(-1)
+	assert.NotNil(t, invalid.ValidateBasic())
+	invalid = msg
+	invalid.PublicKey = "bad"
+	assert.NotNil(t, invalid.ValidateBasic())
+	invalid = msg
+	invalid.Granter = sdk.Address(pk.Address())
+	assert.NotNil(t, invalid.ValidateBasic())
+}
@@ -43,6 +43,12 @@ func CountSubKeys(pub crypto.PubKey) int {
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, memo string) ([]byte, error) {
+	return StdSignBytesWithFeePayer(chainID, entropy, fee, msg, memo, nil)
+}
+
+// StdSignBytesWithFeePayer returns the bytes to sign for a transaction with the fees paid by the fee payer.
+// The fee payer is left out of the sign bytes when empty, so they match StdSignBytes
+func StdSignBytesWithFeePayer(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, memo string, feePayer sdk.Address) ([]byte, error) {
 	msgsBytes := msg.GetSignBytes()
 	var feeBytes sdk.Raw
 	feeBytes, err := fee.MarshalJSON()
@@ -50,11 +56,12 @@ func StdSignBytes(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, mem
 		return nil, fmt.Errorf("could not marshal fee to json for StdSignBytes function: %v", err.Error())
 	}
 	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
-		ChainID: chainID,
-		Fee:     feeBytes,
-		Memo:    memo,
-		Msg:     msgsBytes,
-		Entropy: entropy,
+		ChainID:  chainID,
+		Fee:      feeBytes,
+		Memo:     memo,
+		Msg:      msgsBytes,
+		Entropy:  entropy,
+		FeePayer: feePayer,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not marshal bytes to json for StdSignDoc function: %v", err.Error())
@@ -73,6 +80,7 @@ type StdTx struct {
 	Signature StdSignature `json:"signature" yaml:"signature"`
 	Memo      string       `json:"memo" yaml:"memo"`
 	Entropy   int64        `json:"entropy" yaml:"entropy"`
+	FeePayer  sdk.Address  `json:"fee_payer,omitempty" yaml:"fee_payer"` // optional account paying the fees under a fee allowance
 }
 
 func (tx *StdTx) Reset() {
@@ -147,6 +155,7 @@ func (tx StdTx) ToProto() (ProtoStdTx, error) {
 		Signature: tx.Signature.ToProto(),
 		Memo:      tx.Memo,
 		Entropy:   tx.Entropy,
+		FeePayer:  tx.FeePayer,
 	}, nil
 }
 
@@ -163,6 +172,11 @@ func (tx StdTx) GetFee() sdk.Coins {
 	return tx.Fee
 }
 
+// GetFeePayer returns the account paying the fees of the transaction, empty if the signer pays them
+func (tx StdTx) GetFeePayer() sdk.Address {
+	return tx.FeePayer
+}
+
 func (tx StdTx) GetMemo() string {
 	return tx.Memo
 }
@@ -187,6 +201,11 @@ func (tx StdTx) ValidateBasic() sdk.Error {
 	if len(tx.Signature.Signature) == 0 {
 		return sdk.ErrUnauthorized("empty signature")
 	}
+	if len(tx.FeePayer) != 0 {
+		if err := sdk.VerifyAddressFormat(tx.FeePayer); err != nil {
+			return sdk.ErrInvalidAddress(fmt.Sprintf("invalid fee payer: %s", err.Error()))
+		}
+	}
 	return nil
 }
 
@@ -206,6 +225,7 @@ func (ptx ProtoStdTx) FromProto() (StdTx, error) {
 		Signature: ss,
 		Memo:      ptx.Memo,
 		Entropy:   ptx.Entropy,
+		FeePayer:  ptx.FeePayer,
 	}, nil
 }
 
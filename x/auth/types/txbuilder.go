@@ -20,6 +20,7 @@ type TxBuilder struct {
 	chainID   string
 	memo      string
 	fees      sdk.Coins
+	feePayer  sdk.Address
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -52,6 +53,9 @@ func (bldr TxBuilder) Memo() string { return bldr.memo }
 // Fees returns the fees for the transaction
 func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 
+// FeePayer returns the account paying the fees for the transaction
+func (bldr TxBuilder) FeePayer() sdk.Address { return bldr.feePayer }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -76,6 +80,12 @@ func (bldr TxBuilder) WithFees(fees string) TxBuilder {
 	return bldr
 }
 
+// WithFeePayer returns a copy of the context with an updated fee payer.
+func (bldr TxBuilder) WithFeePayer(feePayer sdk.Address) TxBuilder {
+	bldr.feePayer = feePayer
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -95,7 +105,7 @@ func (bldr TxBuilder) BuildAndSign(address sdk.Address, privateKey crypto.Privat
 		return nil, errors.New("cant build and sign transaciton: the chainID is empty")
 	}
 	entropy := rand.Int64()
-	bytesToSign, err := StdSignBytes(bldr.chainID, entropy, bldr.fees, msg, bldr.memo)
+	bytesToSign, err := StdSignBytesWithFeePayer(bldr.chainID, entropy, bldr.fees, msg, bldr.memo, bldr.feePayer)
 	if err != nil {
 		return nil, err
 	}
@@ -107,10 +117,11 @@ func (bldr TxBuilder) BuildAndSign(address sdk.Address, privateKey crypto.Privat
 		Signature: sigBytes,
 		PublicKey: privateKey.PublicKey(),
 	}
+	tx := bldr.newTx(msg, bldr.fees, sig, bldr.memo, entropy)
 	if legacyCodec {
-		return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), 0)
+		return bldr.txEncoder(tx, 0)
 	}
-	return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), -1)
+	return bldr.txEncoder(tx, -1)
 }
 
 // BuildAndSignWithKeyBase builds a single message to be signed, and signs a transaction
@@ -123,7 +134,7 @@ func (bldr TxBuilder) BuildAndSignWithKeyBase(address sdk.Address, passphrase st
 		return nil, errors.New("cant build and sign transaciton: the chainID is empty")
 	}
 	entropy := rand.Int64()
-	bytesToSign, err := StdSignBytes(bldr.chainID, entropy, bldr.fees, msg, bldr.memo)
+	bytesToSign, err := StdSignBytesWithFeePayer(bldr.chainID, entropy, bldr.fees, msg, bldr.memo, bldr.feePayer)
 	if err != nil {
 		return nil, err
 	}
@@ -135,10 +146,11 @@ func (bldr TxBuilder) BuildAndSignWithKeyBase(address sdk.Address, passphrase st
 		Signature: sigBytes,
 		PublicKey: pk,
 	}
+	tx := bldr.newTx(msg, bldr.fees, sig, bldr.memo, entropy)
 	if legacyCodec {
-		return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), 0)
+		return bldr.txEncoder(tx, 0)
 	}
-	return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), -1)
+	return bldr.txEncoder(tx, -1)
 }
 
 func (bldr TxBuilder) SignMultisigTransaction(address sdk.Address, keys []crypto.PublicKey, passphrase string, txBytes []byte, legacyCodec bool) (signedTx []byte, err error) {
@@ -163,7 +175,7 @@ func (bldr TxBuilder) SignMultisigTransaction(address sdk.Address, keys []crypto
 	}
 	tx := t.(StdTx)
 	// get the sign bytes from the transaction
-	bytesToSign, err := StdSignBytes(bldr.chainID, tx.GetEntropy(), tx.GetFee(), tx.GetMsg(), tx.GetMemo())
+	bytesToSign, err := StdSignBytesWithFeePayer(bldr.chainID, tx.GetEntropy(), tx.GetFee(), tx.GetMsg(), tx.GetMemo(), tx.GetFeePayer())
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +224,7 @@ func (bldr TxBuilder) BuildAndSignMultisigTransaction(address sdk.Address, publi
 	// bulid the transaction from scratch
 	entropy := rand.Int64()
 	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fees)))
-	signBz, err := StdSignBytes(bldr.chainID, entropy, fee, m, bldr.memo)
+	signBz, err := StdSignBytesWithFeePayer(bldr.chainID, entropy, fee, m, bldr.memo, bldr.feePayer)
 	if err != nil {
 		return nil, err
 	}
@@ -229,10 +241,22 @@ func (bldr TxBuilder) BuildAndSignMultisigTransaction(address sdk.Address, publi
 		Signature: ms.Marshal(),
 	}
 	// create a new standard transaction object
-	tx := NewTx(m, fee, sig, "", entropy)
+	tx := bldr.newTx(m, fee, sig, "", entropy)
 	// encode it using the default encoder
 	if legacyCodec {
 		return bldr.TxEncoder()(tx, 0)
 	}
 	return bldr.TxEncoder()(tx, -1)
 }
+
+// newTx returns a transaction with the fees paid by the fee payer of the builder
+func (bldr TxBuilder) newTx(msg sdk.ProtoMsg, fees sdk.Coins, sig StdSignature, memo string, entropy int64) StdTx {
+	return StdTx{
+		Msg:       msg,
+		Fee:       fees,
+		Signature: sig,
+		Memo:      memo,
+		Entropy:   entropy,
+		FeePayer:  bldr.feePayer,
+	}
+}
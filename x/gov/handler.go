@@ -24,6 +24,54 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgDaoTransfer(ctx, msg, k)
 		case types.MsgUpgrade:
 			return handleMsgUpgrade(ctx, msg, k)
+		case types.MsgSubmitProposal:
+			if !k.IsProposalsActivated(ctx) {
+				errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgSubmitProposal(ctx, msg, k)
+		case types.MsgCancelParamChange:
+			if !k.IsScheduledParamsActivated(ctx) {
+				errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgCancelParamChange(ctx, msg, k)
+		case types.MsgCreateMultisigOwner:
+			if !k.IsMultisigACLActivated(ctx) {
+				errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgCreateMultisigOwner(ctx, msg, k)
+		case types.MsgApproveParamChange:
+			if !k.IsMultisigACLActivated(ctx) {
+				errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgApproveParamChange(ctx, msg, k)
+		case types.MsgCreateDAOStream:
+			if !k.IsDAOStreamsActivated(ctx) {
+				errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgCreateDAOStream(ctx, msg, k)
+		case types.MsgCancelDAOStream:
+			if !k.IsDAOStreamsActivated(ctx) {
+				errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgCancelDAOStream(ctx, msg, k)
+		case types.MsgSignalReadiness:
+			if !k.IsUpgradeSignalActivated(ctx) {
+				errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgSignalReadiness(ctx, msg, k)
+		case types.MsgVote:
+			if !k.IsProposalsActivated(ctx) {
+				errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
+				return sdk.ErrUnknownRequest(errMsg).Result()
+			}
+			return handleMsgVote(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -32,7 +80,111 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 func handleMsgChangeParam(ctx sdk.Ctx, msg types.MsgChangeParam, k keeper.Keeper) sdk.Result {
-	return k.ModifyParam(ctx, msg.ParamKey, msg.ParamVal, msg.FromAddress)
+	if msg.ActivationHeight != 0 && !k.IsScheduledParamsActivated(ctx) {
+		return sdk.ErrUnknownRequest("param changes with an activation height are not yet enabled").Result()
+	}
+	// params owned by a multisig owner are changed once enough members approve
+	if k.IsMultisigACLActivated(ctx) {
+		if _, found := k.GetMultisigOwner(ctx, k.GetACL(ctx).GetOwner(msg.ParamKey)); found {
+			approval, executed, err := k.ProposeParamChange(ctx, msg.ParamKey, msg.ParamVal, msg.FromAddress, msg.ActivationHeight)
+			if err != nil {
+				return err.Result()
+			}
+			return paramApprovalResult(ctx, approval, executed, msg.FromAddress)
+		}
+	}
+	if msg.ActivationHeight == 0 {
+		return k.ModifyParam(ctx, msg.ParamKey, msg.ParamVal, msg.FromAddress)
+	}
+	if err := k.ScheduleParamChange(ctx, msg.ParamKey, msg.ParamVal, msg.FromAddress, msg.ActivationHeight); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventParamScheduled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("scheduled: %s to: %v at height: %d", msg.ParamKey, msg.ParamVal, msg.ActivationHeight)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCancelParamChange(ctx sdk.Ctx, msg types.MsgCancelParamChange, k keeper.Keeper) sdk.Result {
+	if err := k.CancelParamChange(ctx, msg.ParamKey, msg.ActivationHeight, msg.FromAddress); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventParamCancelled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("cancelled: %s at height: %d", msg.ParamKey, msg.ActivationHeight)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCreateMultisigOwner(ctx sdk.Ctx, msg types.MsgCreateMultisigOwner, k keeper.Keeper) sdk.Result {
+	owner, err := k.CreateMultisigOwner(ctx, msg.Members, msg.Threshold, msg.ExpiryBlocks)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventMultisigOwner,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("created: %s with threshold: %d of %d", owner.Address, owner.Threshold, len(owner.Members))),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgApproveParamChange(ctx sdk.Ctx, msg types.MsgApproveParamChange, k keeper.Keeper) sdk.Result {
+	approval, executed, err := k.ApproveParamChange(ctx, msg.ApprovalID, msg.FromAddress)
+	if err != nil {
+		return err.Result()
+	}
+	return paramApprovalResult(ctx, approval, executed, msg.FromAddress)
+}
+
+func paramApprovalResult(ctx sdk.Ctx, approval types.ParamApproval, executed bool, sender sdk.Address) sdk.Result {
+	status := "pending"
+	if executed {
+		status = "executed"
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventParamApproval,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyApprovalID, fmt.Sprintf("%d", approval.Id)),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("approved: %s with %d approvals", approval.ParamKey, len(approval.Approvals))),
+			sdk.NewAttribute(types.AttributeKeyStatus, status),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
 func handleMsgDaoTransfer(ctx sdk.Ctx, msg types.MsgDAOTransfer, k keeper.Keeper) sdk.Result {
@@ -49,6 +201,114 @@ func handleMsgDaoTransfer(ctx sdk.Ctx, msg types.MsgDAOTransfer, k keeper.Keeper
 	return sdk.Result{}
 }
 
+func handleMsgCreateDAOStream(ctx sdk.Ctx, msg types.MsgCreateDAOStream, k keeper.Keeper) sdk.Result {
+	stream, err := k.CreateDAOStream(ctx, msg.FromAddress, msg.ToAddress, msg.Total, msg.StartHeight, msg.EndHeight, msg.CliffHeight)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventDAOStreamCreate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Total.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCancelDAOStream(ctx sdk.Ctx, msg types.MsgCancelDAOStream, k keeper.Keeper) sdk.Result {
+	stream, err := k.CancelDAOStream(ctx, msg.FromAddress, msg.StreamID)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventDAOStreamCancel,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Total.Sub(stream.Released).String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgUpgrade(ctx sdk.Ctx, msg types.MsgUpgrade, k keeper.Keeper) sdk.Result {
 	return k.HandleUpgrade(ctx, types.NewACLKey(ModuleName, string(types.UpgradeKey)), msg.Upgrade, msg.Address)
 }
+
+func handleMsgSubmitProposal(ctx sdk.Ctx, msg types.MsgSubmitProposal, k keeper.Keeper) sdk.Result {
+	proposal, err := k.SubmitProposal(ctx, msg.Proposer, msg.Content, msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventSubmitProposal,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(sdk.AttributeKeyAction, proposal.Content.Type),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgVote(ctx sdk.Ctx, msg types.MsgVote, k keeper.Keeper) sdk.Result {
+	if err := k.CastVote(ctx, msg.ProposalID, msg.ValidatorAddress, msg.Signer, msg.Option); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalID)),
+			sdk.NewAttribute(types.AttributeKeyOption, msg.Option),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSignalReadiness(ctx sdk.Ctx, msg types.MsgSignalReadiness, k keeper.Keeper) sdk.Result {
+	if err := k.SignalReadiness(ctx, msg.ValidatorAddress, msg.Signer, msg.Versions, msg.Features); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventUpgradeSignal,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("versions: %v features: %v", msg.Versions, msg.Features)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -71,9 +71,10 @@ func createTestKeeperAndContext(t *testing.T, isCheckTx bool) (sdk.Context, Keep
 	)
 	cdc := makeTestCodec()
 	maccPerms := map[string][]string{
-		auth.FeeCollectorName:   nil,
-		govTypes.DAOAccountName: {"burner", "staking", "minter"},
-		"FAKE":                  {"burner", "staking", "minter"},
+		auth.FeeCollectorName:              nil,
+		govTypes.DAOAccountName:            {"burner", "staking", "minter"},
+		govTypes.ProposalEscrowAccountName: nil,
+		"FAKE":                             {"burner", "staking", "minter"},
 	}
 	modAccAddrs := make(map[string]bool)
 	for acc := range maccPerms {
@@ -105,9 +106,14 @@ func createTestACL() govTypes.ACL {
 		acl.SetOwner("auth/MaxMemoCharacters", getRandomValidatorAddress())
 		acl.SetOwner("auth/TxSigLimit", getRandomValidatorAddress())
 		acl.SetOwner("auth/FeeMultipliers", getRandomValidatorAddress())
+		acl.SetOwner("auth/FeeMarket", getRandomValidatorAddress())
 		acl.SetOwner("gov/daoOwner", getRandomValidatorAddress())
 		acl.SetOwner("gov/acl", getRandomValidatorAddress())
 		acl.SetOwner("gov/upgrade", getRandomValidatorAddress())
+		acl.SetOwner("gov/minProposalDeposit", getRandomValidatorAddress())
+		acl.SetOwner("gov/proposalQuorum", getRandomValidatorAddress())
+		acl.SetOwner("gov/proposalThreshold", getRandomValidatorAddress())
+		acl.SetOwner("gov/votingPeriod", getRandomValidatorAddress())
 		testACL = acl
 	}
 	return testACL
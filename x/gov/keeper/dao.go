@@ -11,6 +11,11 @@ func (k Keeper) DAOTransferFrom(ctx sdk.Ctx, owner, to sdk.Address, amount sdk.B
 	if !k.GetDAOOwner(ctx).Equals(owner) {
 		return sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to transfer from the dao %s", owner.String())).Result()
 	}
+	return k.daoTransfer(ctx, owner, to, amount)
+}
+
+// daoTransfer - Sends tokens from the dao without verifying the sender is the dao owner
+func (k Keeper) daoTransfer(ctx sdk.Ctx, owner, to sdk.Address, amount sdk.BigInt) sdk.Result {
 	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
 	err := k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, types.DAOAccountName, to, coins)
 	if err != nil {
@@ -32,6 +37,11 @@ func (k Keeper) DAOBurn(ctx sdk.Ctx, owner sdk.Address, amount sdk.BigInt) sdk.R
 	if !k.GetDAOOwner(ctx).Equals(owner) {
 		return sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to burn from the dao %s", owner.String())).Result()
 	}
+	return k.daoBurn(ctx, owner, amount)
+}
+
+// daoBurn - Burns tokens from the dao without verifying the sender is the dao owner
+func (k Keeper) daoBurn(ctx sdk.Ctx, owner sdk.Address, amount sdk.BigInt) sdk.Result {
 	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
 	err := k.AuthKeeper.BurnCoins(ctx, types.DAOAccountName, coins)
 	if err != nil {
@@ -26,10 +26,57 @@ func (k Keeper) InitGenesis(ctx sdk.Ctx, data types.GenesisState) []abci.Validat
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Errorf("unable to set dao tokens: %s", err.Error()).Error())
 	}
+	for _, proposal := range data.Proposals {
+		k.SetProposal(ctx, proposal)
+		if proposal.IsActive() {
+			k.insertActiveProposal(ctx, proposal)
+		}
+	}
+	for _, vote := range data.Votes {
+		k.SetVote(ctx, vote)
+	}
+	if data.NextProposalID != 0 {
+		k.setNextProposalID(ctx, data.NextProposalID)
+	}
+	for _, pending := range data.PendingParamChanges {
+		k.SetPendingParamChange(ctx, pending)
+	}
+	for _, owner := range data.MultisigOwners {
+		k.SetMultisigOwner(ctx, owner)
+	}
+	for _, approval := range data.ParamApprovals {
+		k.SetParamApproval(ctx, approval)
+		k.insertExpiringApproval(ctx, approval)
+	}
+	if data.NextParamApprovalID != 0 {
+		k.setNextParamApprovalID(ctx, data.NextParamApprovalID)
+	}
+	for _, stream := range data.DAOStreams {
+		k.SetDAOStream(ctx, stream)
+	}
+	if data.NextDAOStreamID != 0 {
+		k.setNextDAOStreamID(ctx, data.NextDAOStreamID)
+	}
+	for _, signal := range data.UpgradeSignals {
+		k.SetUpgradeSignal(ctx, signal)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func (k Keeper) ExportGenesis(ctx sdk.Ctx) types.GenesisState {
-	return types.NewGenesisState(k.GetParams(ctx), k.GetDAOTokens(ctx))
+	gs := types.NewGenesisState(k.GetParams(ctx), k.GetDAOTokens(ctx))
+	gs.Proposals = k.GetProposals(ctx)
+	for _, proposal := range gs.Proposals {
+		gs.Votes = append(gs.Votes, k.GetVotes(ctx, proposal.Id)...)
+	}
+	gs.NextProposalID = k.getNextProposalID(ctx)
+	gs.PendingParamChanges = k.GetPendingParamChanges(ctx)
+	gs.MultisigOwners = k.GetMultisigOwners(ctx)
+	gs.ParamApprovals = k.GetParamApprovals(ctx)
+	gs.NextParamApprovalID = k.getNextParamApprovalID(ctx)
+	gs.DAOStreams = k.GetDAOStreams(ctx)
+	gs.NextDAOStreamID = k.getNextDAOStreamID(ctx)
+	gs.UpgradeSignals = k.GetUpgradeSignals(ctx)
+	return gs
 }
@@ -29,3 +29,64 @@ func TestExportGenesis(t *testing.T) {
 	assert.Equal(t, k.ExportGenesis(ctx).Params.ACL.String(), d.Params.ACL.String())
 	assert.Equal(t, k.ExportGenesis(ctx).DAOTokens.Int64(), d.DAOTokens.Int64())
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	pos, vals := createTestValidators(100, 200)
+	k.PosKeeper = pos
+	// a proposal with a vote
+	proposer := getRandomValidatorAddress()
+	deposit := k.MinProposalDeposit(ctx)
+	fundAccount(t, ctx, k, proposer, deposit)
+	paramValue, _ := k.cdc.MarshalJSON(int64(100))
+	proposal, err := k.SubmitProposal(ctx, proposer, types.ProposalContent{
+		Type:     types.ParamChangeProposalType,
+		ParamKey: "gov/votingPeriod",
+		ParamVal: paramValue,
+	}, deposit)
+	assert.Nil(t, err)
+	assert.Nil(t, k.CastVote(ctx, proposal.Id, vals[0].Address, vals[0].OutputAddress, types.VoteYesString))
+	// a scheduled param change
+	aclKey := "gov/minProposalDeposit"
+	depositValue, _ := k.cdc.MarshalJSON(sdk.NewInt(10))
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, depositValue, k.GetACL(ctx).GetOwner(aclKey), 20))
+	// a multisig owner with an outstanding approval
+	_, members := createTestMultisigOwner(t, ctx, k, "gov/votingPeriod", 2, 3)
+	_, _, err = k.ProposeParamChange(ctx, "gov/votingPeriod", paramValue, members[0], 0)
+	assert.Nil(t, err)
+	// a dao stream
+	_, err = k.CreateDAOStream(ctx, k.GetDAOOwner(ctx), getRandomValidatorAddress(), sdk.NewInt(100), 10, 20, 0)
+	assert.Nil(t, err)
+	// an upgrade signal
+	assert.Nil(t, k.SignalReadiness(ctx, vals[1].Address, vals[1].OutputAddress, []string{"RC-0.12.0"}, nil))
+
+	exported := k.ExportGenesis(ctx)
+	assert.Len(t, exported.Proposals, 1)
+	assert.Len(t, exported.Votes, 1)
+	assert.Len(t, exported.PendingParamChanges, 1)
+	assert.Len(t, exported.MultisigOwners, 1)
+	assert.Len(t, exported.ParamApprovals, 1)
+	assert.Len(t, exported.DAOStreams, 1)
+	assert.Len(t, exported.UpgradeSignals, 1)
+	assert.Nil(t, types.ValidateGenesis(exported))
+	bz, jsonErr := k.cdc.MarshalJSON(exported)
+	assert.Nil(t, jsonErr)
+	var imported types.GenesisState
+	assert.Nil(t, k.cdc.UnmarshalJSON(bz, &imported))
+
+	newCtx, newK := createTestKeeperAndContext(t, false)
+	newCtx = newCtx.WithBlockHeight(10)
+	newK.PosKeeper = pos
+	newK.InitGenesis(newCtx, imported)
+	reExported, jsonErr := newK.cdc.MarshalJSON(newK.ExportGenesis(newCtx))
+	assert.Nil(t, jsonErr)
+	assert.Equal(t, string(bz), string(reExported))
+	// the queues and ids are restored along with the state
+	assert.Equal(t, proposal.Id+1, newK.getNextProposalID(newCtx))
+	assert.Equal(t, k.Tally(ctx, proposal.Id), newK.Tally(newCtx, proposal.Id))
+	_, found := newK.GetPendingParamChange(newCtx, aclKey, 20)
+	assert.True(t, found)
+	newK.ExpireParamApprovals(newCtx.WithBlockHeight(exported.ParamApprovals[0].ExpiryHeight))
+	assert.Empty(t, newK.GetParamApprovals(newCtx))
+}
@@ -17,6 +17,7 @@ type Keeper struct {
 	codespace  sdk.CodespaceType
 	paramstore sdk.Subspace
 	AuthKeeper types.AuthKeeper
+	PosKeeper  types.PosKeeper
 	spaces     map[string]sdk.Subspace
 }
 
@@ -42,6 +43,31 @@ func (k Keeper) Logger(ctx sdk.Ctx) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// IsProposalsActivated - Returns true if on chain proposals are enabled at the context height
+func (k Keeper) IsProposalsActivated(ctx sdk.Ctx) bool {
+	return k.cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovProposalKey)
+}
+
+// IsScheduledParamsActivated - Returns true if param changes with an activation height are enabled at the context height
+func (k Keeper) IsScheduledParamsActivated(ctx sdk.Ctx) bool {
+	return k.cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ScheduledParamKey)
+}
+
+// IsMultisigACLActivated - Returns true if multisig acl owners are enabled at the context height
+func (k Keeper) IsMultisigACLActivated(ctx sdk.Ctx) bool {
+	return k.cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.MultisigACLKey)
+}
+
+// IsDAOStreamsActivated - Returns true if dao streams are enabled at the context height
+func (k Keeper) IsDAOStreamsActivated(ctx sdk.Ctx) bool {
+	return k.cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.DAOStreamKey)
+}
+
+// IsUpgradeSignalActivated - Returns true if validators may signal their upgrade readiness at the context height
+func (k Keeper) IsUpgradeSignalActivated(ctx sdk.Ctx) bool {
+	return k.cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.UpgradeSignalKey)
+}
+
 func (k Keeper) UpgradeCodec(ctx sdk.Ctx) {
 	if ctx.IsOnUpgradeHeight() {
 		k.ConvertState(ctx)
@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// CreateMultisigOwner - Register an M-of-N owner that may be assigned params in the acl
+func (k Keeper) CreateMultisigOwner(ctx sdk.Ctx, members []sdk.Address, threshold, expiryBlocks int64) (types.MultisigOwner, sdk.Error) {
+	if err := types.ValidateMultisigOwner(members, threshold, expiryBlocks); err != nil {
+		return types.MultisigOwner{}, err
+	}
+	addr := types.NewMultisigOwnerAddress(members, threshold, expiryBlocks)
+	if _, found := k.GetMultisigOwner(ctx, addr); found {
+		return types.MultisigOwner{}, types.ErrInvalidMultisigOwner(types.ModuleName, "already registered as "+addr.String())
+	}
+	owner := types.MultisigOwner{
+		Address:      addr,
+		Members:      members,
+		Threshold:    threshold,
+		ExpiryBlocks: expiryBlocks,
+	}
+	k.SetMultisigOwner(ctx, owner)
+	return owner, nil
+}
+
+// ProposeParamChange - Open a param change of a multisig owned param, approved by the proposing member
+func (k Keeper) ProposeParamChange(ctx sdk.Ctx, aclKey string, paramValue []byte, member sdk.Address, activationHeight int64) (types.ParamApproval, bool, sdk.Error) {
+	owner, found := k.GetMultisigOwner(ctx, k.GetACL(ctx).GetOwner(aclKey))
+	if !found || !owner.IsMember(member) {
+		return types.ParamApproval{}, false, types.ErrUnauthorizedParamChange(types.ModuleName, member, aclKey)
+	}
+	if activationHeight != 0 && activationHeight <= ctx.BlockHeight() {
+		return types.ParamApproval{}, false, types.ErrInvalidActivationHeight(types.ModuleName, activationHeight)
+	}
+	if err := k.validateParamValue(ctx, aclKey, paramValue); err != nil {
+		return types.ParamApproval{}, false, err
+	}
+	approval := types.ParamApproval{
+		Id:               k.getNextParamApprovalID(ctx),
+		Owner:            owner.Address,
+		ParamKey:         aclKey,
+		ParamVal:         paramValue,
+		ActivationHeight: activationHeight,
+		Approvals:        []sdk.Address{member},
+		SubmitHeight:     ctx.BlockHeight(),
+		ExpiryHeight:     ctx.BlockHeight() + owner.ExpiryBlocks,
+	}
+	k.setNextParamApprovalID(ctx, approval.Id+1)
+	if int64(len(approval.Approvals)) >= owner.Threshold {
+		return approval, true, k.executeParamApproval(ctx, approval)
+	}
+	k.SetParamApproval(ctx, approval)
+	k.insertExpiringApproval(ctx, approval)
+	return approval, false, nil
+}
+
+// ApproveParamChange - Record the approval of a member, applying the change once the threshold is reached
+func (k Keeper) ApproveParamChange(ctx sdk.Ctx, id uint64, member sdk.Address) (types.ParamApproval, bool, sdk.Error) {
+	approval, found := k.GetParamApproval(ctx, id)
+	if !found || ctx.BlockHeight() > approval.ExpiryHeight {
+		return types.ParamApproval{}, false, types.ErrParamApprovalNotFound(types.ModuleName, id)
+	}
+	// the acl may have been reassigned since the change was proposed
+	if err := k.VerifyACL(ctx, approval.ParamKey, approval.Owner); err != nil {
+		return types.ParamApproval{}, false, err
+	}
+	owner, found := k.GetMultisigOwner(ctx, approval.Owner)
+	if !found || !owner.IsMember(member) {
+		return types.ParamApproval{}, false, types.ErrUnauthorizedParamChange(types.ModuleName, member, approval.ParamKey)
+	}
+	if approval.HasApproved(member) {
+		return types.ParamApproval{}, false, types.ErrDuplicateApproval(types.ModuleName, member, id)
+	}
+	approval.Approvals = append(approval.Approvals, member)
+	if int64(len(approval.Approvals)) < owner.Threshold {
+		k.SetParamApproval(ctx, approval)
+		return approval, false, nil
+	}
+	k.deleteParamApproval(ctx, approval)
+	return approval, true, k.executeParamApproval(ctx, approval)
+}
+
+// executeParamApproval - Apply or schedule a param change on behalf of its multisig owner
+func (k Keeper) executeParamApproval(ctx sdk.Ctx, approval types.ParamApproval) sdk.Error {
+	if approval.ActivationHeight != 0 {
+		return k.ScheduleParamChange(ctx, approval.ParamKey, approval.ParamVal, approval.Owner, approval.ActivationHeight)
+	}
+	if res := k.ModifyParam(ctx, approval.ParamKey, approval.ParamVal, approval.Owner); !res.IsOK() {
+		return sdk.NewError(res.Codespace, res.Code, res.Log)
+	}
+	return nil
+}
+
+// verifyNoMultisigActionOwner - The upgrade and dao owners act through MsgUpgrade and MsgDAOTransfer, which are
+// not routed through param approvals, so neither may be a multisig owner
+func (k Keeper) verifyNoMultisigActionOwner(ctx sdk.Ctx, aclKey string) sdk.Error {
+	switch aclKey {
+	case types.NewACLKey(types.ModuleName, string(types.ACLKey)):
+		upgradeKey := types.NewACLKey(types.ModuleName, string(types.UpgradeKey))
+		if _, found := k.GetMultisigOwner(ctx, k.GetACL(ctx).GetOwner(upgradeKey)); found {
+			return types.ErrInvalidMultisigOwner(types.ModuleName, "cannot own "+upgradeKey)
+		}
+	case types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey)):
+		if _, found := k.GetMultisigOwner(ctx, k.GetDAOOwner(ctx)); found {
+			return types.ErrInvalidMultisigOwner(types.ModuleName, "cannot be the dao owner")
+		}
+	}
+	return nil
+}
+
+// ExpireParamApprovals - Remove the param approvals that did not reach their threshold before the expiry height
+func (k Keeper) ExpireParamApprovals(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.key)
+	iterator, _ := store.Iterator(types.ApprovalExpiryQueueKey, sdk.PrefixEndBytes(types.KeyForExpiringApprovals(ctx.BlockHeight())))
+	var ids []uint64
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+	for i, id := range ids {
+		_ = store.Delete(keys[i])
+		_ = store.Delete(types.KeyForParamApproval(id))
+	}
+}
+
+// isMultisigMember - Returns true if the owner is a multisig owner and the address is one of its members
+func (k Keeper) isMultisigMember(ctx sdk.Ctx, owner, addr sdk.Address) bool {
+	if !k.IsMultisigACLActivated(ctx) {
+		return false
+	}
+	msig, found := k.GetMultisigOwner(ctx, owner)
+	return found && msig.IsMember(addr)
+}
+
+// SetMultisigOwner - Store a multisig owner
+func (k Keeper) SetMultisigOwner(ctx sdk.Ctx, owner types.MultisigOwner) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.MarshalBinaryLengthPrefixed(&owner, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal multisig owner: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForMultisigOwner(owner.Address), bz)
+}
+
+// GetMultisigOwner - Retrieve a multisig owner by its address
+func (k Keeper) GetMultisigOwner(ctx sdk.Ctx, addr sdk.Address) (owner types.MultisigOwner, found bool) {
+	if addr == nil {
+		return owner, false
+	}
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForMultisigOwner(addr))
+	if bz == nil {
+		return owner, false
+	}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &owner, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal multisig owner %s: %s", addr, err.Error()))
+		return owner, false
+	}
+	return owner, true
+}
+
+// GetMultisigOwners - Retrieve every multisig owner
+func (k Keeper) GetMultisigOwners(ctx sdk.Ctx) (owners []types.MultisigOwner) {
+	owners = make([]types.MultisigOwner, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.MultisigOwnersKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var owner types.MultisigOwner
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &owner, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal multisig owner at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		owners = append(owners, owner)
+	}
+	return
+}
+
+// SetParamApproval - Store a param approval
+func (k Keeper) SetParamApproval(ctx sdk.Ctx, approval types.ParamApproval) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.MarshalBinaryLengthPrefixed(&approval, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal param approval: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForParamApproval(approval.Id), bz)
+}
+
+// GetParamApproval - Retrieve a param approval by its id
+func (k Keeper) GetParamApproval(ctx sdk.Ctx, id uint64) (approval types.ParamApproval, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForParamApproval(id))
+	if bz == nil {
+		return approval, false
+	}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &approval, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal param approval %d: %s", id, err.Error()))
+		return approval, false
+	}
+	return approval, true
+}
+
+// GetParamApprovals - Retrieve every outstanding param approval
+func (k Keeper) GetParamApprovals(ctx sdk.Ctx) (approvals []types.ParamApproval) {
+	approvals = make([]types.ParamApproval, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.ParamApprovalsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.ParamApproval
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &approval, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal param approval at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		approvals = append(approvals, approval)
+	}
+	return
+}
+
+// deleteParamApproval - Remove a param approval and its entry in the expiry queue
+func (k Keeper) deleteParamApproval(ctx sdk.Ctx, approval types.ParamApproval) {
+	store := ctx.KVStore(k.key)
+	_ = store.Delete(types.KeyForParamApproval(approval.Id))
+	_ = store.Delete(types.KeyForExpiringApproval(approval.ExpiryHeight, approval.Id))
+}
+
+// getNextParamApprovalID - Retrieve the id of the next param approval
+func (k Keeper) getNextParamApprovalID(ctx sdk.Ctx) uint64 {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.ParamApprovalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setNextParamApprovalID - Store the id of the next param approval
+func (k Keeper) setNextParamApprovalID(ctx sdk.Ctx, id uint64) {
+	store := ctx.KVStore(k.key)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	_ = store.Set(types.ParamApprovalIDKey, bz)
+}
+
+// insertExpiringApproval - Queue the param approval to be removed at its expiry height
+func (k Keeper) insertExpiringApproval(ctx sdk.Ctx, approval types.ParamApproval) {
+	store := ctx.KVStore(k.key)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, approval.Id)
+	_ = store.Set(types.KeyForExpiringApproval(approval.ExpiryHeight, approval.Id), bz)
+}
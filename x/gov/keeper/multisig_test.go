@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestMultisigOwner(t *testing.T, ctx sdk.Ctx, k Keeper, aclKey string, threshold int64, size int) (types.MultisigOwner, []sdk.Address) {
+	var members []sdk.Address
+	for i := 0; i < size; i++ {
+		members = append(members, getRandomValidatorAddress())
+	}
+	owner, err := k.CreateMultisigOwner(ctx, members, threshold, 10)
+	assert.Nil(t, err)
+	params := k.GetParams(ctx)
+	params.ACL.SetOwner(aclKey, owner.Address)
+	k.SetParams(ctx, params)
+	return owner, members
+}
+
+func TestKeeper_CreateMultisigOwner(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	members := []sdk.Address{getRandomValidatorAddress(), getRandomValidatorAddress()}
+	owner, err := k.CreateMultisigOwner(ctx, members, 2, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewMultisigOwnerAddress(members, 2, 10), owner.Address)
+	stored, found := k.GetMultisigOwner(ctx, owner.Address)
+	assert.True(t, found)
+	assert.Equal(t, owner, stored)
+	// already registered
+	_, err = k.CreateMultisigOwner(ctx, []sdk.Address{members[1], members[0]}, 2, 10)
+	assert.NotNil(t, err)
+	// threshold above the number of members
+	_, err = k.CreateMultisigOwner(ctx, members, 3, 10)
+	assert.NotNil(t, err)
+	assert.Len(t, k.GetMultisigOwners(ctx), 1)
+}
+
+func TestKeeper_ApproveParamChange(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := "gov/votingPeriod"
+	_, members := createTestMultisigOwner(t, ctx, k, aclKey, 2, 3)
+	paramValue, _ := k.cdc.MarshalJSON(int64(100))
+	// not a member
+	_, _, err := k.ProposeParamChange(ctx, aclKey, paramValue, getRandomValidatorAddress(), 0)
+	assert.NotNil(t, err)
+	approval, executed, err := k.ProposeParamChange(ctx, aclKey, paramValue, members[0], 0)
+	assert.Nil(t, err)
+	assert.False(t, executed)
+	assert.Equal(t, types.DefaultVotingPeriod, k.VotingPeriod(ctx))
+	assert.Len(t, k.GetParamApprovals(ctx), 1)
+	// duplicate approval
+	_, _, err = k.ApproveParamChange(ctx, approval.Id, members[0])
+	assert.NotNil(t, err)
+	// not a member
+	_, _, err = k.ApproveParamChange(ctx, approval.Id, getRandomValidatorAddress())
+	assert.NotNil(t, err)
+	_, executed, err = k.ApproveParamChange(ctx, approval.Id, members[1])
+	assert.Nil(t, err)
+	assert.True(t, executed)
+	assert.Equal(t, int64(100), k.VotingPeriod(ctx))
+	assert.Len(t, k.GetParamApprovals(ctx), 0)
+	// the approval is gone once executed
+	_, _, err = k.ApproveParamChange(ctx, approval.Id, members[2])
+	assert.NotNil(t, err)
+}
+
+func TestKeeper_ApproveScheduledParamChange(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	aclKey := "gov/votingPeriod"
+	owner, members := createTestMultisigOwner(t, ctx, k, aclKey, 2, 2)
+	paramValue, _ := k.cdc.MarshalJSON(int64(100))
+	activationHeight := ctx.BlockHeight() + 5
+	approval, _, err := k.ProposeParamChange(ctx, aclKey, paramValue, members[0], activationHeight)
+	assert.Nil(t, err)
+	_, executed, err := k.ApproveParamChange(ctx, approval.Id, members[1])
+	assert.Nil(t, err)
+	assert.True(t, executed)
+	pending, found := k.GetPendingParamChange(ctx, aclKey, activationHeight)
+	assert.True(t, found)
+	assert.Equal(t, owner.Address, pending.Owner)
+	// any member may cancel a change scheduled by the multisig owner
+	codec.UpgradeFeatureMap[codec.MultisigACLKey] = 5
+	defer delete(codec.UpgradeFeatureMap, codec.MultisigACLKey)
+	assert.NotNil(t, k.CancelParamChange(ctx, aclKey, activationHeight, getRandomValidatorAddress()))
+	assert.Nil(t, k.CancelParamChange(ctx, aclKey, activationHeight, members[1]))
+}
+
+func TestKeeper_ExpireParamApprovals(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := "gov/votingPeriod"
+	owner, members := createTestMultisigOwner(t, ctx, k, aclKey, 2, 2)
+	paramValue, _ := k.cdc.MarshalJSON(int64(100))
+	approval, _, err := k.ProposeParamChange(ctx, aclKey, paramValue, members[0], 0)
+	assert.Nil(t, err)
+	assert.Equal(t, ctx.BlockHeight()+owner.ExpiryBlocks, approval.ExpiryHeight)
+	// still approvable until the end of the expiry height
+	k.ExpireParamApprovals(ctx.WithBlockHeight(approval.ExpiryHeight - 1))
+	assert.Len(t, k.GetParamApprovals(ctx), 1)
+	k.ExpireParamApprovals(ctx.WithBlockHeight(approval.ExpiryHeight))
+	assert.Len(t, k.GetParamApprovals(ctx), 0)
+	_, _, err = k.ApproveParamChange(ctx.WithBlockHeight(approval.ExpiryHeight+1), approval.Id, members[1])
+	assert.NotNil(t, err)
+	assert.Equal(t, types.DefaultVotingPeriod, k.VotingPeriod(ctx))
+}
+
+func TestKeeper_MultisigActionOwner(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	owner, err := k.CreateMultisigOwner(ctx, []sdk.Address{getRandomValidatorAddress(), getRandomValidatorAddress()}, 2, 10)
+	assert.Nil(t, err)
+	// the dao owner cannot be a multisig owner
+	daoOwnerKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	paramValue, _ := k.cdc.MarshalJSON(owner.Address)
+	res := k.ModifyParam(ctx, daoOwnerKey, paramValue, k.GetACL(ctx).GetOwner(daoOwnerKey))
+	assert.False(t, res.IsOK())
+	assert.NotNil(t, k.validateParamValue(ctx, daoOwnerKey, paramValue))
+	// nor can the upgrade acl owner
+	aclKey := types.NewACLKey(types.ModuleName, string(types.ACLKey))
+	acl := k.GetACL(ctx)
+	acl.SetOwner(types.NewACLKey(types.ModuleName, string(types.UpgradeKey)), owner.Address)
+	paramValue, _ = k.cdc.MarshalJSON(acl)
+	assert.NotNil(t, k.validateParamValue(ctx, aclKey, paramValue))
+	// other params may still be owned by the multisig owner
+	acl.SetOwner(types.NewACLKey(types.ModuleName, string(types.UpgradeKey)), getRandomValidatorAddress())
+	acl.SetOwner("gov/votingPeriod", owner.Address)
+	paramValue, _ = k.cdc.MarshalJSON(acl)
+	assert.Nil(t, k.validateParamValue(ctx, aclKey, paramValue))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/gov/types"
 )
@@ -22,9 +23,24 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 		ACL:      k.GetACL(ctx),
 		Upgrade:  k.GetUpgrade(ctx),
 		DAOOwner: k.GetDAOOwner(ctx),
+
+		VotingPeriod:       k.VotingPeriod(ctx),
+		MinProposalDeposit: k.MinProposalDeposit(ctx),
+		ProposalQuorum:     k.ProposalQuorum(ctx),
+		ProposalThreshold:  k.ProposalThreshold(ctx),
 	}
 }
 
+// verifyProposalPercentages - Ensures a change of the proposal quorum or threshold keeps them valid percentages
+func (k Keeper) verifyProposalPercentages(ctx sdk.Ctx, aclKey string) sdk.Error {
+	switch aclKey {
+	case types.NewACLKey(types.ModuleName, string(types.ProposalQuorumKey)),
+		types.NewACLKey(types.ModuleName, string(types.ProposalThresholdKey)):
+		return k.GetParams(ctx).ValidateProposalPercentages()
+	}
+	return nil
+}
+
 // set the params
 func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
@@ -35,7 +51,40 @@ func (k Keeper) GetDAOOwner(ctx sdk.Ctx) (res sdk.Address) {
 	return
 }
 
+// GetFeatureStatuses - Returns the lifecycle of the registered and upgraded features at the context height
+func (k Keeper) GetFeatureStatuses(ctx sdk.Ctx) []codec.FeatureStatus {
+	return codec.FeatureStatuses(k.GetUpgrade(ctx).Features, ctx.BlockHeight())
+}
+
 func (k Keeper) GetUpgrade(ctx sdk.Ctx) (res types.Upgrade) {
 	k.paramstore.Get(ctx, types.UpgradeKey, &res)
 	return
 }
+
+// VotingPeriod - Retrieve the number of blocks a proposal is open for voting
+func (k Keeper) VotingPeriod(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultVotingPeriod
+	k.paramstore.GetIfExists(ctx, types.VotingPeriodKey, &res)
+	return
+}
+
+// MinProposalDeposit - Retrieve the minimum deposit to submit a proposal
+func (k Keeper) MinProposalDeposit(ctx sdk.Ctx) (res sdk.BigInt) {
+	res = types.DefaultMinProposalDeposit
+	k.paramstore.GetIfExists(ctx, types.MinProposalDepositKey, &res)
+	return
+}
+
+// ProposalQuorum - Retrieve the percentage of the staked tokens that must vote on a proposal
+func (k Keeper) ProposalQuorum(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultProposalQuorum
+	k.paramstore.GetIfExists(ctx, types.ProposalQuorumKey, &res)
+	return
+}
+
+// ProposalThreshold - Retrieve the percentage of the yes/no votes that must be yes for a proposal to pass
+func (k Keeper) ProposalThreshold(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultProposalThreshold
+	k.paramstore.GetIfExists(ctx, types.ProposalThresholdKey, &res)
+	return
+}
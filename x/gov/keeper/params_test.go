@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/x/gov/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -28,3 +29,27 @@ func TestKeeper_SetParamsAndGetDAOOwner(t *testing.T) {
 	k.SetParams(ctx, d)
 	assert.Equal(t, k.GetDAOOwner(ctx).String(), d.DAOOwner.String())
 }
+
+func TestKeeper_GetFeatureStatuses(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(7)
+	params := k.GetParams(ctx)
+	params.Upgrade.Features = []string{codec.GovProposalKey + ":10", codec.DAOStreamKey + ":5", "ZZZZZ:5"}
+	k.SetParams(ctx, params)
+	bz, err := queryFeatures(ctx, k)
+	assert.Nil(t, err)
+	var statuses []codec.FeatureStatus
+	assert.Nil(t, k.cdc.UnmarshalJSON(bz, &statuses))
+	assert.Len(t, statuses, len(codec.RegisteredFeatures())+1)
+	byKey := make(map[string]codec.FeatureStatus)
+	for _, s := range statuses {
+		byKey[s.Key] = s
+	}
+	assert.Equal(t, codec.FeatureScheduled, byKey[codec.GovProposalKey].Status)
+	assert.Equal(t, int64(10), byKey[codec.GovProposalKey].ActivationHeight)
+	assert.Equal(t, codec.FeatureActive, byKey[codec.DAOStreamKey].Status)
+	assert.Equal(t, codec.FeatureInactive, byKey[codec.ReplayBurnKey].Status)
+	assert.Equal(t, "gov", byKey[codec.DAOStreamKey].Module)
+	assert.False(t, byKey["ZZZZZ"].Registered)
+	assert.Equal(t, codec.FeatureActive, byKey["ZZZZZ"].Status)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// ScheduleParamChange - Verify the owner and value of the param and store the change until its activation height
+func (k Keeper) ScheduleParamChange(ctx sdk.Ctx, aclKey string, paramValue []byte, owner sdk.Address, activationHeight int64) sdk.Error {
+	if activationHeight <= ctx.BlockHeight() {
+		return types.ErrInvalidActivationHeight(types.ModuleName, activationHeight)
+	}
+	if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
+		return err
+	}
+	if err := k.validateParamValue(ctx, aclKey, paramValue); err != nil {
+		return err
+	}
+	// a second change would silently overwrite the first, so it must be cancelled first
+	if _, found := k.GetPendingParamChange(ctx, aclKey, activationHeight); found {
+		return types.ErrDuplicatePendingParamChange(types.ModuleName, aclKey, activationHeight)
+	}
+	k.SetPendingParamChange(ctx, types.PendingParamChange{
+		ParamKey:         aclKey,
+		ParamVal:         paramValue,
+		Owner:            owner,
+		SubmitHeight:     ctx.BlockHeight(),
+		ActivationHeight: activationHeight,
+	})
+	return nil
+}
+
+// CancelParamChange - Remove a pending param change if the sender is the owner that scheduled it,
+// or any member of the multisig owner that scheduled it
+func (k Keeper) CancelParamChange(ctx sdk.Ctx, aclKey string, activationHeight int64, owner sdk.Address) sdk.Error {
+	pending, found := k.GetPendingParamChange(ctx, aclKey, activationHeight)
+	if !found {
+		return types.ErrPendingParamChangeNotFound(types.ModuleName, aclKey, activationHeight)
+	}
+	if !pending.Owner.Equals(owner) && !k.isMultisigMember(ctx, pending.Owner, owner) {
+		return types.ErrUnauthorizedParamChange(types.ModuleName, owner, aclKey)
+	}
+	store := ctx.KVStore(k.key)
+	_ = store.Delete(types.KeyForPendingParamChange(activationHeight, aclKey))
+	return nil
+}
+
+// ApplyPendingParamChanges - Apply the param changes scheduled up to the current height
+func (k Keeper) ApplyPendingParamChanges(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.key)
+	iterator, _ := store.Iterator(types.PendingParamsKey, sdk.PrefixEndBytes(types.KeyForPendingParamChanges(ctx.BlockHeight())))
+	var changes []types.PendingParamChange
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingParamChange
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &pending, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal pending param change at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		changes = append(changes, pending)
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+	for i, pending := range changes {
+		_ = store.Delete(keys[i])
+		if err := k.applyPendingParamChange(ctx, pending); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not apply the pending change of %s at height %d: %s", pending.ParamKey, ctx.BlockHeight(), err.Error()))
+		}
+	}
+}
+
+// applyPendingParamChange - Apply a single param change, discarding any state changes on failure
+func (k Keeper) applyPendingParamChange(ctx sdk.Ctx, pending types.PendingParamChange) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	// the acl is verified again in case ownership changed since the change was scheduled
+	res := k.ModifyParam(cacheCtx, pending.ParamKey, pending.ParamVal, pending.Owner)
+	if !res.IsOK() {
+		return errors.New(res.Log)
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// SetPendingParamChange - Store a param change until its activation height
+func (k Keeper) SetPendingParamChange(ctx sdk.Ctx, pending types.PendingParamChange) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.MarshalBinaryLengthPrefixed(&pending, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal pending param change: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForPendingParamChange(pending.ActivationHeight, pending.ParamKey), bz)
+}
+
+// GetPendingParamChange - Retrieve a pending param change by its key and activation height
+func (k Keeper) GetPendingParamChange(ctx sdk.Ctx, aclKey string, activationHeight int64) (pending types.PendingParamChange, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForPendingParamChange(activationHeight, aclKey))
+	if bz == nil {
+		return pending, false
+	}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &pending, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal pending param change at height %d: %s", ctx.BlockHeight(), err.Error()))
+		return pending, false
+	}
+	return pending, true
+}
+
+// GetPendingParamChanges - Retrieve all pending param changes ordered by activation height
+func (k Keeper) GetPendingParamChanges(ctx sdk.Ctx) (changes []types.PendingParamChange) {
+	changes = make([]types.PendingParamChange, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.PendingParamsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingParamChange
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &pending, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal pending param change at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		changes = append(changes, pending)
+	}
+	return
+}
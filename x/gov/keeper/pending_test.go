@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_ScheduleParamChange(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := "gov/votingPeriod"
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	paramValue, _ := k.cdc.MarshalJSON(int64(100))
+	activationHeight := ctx.BlockHeight() + 10
+	// not in the future
+	err := k.ScheduleParamChange(ctx, aclKey, paramValue, owner, ctx.BlockHeight())
+	assert.NotNil(t, err)
+	// not the acl owner
+	err = k.ScheduleParamChange(ctx, aclKey, paramValue, getRandomValidatorAddress(), activationHeight)
+	assert.NotNil(t, err)
+	// invalid value
+	err = k.ScheduleParamChange(ctx, aclKey, []byte("not json"), owner, activationHeight)
+	assert.NotNil(t, err)
+	assert.Len(t, k.GetPendingParamChanges(ctx), 0)
+	err = k.ScheduleParamChange(ctx, aclKey, paramValue, owner, activationHeight)
+	assert.Nil(t, err)
+	pending, found := k.GetPendingParamChange(ctx, aclKey, activationHeight)
+	assert.True(t, found)
+	assert.Equal(t, owner, pending.Owner)
+	assert.Equal(t, ctx.BlockHeight(), pending.SubmitHeight)
+	assert.Len(t, k.GetPendingParamChanges(ctx), 1)
+	// a second change of the same param at the same height is rejected
+	otherValue, _ := k.cdc.MarshalJSON(int64(200))
+	err = k.ScheduleParamChange(ctx, aclKey, otherValue, owner, activationHeight)
+	assert.NotNil(t, err)
+	pending, _ = k.GetPendingParamChange(ctx, aclKey, activationHeight)
+	assert.Equal(t, paramValue, pending.ParamVal)
+	// the param is unchanged until the activation height
+	assert.Equal(t, types.DefaultVotingPeriod, k.VotingPeriod(ctx))
+}
+
+func TestKeeper_CancelParamChange(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := "gov/votingPeriod"
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	paramValue, _ := k.cdc.MarshalJSON(int64(100))
+	activationHeight := ctx.BlockHeight() + 10
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, paramValue, owner, activationHeight))
+	// wrong height
+	assert.NotNil(t, k.CancelParamChange(ctx, aclKey, activationHeight+1, owner))
+	// not the owner that scheduled it
+	assert.NotNil(t, k.CancelParamChange(ctx, aclKey, activationHeight, getRandomValidatorAddress()))
+	assert.Nil(t, k.CancelParamChange(ctx, aclKey, activationHeight, owner))
+	_, found := k.GetPendingParamChange(ctx, aclKey, activationHeight)
+	assert.False(t, found)
+	k.ApplyPendingParamChanges(ctx.WithBlockHeight(activationHeight))
+	assert.Equal(t, types.DefaultVotingPeriod, k.VotingPeriod(ctx))
+}
+
+func TestKeeper_ApplyPendingParamChanges(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := "gov/votingPeriod"
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	early, _ := k.cdc.MarshalJSON(int64(100))
+	late, _ := k.cdc.MarshalJSON(int64(200))
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, early, owner, ctx.BlockHeight()+5))
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, late, owner, ctx.BlockHeight()+10))
+	// nothing is due yet
+	k.ApplyPendingParamChanges(ctx.WithBlockHeight(ctx.BlockHeight() + 4))
+	assert.Equal(t, types.DefaultVotingPeriod, k.VotingPeriod(ctx))
+	assert.Len(t, k.GetPendingParamChanges(ctx), 2)
+	// only the first change is due
+	k.ApplyPendingParamChanges(ctx.WithBlockHeight(ctx.BlockHeight() + 5))
+	assert.Equal(t, int64(100), k.VotingPeriod(ctx))
+	assert.Len(t, k.GetPendingParamChanges(ctx), 1)
+	// a change is dropped if the owner lost the acl before the activation height
+	params := k.GetParams(ctx)
+	params.ACL.SetOwner(aclKey, getRandomValidatorAddress())
+	k.SetParams(ctx, params)
+	k.ApplyPendingParamChanges(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	assert.Equal(t, int64(100), k.VotingPeriod(ctx))
+	assert.Len(t, k.GetPendingParamChanges(ctx), 0)
+}
+
+func TestKeeper_IsScheduledParamsActivated(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	assert.False(t, k.IsScheduledParamsActivated(ctx))
+	codec.UpgradeFeatureMap[codec.ScheduledParamKey] = 5
+	defer delete(codec.UpgradeFeatureMap, codec.ScheduledParamKey)
+	assert.True(t, k.IsScheduledParamsActivated(ctx))
+}
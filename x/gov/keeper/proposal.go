@@ -0,0 +1,327 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// SubmitProposal - Escrow the deposit and open the proposal for voting
+func (k Keeper) SubmitProposal(ctx sdk.Ctx, proposer sdk.Address, content types.ProposalContent, deposit sdk.BigInt) (types.Proposal, sdk.Error) {
+	if err := k.ValidateProposal(ctx, content, deposit); err != nil {
+		return types.Proposal{}, err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, deposit))
+	if err := k.AuthKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ProposalEscrowAccountName, coins); err != nil {
+		return types.Proposal{}, err
+	}
+	proposal := types.Proposal{
+		Id:              k.getNextProposalID(ctx),
+		Proposer:        proposer,
+		Content:         content,
+		Deposit:         deposit,
+		SubmitHeight:    ctx.BlockHeight(),
+		VotingEndHeight: ctx.BlockHeight() + k.VotingPeriod(ctx),
+		Status:          types.StatusVotingPeriod,
+		FinalTally:      types.NewTallyResult(sdk.ZeroInt()),
+	}
+	k.SetProposal(ctx, proposal)
+	k.setNextProposalID(ctx, proposal.Id+1)
+	k.insertActiveProposal(ctx, proposal)
+	return proposal, nil
+}
+
+// ValidateProposal - Stateful validation of a proposal submission
+func (k Keeper) ValidateProposal(ctx sdk.Ctx, content types.ProposalContent, deposit sdk.BigInt) sdk.Error {
+	if err := content.ValidateBasic(); err != nil {
+		return err
+	}
+	if minDeposit := k.MinProposalDeposit(ctx); deposit.LT(minDeposit) {
+		return types.ErrInsufficientDeposit(types.ModuleName, minDeposit)
+	}
+	if content.Type != types.ParamChangeProposalType {
+		return nil
+	}
+	return k.validateParamValue(ctx, content.ParamKey, content.ParamVal)
+}
+
+// validateParamValue - Ensures the param is registered and the value can be applied without committing it
+func (k Keeper) validateParamValue(ctx sdk.Ctx, aclKey string, paramValue []byte) sdk.Error {
+	// only params in the acl are registered and may be changed
+	if k.GetACL(ctx).GetOwner(aclKey) == nil {
+		return types.ErrUnrecognizedParam(types.ModuleName, aclKey)
+	}
+	subspaceName, paramKey := types.SplitACLKey(aclKey)
+	space, ok := k.spaces[subspaceName]
+	if !ok {
+		return types.ErrSubspaceNotFound(types.ModuleName, subspaceName)
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	if err := space.Update(cacheCtx, []byte(paramKey), paramValue); err != nil {
+		return types.ErrSettingParameter(types.ModuleName, subspaceName, paramKey, string(paramValue), err.Error())
+	}
+	if err := k.verifyProposalPercentages(cacheCtx, aclKey); err != nil {
+		return err
+	}
+	return k.verifyNoMultisigActionOwner(cacheCtx, aclKey)
+}
+
+// CastVote - Record the vote of a staked validator, replacing any previous vote on the proposal
+func (k Keeper) CastVote(ctx sdk.Ctx, proposalID uint64, validatorAddr, signer sdk.Address, option string) sdk.Error {
+	if _, err := types.VoteOptionFromString(option); err != nil {
+		return err
+	}
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.ErrProposalNotFound(types.ModuleName, proposalID)
+	}
+	if !proposal.IsActive() || ctx.BlockHeight() > proposal.VotingEndHeight {
+		return types.ErrInactiveProposal(types.ModuleName, proposalID)
+	}
+	if err := k.verifyValidatorSigner(ctx, validatorAddr, signer); err != nil {
+		return err
+	}
+	k.SetVote(ctx, types.Vote{
+		ProposalID:       proposalID,
+		ValidatorAddress: validatorAddr,
+		Option:           option,
+		Height:           ctx.BlockHeight(),
+	})
+	return nil
+}
+
+// verifyValidatorSigner - Ensures the validator is staked and the signer is its operator or output address
+func (k Keeper) verifyValidatorSigner(ctx sdk.Ctx, validatorAddr, signer sdk.Address) sdk.Error {
+	validator := k.PosKeeper.Validator(ctx, validatorAddr)
+	if validator == nil || !validator.IsStaked() {
+		return types.ErrUnauthorizedVoter(types.ModuleName, signer)
+	}
+	outputAddr, _ := k.PosKeeper.GetValidatorOutputAddress(ctx, validatorAddr)
+	if !signer.Equals(validatorAddr) && !signer.Equals(outputAddr) {
+		return types.ErrUnauthorizedVoter(types.ModuleName, signer)
+	}
+	return nil
+}
+
+// Tally - Weight the votes of a proposal by the current stake of each validator
+func (k Keeper) Tally(ctx sdk.Ctx, proposalID uint64) types.TallyResult {
+	tally := types.NewTallyResult(k.PosKeeper.GetStakedTokens(ctx))
+	for _, vote := range k.GetVotes(ctx, proposalID) {
+		validator := k.PosKeeper.Validator(ctx, vote.ValidatorAddress)
+		if validator == nil || !validator.IsStaked() {
+			continue
+		}
+		option, err := types.VoteOptionFromString(vote.Option)
+		if err != nil {
+			continue
+		}
+		tally = tally.AddVote(option, validator.GetTokens())
+	}
+	return tally
+}
+
+// GetProposalTally - Retrieve the final tally of a closed proposal or the running tally of an active one
+func (k Keeper) GetProposalTally(ctx sdk.Ctx, proposalID uint64) (types.TallyResult, sdk.Error) {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.TallyResult{}, types.ErrProposalNotFound(types.ModuleName, proposalID)
+	}
+	if !proposal.IsActive() {
+		return proposal.FinalTally, nil
+	}
+	return k.Tally(ctx, proposalID), nil
+}
+
+// EndProposals - Tally and close the proposals whose voting period ends at or before this height
+func (k Keeper) EndProposals(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.key)
+	iterator, _ := store.Iterator(types.ActiveProposalQueueKey, sdk.PrefixEndBytes(types.KeyForActiveProposals(ctx.BlockHeight())))
+	var ids []uint64
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		id, err := types.ProposalIDFromBytes(iterator.Value())
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not decode active proposal at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		ids = append(ids, id)
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+	for i, id := range ids {
+		_ = store.Delete(keys[i])
+		proposal, found := k.GetProposal(ctx, id)
+		if !found || !proposal.IsActive() {
+			continue
+		}
+		k.endProposal(ctx, proposal)
+	}
+}
+
+// endProposal - Tally, refund and execute a single proposal
+func (k Keeper) endProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	tally := k.Tally(ctx, proposal.Id)
+	quorum, threshold := k.ProposalQuorum(ctx), k.ProposalThreshold(ctx)
+	// the deposit is forfeited to the dao if the validators do not show up to vote
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, proposal.Deposit))
+	if tally.HasQuorum(quorum) {
+		if err := k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, types.ProposalEscrowAccountName, proposal.Proposer, coins); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not refund the deposit of proposal %d: %s", proposal.Id, err.Error()))
+		}
+	} else if err := k.AuthKeeper.SendCoinsFromModuleToModule(ctx, types.ProposalEscrowAccountName, types.DAOAccountName, coins); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not forfeit the deposit of proposal %d: %s", proposal.Id, err.Error()))
+	}
+	proposal.Status = types.StatusRejected
+	if tally.Passes(quorum, threshold) {
+		if err := k.executeProposal(ctx, proposal); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not execute proposal %d: %s", proposal.Id, err.Error()))
+			proposal.Status = types.StatusFailed
+		} else {
+			proposal.Status = types.StatusPassed
+		}
+	}
+	proposal.FinalTally = tally
+	k.SetProposal(ctx, proposal)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventProposalResult,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+		sdk.NewAttribute(types.AttributeKeyStatus, proposal.Status),
+	))
+}
+
+// executeProposal - Run the proposal content through the governance paths, discarding all changes on failure
+func (k Keeper) executeProposal(ctx sdk.Ctx, proposal types.Proposal) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	var res sdk.Result
+	content := proposal.Content
+	switch content.Type {
+	case types.ParamChangeProposalType:
+		res = k.modifyParam(cacheCtx, content.ParamKey, content.ParamVal, proposal.Proposer)
+	case types.UpgradeProposalType:
+		res = k.handleUpgrade(cacheCtx, types.NewACLKey(types.ModuleName, string(types.UpgradeKey)), content.Upgrade, proposal.Proposer)
+	case types.DAOTransferProposalType:
+		da, er := types.DAOActionFromString(content.DaoAction)
+		if er != nil {
+			return er
+		}
+		switch da {
+		case types.DAOTransfer:
+			res = k.daoTransfer(cacheCtx, proposal.Proposer, content.ToAddress, content.Amount)
+		case types.DAOBurn:
+			res = k.daoBurn(cacheCtx, proposal.Proposer, content.Amount)
+		}
+	default:
+		return types.ErrUnrecognizedProposalType(types.ModuleName, content.Type)
+	}
+	if !res.IsOK() {
+		return errors.New(res.Log)
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// SetProposal - Store a proposal
+func (k Keeper) SetProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.MarshalBinaryLengthPrefixed(&proposal, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal proposal: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForProposal(proposal.Id), bz)
+}
+
+// GetProposal - Retrieve a proposal by id
+func (k Keeper) GetProposal(ctx sdk.Ctx, id uint64) (proposal types.Proposal, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForProposal(id))
+	if bz == nil {
+		return proposal, false
+	}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &proposal, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal proposal %d: %s", id, err.Error()))
+		return proposal, false
+	}
+	return proposal, true
+}
+
+// GetProposals - Retrieve every proposal
+func (k Keeper) GetProposals(ctx sdk.Ctx) (proposals []types.Proposal) {
+	proposals = make([]types.Proposal, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.ProposalsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &proposal, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal proposal at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		proposals = append(proposals, proposal)
+	}
+	return
+}
+
+// SetVote - Store a vote on a proposal
+func (k Keeper) SetVote(ctx sdk.Ctx, vote types.Vote) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.MarshalBinaryLengthPrefixed(&vote, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal vote: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForVote(vote.ProposalID, vote.ValidatorAddress), bz)
+}
+
+// GetVotes - Retrieve every vote on a proposal
+func (k Keeper) GetVotes(ctx sdk.Ctx, proposalID uint64) (votes []types.Vote) {
+	votes = make([]types.Vote, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.KeyForVotes(proposalID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &vote, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal vote at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		votes = append(votes, vote)
+	}
+	return
+}
+
+// getNextProposalID - Retrieve the id of the next proposal, starting at 1
+func (k Keeper) getNextProposalID(ctx sdk.Ctx) uint64 {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.ProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setNextProposalID - Store the id of the next proposal
+func (k Keeper) setNextProposalID(ctx sdk.Ctx, id uint64) {
+	store := ctx.KVStore(k.key)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	_ = store.Set(types.ProposalIDKey, bz)
+}
+
+// insertActiveProposal - Queue the proposal to be tallied at the end of its voting period
+func (k Keeper) insertActiveProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, proposal.Id)
+	_ = store.Set(types.KeyForActiveProposal(proposal.VotingEndHeight, proposal.Id), bz)
+}
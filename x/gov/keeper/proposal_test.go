@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/keeper"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	nodesExported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPosKeeper struct {
+	validators map[string]nodesTypes.Validator
+}
+
+func (m mockPosKeeper) GetStakedTokens(ctx sdk.Ctx) sdk.BigInt {
+	total := sdk.ZeroInt()
+	for _, v := range m.validators {
+		if v.IsStaked() {
+			total = total.Add(v.StakedTokens)
+		}
+	}
+	return total
+}
+
+func (m mockPosKeeper) Validator(ctx sdk.Ctx, addr sdk.Address) nodesExported.ValidatorI {
+	v, found := m.validators[addr.String()]
+	if !found {
+		return nil
+	}
+	return v
+}
+
+func (m mockPosKeeper) GetValidatorOutputAddress(ctx sdk.Ctx, operatorAddress sdk.Address) (sdk.Address, bool) {
+	v, found := m.validators[operatorAddress.String()]
+	if v.OutputAddress == nil {
+		return v.Address, found
+	}
+	return v.OutputAddress, found
+}
+
+func (m mockPosKeeper) BlocksPerSession(ctx sdk.Ctx) int64 {
+	return 4
+}
+
+func createTestValidators(stakes ...int64) (mockPosKeeper, []nodesTypes.Validator) {
+	m := mockPosKeeper{validators: make(map[string]nodesTypes.Validator)}
+	var vals []nodesTypes.Validator
+	for _, stake := range stakes {
+		pk := getRandomPubKey()
+		v := nodesTypes.NewValidator(sdk.Address(pk.Address()), pk, []string{"0001"}, "https://www.google.com:443", sdk.NewInt(stake), getRandomValidatorAddress())
+		m.validators[v.Address.String()] = v
+		vals = append(vals, v)
+	}
+	return m, vals
+}
+
+func fundAccount(t *testing.T, ctx sdk.Ctx, k Keeper, addr sdk.Address, amount sdk.BigInt) {
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, coins))
+	assert.Nil(t, k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, types.DAOAccountName, addr, coins))
+}
+
+func TestKeeper_SubmitProposal(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	proposer := getRandomValidatorAddress()
+	deposit := k.MinProposalDeposit(ctx)
+	fundAccount(t, ctx, k, proposer, deposit)
+	paramValue, _ := k.cdc.MarshalJSON(int64(100))
+	content := types.ProposalContent{
+		Type:     types.ParamChangeProposalType,
+		ParamKey: "gov/votingPeriod",
+		ParamVal: paramValue,
+	}
+	// below the minimum deposit
+	_, err := k.SubmitProposal(ctx, proposer, content, deposit.Sub(sdk.OneInt()))
+	assert.NotNil(t, err)
+	// unknown param
+	unknown := content
+	unknown.ParamKey = "gov/notAParam"
+	_, err = k.SubmitProposal(ctx, proposer, unknown, deposit)
+	assert.NotNil(t, err)
+	// malformed value
+	malformed := content
+	malformed.ParamVal = []byte(`"abc"`)
+	_, err = k.SubmitProposal(ctx, proposer, malformed, deposit)
+	assert.NotNil(t, err)
+	// quorum or threshold out of (0, 100]
+	for _, value := range []int64{0, 101} {
+		percentage := content
+		percentage.ParamKey = "gov/proposalQuorum"
+		percentage.ParamVal, _ = k.cdc.MarshalJSON(value)
+		_, err = k.SubmitProposal(ctx, proposer, percentage, deposit)
+		assert.NotNil(t, err)
+		percentage.ParamKey = "gov/proposalThreshold"
+		_, err = k.SubmitProposal(ctx, proposer, percentage, deposit)
+		assert.NotNil(t, err)
+	}
+	daoTokens := k.GetDAOTokens(ctx)
+	proposal, err := k.SubmitProposal(ctx, proposer, content, deposit)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), proposal.Id)
+	assert.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	assert.Equal(t, ctx.BlockHeight()+k.VotingPeriod(ctx), proposal.VotingEndHeight)
+	// the deposit is escrowed apart from the dao funds
+	assert.True(t, k.GetDAOTokens(ctx).Equal(daoTokens))
+	escrow := k.AuthKeeper.GetModuleAccount(ctx, types.ProposalEscrowAccountName)
+	assert.True(t, escrow.GetCoins().AmountOf(sdk.DefaultStakeDenom).Equal(deposit))
+	stored, found := k.GetProposal(ctx, proposal.Id)
+	assert.True(t, found)
+	assert.Equal(t, proposal.Id, stored.Id)
+	assert.Equal(t, proposal.Content.ParamKey, stored.Content.ParamKey)
+	assert.Equal(t, proposal.Content.ParamVal, stored.Content.ParamVal)
+	assert.True(t, stored.Deposit.Equal(deposit))
+	assert.Equal(t, proposal.VotingEndHeight, stored.VotingEndHeight)
+	// insufficient funds for a second deposit
+	_, err = k.SubmitProposal(ctx, proposer, content, deposit)
+	assert.NotNil(t, err)
+	assert.Len(t, k.GetProposals(ctx), 1)
+}
+
+func TestKeeper_CastVote(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	pos, vals := createTestValidators(100, 200)
+	k.PosKeeper = pos
+	proposer := getRandomValidatorAddress()
+	deposit := k.MinProposalDeposit(ctx)
+	fundAccount(t, ctx, k, proposer, deposit)
+	proposal, err := k.SubmitProposal(ctx, proposer, types.ProposalContent{
+		Type:    types.UpgradeProposalType,
+		Upgrade: types.NewUpgrade(1000, "2.0.0"),
+	}, deposit)
+	assert.Nil(t, err)
+	// the operator may vote
+	assert.Nil(t, k.CastVote(ctx, proposal.Id, vals[0].Address, vals[0].Address, types.VoteYesString))
+	// the output address may vote
+	assert.Nil(t, k.CastVote(ctx, proposal.Id, vals[1].Address, vals[1].OutputAddress, types.VoteNoString))
+	// any other signer may not
+	assert.NotNil(t, k.CastVote(ctx, proposal.Id, vals[1].Address, getRandomValidatorAddress(), types.VoteYesString))
+	// a non validator may not
+	nonValidator := getRandomValidatorAddress()
+	assert.NotNil(t, k.CastVote(ctx, proposal.Id, nonValidator, nonValidator, types.VoteYesString))
+	// unknown proposal and option
+	assert.NotNil(t, k.CastVote(ctx, proposal.Id+1, vals[0].Address, vals[0].Address, types.VoteYesString))
+	assert.NotNil(t, k.CastVote(ctx, proposal.Id, vals[0].Address, vals[0].Address, "maybe"))
+	tally := k.Tally(ctx, proposal.Id)
+	assert.True(t, tally.Yes.Equal(sdk.NewInt(100)))
+	assert.True(t, tally.No.Equal(sdk.NewInt(200)))
+	// a new vote replaces the previous one
+	assert.Nil(t, k.CastVote(ctx, proposal.Id, vals[1].Address, vals[1].Address, types.VoteYesString))
+	tally = k.Tally(ctx, proposal.Id)
+	assert.True(t, tally.Yes.Equal(sdk.NewInt(300)))
+	assert.True(t, tally.No.IsZero())
+	assert.Len(t, k.GetVotes(ctx, proposal.Id), 2)
+	// voting closes after the end height
+	ctx = ctx.WithBlockHeight(proposal.VotingEndHeight + 1)
+	assert.NotNil(t, k.CastVote(ctx, proposal.Id, vals[0].Address, vals[0].Address, types.VoteNoString))
+}
+
+func TestKeeper_EndProposals(t *testing.T) {
+	tests := []struct {
+		name          string
+		votes         []string
+		expected      string
+		depositRefund bool
+	}{
+		{"passes", []string{types.VoteYesString, types.VoteYesString, types.VoteNoString}, types.StatusPassed, true},
+		{"rejected by no votes", []string{types.VoteNoString, types.VoteNoString, types.VoteYesString}, types.StatusRejected, true},
+		{"rejected without quorum", []string{types.VoteYesString}, types.StatusRejected, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, k := createTestKeeperAndContext(t, false)
+			pos, vals := createTestValidators(100, 100, 100, 100)
+			k.PosKeeper = pos
+			proposer := getRandomValidatorAddress()
+			deposit := k.MinProposalDeposit(ctx)
+			fundAccount(t, ctx, k, proposer, deposit)
+			paramValue, _ := k.cdc.MarshalJSON(int64(100))
+			proposal, err := k.SubmitProposal(ctx, proposer, types.ProposalContent{
+				Type:     types.ParamChangeProposalType,
+				ParamKey: "gov/votingPeriod",
+				ParamVal: paramValue,
+			}, deposit)
+			assert.Nil(t, err)
+			for i, option := range tt.votes {
+				assert.Nil(t, k.CastVote(ctx, proposal.Id, vals[i].Address, vals[i].Address, option))
+			}
+			// not yet at the end of the voting period
+			k.EndProposals(ctx.WithBlockHeight(proposal.VotingEndHeight - 1))
+			stored, _ := k.GetProposal(ctx, proposal.Id)
+			assert.True(t, stored.IsActive())
+			endCtx := ctx.WithBlockHeight(proposal.VotingEndHeight)
+			k.EndProposals(endCtx)
+			stored, _ = k.GetProposal(endCtx, proposal.Id)
+			assert.Equal(t, tt.expected, stored.Status)
+			assert.True(t, stored.FinalTally.TotalStaked.Equal(sdk.NewInt(400)))
+			if tt.expected == types.StatusPassed {
+				assert.Equal(t, int64(100), k.VotingPeriod(endCtx))
+			} else {
+				assert.Equal(t, types.DefaultVotingPeriod, k.VotingPeriod(endCtx))
+			}
+			escrow := k.AuthKeeper.GetModuleAccount(endCtx, types.ProposalEscrowAccountName)
+			assert.True(t, escrow.GetCoins().AmountOf(sdk.DefaultStakeDenom).IsZero())
+			balance := k.AuthKeeper.GetModuleAccount(endCtx, types.DAOAccountName).GetCoins().AmountOf(sdk.DefaultStakeDenom)
+			if tt.depositRefund {
+				assert.True(t, balance.IsZero())
+			} else {
+				assert.True(t, balance.Equal(deposit))
+			}
+			// the final tally is kept once the proposal is closed
+			tally, er := k.GetProposalTally(endCtx, proposal.Id)
+			assert.Nil(t, er)
+			assert.Equal(t, stored.FinalTally, tally)
+		})
+	}
+}
+
+func TestKeeper_EndProposalsExecution(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	pos, vals := createTestValidators(100)
+	k.PosKeeper = pos
+	proposer := getRandomValidatorAddress()
+	recipient := getRandomValidatorAddress()
+	deposit := k.MinProposalDeposit(ctx)
+	fundAccount(t, ctx, k, proposer, deposit.MulRaw(2))
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, deposit.MulRaw(2)))))
+	// a transfer the dao can afford and one it cannot
+	transfer, err := k.SubmitProposal(ctx, proposer, types.ProposalContent{
+		Type:      types.DAOTransferProposalType,
+		DaoAction: types.DAOTransferString,
+		ToAddress: recipient,
+		Amount:    deposit.MulRaw(2),
+	}, deposit)
+	assert.Nil(t, err)
+	tooLarge, err := k.SubmitProposal(ctx, proposer, types.ProposalContent{
+		Type:      types.DAOTransferProposalType,
+		DaoAction: types.DAOTransferString,
+		ToAddress: recipient,
+		Amount:    deposit.MulRaw(10),
+	}, deposit)
+	assert.Nil(t, err)
+	assert.Nil(t, k.CastVote(ctx, transfer.Id, vals[0].Address, vals[0].Address, types.VoteYesString))
+	assert.Nil(t, k.CastVote(ctx, tooLarge.Id, vals[0].Address, vals[0].Address, types.VoteYesString))
+	endCtx := ctx.WithBlockHeight(transfer.VotingEndHeight)
+	k.EndProposals(endCtx)
+	// the deposits are refunded before each proposal is executed
+	stored, _ := k.GetProposal(endCtx, transfer.Id)
+	assert.Equal(t, types.StatusPassed, stored.Status)
+	stored, _ = k.GetProposal(endCtx, tooLarge.Id)
+	assert.Equal(t, types.StatusFailed, stored.Status)
+	// the passed transfer emptied the dao and the failed one changed nothing
+	assert.True(t, k.GetDAOTokens(endCtx).IsZero())
+	// the escrowed deposits are not part of the dao funds the transfer spent
+	assert.True(t, k.AuthKeeper.(keeper.Keeper).GetCoins(endCtx, proposer).AmountOf(sdk.DefaultStakeDenom).Equal(deposit.MulRaw(2)))
+}
+
+func TestKeeper_IsProposalsActivated(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	assert.False(t, k.IsProposalsActivated(ctx))
+	codec.UpgradeFeatureMap[codec.GovProposalKey] = 5
+	defer delete(codec.UpgradeFeatureMap, codec.GovProposalKey)
+	assert.True(t, k.IsProposalsActivated(ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/gov/types"
@@ -19,6 +21,24 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDAOOwner(ctx, k)
 		case types.QueryUpgrade:
 			return queryUpgrade(ctx, k)
+		case types.QueryProposals:
+			return queryProposals(ctx, k)
+		case types.QueryProposal:
+			return queryProposal(ctx, req, k)
+		case types.QueryTally:
+			return queryTally(ctx, req, k)
+		case types.QueryPendingParams:
+			return queryPendingParams(ctx, k)
+		case types.QueryMultisigOwners:
+			return queryMultisigOwners(ctx, k)
+		case types.QueryParamApprovals:
+			return queryParamApprovals(ctx, k)
+		case types.QueryDAOStreams:
+			return queryDAOStreams(ctx, k)
+		case types.QueryUpgradeReadiness:
+			return queryUpgradeReadiness(ctx, k)
+		case types.QueryFeatures:
+			return queryFeatures(ctx, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -60,3 +80,100 @@ func queryUpgrade(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	}
 	return res, nil
 }
+
+func queryProposals(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	proposals := k.GetProposals(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, proposals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryProposal(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	proposal, found := k.GetProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, types.ErrProposalNotFound(types.ModuleName, params.ProposalID)
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, proposal)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryTally(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	tally, er := k.GetProposalTally(ctx, params.ProposalID)
+	if er != nil {
+		return nil, er
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, tally)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryPendingParams(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	changes := k.GetPendingParamChanges(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryMultisigOwners(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	owners := k.GetMultisigOwners(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, owners)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryParamApprovals(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	approvals := k.GetParamApprovals(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, approvals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryDAOStreams(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	streams := k.GetDAOStreams(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, streams)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryUpgradeReadiness(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	readiness := k.GetUpgradeReadiness(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, readiness)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryFeatures(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	features := k.GetFeatureStatuses(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, features)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
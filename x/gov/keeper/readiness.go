@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// SignalReadiness - Record the versions and feature keys supported by the binary of a staked validator,
+// replacing its previous signal
+func (k Keeper) SignalReadiness(ctx sdk.Ctx, validatorAddr, signer sdk.Address, versions, features []string) sdk.Error {
+	if err := k.verifyValidatorSigner(ctx, validatorAddr, signer); err != nil {
+		return err
+	}
+	k.SetUpgradeSignal(ctx, types.UpgradeSignal{
+		ValidatorAddress: validatorAddr,
+		Versions:         versions,
+		Features:         features,
+		Height:           ctx.BlockHeight(),
+	})
+	return nil
+}
+
+// GetUpgradeReadiness - Returns the share of the staked tokens whose validators signalled support for
+// every part of the upgrade not yet activated
+func (k Keeper) GetUpgradeReadiness(ctx sdk.Ctx) types.UpgradeReadiness {
+	u := k.GetUpgrade(ctx)
+	version, features := types.PendingUpgrade(u, ctx.BlockHeight())
+	readiness := types.UpgradeReadiness{
+		Version:    version,
+		Height:     u.Height,
+		Features:   features,
+		ReadyStake: sdk.ZeroInt(),
+		TotalStake: k.PosKeeper.GetStakedTokens(ctx),
+	}
+	for _, signal := range k.GetUpgradeSignals(ctx) {
+		validator := k.PosKeeper.Validator(ctx, signal.ValidatorAddress)
+		if validator == nil || !validator.IsStaked() {
+			continue
+		}
+		readiness.SignalledValidators++
+		if !signal.Supports(version, features) {
+			continue
+		}
+		readiness.ReadyValidators++
+		readiness.ReadyStake = readiness.ReadyStake.Add(validator.GetTokens())
+	}
+	return readiness
+}
+
+// PruneUpgradeSignals - Delete the readiness signals of the validators that are no longer staked
+func (k Keeper) PruneUpgradeSignals(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.key)
+	for _, signal := range k.GetUpgradeSignals(ctx) {
+		validator := k.PosKeeper.Validator(ctx, signal.ValidatorAddress)
+		if validator == nil || !validator.IsStaked() {
+			_ = store.Delete(types.KeyForUpgradeSignal(signal.ValidatorAddress))
+		}
+	}
+}
+
+// SetUpgradeSignal - Store the readiness signal of a validator
+func (k Keeper) SetUpgradeSignal(ctx sdk.Ctx, signal types.UpgradeSignal) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.MarshalBinaryLengthPrefixed(&signal, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal upgrade signal: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForUpgradeSignal(signal.ValidatorAddress), bz)
+}
+
+// GetUpgradeSignals - Retrieve the latest readiness signal of every validator
+func (k Keeper) GetUpgradeSignals(ctx sdk.Ctx) (signals []types.UpgradeSignal) {
+	signals = make([]types.UpgradeSignal, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.UpgradeSignalsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var signal types.UpgradeSignal
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &signal, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal upgrade signal at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		signals = append(signals, signal)
+	}
+	return
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_SignalReadiness(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	pos, vals := createTestValidators(100)
+	k.PosKeeper = pos
+	versions := []string{"2.0.0"}
+	// not a validator
+	notValidator := getRandomValidatorAddress()
+	assert.NotNil(t, k.SignalReadiness(ctx, notValidator, notValidator, versions, nil))
+	// neither the operator nor the output address
+	assert.NotNil(t, k.SignalReadiness(ctx, vals[0].Address, getRandomValidatorAddress(), versions, nil))
+	assert.Nil(t, k.SignalReadiness(ctx, vals[0].Address, vals[0].OutputAddress, versions, nil))
+	// a new signal replaces the previous one
+	assert.Nil(t, k.SignalReadiness(ctx, vals[0].Address, vals[0].Address, versions, []string{"RSCAL"}))
+	signals := k.GetUpgradeSignals(ctx)
+	assert.Len(t, signals, 1)
+	assert.Equal(t, vals[0].Address, signals[0].ValidatorAddress)
+	assert.Equal(t, []string{"RSCAL"}, signals[0].Features)
+}
+
+func TestKeeper_GetUpgradeReadiness(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	pos, vals := createTestValidators(100, 200, 300)
+	k.PosKeeper = pos
+	params := k.GetParams(ctx)
+	params.Upgrade = types.NewUpgrade(1000, "2.0.0")
+	params.Upgrade.Features = []string{"RSCAL:1000"}
+	k.SetParams(ctx, params)
+	assert.Nil(t, k.SignalReadiness(ctx, vals[0].Address, vals[0].Address, []string{"2.0.0"}, []string{"RSCAL"}))
+	// missing the pending feature
+	assert.Nil(t, k.SignalReadiness(ctx, vals[1].Address, vals[1].Address, []string{"2.0.0"}, nil))
+	readiness := k.GetUpgradeReadiness(ctx)
+	assert.Equal(t, "2.0.0", readiness.Version)
+	assert.Equal(t, []string{"RSCAL"}, readiness.Features)
+	assert.True(t, readiness.ReadyStake.Equal(sdk.NewInt(100)))
+	assert.True(t, readiness.TotalStake.Equal(sdk.NewInt(600)))
+	assert.Equal(t, int64(1), readiness.ReadyValidators)
+	assert.Equal(t, int64(2), readiness.SignalledValidators)
+}
+
+func TestKeeper_PruneUpgradeSignals(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	pos, vals := createTestValidators(100, 200, 300)
+	k.PosKeeper = pos
+	for _, val := range vals {
+		assert.Nil(t, k.SignalReadiness(ctx, val.Address, val.Address, []string{"2.0.0"}, nil))
+	}
+	// the first validator unstakes, the second is removed from the state
+	unstaked := vals[0]
+	unstaked.Status = sdk.Unstaking
+	pos.validators[unstaked.Address.String()] = unstaked
+	delete(pos.validators, vals[1].Address.String())
+	k.PruneUpgradeSignals(ctx)
+	signals := k.GetUpgradeSignals(ctx)
+	assert.Len(t, signals, 1)
+	assert.Equal(t, vals[2].Address, signals[0].ValidatorAddress)
+}
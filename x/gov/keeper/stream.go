@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// CreateDAOStream - Schedule a payment from the dao released linearly between the start and end heights
+func (k Keeper) CreateDAOStream(ctx sdk.Ctx, owner, to sdk.Address, total sdk.BigInt, startHeight, endHeight, cliffHeight int64) (types.DAOStream, sdk.Error) {
+	if !k.GetDAOOwner(ctx).Equals(owner) {
+		return types.DAOStream{}, sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to create a stream from the dao %s", owner.String()))
+	}
+	if err := types.ValidateDAOStream(total, startHeight, endHeight, cliffHeight); err != nil {
+		return types.DAOStream{}, err
+	}
+	if startHeight < ctx.BlockHeight() {
+		return types.DAOStream{}, types.ErrInvalidDAOStream(types.ModuleName, "the start height must not be before the current height")
+	}
+	stream := types.DAOStream{
+		Id:           k.getNextDAOStreamID(ctx),
+		Recipient:    to,
+		Total:        total,
+		Released:     sdk.ZeroInt(),
+		StartHeight:  startHeight,
+		EndHeight:    endHeight,
+		CliffHeight:  cliffHeight,
+		CreateHeight: ctx.BlockHeight(),
+	}
+	k.SetDAOStream(ctx, stream)
+	k.setNextDAOStreamID(ctx, stream.Id+1)
+	return stream, nil
+}
+
+// CancelDAOStream - Release the vested amount of the stream and keep the unvested remainder in the dao
+func (k Keeper) CancelDAOStream(ctx sdk.Ctx, owner sdk.Address, id uint64) (types.DAOStream, sdk.Error) {
+	if !k.GetDAOOwner(ctx).Equals(owner) {
+		return types.DAOStream{}, sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to cancel a stream from the dao %s", owner.String()))
+	}
+	stream, found := k.GetDAOStream(ctx, id)
+	if !found {
+		return types.DAOStream{}, types.ErrDAOStreamNotFound(types.ModuleName, id)
+	}
+	stream, err := k.releaseDAOStream(ctx, stream)
+	if err != nil {
+		return types.DAOStream{}, err
+	}
+	store := ctx.KVStore(k.key)
+	_ = store.Delete(types.KeyForDAOStream(id))
+	return stream, nil
+}
+
+// ReleaseDAOStreams - Send the newly vested amount of every stream to its recipient
+func (k Keeper) ReleaseDAOStreams(ctx sdk.Ctx) {
+	for _, stream := range k.GetDAOStreams(ctx) {
+		if !stream.Releasable(ctx.BlockHeight()).IsPositive() {
+			continue
+		}
+		stream, err := k.releaseDAOStream(ctx, stream)
+		if err != nil {
+			// the amount stays vested and is released once the dao can afford it
+			ctx.Logger().Error(fmt.Sprintf("could not release dao stream %d at height %d: %s", stream.Id, ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		if stream.IsComplete() {
+			store := ctx.KVStore(k.key)
+			_ = store.Delete(types.KeyForDAOStream(stream.Id))
+			continue
+		}
+		k.SetDAOStream(ctx, stream)
+	}
+}
+
+// releaseDAOStream - Send the releasable amount of the stream from the dao and record it as released
+func (k Keeper) releaseDAOStream(ctx sdk.Ctx, stream types.DAOStream) (types.DAOStream, sdk.Error) {
+	amount := stream.Releasable(ctx.BlockHeight())
+	if !amount.IsPositive() {
+		return stream, nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
+	if err := k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, types.DAOAccountName, stream.Recipient, coins); err != nil {
+		return stream, err
+	}
+	stream.Released = stream.Released.Add(amount)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventDAOStreamRelease,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+		),
+	)
+	return stream, nil
+}
+
+// SetDAOStream - Store a dao stream
+func (k Keeper) SetDAOStream(ctx sdk.Ctx, stream types.DAOStream) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.MarshalBinaryLengthPrefixed(&stream, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal dao stream: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForDAOStream(stream.Id), bz)
+}
+
+// GetDAOStream - Retrieve a dao stream by its id
+func (k Keeper) GetDAOStream(ctx sdk.Ctx, id uint64) (stream types.DAOStream, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForDAOStream(id))
+	if bz == nil {
+		return stream, false
+	}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &stream, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal dao stream %d: %s", id, err.Error()))
+		return stream, false
+	}
+	return stream, true
+}
+
+// GetDAOStreams - Retrieve every dao stream that is not complete or cancelled
+func (k Keeper) GetDAOStreams(ctx sdk.Ctx) (streams []types.DAOStream) {
+	streams = make([]types.DAOStream, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.DAOStreamsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.DAOStream
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &stream, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal dao stream at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		streams = append(streams, stream)
+	}
+	return
+}
+
+// getNextDAOStreamID - Retrieve the id of the next dao stream
+func (k Keeper) getNextDAOStreamID(ctx sdk.Ctx) uint64 {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.DAOStreamIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setNextDAOStreamID - Store the id of the next dao stream
+func (k Keeper) setNextDAOStreamID(ctx sdk.Ctx, id uint64) {
+	store := ctx.KVStore(k.key)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	_ = store.Set(types.DAOStreamIDKey, bz)
+}
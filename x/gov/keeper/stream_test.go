@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/keeper"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_CreateDAOStream(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	owner := k.GetDAOOwner(ctx)
+	recipient := getRandomValidatorAddress()
+	// not the dao owner
+	_, err := k.CreateDAOStream(ctx, getRandomValidatorAddress(), recipient, sdk.NewInt(100), 10, 20, 0)
+	assert.NotNil(t, err)
+	// starts in the past
+	_, err = k.CreateDAOStream(ctx, owner, recipient, sdk.NewInt(100), 5, 20, 0)
+	assert.NotNil(t, err)
+	// cliff after the end
+	_, err = k.CreateDAOStream(ctx, owner, recipient, sdk.NewInt(100), 10, 20, 21)
+	assert.NotNil(t, err)
+	stream, err := k.CreateDAOStream(ctx, owner, recipient, sdk.NewInt(100), 10, 20, 15)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), stream.Id)
+	stored, found := k.GetDAOStream(ctx, stream.Id)
+	assert.True(t, found)
+	assert.Equal(t, recipient, stored.Recipient)
+	assert.True(t, stored.Released.IsZero())
+	assert.Len(t, k.GetDAOStreams(ctx), 1)
+}
+
+func TestKeeper_ReleaseDAOStreams(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	total := sdk.NewInt(100)
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, total))))
+	daoTokens := k.GetDAOTokens(ctx)
+	recipient := getRandomValidatorAddress()
+	stream, err := k.CreateDAOStream(ctx, k.GetDAOOwner(ctx), recipient, total, 10, 20, 15)
+	assert.Nil(t, err)
+	balance := func(c sdk.Ctx) sdk.BigInt {
+		return k.AuthKeeper.(keeper.Keeper).GetCoins(c, recipient).AmountOf(sdk.DefaultStakeDenom)
+	}
+	// nothing is released before the cliff
+	k.ReleaseDAOStreams(ctx.WithBlockHeight(14))
+	assert.True(t, balance(ctx).IsZero())
+	// the amount vested until the cliff is released at once
+	k.ReleaseDAOStreams(ctx.WithBlockHeight(15))
+	assert.Equal(t, sdk.NewInt(50), balance(ctx))
+	k.ReleaseDAOStreams(ctx.WithBlockHeight(18))
+	assert.Equal(t, sdk.NewInt(80), balance(ctx))
+	stored, _ := k.GetDAOStream(ctx, stream.Id)
+	assert.Equal(t, sdk.NewInt(80), stored.Released)
+	// the stream is removed once complete
+	k.ReleaseDAOStreams(ctx.WithBlockHeight(25))
+	assert.Equal(t, total, balance(ctx))
+	_, found := k.GetDAOStream(ctx, stream.Id)
+	assert.False(t, found)
+	assert.True(t, daoTokens.Sub(total).Equal(k.GetDAOTokens(ctx)))
+}
+
+func TestKeeper_CancelDAOStream(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	total := sdk.NewInt(100)
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, total))))
+	daoTokens := k.GetDAOTokens(ctx)
+	owner := k.GetDAOOwner(ctx)
+	recipient := getRandomValidatorAddress()
+	stream, err := k.CreateDAOStream(ctx, owner, recipient, total, 10, 20, 0)
+	assert.Nil(t, err)
+	k.ReleaseDAOStreams(ctx.WithBlockHeight(12))
+	cancelCtx := ctx.WithBlockHeight(14)
+	// not the dao owner
+	_, err = k.CancelDAOStream(cancelCtx, recipient, stream.Id)
+	assert.NotNil(t, err)
+	_, err = k.CancelDAOStream(cancelCtx, owner, stream.Id+1)
+	assert.NotNil(t, err)
+	cancelled, err := k.CancelDAOStream(cancelCtx, owner, stream.Id)
+	assert.Nil(t, err)
+	// the vested amount is released and the remainder stays in the dao
+	assert.Equal(t, sdk.NewInt(40), cancelled.Released)
+	assert.Equal(t, sdk.NewInt(40), k.AuthKeeper.(keeper.Keeper).GetCoins(ctx, recipient).AmountOf(sdk.DefaultStakeDenom))
+	assert.True(t, daoTokens.Sub(sdk.NewInt(40)).Equal(k.GetDAOTokens(ctx)))
+	assert.Len(t, k.GetDAOStreams(ctx), 0)
+}
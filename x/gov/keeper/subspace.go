@@ -80,12 +80,17 @@ func (k Keeper) GetAllParamNameValue(ctx sdk.Ctx) (paramNames map[string]string)
 }
 
 func (k Keeper) HandleUpgrade(ctx sdk.Ctx, aclKey string, paramValue interface{}, owner sdk.Address) sdk.Result {
+	if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
+		return err.Result()
+	}
+	return k.handleUpgrade(ctx, aclKey, paramValue, owner)
+}
+
+// handleUpgrade - Sets the upgrade without verifying the sender against the ACL
+func (k Keeper) handleUpgrade(ctx sdk.Ctx, aclKey string, paramValue interface{}, owner sdk.Address) sdk.Result {
 	if ctx.IsAfterUpgradeHeight() {
 		return handleUpgradeAfterUpdate(ctx, aclKey, paramValue, owner, k)
 	} else {
-		if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
-			return err.Result()
-		}
 		subspaceName, paramKey := types.SplitACLKey(aclKey)
 		space, ok := k.spaces[subspaceName]
 		if !ok {
@@ -128,9 +133,6 @@ func (k Keeper) HandleUpgrade(ctx sdk.Ctx, aclKey string, paramValue interface{}
 }
 
 func handleUpgradeAfterUpdate(ctx sdk.Ctx, aclKey string, paramValue interface{}, owner sdk.Address, k Keeper) sdk.Result {
-	if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
-		return err.Result()
-	}
 	subspaceName, paramKey := types.SplitACLKey(aclKey)
 	space, ok := k.spaces[subspaceName]
 	if !ok {
@@ -200,7 +202,11 @@ func (k Keeper) ModifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner
 	if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
 		return err.Result()
 	}
+	return k.modifyParam(ctx, aclKey, paramValue, owner)
+}
 
+// modifyParam - Updates the param without verifying the sender against the ACL
+func (k Keeper) modifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner sdk.Address) sdk.Result {
 	if ctx.BlockHeight() >= minSafeMaxValidatorParamChangeHeight {
 		if !k.cdc.IsAfterValidatorSplitUpgrade(ctx.BlockHeight()) && aclKey == mAxValidatorsACLKey {
 			return types.ErrUnauthorizedHeightParamChange(types.ModuleName, codec.UpgradeHeight, aclKey).Result()
@@ -213,7 +219,20 @@ func (k Keeper) ModifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner
 		k.Logger(ctx).Error(types.ErrSubspaceNotFound(types.ModuleName, subspaceName).Error())
 		os.Exit(1)
 	}
-	_ = space.Update(ctx, []byte(paramKey), paramValue)
+	if k.cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ParamValidationKey) {
+		if err := space.Update(ctx, []byte(paramKey), paramValue); err != nil {
+			return types.ErrSettingParameter(types.ModuleName, subspaceName, paramKey, string(paramValue), err.Error()).Result()
+		}
+	} else {
+		_ = space.Update(ctx, []byte(paramKey), paramValue)
+	}
+	// the tx is reverted on failure, as are proposals and scheduled changes through their cache context
+	if err := k.verifyProposalPercentages(ctx, aclKey); err != nil {
+		return err.Result()
+	}
+	if err := k.verifyNoMultisigActionOwner(ctx, aclKey); err != nil {
+		return err.Result()
+	}
 	k.spaces[subspaceName] = space
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
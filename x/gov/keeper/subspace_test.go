@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/gov/types"
 	"github.com/stretchr/testify/assert"
@@ -37,3 +38,15 @@ func TestModifyParam(t *testing.T) {
 		),
 	)
 }
+
+func TestModifyParam_Malformed(t *testing.T) {
+	var aclKey = types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	// before the param validation feature a malformed value is ignored
+	assert.Zero(t, k.ModifyParam(ctx, aclKey, []byte(`{"malformed"`), owner).Code)
+	codec.UpgradeFeatureMap[codec.ParamValidationKey] = 10
+	defer delete(codec.UpgradeFeatureMap, codec.ParamValidationKey)
+	assert.Equal(t, types.CodeSettingParameter, k.ModifyParam(ctx, aclKey, []byte(`{"malformed"`), owner).Code)
+}
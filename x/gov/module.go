@@ -112,6 +112,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Ctx) json.RawMessage {
 
 // module begin-block
 func (am AppModule) BeginBlock(ctx sdk.Ctx, req abci.RequestBeginBlock) {
+	if am.keeper.IsDAOStreamsActivated(ctx) {
+		am.keeper.ReleaseDAOStreams(ctx)
+	}
 	u := am.keeper.GetUpgrade(ctx)
 	if ctx.AppVersion() < u.Version && ctx.BlockHeight() == u.UpgradeHeight() && ctx.BlockHeight() != 0 {
 		ctx.Logger().Error("MUST UPGRADE TO NEXT VERSION: ", u.Version)
@@ -136,5 +139,18 @@ func (am AppModule) BeginBlock(ctx sdk.Ctx, req abci.RequestBeginBlock) {
 // EndBlock returns the end blocker for the staking module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Ctx, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if am.keeper.IsProposalsActivated(ctx) {
+		am.keeper.EndProposals(ctx)
+	}
+	if am.keeper.IsScheduledParamsActivated(ctx) {
+		am.keeper.ApplyPendingParamChanges(ctx)
+	}
+	if am.keeper.IsMultisigACLActivated(ctx) {
+		am.keeper.ExpireParamApprovals(ctx)
+	}
+	// the validators unstake at the end of a session, see the nodes EndBlocker, so the signals are pruned then
+	if am.keeper.IsUpgradeSignalActivated(ctx) && ctx.BlockHeight()%am.keeper.PosKeeper.BlocksPerSession(ctx) == 0 {
+		am.keeper.PruneUpgradeSignals(ctx)
+	}
 	return []abci.ValidatorUpdate{}
 }
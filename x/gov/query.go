@@ -59,3 +59,119 @@ func QueryUpgrade(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (upgr
 	}
 	return u, err
 }
+
+func QueryProposals(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (proposals []types.Proposal, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	proposalsBz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryProposals))
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(proposalsBz, &proposals); err != nil {
+		return nil, err
+	}
+	return proposals, nil
+}
+
+func QueryPendingParams(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (changes []types.PendingParamChange, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	changesBz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryPendingParams))
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(changesBz, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func QueryMultisigOwners(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (owners []types.MultisigOwner, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	ownersBz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryMultisigOwners))
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(ownersBz, &owners); err != nil {
+		return nil, err
+	}
+	return owners, nil
+}
+
+func QueryParamApprovals(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (approvals []types.ParamApproval, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	approvalsBz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryParamApprovals))
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(approvalsBz, &approvals); err != nil {
+		return nil, err
+	}
+	return approvals, nil
+}
+
+func QueryDAOStreams(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (streams []types.DAOStream, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	streamsBz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryDAOStreams))
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(streamsBz, &streams); err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+func QueryUpgradeReadiness(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (readiness types.UpgradeReadiness, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	readinessBz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryUpgradeReadiness))
+	if err != nil {
+		return readiness, err
+	}
+	if err := cdc.UnmarshalJSON(readinessBz, &readiness); err != nil {
+		return readiness, err
+	}
+	return readiness, nil
+}
+
+func QueryProposal(cdc *codec.Codec, tmNode rpcclient.Client, proposalID uint64, height int64) (proposal types.Proposal, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.QueryProposalParams{ProposalID: proposalID})
+	if err != nil {
+		return proposal, err
+	}
+	proposalBz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryProposal), bz)
+	if err != nil {
+		return proposal, err
+	}
+	if err := cdc.UnmarshalJSON(proposalBz, &proposal); err != nil {
+		return proposal, err
+	}
+	return proposal, nil
+}
+
+func QueryTally(cdc *codec.Codec, tmNode rpcclient.Client, proposalID uint64, height int64) (tally types.TallyResult, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.QueryProposalParams{ProposalID: proposalID})
+	if err != nil {
+		return tally, err
+	}
+	tallyBz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryTally), bz)
+	if err != nil {
+		return tally, err
+	}
+	if err := cdc.UnmarshalJSON(tallyBz, &tally); err != nil {
+		return tally, err
+	}
+	return tally, nil
+}
+
+func QueryFeatures(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (features []codec.FeatureStatus, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	featuresBz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryFeatures))
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(featuresBz, &features); err != nil {
+		return nil, err
+	}
+	return features, nil
+}
@@ -59,6 +59,35 @@ func UpgradeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, fro
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
 }
 
+func SubmitProposalTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, proposer sdk.Address, content types.ProposalContent, deposit sdk.BigInt, passphrase string, fee int64, legacyCodec bool) (*sdk.TxResponse, error) {
+	msg := types.MsgSubmitProposal{
+		Proposer: proposer,
+		Content:  content,
+		Deposit:  deposit,
+	}
+	txBuilder, cliCtx := newTx(cdc, &msg, proposer, tmNode, keybase, passphrase, fee)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
+func VoteTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, validatorAddress, signer sdk.Address, proposalID uint64, option, passphrase string, fee int64, legacyCodec bool) (*sdk.TxResponse, error) {
+	msg := types.MsgVote{
+		ProposalID:       proposalID,
+		ValidatorAddress: validatorAddress,
+		Signer:           signer,
+		Option:           option,
+	}
+	txBuilder, cliCtx := newTx(cdc, &msg, signer, tmNode, keybase, passphrase, fee)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
 func newTx(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, tmNode client.Client, keybase keys.Keybase, passphrase string, fee int64) (txBuilder auth.TxBuilder, cliCtx util.CLIContext) {
 	genDoc, err := tmNode.Genesis()
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/codec/types"
 	sdk "github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 )
 
 // module codec
@@ -13,6 +14,8 @@ func init() {
 	ModuleCdc = codec.NewCodec(types.NewInterfaceRegistry())
 	RegisterCodec(ModuleCdc)
 	ModuleCdc.AminoCodec().Seal()
+	// the deposits and the dao funds move out of the reach of the spend limit
+	authTypes.RegisterUnscopedMsgTypes(MsgSubmitProposalName, MsgDAOTransferName, MsgCreateStreamName)
 }
 
 // RegisterCodec registers all necessary param module types with a given codec.
@@ -20,10 +23,18 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgChangeParam{}, "gov/msg_change_param")
 	cdc.RegisterStructure(MsgDAOTransfer{}, "gov/msg_dao_transfer")
 	cdc.RegisterStructure(MsgUpgrade{}, "gov/msg_upgrade")
+	cdc.RegisterStructure(MsgSubmitProposal{}, "gov/msg_submit_proposal")
+	cdc.RegisterStructure(MsgVote{}, "gov/msg_vote")
+	cdc.RegisterStructure(MsgCancelParamChange{}, "gov/msg_cancel_param_change")
+	cdc.RegisterStructure(MsgCreateMultisigOwner{}, "gov/msg_create_multisig_owner")
+	cdc.RegisterStructure(MsgApproveParamChange{}, "gov/msg_approve_param_change")
+	cdc.RegisterStructure(MsgCreateDAOStream{}, "gov/msg_create_dao_stream")
+	cdc.RegisterStructure(MsgCancelDAOStream{}, "gov/msg_cancel_dao_stream")
+	cdc.RegisterStructure(MsgSignalReadiness{}, "gov/msg_signal_readiness")
 	cdc.RegisterInterface("x.interface.nil", (*interface{})(nil))
 	cdc.RegisterStructure(ACL{}, "gov/non_map_acl")
 	cdc.RegisterStructure(Upgrade{}, "gov/upgrade")
-	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{})
-	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{})
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{}, &MsgSubmitProposal{}, &MsgVote{}, &MsgCancelParamChange{}, &MsgCreateMultisigOwner{}, &MsgApproveParamChange{}, &MsgCreateDAOStream{}, &MsgCancelDAOStream{}, &MsgSignalReadiness{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{}, &MsgSubmitProposal{}, &MsgVote{}, &MsgCancelParamChange{}, &MsgCreateMultisigOwner{}, &MsgApproveParamChange{}, &MsgCreateDAOStream{}, &MsgCancelDAOStream{}, &MsgSignalReadiness{})
 	ModuleCdc = cdc
 }
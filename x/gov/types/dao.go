@@ -5,10 +5,11 @@ import (
 )
 
 const (
-	DAOAccountName              = "dao"
-	DAOTransferString           = "dao_transfer"
-	DAOBurnString               = "dao_burn"
-	DAOTransfer       DAOAction = iota + 1
+	DAOAccountName                      = "dao"
+	ProposalEscrowAccountName           = "proposal_escrow" // holds the deposits of the open proposals apart from the dao funds
+	DAOTransferString                   = "dao_transfer"
+	DAOBurnString                       = "dao_burn"
+	DAOTransfer               DAOAction = iota + 1
 	DAOBurn
 )
 
@@ -18,8 +18,93 @@ const (
 	CodeZeroHeightUpgrade             sdk.CodeType = 9
 	CodeEmptyVersionUpgrade           sdk.CodeType = 10
 	CodeUnauthorizedHeightParamChange sdk.CodeType = 11
+	CodeUnrecognizedProposalType      sdk.CodeType = 12
+	CodeProposalNotFound              sdk.CodeType = 13
+	CodeInactiveProposal              sdk.CodeType = 14
+	CodeUnrecognizedVoteOption        sdk.CodeType = 15
+	CodeInsufficientDeposit           sdk.CodeType = 16
+	CodeUnauthorizedVoter             sdk.CodeType = 17
+	CodeUnrecognizedParam             sdk.CodeType = 18
+	CodeInvalidActivationHeight       sdk.CodeType = 19
+	CodePendingParamChangeNotFound    sdk.CodeType = 20
+	CodeInvalidMultisigOwner          sdk.CodeType = 21
+	CodeParamApprovalNotFound         sdk.CodeType = 22
+	CodeDuplicateApproval             sdk.CodeType = 23
+	CodeInvalidDAOStream              sdk.CodeType = 24
+	CodeDAOStreamNotFound             sdk.CodeType = 25
+	CodeInvalidSignal                 sdk.CodeType = 26
+	CodeDuplicatePendingParamChange   sdk.CodeType = 27
+	CodeInvalidProposalPercentage     sdk.CodeType = 28
 )
 
+func ErrInvalidSignal(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSignal, "invalid readiness signal: "+reason)
+}
+
+func ErrInvalidDAOStream(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDAOStream, "invalid dao stream: "+reason)
+}
+
+func ErrDAOStreamNotFound(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeDAOStreamNotFound, fmt.Sprintf("the dao stream %d cannot be found", id))
+}
+
+func ErrInvalidMultisigOwner(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMultisigOwner, "invalid multisig owner: "+reason)
+}
+
+func ErrParamApprovalNotFound(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeParamApprovalNotFound, fmt.Sprintf("the param approval %d cannot be found or has expired", id))
+}
+
+func ErrDuplicateApproval(codespace sdk.CodespaceType, member sdk.Address, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateApproval, fmt.Sprintf("the member %s already approved the param change %d", member, id))
+}
+
+func ErrInvalidActivationHeight(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidActivationHeight, fmt.Sprintf("the activation height %d must be after the current height", height))
+}
+
+func ErrPendingParamChangeNotFound(codespace sdk.CodespaceType, param string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodePendingParamChangeNotFound, fmt.Sprintf("no pending change of param %s at height %d", param, height))
+}
+
+func ErrInvalidProposalPercentage(codespace sdk.CodespaceType, param string, value int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalPercentage, fmt.Sprintf("the %s percentage %d is not in (0, 100]", param, value))
+}
+
+func ErrDuplicatePendingParamChange(codespace sdk.CodespaceType, param string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicatePendingParamChange, fmt.Sprintf("a change of param %s is already pending at height %d", param, height))
+}
+
+func ErrUnrecognizedProposalType(codespace sdk.CodespaceType, proposalType string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnrecognizedProposalType, "unrecognized proposal type: "+proposalType)
+}
+
+func ErrProposalNotFound(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalNotFound, fmt.Sprintf("the proposal %d cannot be found", id))
+}
+
+func ErrInactiveProposal(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInactiveProposal, fmt.Sprintf("the proposal %d is not in its voting period", id))
+}
+
+func ErrUnrecognizedVoteOption(codespace sdk.CodespaceType, option string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnrecognizedVoteOption, "unrecognized vote option: "+option)
+}
+
+func ErrInsufficientDeposit(codespace sdk.CodespaceType, minDeposit sdk.BigInt) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientDeposit, fmt.Sprintf("the proposal deposit is below the minimum of %s", minDeposit.String()))
+}
+
+func ErrUnauthorizedVoter(codespace sdk.CodespaceType, voter sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedVoter, fmt.Sprintf("the account %s is not allowed to vote for the validator", voter))
+}
+
+func ErrUnrecognizedParam(codespace sdk.CodespaceType, param string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnrecognizedParam, fmt.Sprintf("the key: %s is not a recognized parameter", param))
+}
+
 func ErrZeroHeightUpgrade(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeZeroHeightUpgrade, "the upgrade Height must not be zero")
 }
@@ -7,5 +7,22 @@ const (
 	EventParamChange       = "param_change"
 	EventUpgrade           = "upgrade"
 	EventMustUpgrade       = "must_upgrade"
+	EventSubmitProposal    = "submit_proposal"
+	EventVote              = "proposal_vote"
+	EventProposalResult    = "proposal_result"
+	EventParamScheduled    = "param_change_scheduled"
+	EventParamCancelled    = "param_change_cancelled"
+	EventMultisigOwner     = "multisig_owner_created"
+	EventParamApproval     = "param_change_approval"
+	EventDAOStreamCreate   = "dao_stream_created"
+	EventDAOStreamRelease  = "dao_stream_release"
+	EventDAOStreamCancel   = "dao_stream_cancelled"
+	EventUpgradeSignal     = "upgrade_signal"
+	AttributeKeyApprovalID = "approval_id"
+	AttributeKeyStreamID   = "stream_id"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyOption     = "option"
+	AttributeKeyStatus     = "status"
 	AttributeValueCategory = ModuleName
 )
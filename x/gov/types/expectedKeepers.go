@@ -28,15 +28,14 @@ type AuthKeeper interface {
 	BurnCoins(ctx sdk.Ctx, name string, amt sdk.Coins) sdk.Error
 }
 
+// PosKeeper defines the expected staking keeper used to weight proposal votes (noalias)
 type PosKeeper interface {
-	RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address)
+	// get the total staked tokens
 	GetStakedTokens(ctx sdk.Ctx) sdk.BigInt
+	// get a validator by the operator address
 	Validator(ctx sdk.Ctx, addr sdk.Address) nodesExported.ValidatorI
-	TotalTokens(ctx sdk.Ctx) sdk.BigInt
-	BurnForChallenge(ctx sdk.Ctx, challenges sdk.BigInt, address sdk.Address)
-	JailValidator(ctx sdk.Ctx, addr sdk.Address)
-	AllValidators(ctx sdk.Ctx) (validators []nodesExported.ValidatorI)
-	GetStakedValidators(ctx sdk.Ctx) (validators []nodesExported.ValidatorI)
-	SessionBlockFrequency(ctx sdk.Ctx) (res int64)
-	StakeDenom(ctx sdk.Ctx) (res string)
+	// get the output address of a validator
+	GetValidatorOutputAddress(ctx sdk.Ctx, operatorAddress sdk.Address) (sdk.Address, bool)
+	// get the number of blocks in a session
+	BlocksPerSession(ctx sdk.Ctx) int64
 }
@@ -1,15 +1,31 @@
 package types
 
 const (
-	DAOTransferFee    = 10000
-	MsgChangeParamFee = 10000
-	MsgUpgradeFee     = 10000
+	DAOTransferFee       = 10000
+	MsgChangeParamFee    = 10000
+	MsgUpgradeFee        = 10000
+	MsgSubmitProposalFee = 10000
+	MsgVoteFee           = 10000
+	MsgCancelParamFee    = 10000
+	MsgMultisigOwnerFee  = 10000
+	MsgApproveParamFee   = 10000
+	MsgCreateStreamFee   = 10000
+	MsgCancelStreamFee   = 10000
+	MsgSignalFee         = 10000
 )
 
 var (
 	GovFeeMap = map[string]int64{
-		MsgDAOTransferName: DAOTransferFee,
-		MsgChangeParamName: MsgChangeParamFee,
-		MsgUpgradeName:     MsgUpgradeFee,
+		MsgDAOTransferName:    DAOTransferFee,
+		MsgChangeParamName:    MsgChangeParamFee,
+		MsgUpgradeName:        MsgUpgradeFee,
+		MsgSubmitProposalName: MsgSubmitProposalFee,
+		MsgVoteName:           MsgVoteFee,
+		MsgCancelParamName:    MsgCancelParamFee,
+		MsgMultisigOwnerName:  MsgMultisigOwnerFee,
+		MsgApproveParamName:   MsgApproveParamFee,
+		MsgCreateStreamName:   MsgCreateStreamFee,
+		MsgCancelStreamName:   MsgCancelStreamFee,
+		MsgSignalName:         MsgSignalFee,
 	}
 )
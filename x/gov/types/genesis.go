@@ -7,8 +7,18 @@ import (
 
 // GenesisState - all auth state that must be provided at genesis
 type GenesisState struct {
-	Params    Params     `json:"params" yaml:"params"`
-	DAOTokens sdk.BigInt `json:"DAO_Tokens"`
+	Params              Params               `json:"params" yaml:"params"`
+	DAOTokens           sdk.BigInt           `json:"DAO_Tokens"`
+	Proposals           []Proposal           `json:"proposals,omitempty" yaml:"proposals"`
+	Votes               []Vote               `json:"votes,omitempty" yaml:"votes"`
+	NextProposalID      uint64               `json:"next_proposal_id,omitempty" yaml:"next_proposal_id"`
+	PendingParamChanges []PendingParamChange `json:"pending_param_changes,omitempty" yaml:"pending_param_changes"`
+	MultisigOwners      []MultisigOwner      `json:"multisig_owners,omitempty" yaml:"multisig_owners"`
+	ParamApprovals      []ParamApproval      `json:"param_approvals,omitempty" yaml:"param_approvals"`
+	NextParamApprovalID uint64               `json:"next_param_approval_id,omitempty" yaml:"next_param_approval_id"`
+	DAOStreams          []DAOStream          `json:"dao_streams,omitempty" yaml:"dao_streams"`
+	NextDAOStreamID     uint64               `json:"next_dao_stream_id,omitempty" yaml:"next_dao_stream_id"`
+	UpgradeSignals      []UpgradeSignal      `json:"upgrade_signals,omitempty" yaml:"upgrade_signals"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -30,5 +40,21 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.ACL == nil {
 		return ErrInvalidACL(ModuleName, fmt.Errorf("nil acl"))
 	}
+	if err := data.Params.ValidateProposalPercentages(); err != nil {
+		return err
+	}
+	for _, owner := range data.MultisigOwners {
+		if err := ValidateMultisigOwner(owner.Members, owner.Threshold, owner.ExpiryBlocks); err != nil {
+			return err
+		}
+		if !owner.Address.Equals(NewMultisigOwnerAddress(owner.Members, owner.Threshold, owner.ExpiryBlocks)) {
+			return ErrInvalidMultisigOwner(ModuleName, "the address does not match the members of "+owner.Address.String())
+		}
+	}
+	for _, stream := range data.DAOStreams {
+		if err := ValidateDAOStream(stream.Total, stream.StartHeight, stream.EndHeight, stream.CliffHeight); err != nil {
+			return err
+		}
+	}
 	return nil
 }
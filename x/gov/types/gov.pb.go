@@ -25,9 +25,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgChangeParam struct {
-	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
-	ParamKey    string                                            `protobuf:"bytes,2,opt,name=paramKey,proto3" json:"param_key"`
-	ParamVal    []byte                                            `protobuf:"bytes,3,opt,name=paramVal,proto3" json:"param_value"`
+	FromAddress      github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	ParamKey         string                                            `protobuf:"bytes,2,opt,name=paramKey,proto3" json:"param_key"`
+	ParamVal         []byte                                            `protobuf:"bytes,3,opt,name=paramVal,proto3" json:"param_value"`
+	ActivationHeight int64                                             `protobuf:"varint,4,opt,name=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
 func (m *MsgChangeParam) Reset()         { *m = MsgChangeParam{} }
@@ -84,10 +85,81 @@ func (m *MsgChangeParam) GetParamVal() []byte {
 	return nil
 }
 
+func (m *MsgChangeParam) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 func (*MsgChangeParam) XXX_MessageName() string {
 	return "x.gov.MsgChangeParam"
 }
 
+type MsgCancelParamChange struct {
+	FromAddress      github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	ParamKey         string                                            `protobuf:"bytes,2,opt,name=paramKey,proto3" json:"param_key"`
+	ActivationHeight int64                                             `protobuf:"varint,3,opt,name=activationHeight,proto3" json:"activation_height"`
+}
+
+func (m *MsgCancelParamChange) Reset()         { *m = MsgCancelParamChange{} }
+func (m *MsgCancelParamChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamChange) ProtoMessage()    {}
+func (*MsgCancelParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{1}
+}
+func (m *MsgCancelParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamChange.Merge(m, src)
+}
+func (m *MsgCancelParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelParamChange proto.InternalMessageInfo
+
+func (m *MsgCancelParamChange) GetFromAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *MsgCancelParamChange) GetParamKey() string {
+	if m != nil {
+		return m.ParamKey
+	}
+	return ""
+}
+
+func (m *MsgCancelParamChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (*MsgCancelParamChange) XXX_MessageName() string {
+	return "x.gov.MsgCancelParamChange"
+}
+
 type MsgDAOTransfer struct {
 	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"from_address"`
 	ToAddress   github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=toAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"to_address"`
@@ -99,7 +171,7 @@ func (m *MsgDAOTransfer) Reset()         { *m = MsgDAOTransfer{} }
 func (m *MsgDAOTransfer) String() string { return proto.CompactTextString(m) }
 func (*MsgDAOTransfer) ProtoMessage()    {}
 func (*MsgDAOTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8366cfab811ef854, []int{1}
+	return fileDescriptor_8366cfab811ef854, []int{2}
 }
 func (m *MsgDAOTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +234,7 @@ func (m *MsgUpgrade) Reset()         { *m = MsgUpgrade{} }
 func (m *MsgUpgrade) String() string { return proto.CompactTextString(m) }
 func (*MsgUpgrade) ProtoMessage()    {}
 func (*MsgUpgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8366cfab811ef854, []int{2}
+	return fileDescriptor_8366cfab811ef854, []int{3}
 }
 func (m *MsgUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +292,7 @@ func (m *Upgrade) Reset()         { *m = Upgrade{} }
 func (m *Upgrade) String() string { return proto.CompactTextString(m) }
 func (*Upgrade) ProtoMessage()    {}
 func (*Upgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8366cfab811ef854, []int{3}
+	return fileDescriptor_8366cfab811ef854, []int{4}
 }
 func (m *Upgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +358,7 @@ func (m *ACLPair) Reset()         { *m = ACLPair{} }
 func (m *ACLPair) String() string { return proto.CompactTextString(m) }
 func (*ACLPair) ProtoMessage()    {}
 func (*ACLPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8366cfab811ef854, []int{4}
+	return fileDescriptor_8366cfab811ef854, []int{5}
 }
 func (m *ACLPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,399 +401,6021 @@ func (m *ACLPair) GetAddr() github_com_pokt_network_pocket_core_types.Address {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgChangeParam)(nil), "x.gov.MsgChangeParam")
-	proto.RegisterType((*MsgDAOTransfer)(nil), "x.gov.MsgDAOTransfer")
-	proto.RegisterType((*MsgUpgrade)(nil), "x.gov.MsgUpgrade")
-	proto.RegisterType((*Upgrade)(nil), "x.gov.Upgrade")
-	proto.RegisterType((*ACLPair)(nil), "x.gov.ACLPair")
+type MsgSubmitProposal struct {
+	Proposer github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"proposer"`
+	Content  ProposalContent                                   `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	Deposit  github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=deposit,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"deposit"`
 }
 
-func init() { proto.RegisterFile("x/gov/gov.proto", fileDescriptor_8366cfab811ef854) }
-
-var fileDescriptor_8366cfab811ef854 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x14, 0x6e, 0xda, 0xfe, 0xda, 0xd5, 0xdd, 0x6f, 0x45, 0x16, 0x42, 0xd5, 0x24, 0xe2, 0xaa, 0x12,
-	0x52, 0x11, 0x2c, 0x81, 0x71, 0x82, 0x13, 0x4d, 0x01, 0x31, 0x60, 0xda, 0x14, 0x8d, 0x1d, 0x7a,
-	0x99, 0xdc, 0xd6, 0x75, 0xa3, 0x36, 0x71, 0xe4, 0xb8, 0x65, 0xb9, 0x70, 0xe6, 0xc8, 0xdf, 0xc0,
-	0x8d, 0xff, 0x64, 0xc7, 0x09, 0x71, 0x40, 0x1c, 0x2c, 0xd4, 0xde, 0xf2, 0x27, 0x70, 0x42, 0x71,
-	0x9c, 0x32, 0x01, 0x87, 0x49, 0xdb, 0x21, 0xb2, 0xf5, 0xbd, 0xe7, 0xef, 0x7b, 0xef, 0xf3, 0x8b,
-	0x41, 0xe3, 0xd4, 0xa6, 0x6c, 0x91, 0x7e, 0x56, 0xc8, 0x99, 0x60, 0xf0, 0xbf, 0x53, 0x8b, 0xb2,
-	0xc5, 0xf6, 0x4d, 0xca, 0x28, 0x53, 0x88, 0x9d, 0xee, 0xb2, 0x60, 0xfb, 0x8b, 0x01, 0xb6, 0xf6,
-	0x23, 0xda, 0x9b, 0xe0, 0x80, 0x92, 0x43, 0xcc, 0xb1, 0x0f, 0x07, 0xa0, 0x3e, 0xe6, 0xcc, 0xef,
-	0x8e, 0x46, 0x9c, 0x44, 0x51, 0xd3, 0x68, 0x19, 0x9d, 0x4d, 0xe7, 0x69, 0x22, 0x51, 0x15, 0x67,
-	0xd0, 0x4f, 0x89, 0x1e, 0x52, 0x4f, 0x4c, 0xe6, 0x03, 0x6b, 0xc8, 0x7c, 0x3b, 0x64, 0x53, 0xb1,
-	0x13, 0x10, 0xf1, 0x8e, 0xf1, 0xa9, 0x1d, 0xb2, 0xe1, 0x94, 0x88, 0x9d, 0x21, 0xe3, 0xc4, 0x16,
-	0x71, 0x48, 0x22, 0x4b, 0xf3, 0xb8, 0x17, 0x49, 0xe1, 0x5d, 0xb0, 0x11, 0xa6, 0x62, 0xaf, 0x49,
-	0xdc, 0x2c, 0xb6, 0x8c, 0x4e, 0xcd, 0xf9, 0x3f, 0x91, 0xa8, 0xa6, 0xb0, 0x93, 0x29, 0x89, 0xdd,
-	0x75, 0x18, 0xde, 0xd3, 0xa9, 0xc7, 0x78, 0xd6, 0x2c, 0xa9, 0x5a, 0x1a, 0x89, 0x44, 0xf5, 0x2c,
-	0x75, 0x81, 0x67, 0x73, 0xe2, 0xae, 0x13, 0x9e, 0x94, 0x3f, 0x7c, 0x42, 0x46, 0x7b, 0x59, 0x54,
-	0x4d, 0x3d, 0xeb, 0x1e, 0x1c, 0x71, 0x1c, 0x44, 0x63, 0xc2, 0x21, 0xfd, 0x57, 0x53, 0xcf, 0x13,
-	0x89, 0x36, 0x53, 0xf8, 0xe4, 0xfa, 0x3a, 0xc3, 0xa0, 0x26, 0x58, 0x2e, 0x53, 0x54, 0x32, 0xbd,
-	0x44, 0x22, 0x20, 0xd8, 0xd5, 0x44, 0x7e, 0xb3, 0xc2, 0x3e, 0xa8, 0x60, 0x9f, 0xcd, 0x03, 0xa1,
-	0xfc, 0xa8, 0x39, 0xce, 0x99, 0x44, 0x85, 0xef, 0x12, 0x3d, 0xb8, 0x3c, 0xab, 0xe3, 0xd1, 0xbd,
-	0x40, 0x24, 0x12, 0x69, 0x26, 0x57, 0xaf, 0xb0, 0x0d, 0x2a, 0x78, 0x28, 0x3c, 0x16, 0x34, 0xcb,
-	0x8a, 0x1b, 0xa8, 0x1c, 0x85, 0xb8, 0x7a, 0xd5, 0x26, 0x7f, 0x36, 0x00, 0xd8, 0x8f, 0xe8, 0xdb,
-	0x90, 0x72, 0x3c, 0x22, 0xb0, 0x0f, 0xf2, 0xf1, 0xb8, 0xb6, 0x89, 0xc9, 0x4f, 0xc3, 0xc7, 0xa0,
-	0x3a, 0xcf, 0x64, 0x94, 0xa3, 0xf5, 0xdd, 0x2d, 0x4b, 0xcd, 0xb4, 0xa5, 0xc5, 0x9d, 0x46, 0xea,
-	0x40, 0xaa, 0xa7, 0xd3, 0xdc, 0x7c, 0xa3, 0x6b, 0xfd, 0x6a, 0x80, 0x6a, 0x5e, 0x68, 0x1b, 0x54,
-	0x26, 0xc4, 0xa3, 0x13, 0xa1, 0xea, 0x2c, 0x65, 0x1d, 0xbe, 0x54, 0x88, 0xab, 0x23, 0xf0, 0x0e,
-	0xa8, 0x2e, 0x08, 0x8f, 0x52, 0x1b, 0xb2, 0xe9, 0xac, 0xa7, 0xe4, 0xc7, 0x19, 0xe4, 0xe6, 0x31,
-	0xf8, 0x0a, 0xdc, 0x60, 0xb3, 0x91, 0x26, 0xce, 0x28, 0xd4, 0x95, 0x94, 0x1c, 0x33, 0x91, 0x68,
-	0xfb, 0xe0, 0x8f, 0xd8, 0x7d, 0xe6, 0x7b, 0x82, 0xf8, 0xa1, 0x88, 0xdd, 0xbf, 0xce, 0xc1, 0x5d,
-	0xb0, 0x31, 0x26, 0x58, 0xcc, 0x39, 0x89, 0x9a, 0xe5, 0x56, 0xa9, 0x53, 0x73, 0x6e, 0x25, 0x12,
-	0xc1, 0x17, 0x1a, 0xbb, 0x70, 0x76, 0x9d, 0xd7, 0x7e, 0x0f, 0xaa, 0xdd, 0xde, 0x9b, 0x43, 0xec,
-	0x71, 0x78, 0x1b, 0x94, 0xa6, 0x24, 0x56, 0x2d, 0xe9, 0x6a, 0xf1, 0x70, 0xa6, 0xfe, 0xa4, 0x14,
-	0x87, 0x47, 0xa0, 0x9c, 0x9a, 0xa9, 0x07, 0xf2, 0xea, 0x57, 0xa3, 0xd8, 0x9c, 0xbd, 0xb3, 0xa5,
-	0x69, 0x9c, 0x2f, 0x4d, 0xe3, 0xc7, 0xd2, 0x34, 0x3e, 0xae, 0xcc, 0xc2, 0xf9, 0xca, 0x2c, 0x7c,
-	0x5b, 0x99, 0x85, 0xbe, 0x7d, 0x19, 0xca, 0xec, 0xa1, 0x52, 0xc4, 0x83, 0x8a, 0x7a, 0x8e, 0x1e,
-	0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x82, 0xb5, 0x6d, 0xea, 0xbe, 0x04, 0x00, 0x00,
+func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
+func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProposal) ProtoMessage()    {}
+func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{6}
 }
-
-func (m *MsgChangeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgChangeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProposal.Merge(m, src)
+}
+func (m *MsgSubmitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProposal.DiscardUnknown(m)
 }
 
-func (m *MsgChangeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ParamVal) > 0 {
-		i -= len(m.ParamVal)
-		copy(dAtA[i:], m.ParamVal)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamVal)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ParamKey) > 0 {
-		i -= len(m.ParamKey)
-		copy(dAtA[i:], m.ParamKey)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSubmitProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitProposal) GetProposer() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Proposer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDAOTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProposal) GetContent() ProposalContent {
+	if m != nil {
+		return m.Content
 	}
-	return dAtA[:n], nil
+	return ProposalContent{}
 }
 
-func (m *MsgDAOTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*MsgSubmitProposal) XXX_MessageName() string {
+	return "x.gov.MsgSubmitProposal"
 }
 
-func (m *MsgDAOTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type MsgVote struct {
+	ProposalID       uint64                                            `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposal_id"`
+	ValidatorAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=validatorAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"validator_address"`
+	Signer           github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"signer_address"`
+	Option           string                                            `protobuf:"bytes,4,opt,name=option,proto3" json:"option"`
+}
+
+func (m *MsgVote) Reset()         { *m = MsgVote{} }
+func (m *MsgVote) String() string { return proto.CompactTextString(m) }
+func (*MsgVote) ProtoMessage()    {}
+func (*MsgVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{7}
+}
+func (m *MsgVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGov(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVote.Merge(m, src)
+}
+func (m *MsgVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVote proto.InternalMessageInfo
+
+func (m *MsgVote) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgVote) GetValidatorAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgUpgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVote) GetSigner() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVote) GetOption() string {
+	if m != nil {
+		return m.Option
+	}
+	return ""
 }
 
-func (m *MsgUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+func (*MsgVote) XXX_MessageName() string {
+	return "x.gov.MsgVote"
+}
+
+type ProposalContent struct {
+	Type      string                                            `protobuf:"bytes,1,opt,name=type,proto3" json:"type"`
+	ParamKey  string                                            `protobuf:"bytes,2,opt,name=paramKey,proto3" json:"param_key,omitempty"`
+	ParamVal  []byte                                            `protobuf:"bytes,3,opt,name=paramVal,proto3" json:"param_value,omitempty"`
+	Upgrade   Upgrade                                           `protobuf:"bytes,4,opt,name=upgrade,proto3" json:"upgrade"`
+	ToAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,5,opt,name=toAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"to_address,omitempty"`
+	Amount    github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount"`
+	DaoAction string                                            `protobuf:"bytes,7,opt,name=daoAction,proto3" json:"dao_action,omitempty"`
+}
+
+func (m *ProposalContent) Reset()         { *m = ProposalContent{} }
+func (m *ProposalContent) String() string { return proto.CompactTextString(m) }
+func (*ProposalContent) ProtoMessage()    {}
+func (*ProposalContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{8}
+}
+func (m *ProposalContent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalContent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGov(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ProposalContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalContent.Merge(m, src)
+}
+func (m *ProposalContent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalContent.DiscardUnknown(m)
 }
 
-func (m *Upgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProposalContent proto.InternalMessageInfo
+
+func (m *ProposalContent) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProposalContent) GetParamKey() string {
+	if m != nil {
+		return m.ParamKey
+	}
+	return ""
 }
 
-func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Features) > 0 {
-		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Features[iNdEx])
-			copy(dAtA[i:], m.Features[iNdEx])
-			i = encodeVarintGov(dAtA, i, uint64(len(m.Features[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.OldUpgradeHeight != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.OldUpgradeHeight))
-		i--
-		dAtA[i] = 0x18
+func (m *ProposalContent) GetParamVal() []byte {
+	if m != nil {
+		return m.ParamVal
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ProposalContent) GetUpgrade() Upgrade {
+	if m != nil {
+		return m.Upgrade
 	}
-	if m.Height != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	return Upgrade{}
+}
+
+func (m *ProposalContent) GetToAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.ToAddress
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ACLPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProposalContent) GetDaoAction() string {
+	if m != nil {
+		return m.DaoAction
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ACLPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Proposal struct {
+	Id              uint64                                            `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Proposer        github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=proposer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"proposer"`
+	Content         ProposalContent                                   `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
+	Deposit         github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"deposit"`
+	SubmitHeight    int64                                             `protobuf:"varint,5,opt,name=submitHeight,proto3" json:"submit_height"`
+	VotingEndHeight int64                                             `protobuf:"varint,6,opt,name=votingEndHeight,proto3" json:"voting_end_height"`
+	Status          string                                            `protobuf:"bytes,7,opt,name=status,proto3" json:"status"`
+	FinalTally      TallyResult                                       `protobuf:"bytes,8,opt,name=finalTally,proto3" json:"final_tally"`
 }
 
-func (m *ACLPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x12
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{9}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Proposal) GetProposer() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Proposer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgChangeParam) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Proposal) GetContent() ProposalContent {
+	if m != nil {
+		return m.Content
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ProposalContent{}
+}
+
+func (m *Proposal) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
 	}
-	l = len(m.ParamKey)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+func (m *Proposal) GetVotingEndHeight() int64 {
+	if m != nil {
+		return m.VotingEndHeight
 	}
-	l = len(m.ParamVal)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+func (m *Proposal) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return ""
 }
 
-func (m *MsgDAOTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Proposal) GetFinalTally() TallyResult {
+	if m != nil {
+		return m.FinalTally
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return TallyResult{}
+}
+
+type Vote struct {
+	ProposalID       uint64                                            `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposal_id"`
+	ValidatorAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=validatorAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"validator_address"`
+	Option           string                                            `protobuf:"bytes,3,opt,name=option,proto3" json:"option"`
+	Height           int64                                             `protobuf:"varint,4,opt,name=height,proto3" json:"height"`
+}
+
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{10}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+func (m *Vote) GetValidatorAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return n
+	return nil
 }
 
-func (m *MsgUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Vote) GetOption() string {
+	if m != nil {
+		return m.Option
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *Vote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = m.Upgrade.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+	return 0
 }
 
-func (m *Upgrade) Size() (n int) {
-	if m == nil {
-		return 0
+type TallyResult struct {
+	Yes         github_com_pokt_network_pocket_core_types.BigInt `protobuf:"bytes,1,opt,name=yes,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"yes"`
+	No          github_com_pokt_network_pocket_core_types.BigInt `protobuf:"bytes,2,opt,name=no,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"no"`
+	Abstain     github_com_pokt_network_pocket_core_types.BigInt `protobuf:"bytes,3,opt,name=abstain,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"abstain"`
+	TotalStaked github_com_pokt_network_pocket_core_types.BigInt `protobuf:"bytes,4,opt,name=totalStaked,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"total_staked"`
+}
+
+func (m *TallyResult) Reset()         { *m = TallyResult{} }
+func (m *TallyResult) String() string { return proto.CompactTextString(m) }
+func (*TallyResult) ProtoMessage()    {}
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{11}
+}
+func (m *TallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovGov(uint64(m.Height))
+}
+func (m *TallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyResult.Merge(m, src)
+}
+func (m *TallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyResult proto.InternalMessageInfo
+
+type PendingParamChange struct {
+	ParamKey         string                                            `protobuf:"bytes,1,opt,name=paramKey,proto3" json:"param_key"`
+	ParamVal         []byte                                            `protobuf:"bytes,2,opt,name=paramVal,proto3" json:"param_value"`
+	Owner            github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,3,opt,name=owner,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"owner"`
+	SubmitHeight     int64                                             `protobuf:"varint,4,opt,name=submitHeight,proto3" json:"submit_height"`
+	ActivationHeight int64                                             `protobuf:"varint,5,opt,name=activationHeight,proto3" json:"activation_height"`
+}
+
+func (m *PendingParamChange) Reset()         { *m = PendingParamChange{} }
+func (m *PendingParamChange) String() string { return proto.CompactTextString(m) }
+func (*PendingParamChange) ProtoMessage()    {}
+func (*PendingParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{12}
+}
+func (m *PendingParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *PendingParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingParamChange.Merge(m, src)
+}
+func (m *PendingParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingParamChange proto.InternalMessageInfo
+
+func (m *PendingParamChange) GetParamKey() string {
+	if m != nil {
+		return m.ParamKey
 	}
-	if m.OldUpgradeHeight != 0 {
-		n += 1 + sovGov(uint64(m.OldUpgradeHeight))
+	return ""
+}
+
+func (m *PendingParamChange) GetParamVal() []byte {
+	if m != nil {
+		return m.ParamVal
 	}
-	if len(m.Features) > 0 {
-		for _, s := range m.Features {
+	return nil
+}
+
+func (m *PendingParamChange) GetOwner() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *PendingParamChange) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *PendingParamChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+type MsgCreateMultisigOwner struct {
+	FromAddress  github_com_pokt_network_pocket_core_types.Address   `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	Members      []github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,rep,name=members,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"members"`
+	Threshold    int64                                               `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold"`
+	ExpiryBlocks int64                                               `protobuf:"varint,4,opt,name=expiryBlocks,proto3" json:"expiry_blocks"`
+}
+
+func (m *MsgCreateMultisigOwner) Reset()         { *m = MsgCreateMultisigOwner{} }
+func (m *MsgCreateMultisigOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMultisigOwner) ProtoMessage()    {}
+func (*MsgCreateMultisigOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{13}
+}
+func (m *MsgCreateMultisigOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMultisigOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMultisigOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateMultisigOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMultisigOwner.Merge(m, src)
+}
+func (m *MsgCreateMultisigOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMultisigOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMultisigOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateMultisigOwner proto.InternalMessageInfo
+
+func (m *MsgCreateMultisigOwner) GetFromAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *MsgCreateMultisigOwner) GetMembers() []github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *MsgCreateMultisigOwner) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgCreateMultisigOwner) GetExpiryBlocks() int64 {
+	if m != nil {
+		return m.ExpiryBlocks
+	}
+	return 0
+}
+
+func (*MsgCreateMultisigOwner) XXX_MessageName() string {
+	return "x.gov.MsgCreateMultisigOwner"
+}
+
+type MsgApproveParamChange struct {
+	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	ApprovalID  uint64                                            `protobuf:"varint,2,opt,name=approvalID,proto3" json:"approval_id"`
+}
+
+func (m *MsgApproveParamChange) Reset()         { *m = MsgApproveParamChange{} }
+func (m *MsgApproveParamChange) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveParamChange) ProtoMessage()    {}
+func (*MsgApproveParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{14}
+}
+func (m *MsgApproveParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveParamChange.Merge(m, src)
+}
+func (m *MsgApproveParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveParamChange proto.InternalMessageInfo
+
+func (m *MsgApproveParamChange) GetFromAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *MsgApproveParamChange) GetApprovalID() uint64 {
+	if m != nil {
+		return m.ApprovalID
+	}
+	return 0
+}
+
+func (*MsgApproveParamChange) XXX_MessageName() string {
+	return "x.gov.MsgApproveParamChange"
+}
+
+type MultisigOwner struct {
+	Address      github_com_pokt_network_pocket_core_types.Address   `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	Members      []github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,rep,name=members,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"members"`
+	Threshold    int64                                               `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold"`
+	ExpiryBlocks int64                                               `protobuf:"varint,4,opt,name=expiryBlocks,proto3" json:"expiry_blocks"`
+}
+
+func (m *MultisigOwner) Reset()         { *m = MultisigOwner{} }
+func (m *MultisigOwner) String() string { return proto.CompactTextString(m) }
+func (*MultisigOwner) ProtoMessage()    {}
+func (*MultisigOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{15}
+}
+func (m *MultisigOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigOwner.Merge(m, src)
+}
+func (m *MultisigOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigOwner proto.InternalMessageInfo
+
+func (m *MultisigOwner) GetAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *MultisigOwner) GetMembers() []github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *MultisigOwner) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultisigOwner) GetExpiryBlocks() int64 {
+	if m != nil {
+		return m.ExpiryBlocks
+	}
+	return 0
+}
+
+type ParamApproval struct {
+	Id               uint64                                              `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Owner            github_com_pokt_network_pocket_core_types.Address   `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"owner"`
+	ParamKey         string                                              `protobuf:"bytes,3,opt,name=paramKey,proto3" json:"param_key"`
+	ParamVal         []byte                                              `protobuf:"bytes,4,opt,name=paramVal,proto3" json:"param_value"`
+	ActivationHeight int64                                               `protobuf:"varint,5,opt,name=activationHeight,proto3" json:"activation_height,omitempty"`
+	Approvals        []github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,6,rep,name=approvals,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"approvals"`
+	SubmitHeight     int64                                               `protobuf:"varint,7,opt,name=submitHeight,proto3" json:"submit_height"`
+	ExpiryHeight     int64                                               `protobuf:"varint,8,opt,name=expiryHeight,proto3" json:"expiry_height"`
+}
+
+func (m *ParamApproval) Reset()         { *m = ParamApproval{} }
+func (m *ParamApproval) String() string { return proto.CompactTextString(m) }
+func (*ParamApproval) ProtoMessage()    {}
+func (*ParamApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{16}
+}
+func (m *ParamApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamApproval.Merge(m, src)
+}
+func (m *ParamApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamApproval proto.InternalMessageInfo
+
+func (m *ParamApproval) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ParamApproval) GetOwner() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *ParamApproval) GetParamKey() string {
+	if m != nil {
+		return m.ParamKey
+	}
+	return ""
+}
+
+func (m *ParamApproval) GetParamVal() []byte {
+	if m != nil {
+		return m.ParamVal
+	}
+	return nil
+}
+
+func (m *ParamApproval) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *ParamApproval) GetApprovals() []github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *ParamApproval) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *ParamApproval) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+type MsgCreateDAOStream struct {
+	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"from_address"`
+	ToAddress   github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=toAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"to_address"`
+	Total       github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=total,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"total"`
+	StartHeight int64                                             `protobuf:"varint,4,opt,name=startHeight,proto3" json:"start_height"`
+	EndHeight   int64                                             `protobuf:"varint,5,opt,name=endHeight,proto3" json:"end_height"`
+	CliffHeight int64                                             `protobuf:"varint,6,opt,name=cliffHeight,proto3" json:"cliff_height,omitempty"`
+}
+
+func (m *MsgCreateDAOStream) Reset()         { *m = MsgCreateDAOStream{} }
+func (m *MsgCreateDAOStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDAOStream) ProtoMessage()    {}
+func (*MsgCreateDAOStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{17}
+}
+func (m *MsgCreateDAOStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDAOStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDAOStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDAOStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDAOStream.Merge(m, src)
+}
+func (m *MsgCreateDAOStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDAOStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDAOStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDAOStream proto.InternalMessageInfo
+
+func (m *MsgCreateDAOStream) GetFromAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *MsgCreateDAOStream) GetToAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *MsgCreateDAOStream) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateDAOStream) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateDAOStream) GetCliffHeight() int64 {
+	if m != nil {
+		return m.CliffHeight
+	}
+	return 0
+}
+
+func (*MsgCreateDAOStream) XXX_MessageName() string {
+	return "x.gov.MsgCreateDAOStream"
+}
+
+type MsgCancelDAOStream struct {
+	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"from_address"`
+	StreamID    uint64                                            `protobuf:"varint,2,opt,name=streamID,proto3" json:"stream_id"`
+}
+
+func (m *MsgCancelDAOStream) Reset()         { *m = MsgCancelDAOStream{} }
+func (m *MsgCancelDAOStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDAOStream) ProtoMessage()    {}
+func (*MsgCancelDAOStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{18}
+}
+func (m *MsgCancelDAOStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDAOStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDAOStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDAOStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDAOStream.Merge(m, src)
+}
+func (m *MsgCancelDAOStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDAOStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDAOStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDAOStream proto.InternalMessageInfo
+
+func (m *MsgCancelDAOStream) GetFromAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *MsgCancelDAOStream) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
+	}
+	return 0
+}
+
+func (*MsgCancelDAOStream) XXX_MessageName() string {
+	return "x.gov.MsgCancelDAOStream"
+}
+
+type DAOStream struct {
+	Id           uint64                                            `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Recipient    github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=recipient,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"recipient"`
+	Total        github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=total,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"total"`
+	Released     github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,4,opt,name=released,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"released"`
+	StartHeight  int64                                             `protobuf:"varint,5,opt,name=startHeight,proto3" json:"start_height"`
+	EndHeight    int64                                             `protobuf:"varint,6,opt,name=endHeight,proto3" json:"end_height"`
+	CliffHeight  int64                                             `protobuf:"varint,7,opt,name=cliffHeight,proto3" json:"cliff_height,omitempty"`
+	CreateHeight int64                                             `protobuf:"varint,8,opt,name=createHeight,proto3" json:"create_height"`
+}
+
+func (m *DAOStream) Reset()         { *m = DAOStream{} }
+func (m *DAOStream) String() string { return proto.CompactTextString(m) }
+func (*DAOStream) ProtoMessage()    {}
+func (*DAOStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{19}
+}
+func (m *DAOStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DAOStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DAOStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DAOStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DAOStream.Merge(m, src)
+}
+func (m *DAOStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *DAOStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_DAOStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DAOStream proto.InternalMessageInfo
+
+func (m *DAOStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DAOStream) GetRecipient() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *DAOStream) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DAOStream) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *DAOStream) GetCliffHeight() int64 {
+	if m != nil {
+		return m.CliffHeight
+	}
+	return 0
+}
+
+func (m *DAOStream) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+type MsgSignalReadiness struct {
+	ValidatorAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=validatorAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"validator_address"`
+	Signer           github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"signer_address"`
+	Versions         []string                                          `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions"`
+	Features         []string                                          `protobuf:"bytes,4,rep,name=features,proto3" json:"features"`
+}
+
+func (m *MsgSignalReadiness) Reset()         { *m = MsgSignalReadiness{} }
+func (m *MsgSignalReadiness) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalReadiness) ProtoMessage()    {}
+func (*MsgSignalReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{20}
+}
+func (m *MsgSignalReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalReadiness.Merge(m, src)
+}
+func (m *MsgSignalReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalReadiness proto.InternalMessageInfo
+
+func (m *MsgSignalReadiness) GetValidatorAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSignalReadiness) GetSigner() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func (m *MsgSignalReadiness) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *MsgSignalReadiness) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (*MsgSignalReadiness) XXX_MessageName() string {
+	return "x.gov.MsgSignalReadiness"
+}
+
+type UpgradeSignal struct {
+	ValidatorAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=validatorAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"validator_address"`
+	Versions         []string                                          `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions"`
+	Features         []string                                          `protobuf:"bytes,3,rep,name=features,proto3" json:"features"`
+	Height           int64                                             `protobuf:"varint,4,opt,name=height,proto3" json:"height"`
+}
+
+func (m *UpgradeSignal) Reset()         { *m = UpgradeSignal{} }
+func (m *UpgradeSignal) String() string { return proto.CompactTextString(m) }
+func (*UpgradeSignal) ProtoMessage()    {}
+func (*UpgradeSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{21}
+}
+func (m *UpgradeSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeSignal.Merge(m, src)
+}
+func (m *UpgradeSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeSignal proto.InternalMessageInfo
+
+func (m *UpgradeSignal) GetValidatorAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *UpgradeSignal) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *UpgradeSignal) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *UpgradeSignal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgChangeParam)(nil), "x.gov.MsgChangeParam")
+	proto.RegisterType((*MsgCancelParamChange)(nil), "x.gov.MsgCancelParamChange")
+	proto.RegisterType((*MsgDAOTransfer)(nil), "x.gov.MsgDAOTransfer")
+	proto.RegisterType((*MsgUpgrade)(nil), "x.gov.MsgUpgrade")
+	proto.RegisterType((*Upgrade)(nil), "x.gov.Upgrade")
+	proto.RegisterType((*ACLPair)(nil), "x.gov.ACLPair")
+	proto.RegisterType((*MsgSubmitProposal)(nil), "x.gov.MsgSubmitProposal")
+	proto.RegisterType((*MsgVote)(nil), "x.gov.MsgVote")
+	proto.RegisterType((*ProposalContent)(nil), "x.gov.ProposalContent")
+	proto.RegisterType((*Proposal)(nil), "x.gov.Proposal")
+	proto.RegisterType((*Vote)(nil), "x.gov.Vote")
+	proto.RegisterType((*TallyResult)(nil), "x.gov.TallyResult")
+	proto.RegisterType((*PendingParamChange)(nil), "x.gov.PendingParamChange")
+	proto.RegisterType((*MsgCreateMultisigOwner)(nil), "x.gov.MsgCreateMultisigOwner")
+	proto.RegisterType((*MsgApproveParamChange)(nil), "x.gov.MsgApproveParamChange")
+	proto.RegisterType((*MultisigOwner)(nil), "x.gov.MultisigOwner")
+	proto.RegisterType((*ParamApproval)(nil), "x.gov.ParamApproval")
+	proto.RegisterType((*MsgCreateDAOStream)(nil), "x.gov.MsgCreateDAOStream")
+	proto.RegisterType((*MsgCancelDAOStream)(nil), "x.gov.MsgCancelDAOStream")
+	proto.RegisterType((*DAOStream)(nil), "x.gov.DAOStream")
+	proto.RegisterType((*MsgSignalReadiness)(nil), "x.gov.MsgSignalReadiness")
+	proto.RegisterType((*UpgradeSignal)(nil), "x.gov.UpgradeSignal")
+}
+
+func init() { proto.RegisterFile("x/gov/gov.proto", fileDescriptor_8366cfab811ef854) }
+
+var fileDescriptor_8366cfab811ef854 = []byte{
+	// 1694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4b, 0x6c, 0x1c, 0x49,
+	0x19, 0x76, 0x77, 0xcf, 0xf3, 0x1f, 0xbf, 0x52, 0x9b, 0x98, 0x21, 0x80, 0xdb, 0x1a, 0x09, 0xc9,
+	0xab, 0xdd, 0xf5, 0x40, 0x56, 0x8b, 0x04, 0x42, 0xbb, 0xcc, 0xd8, 0x4b, 0x5e, 0x18, 0x9b, 0xb6,
+	0x09, 0x92, 0xa5, 0x68, 0x52, 0x9e, 0x2e, 0xb7, 0x9b, 0xe9, 0xe9, 0x6a, 0x75, 0xd7, 0x4c, 0x32,
+	0x17, 0xce, 0x39, 0x72, 0x43, 0xe2, 0x82, 0xc4, 0x0d, 0x21, 0x71, 0xe0, 0x0c, 0x17, 0x2e, 0xe4,
+	0x98, 0x03, 0x12, 0x88, 0x43, 0x83, 0x9c, 0x5b, 0x1f, 0x38, 0x70, 0x41, 0x82, 0x03, 0xa8, 0xaa,
+	0xab, 0x1f, 0xe3, 0xb1, 0x61, 0xec, 0xb1, 0x13, 0xb2, 0x87, 0xb8, 0x2b, 0x7f, 0xfd, 0x8f, 0xaa,
+	0xbf, 0xbe, 0xfa, 0xfe, 0xaa, 0x1a, 0x58, 0x7a, 0xd6, 0xb4, 0xe8, 0x90, 0xff, 0xdb, 0xf0, 0x7c,
+	0xca, 0x28, 0x2a, 0x3e, 0xdb, 0xb0, 0xe8, 0xf0, 0xf6, 0x4d, 0x8b, 0x5a, 0x54, 0x48, 0x9a, 0xbc,
+	0x15, 0x77, 0x36, 0x7e, 0xa6, 0xc2, 0xe2, 0x76, 0x60, 0x6d, 0x1e, 0x63, 0xd7, 0x22, 0xbb, 0xd8,
+	0xc7, 0x7d, 0x74, 0x08, 0xb5, 0x23, 0x9f, 0xf6, 0x5b, 0xa6, 0xe9, 0x93, 0x20, 0xa8, 0x2b, 0x6b,
+	0xca, 0xfa, 0x7c, 0xfb, 0x5b, 0x51, 0xa8, 0x97, 0x71, 0x2c, 0xfa, 0x67, 0xa8, 0x7f, 0xd5, 0xb2,
+	0xd9, 0xf1, 0xe0, 0x70, 0xa3, 0x4b, 0xfb, 0x4d, 0x8f, 0xf6, 0xd8, 0x07, 0x2e, 0x61, 0x4f, 0xa9,
+	0xdf, 0x6b, 0x7a, 0xb4, 0xdb, 0x23, 0xec, 0x83, 0x2e, 0xf5, 0x49, 0x93, 0x8d, 0x3c, 0x12, 0x6c,
+	0x48, 0x3f, 0x46, 0xde, 0x29, 0x7a, 0x17, 0x2a, 0x1e, 0x0f, 0xf6, 0x90, 0x8c, 0xea, 0xea, 0x9a,
+	0xb2, 0x5e, 0x6d, 0x2f, 0x44, 0xa1, 0x5e, 0x15, 0xb2, 0x4e, 0x8f, 0x8c, 0x8c, 0xb4, 0x1b, 0xbd,
+	0x27, 0x55, 0x1f, 0x61, 0xa7, 0xae, 0x89, 0xb1, 0x2c, 0x45, 0xa1, 0x5e, 0x8b, 0x55, 0x87, 0xd8,
+	0x19, 0x10, 0x23, 0x55, 0x40, 0x0f, 0x61, 0x19, 0x77, 0x99, 0x3d, 0xc4, 0xcc, 0xa6, 0xee, 0x3d,
+	0x62, 0x5b, 0xc7, 0xac, 0x5e, 0x58, 0x53, 0xd6, 0xb5, 0xb6, 0x1e, 0x85, 0xfa, 0x17, 0xb2, 0xbe,
+	0xce, 0xb1, 0xe8, 0x7c, 0x9f, 0xf6, 0x6d, 0x46, 0xfa, 0x1e, 0x1b, 0x19, 0x13, 0x86, 0xdf, 0x28,
+	0x3c, 0xff, 0xb9, 0xae, 0x34, 0xfe, 0xa6, 0xc0, 0x4d, 0x9e, 0x21, 0xec, 0x76, 0x89, 0x23, 0x32,
+	0x14, 0x27, 0xeb, 0xff, 0x2d, 0x4f, 0xad, 0x33, 0xa6, 0xae, 0x89, 0xa9, 0xdf, 0x8a, 0x42, 0xfd,
+	0xc6, 0xc4, 0xd4, 0xcf, 0x9d, 0xf0, 0x49, 0x0c, 0x89, 0xad, 0xd6, 0xce, 0xbe, 0x8f, 0xdd, 0xe0,
+	0x88, 0xf8, 0xc8, 0x3a, 0x6b, 0xaa, 0x9f, 0x46, 0xa1, 0x3e, 0xcf, 0xc5, 0x9d, 0xab, 0x9b, 0x2f,
+	0x86, 0x2a, 0xa3, 0x49, 0x18, 0x55, 0x84, 0xd9, 0x8c, 0x42, 0x1d, 0x18, 0x9d, 0x2d, 0x48, 0xe6,
+	0x15, 0x1d, 0x40, 0x09, 0xf7, 0xe9, 0xc0, 0x8d, 0xb3, 0x53, 0x6d, 0xb7, 0x5f, 0x84, 0xfa, 0xdc,
+	0x9f, 0x43, 0xfd, 0x2b, 0xd3, 0x7b, 0x6d, 0xdb, 0xd6, 0x7d, 0x97, 0x45, 0xa1, 0x2e, 0x3d, 0x19,
+	0xf2, 0x8b, 0x1a, 0x50, 0xe2, 0x49, 0xa5, 0xae, 0x00, 0x5d, 0xb5, 0x0d, 0x42, 0x47, 0x48, 0x0c,
+	0xf9, 0x95, 0x49, 0xfe, 0x85, 0x02, 0xb0, 0x1d, 0x58, 0xdf, 0xf7, 0x2c, 0x1f, 0x9b, 0x04, 0x1d,
+	0x40, 0x19, 0x8f, 0x25, 0x77, 0x76, 0x1c, 0x25, 0xd6, 0xe8, 0xeb, 0x50, 0x1e, 0xc4, 0x61, 0x44,
+	0x46, 0x6b, 0x77, 0x16, 0x37, 0x04, 0x23, 0x6c, 0xc8, 0xe0, 0xed, 0x25, 0x9e, 0x01, 0x1e, 0x4f,
+	0xaa, 0x19, 0x49, 0x43, 0x8e, 0xf5, 0x0f, 0x0a, 0x94, 0x93, 0x81, 0x36, 0xa0, 0x14, 0xc3, 0x47,
+	0x8c, 0x53, 0x8b, 0x67, 0x18, 0xc3, 0xc7, 0x90, 0x3d, 0xe8, 0xcb, 0x50, 0x1e, 0x12, 0x3f, 0xe0,
+	0x69, 0x88, 0x31, 0x5b, 0xe3, 0xce, 0x1f, 0xc5, 0x22, 0x23, 0xe9, 0x43, 0x0f, 0x60, 0x99, 0x3a,
+	0xa6, 0x74, 0x3c, 0x06, 0xd8, 0xd5, 0x28, 0xd4, 0x6f, 0xef, 0x9c, 0xea, 0xcb, 0x6f, 0xd5, 0xd3,
+	0x76, 0xe8, 0x0e, 0x54, 0x8e, 0x08, 0x66, 0x03, 0x9f, 0x04, 0xf5, 0xc2, 0x9a, 0xb6, 0x5e, 0x6d,
+	0xaf, 0x44, 0xa1, 0x8e, 0xbe, 0x2d, 0x65, 0x39, 0xdb, 0x54, 0xaf, 0xf1, 0x23, 0x28, 0xb7, 0x36,
+	0xbf, 0xb3, 0x8b, 0x6d, 0x1f, 0x7d, 0x09, 0xb4, 0x1e, 0x19, 0xd5, 0x95, 0x6c, 0xb4, 0xb8, 0xeb,
+	0x88, 0xfd, 0xc5, 0xe5, 0x68, 0x1f, 0x0a, 0x3c, 0x99, 0x75, 0xf5, 0x8a, 0x96, 0x46, 0x78, 0x6b,
+	0xfc, 0x54, 0x85, 0x1b, 0xdb, 0x81, 0xb5, 0x37, 0x38, 0xec, 0xdb, 0x6c, 0xd7, 0xa7, 0x1e, 0x0d,
+	0xb0, 0x83, 0x1e, 0x43, 0xc5, 0x13, 0x6d, 0xe2, 0x4b, 0x28, 0xb4, 0xa2, 0x50, 0x4f, 0x65, 0x97,
+	0x0b, 0x98, 0x9a, 0xa3, 0x16, 0x94, 0xbb, 0xd4, 0x65, 0xc4, 0x65, 0x12, 0x0c, 0x2b, 0x12, 0x0c,
+	0xc9, 0x00, 0x36, 0xe3, 0xde, 0x0c, 0x14, 0x52, 0xdd, 0x48, 0x1a, 0xe8, 0x31, 0x94, 0x4d, 0xe2,
+	0xd1, 0xc0, 0x4e, 0x76, 0xd0, 0xe6, 0x0c, 0x3b, 0x28, 0x71, 0x65, 0x24, 0x0d, 0x89, 0xb9, 0xdf,
+	0xaa, 0x50, 0xde, 0x0e, 0xac, 0x47, 0x94, 0x11, 0xd4, 0x04, 0xf0, 0xe4, 0xe8, 0xee, 0x6f, 0x89,
+	0xa4, 0x14, 0x64, 0x0d, 0x90, 0xd2, 0x8e, 0x6d, 0x1a, 0x39, 0x15, 0x34, 0x84, 0xe5, 0x21, 0x76,
+	0x6c, 0x13, 0x33, 0xea, 0x8f, 0x93, 0xc9, 0x03, 0x4e, 0x85, 0x69, 0xdf, 0x6c, 0x9c, 0x32, 0x11,
+	0x03, 0x75, 0xa0, 0x14, 0xd8, 0x96, 0x4b, 0x7c, 0x59, 0xa8, 0xee, 0x46, 0xa1, 0xbe, 0x18, 0x4b,
+	0x66, 0x0b, 0x25, 0xdd, 0xf2, 0xdd, 0x47, 0xbd, 0xd3, 0xfc, 0x12, 0x4b, 0x0c, 0xf9, 0x95, 0xf9,
+	0xfb, 0xa3, 0x06, 0x4b, 0xa7, 0x96, 0x14, 0x7d, 0x11, 0x0a, 0xdc, 0xad, 0x84, 0x79, 0x25, 0x0a,
+	0x75, 0xf1, 0x7f, 0x43, 0xfc, 0x45, 0x1f, 0x4e, 0x94, 0x9a, 0xcf, 0x45, 0xa1, 0xfe, 0x4e, 0x5a,
+	0x6a, 0xf2, 0x7b, 0x28, 0x2d, 0x3a, 0x1f, 0x4d, 0x14, 0xe7, 0xcf, 0x47, 0xa1, 0x7e, 0x2b, 0x57,
+	0x9c, 0x27, 0xcc, 0x78, 0x99, 0xce, 0x51, 0x52, 0xe1, 0x62, 0x94, 0x84, 0x7a, 0xf9, 0x0a, 0x51,
+	0x14, 0x21, 0xb7, 0xa3, 0x50, 0xbf, 0x99, 0x55, 0x88, 0x2c, 0xe2, 0x15, 0xd6, 0x8a, 0xd2, 0x95,
+	0xd7, 0x8a, 0xaf, 0x41, 0xd5, 0xc4, 0xb4, 0x15, 0x97, 0x8b, 0xb2, 0x70, 0x5f, 0xe7, 0x13, 0x31,
+	0x31, 0xed, 0xc4, 0xa5, 0x22, 0x97, 0xba, 0x4c, 0xb5, 0xf1, 0xbc, 0x00, 0x95, 0x94, 0x2d, 0x56,
+	0x40, 0xb5, 0x4d, 0xb9, 0x25, 0x4a, 0x51, 0xa8, 0xab, 0xb6, 0x69, 0xa8, 0xb6, 0x39, 0xc6, 0x22,
+	0xea, 0xb5, 0xb2, 0x88, 0x36, 0x3b, 0x8b, 0x14, 0xae, 0x9e, 0x45, 0xd0, 0x47, 0x30, 0x1f, 0x08,
+	0x62, 0x95, 0x85, 0xa5, 0x28, 0x0a, 0xcb, 0x8d, 0x28, 0xd4, 0x17, 0x62, 0x79, 0x72, 0x0a, 0x1a,
+	0x53, 0x43, 0x9f, 0xc0, 0xd2, 0x90, 0x32, 0xdb, 0xb5, 0x3e, 0x75, 0x4d, 0x69, 0x59, 0xca, 0xce,
+	0x50, 0x71, 0x57, 0x87, 0xb8, 0x66, 0x62, 0x7d, 0x5a, 0x9b, 0xef, 0xd0, 0x80, 0x61, 0x36, 0x08,
+	0xea, 0xe5, 0x6c, 0x87, 0xc6, 0x12, 0x43, 0x7e, 0xd1, 0x5d, 0x80, 0x23, 0xdb, 0xc5, 0xce, 0x3e,
+	0x76, 0x9c, 0x51, 0xbd, 0x22, 0x12, 0x88, 0x64, 0x02, 0x85, 0xcc, 0x20, 0xc1, 0xc0, 0x61, 0xed,
+	0x77, 0x64, 0xf2, 0x6a, 0x42, 0xbb, 0xc3, 0x44, 0x57, 0xce, 0xb4, 0xf1, 0x0f, 0x05, 0x0a, 0x6f,
+	0x17, 0x43, 0x66, 0x04, 0xa6, 0x9d, 0x47, 0x60, 0xb9, 0x23, 0x46, 0x21, 0x3b, 0x62, 0x1c, 0x8f,
+	0x1d, 0x31, 0x1a, 0xff, 0x52, 0xa1, 0x96, 0x4b, 0x15, 0xfa, 0x1e, 0x68, 0x23, 0x12, 0x48, 0x66,
+	0xfb, 0x64, 0x06, 0x24, 0x71, 0x37, 0x06, 0xff, 0x83, 0xbe, 0x0b, 0xaa, 0x4b, 0x25, 0x13, 0x7e,
+	0x3c, 0x83, 0x47, 0xd5, 0xa5, 0x86, 0xea, 0x52, 0x0e, 0x78, 0x7c, 0x18, 0x30, 0x6c, 0xbb, 0x57,
+	0x51, 0x36, 0xa5, 0x2b, 0x23, 0x69, 0xa0, 0x1f, 0x42, 0x8d, 0x51, 0x86, 0x9d, 0x3d, 0x86, 0x7b,
+	0xc4, 0x94, 0x7b, 0xea, 0xde, 0x0c, 0x21, 0xe6, 0x85, 0xbb, 0x4e, 0x20, 0xfc, 0x19, 0x79, 0xe7,
+	0x8d, 0xdf, 0xa8, 0x80, 0x76, 0x89, 0x6b, 0xda, 0xae, 0x95, 0xbf, 0x10, 0xe5, 0x2f, 0x2b, 0xca,
+	0xf4, 0x97, 0x3a, 0xf5, 0x7f, 0x5d, 0xea, 0xf6, 0xa1, 0x48, 0x9f, 0x66, 0x55, 0xf5, 0xe3, 0x28,
+	0xd4, 0x63, 0xc1, 0xe5, 0x50, 0x19, 0xdb, 0x4e, 0x30, 0x44, 0x61, 0x3a, 0x86, 0x38, 0xeb, 0x9a,
+	0x55, 0xbc, 0xd0, 0x35, 0xab, 0xf1, 0x3b, 0x15, 0x56, 0xf8, 0x8d, 0xd2, 0x27, 0x98, 0x91, 0xed,
+	0x81, 0xc3, 0xec, 0xc0, 0xb6, 0x76, 0xc4, 0xa0, 0x5e, 0xc7, 0x9d, 0xf2, 0x00, 0xca, 0x7d, 0xd2,
+	0x3f, 0x24, 0x3e, 0xdf, 0xf2, 0x5a, 0xe2, 0x5f, 0x8a, 0x2e, 0x79, 0xd7, 0x90, 0xd6, 0xe8, 0x3d,
+	0xa8, 0xb2, 0x63, 0x9f, 0x04, 0xc7, 0xd4, 0x31, 0xe5, 0x61, 0x5e, 0x60, 0x20, 0x15, 0x1a, 0x59,
+	0x93, 0xaf, 0x00, 0x79, 0xe6, 0xd9, 0xfe, 0xa8, 0xed, 0xd0, 0x6e, 0x2f, 0xc8, 0xaf, 0x40, 0x2c,
+	0xef, 0x1c, 0x8a, 0x0e, 0x63, 0x4c, 0x4d, 0x1e, 0x70, 0x7e, 0xad, 0xc0, 0xad, 0xed, 0xc0, 0x6a,
+	0x79, 0x9e, 0x4f, 0x87, 0xe4, 0x75, 0xdf, 0xcb, 0x9b, 0x00, 0x58, 0x44, 0x16, 0x84, 0xab, 0x66,
+	0x84, 0x9b, 0x48, 0x05, 0xe1, 0x66, 0x2a, 0x72, 0xd0, 0xbf, 0x54, 0x61, 0x61, 0x7c, 0xc1, 0xaf,
+	0xf3, 0xe2, 0xf7, 0x96, 0x2f, 0x74, 0xe3, 0xef, 0x1a, 0x2c, 0x88, 0x85, 0x6d, 0xc9, 0x3c, 0x9e,
+	0x7b, 0xdc, 0x49, 0x19, 0x42, 0xbd, 0x4a, 0x86, 0xc8, 0xf3, 0x99, 0x36, 0x3d, 0x9f, 0x15, 0x2e,
+	0xf3, 0x48, 0x55, 0xbc, 0xe4, 0x23, 0x15, 0x7a, 0x02, 0xd5, 0x04, 0x66, 0x41, 0xbd, 0x24, 0x96,
+	0xb9, 0xcd, 0x47, 0x99, 0x0a, 0x2f, 0x79, 0x08, 0x4e, 0xed, 0x27, 0x88, 0xb2, 0x3c, 0x1d, 0x51,
+	0xa6, 0x8b, 0x2e, 0xcd, 0x2a, 0x13, 0x8b, 0x9e, 0x98, 0xe5, 0xd5, 0x1a, 0x7f, 0xd1, 0x00, 0xa5,
+	0xe4, 0xb8, 0xd5, 0xda, 0xd9, 0x63, 0x3e, 0xc1, 0xfd, 0xcf, 0xd4, 0x0b, 0xd4, 0x0f, 0xa0, 0x28,
+	0xaa, 0xa9, 0x04, 0x55, 0x6b, 0x86, 0x22, 0x1d, 0x3b, 0x32, 0xe2, 0x0f, 0xba, 0x03, 0xb5, 0x80,
+	0x61, 0x7f, 0xbc, 0xa2, 0x2d, 0xf3, 0x24, 0x09, 0x71, 0x92, 0xf0, 0xbc, 0x12, 0x7a, 0x1f, 0xaa,
+	0xc4, 0x35, 0xc7, 0x50, 0xb8, 0xc8, 0xe7, 0x9b, 0x3b, 0xe4, 0x66, 0x0a, 0xe8, 0x9b, 0x50, 0xeb,
+	0x3a, 0xf6, 0xd1, 0xd1, 0xd8, 0xd9, 0xf8, 0x76, 0x14, 0xea, 0x2b, 0x42, 0x3c, 0x09, 0xd8, 0xbc,
+	0xba, 0x24, 0xc1, 0x5f, 0x29, 0x80, 0xd2, 0x07, 0xd5, 0x37, 0xb0, 0xc2, 0xef, 0x42, 0x25, 0x10,
+	0x21, 0x53, 0xe6, 0x16, 0xdb, 0x3a, 0x96, 0x71, 0xde, 0x4e, 0xbb, 0xe5, 0x80, 0x7f, 0x52, 0x80,
+	0x6a, 0x36, 0xce, 0xf3, 0x38, 0xe8, 0x09, 0x54, 0x7d, 0xd2, 0xb5, 0x3d, 0x3b, 0x79, 0x5b, 0x91,
+	0x1b, 0x31, 0x15, 0x5e, 0x12, 0x37, 0xa9, 0xfd, 0xf5, 0xe1, 0xe6, 0x09, 0x54, 0x7c, 0xe2, 0x10,
+	0x1c, 0xa4, 0x07, 0xc7, 0xad, 0x19, 0x7c, 0xa7, 0xbe, 0x8c, 0xb4, 0x75, 0x1a, 0x99, 0xc5, 0x0b,
+	0x23, 0xb3, 0x74, 0x41, 0x64, 0x96, 0x2f, 0x84, 0x4c, 0x4e, 0x56, 0x5d, 0xc1, 0x38, 0x93, 0x64,
+	0x15, 0xcb, 0x53, 0xb2, 0xca, 0xab, 0x35, 0x7e, 0xaf, 0x0a, 0x28, 0xef, 0xd9, 0x96, 0x8b, 0x1d,
+	0x83, 0x60, 0xd3, 0x76, 0x39, 0xc2, 0xce, 0xba, 0x5d, 0x29, 0xaf, 0xf5, 0xfd, 0x49, 0xbd, 0x9e,
+	0xf7, 0xa7, 0x75, 0xa8, 0xc8, 0xd7, 0xdb, 0xa0, 0xae, 0x89, 0x67, 0xd6, 0x79, 0xbe, 0xe0, 0x89,
+	0xcc, 0x48, 0x5b, 0x5c, 0xf3, 0xd4, 0x83, 0xac, 0xd0, 0x4c, 0x64, 0xd9, 0x33, 0xac, 0xdc, 0x63,
+	0xff, 0x56, 0x60, 0x41, 0x3e, 0xfe, 0xc4, 0xd9, 0x7c, 0x63, 0x49, 0xcc, 0xcf, 0x51, 0x9d, 0x7a,
+	0x8e, 0xda, 0x7f, 0x9b, 0xe3, 0x34, 0x57, 0xda, 0xf6, 0xfd, 0x17, 0x27, 0xab, 0xca, 0xcb, 0x93,
+	0x55, 0xe5, 0xaf, 0x27, 0xab, 0xca, 0x8f, 0x5f, 0xad, 0xce, 0xbd, 0x7c, 0xb5, 0x3a, 0xf7, 0xa7,
+	0x57, 0xab, 0x73, 0x07, 0xcd, 0x69, 0xa6, 0x15, 0xff, 0xea, 0x27, 0x26, 0x77, 0x58, 0x12, 0xbf,
+	0xed, 0x7d, 0xf8, 0x9f, 0x01, 0x00, 0xf5, 0x4b, 0x11, 0x33, 0x0b, 0x1c, 0x00, 0x00,
+}
+
+func (m *MsgChangeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ParamVal) > 0 {
+		i -= len(m.ParamVal)
+		copy(dAtA[i:], m.ParamVal)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamVal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDAOTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDAOTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDAOTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Upgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.OldUpgradeHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.OldUpgradeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ACLPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ACLPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ACLPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Option) > 0 {
+		i -= len(m.Option)
+		copy(dAtA[i:], m.Option)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Option)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DaoAction) > 0 {
+		i -= len(m.DaoAction)
+		copy(dAtA[i:], m.DaoAction)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DaoAction)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ParamVal) > 0 {
+		i -= len(m.ParamVal)
+		copy(dAtA[i:], m.ParamVal)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamVal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinalTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.VotingEndHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VotingEndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Option) > 0 {
+		i -= len(m.Option)
+		copy(dAtA[i:], m.Option)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Option)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalStaked.Size()
+		i -= size
+		if _, err := m.TotalStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Abstain.Size()
+		i -= size
+		if _, err := m.Abstain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.No.Size()
+		i -= size
+		if _, err := m.No.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Yes.Size()
+		i -= size
+		if _, err := m.Yes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParamVal) > 0 {
+		i -= len(m.ParamVal)
+		copy(dAtA[i:], m.ParamVal)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamVal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMultisigOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultisigOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultisigOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryBlocks != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExpiryBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ApprovalID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryBlocks != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExpiryBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ParamVal) > 0 {
+		i -= len(m.ParamVal)
+		copy(dAtA[i:], m.ParamVal)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamVal)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDAOStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDAOStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDAOStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CliffHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CliffHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDAOStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDAOStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDAOStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DAOStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAOStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAOStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreateHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CreateHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CliffHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CliffHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Released.Size()
+		i -= size
+		if _, err := m.Released.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgChangeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamVal)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovGov(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovGov(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgDAOTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Upgrade.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.OldUpgradeHeight != 0 {
+		n += 1 + sovGov(uint64(m.OldUpgradeHeight))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ACLPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Content.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovGov(uint64(m.ProposalID))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Option)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamVal)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Upgrade.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.DaoAction)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Content.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.SubmitHeight != 0 {
+		n += 1 + sovGov(uint64(m.SubmitHeight))
+	}
+	if m.VotingEndHeight != 0 {
+		n += 1 + sovGov(uint64(m.VotingEndHeight))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.FinalTally.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovGov(uint64(m.ProposalID))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Option)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Yes.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.No.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Abstain.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.TotalStaked.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *PendingParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamVal)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovGov(uint64(m.SubmitHeight))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovGov(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgCreateMultisigOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, b := range m.Members {
+			l = len(b)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovGov(uint64(m.Threshold))
+	}
+	if m.ExpiryBlocks != 0 {
+		n += 1 + sovGov(uint64(m.ExpiryBlocks))
+	}
+	return n
+}
+
+func (m *MsgApproveParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ApprovalID != 0 {
+		n += 1 + sovGov(uint64(m.ApprovalID))
+	}
+	return n
+}
+
+func (m *MultisigOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, b := range m.Members {
+			l = len(b)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovGov(uint64(m.Threshold))
+	}
+	if m.ExpiryBlocks != 0 {
+		n += 1 + sovGov(uint64(m.ExpiryBlocks))
+	}
+	return n
+}
+
+func (m *ParamApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamVal)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovGov(uint64(m.ActivationHeight))
+	}
+	if len(m.Approvals) > 0 {
+		for _, b := range m.Approvals {
+			l = len(b)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovGov(uint64(m.SubmitHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGov(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgCreateDAOStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovGov(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGov(uint64(m.EndHeight))
+	}
+	if m.CliffHeight != 0 {
+		n += 1 + sovGov(uint64(m.CliffHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelDAOStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.StreamID != 0 {
+		n += 1 + sovGov(uint64(m.StreamID))
+	}
+	return n
+}
+
+func (m *DAOStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Released.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovGov(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGov(uint64(m.EndHeight))
+	}
+	if m.CliffHeight != 0 {
+		n += 1 + sovGov(uint64(m.CliffHeight))
+	}
+	if m.CreateHeight != 0 {
+		n += 1 + sovGov(uint64(m.CreateHeight))
+	}
+	return n
+}
+
+func (m *MsgSignalReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpgradeSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
 			l = len(s)
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamVal = append(m.ParamVal[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParamVal == nil {
+				m.ParamVal = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDAOTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDAOTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldUpgradeHeight", wireType)
+			}
+			m.OldUpgradeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldUpgradeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ACLPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ACLPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ACLPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
+			if m.Addr == nil {
+				m.Addr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Option = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamVal = append(m.ParamVal[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParamVal == nil {
+				m.ParamVal = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndHeight", wireType)
+			}
+			m.VotingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Option = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamVal = append(m.ParamVal[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParamVal == nil {
+				m.ParamVal = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMultisigOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMultisigOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMultisigOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, make([]byte, postIndex-iNdEx))
+			copy(m.Members[len(m.Members)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlocks", wireType)
+			}
+			m.ExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalID", wireType)
+			}
+			m.ApprovalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, make([]byte, postIndex-iNdEx))
+			copy(m.Members[len(m.Members)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlocks", wireType)
+			}
+			m.ExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamVal = append(m.ParamVal[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParamVal == nil {
+				m.ParamVal = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, make([]byte, postIndex-iNdEx))
+			copy(m.Approvals[len(m.Approvals)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ACLPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDAOStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -744,10 +6438,10 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDAOStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDAOStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -786,9 +6480,9 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -798,29 +6492,31 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -830,26 +6526,83 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParamVal = append(m.ParamVal[:0], dAtA[iNdEx:postIndex]...)
-			if m.ParamVal == nil {
-				m.ParamVal = []byte{}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffHeight", wireType)
+			}
+			m.CliffHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -874,7 +6627,7 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDAOStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -897,10 +6650,10 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDAOTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDAOStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDAOTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDAOStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -936,10 +6689,101 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 			if m.FromAddress == nil {
 				m.FromAddress = []byte{}
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAOStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAOStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAOStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -966,14 +6810,14 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ToAddress == nil {
-				m.ToAddress = []byte{}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1001,13 +6845,13 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1035,66 +6879,15 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Released.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpgrade: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var byteLen int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1104,31 +6897,16 @@ func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			var msglen int
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1138,25 +6916,49 @@ func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffHeight", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			m.CliffHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateHeight", wireType)
 			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CreateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1181,7 +6983,7 @@ func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Upgrade) Unmarshal(dAtA []byte) error {
+func (m *MsgSignalReadiness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1204,17 +7006,17 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignalReadiness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignalReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1224,16 +7026,31 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1243,29 +7060,31 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldUpgradeHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			m.OldUpgradeHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1275,11 +7094,24 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OldUpgradeHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
@@ -1336,7 +7168,7 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ACLPair) Unmarshal(dAtA []byte) error {
+func (m *UpgradeSignal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1359,15 +7191,49 @@ func (m *ACLPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ACLPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeSignal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ACLPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1395,13 +7261,13 @@ func (m *ACLPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1411,26 +7277,43 @@ func (m *ACLPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
-			if m.Addr == nil {
-				m.Addr = []byte{}
-			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
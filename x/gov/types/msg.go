@@ -7,14 +7,30 @@ import (
 // ensure ProtoMsg interface compliance at compile time
 var (
 	_ sdk.ProtoMsg = &MsgChangeParam{}
+	_ sdk.ProtoMsg = &MsgCancelParamChange{}
+	_ sdk.ProtoMsg = &MsgCreateMultisigOwner{}
+	_ sdk.ProtoMsg = &MsgApproveParamChange{}
+	_ sdk.ProtoMsg = &MsgCreateDAOStream{}
+	_ sdk.ProtoMsg = &MsgCancelDAOStream{}
+	_ sdk.ProtoMsg = &MsgSignalReadiness{}
 	_ sdk.ProtoMsg = &MsgDAOTransfer{}
 	_ sdk.ProtoMsg = &MsgUpgrade{}
+	_ sdk.ProtoMsg = &MsgSubmitProposal{}
+	_ sdk.ProtoMsg = &MsgVote{}
 )
 
 const (
-	MsgDAOTransferName = "dao_tranfer"
-	MsgChangeParamName = "change_param"
-	MsgUpgradeName     = "upgrade"
+	MsgDAOTransferName    = "dao_tranfer"
+	MsgChangeParamName    = "change_param"
+	MsgCancelParamName    = "cancel_param_change"
+	MsgMultisigOwnerName  = "create_multisig_owner"
+	MsgApproveParamName   = "approve_param_change"
+	MsgCreateStreamName   = "create_dao_stream"
+	MsgCancelStreamName   = "cancel_dao_stream"
+	MsgSignalName         = "signal_readiness"
+	MsgUpgradeName        = "upgrade"
+	MsgSubmitProposalName = "submit_proposal"
+	MsgVoteName           = "vote"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -23,6 +39,7 @@ const (
 // 	FromAddress sdk.Address `json:"address"`
 // 	ParamKey    string      `json:"param_key"`
 // 	ParamVal    []byte      `json:"param_value"`
+// 	ActivationHeight int64  `json:"activation_height,omitempty"`
 // }
 
 // Route provides router key for msg
@@ -63,6 +80,59 @@ func (msg MsgChangeParam) ValidateBasic() sdk.Error {
 	if msg.ParamVal == nil {
 		return ErrEmptyValue(ModuleName)
 	}
+	if msg.ActivationHeight < 0 {
+		return ErrInvalidActivationHeight(ModuleName, msg.ActivationHeight)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgCancelParamChange structure for cancelling a scheduled param change
+// type MsgCancelParamChange struct {
+// 	FromAddress      sdk.Address `json:"address"`
+// 	ParamKey         string      `json:"param_key"`
+// 	ActivationHeight int64       `json:"activation_height"`
+// }
+
+// Route provides router key for msg
+func (msg MsgCancelParamChange) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgCancelParamChange) Type() string { return MsgCancelParamName }
+
+// GetFee get fee for msg
+func (msg MsgCancelParamChange) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCancelParamChange) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCancelParamChange) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelParamChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgCancelParamChange) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil address")
+	}
+	if msg.ParamKey == "" {
+		return ErrEmptyKey(ModuleName)
+	}
+	if msg.ActivationHeight <= 0 {
+		return ErrInvalidActivationHeight(ModuleName, msg.ActivationHeight)
+	}
 	return nil
 }
 
@@ -169,3 +239,333 @@ func (msg MsgUpgrade) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgSubmitProposal structure for proposing a governance action to the staked validators
+// type MsgSubmitProposal struct {
+// 	Proposer sdk.Address     `json:"proposer"`
+// 	Content  ProposalContent `json:"content"`
+// 	Deposit  sdk.BigInt      `json:"deposit"`
+// }
+
+// Route provides router key for msg
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgSubmitProposal) Type() string { return MsgSubmitProposalName }
+
+// GetFee get fee for msg
+func (msg MsgSubmitProposal) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSubmitProposal) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Proposer}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSubmitProposal) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer == nil {
+		return sdk.ErrInvalidAddress("nil proposer address")
+	}
+	if msg.Deposit.IsZero() || msg.Deposit.IsNegative() {
+		return ErrInsufficientDeposit(ModuleName, sdk.OneInt())
+	}
+	return msg.Content.ValidateBasic()
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgVote structure for a staked validator voting on a proposal
+// type MsgVote struct {
+// 	ProposalID       uint64      `json:"proposal_id"`
+// 	ValidatorAddress sdk.Address `json:"validator_address"`
+// 	Signer           sdk.Address `json:"signer_address"`
+// 	Option           string      `json:"option"`
+// }
+
+// Route provides router key for msg
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgVote) Type() string { return MsgVoteName }
+
+// GetFee get fee for msg
+func (msg MsgVote) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgVote) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Signer}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgVote) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgVote) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress == nil {
+		return sdk.ErrInvalidAddress("nil validator address")
+	}
+	if msg.Signer == nil {
+		return sdk.ErrInvalidAddress("nil signer address")
+	}
+	if _, err := VoteOptionFromString(msg.Option); err != nil {
+		return err
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgCreateMultisigOwner structure for registering an M-of-N acl owner
+// type MsgCreateMultisigOwner struct {
+// 	FromAddress  sdk.Address   `json:"address"`
+// 	Members      []sdk.Address `json:"members"`
+// 	Threshold    int64         `json:"threshold"`
+// 	ExpiryBlocks int64         `json:"expiry_blocks"`
+// }
+
+// Route provides router key for msg
+func (msg MsgCreateMultisigOwner) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgCreateMultisigOwner) Type() string { return MsgMultisigOwnerName }
+
+// GetFee get fee for msg
+func (msg MsgCreateMultisigOwner) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCreateMultisigOwner) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCreateMultisigOwner) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateMultisigOwner) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgCreateMultisigOwner) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil address")
+	}
+	return ValidateMultisigOwner(msg.Members, msg.Threshold, msg.ExpiryBlocks)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgApproveParamChange structure for approving a param change of a multisig owner
+// type MsgApproveParamChange struct {
+// 	FromAddress sdk.Address `json:"address"`
+// 	ApprovalID  uint64      `json:"approval_id"`
+// }
+
+// Route provides router key for msg
+func (msg MsgApproveParamChange) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgApproveParamChange) Type() string { return MsgApproveParamName }
+
+// GetFee get fee for msg
+func (msg MsgApproveParamChange) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgApproveParamChange) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgApproveParamChange) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgApproveParamChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgApproveParamChange) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil address")
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgCreateDAOStream structure for paying a recipient from the dao over a range of heights
+// type MsgCreateDAOStream struct {
+// 	FromAddress sdk.Address `json:"from_address"`
+// 	ToAddress   sdk.Address `json:"to_address"`
+// 	Total       sdk.BigInt  `json:"total"`
+// 	StartHeight int64       `json:"start_height"`
+// 	EndHeight   int64       `json:"end_height"`
+// 	CliffHeight int64       `json:"cliff_height,omitempty"`
+// }
+
+// Route provides router key for msg
+func (msg MsgCreateDAOStream) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgCreateDAOStream) Type() string { return MsgCreateStreamName }
+
+// GetFee get fee for msg
+func (msg MsgCreateDAOStream) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCreateDAOStream) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCreateDAOStream) GetRecipient() sdk.Address {
+	return msg.ToAddress
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateDAOStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgCreateDAOStream) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil from address")
+	}
+	if msg.ToAddress == nil {
+		return sdk.ErrInvalidAddress("nil to address")
+	}
+	return ValidateDAOStream(msg.Total, msg.StartHeight, msg.EndHeight, msg.CliffHeight)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgCancelDAOStream structure for cancelling a dao stream
+// type MsgCancelDAOStream struct {
+// 	FromAddress sdk.Address `json:"from_address"`
+// 	StreamID    uint64      `json:"stream_id"`
+// }
+
+// Route provides router key for msg
+func (msg MsgCancelDAOStream) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgCancelDAOStream) Type() string { return MsgCancelStreamName }
+
+// GetFee get fee for msg
+func (msg MsgCancelDAOStream) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCancelDAOStream) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCancelDAOStream) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelDAOStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgCancelDAOStream) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil address")
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgSignalReadiness structure for a validator to name the versions and features its binary supports
+// type MsgSignalReadiness struct {
+// 	ValidatorAddress sdk.Address `json:"validator_address"`
+// 	Signer           sdk.Address `json:"signer_address"`
+// 	Versions         []string    `json:"versions"`
+// 	Features         []string    `json:"features"`
+// }
+
+// Route provides router key for msg
+func (msg MsgSignalReadiness) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgSignalReadiness) Type() string { return MsgSignalName }
+
+// GetFee get fee for msg
+func (msg MsgSignalReadiness) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSignalReadiness) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Signer}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSignalReadiness) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSignalReadiness) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgSignalReadiness) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress == nil {
+		return sdk.ErrInvalidAddress("nil validator address")
+	}
+	if msg.Signer == nil {
+		return sdk.ErrInvalidAddress("nil signer address")
+	}
+	if err := ValidateSignalEntries(msg.Versions); err != nil {
+		return err
+	}
+	return ValidateSignalEntries(msg.Features)
+}
@@ -32,6 +32,30 @@ func TestMsgChangeParam_ValidateBasic(t *testing.T) {
 		ParamKey:    "bank/sendenabled",
 	}
 	assert.NotNil(t, m.ValidateBasic())
+	m = MsgChangeParam{
+		FromAddress:      getRandomValidatorAddress(),
+		ParamKey:         "bank/sendenabled",
+		ParamVal:         bytes,
+		ActivationHeight: -1,
+	}
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgCancelParamChange_ValidateBasic(t *testing.T) {
+	m := MsgCancelParamChange{
+		FromAddress:      getRandomValidatorAddress(),
+		ParamKey:         "bank/sendenabled",
+		ActivationHeight: 10,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.ActivationHeight = 0
+	assert.NotNil(t, m.ValidateBasic())
+	m.ActivationHeight = 10
+	m.ParamKey = ""
+	assert.NotNil(t, m.ValidateBasic())
+	m.ParamKey = "bank/sendenabled"
+	m.FromAddress = nil
+	assert.NotNil(t, m.ValidateBasic())
 }
 
 func TestAminoPrimitive(t *testing.T) {
@@ -110,3 +134,120 @@ func TestMsgUpgrade_ValidateBasic(t *testing.T) {
 	}
 	assert.NotNil(t, m.ValidateBasic())
 }
+
+func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
+	cdc := makeTestCodec()
+	bytes, _ := cdc.MarshalJSON(int64(100))
+	m := MsgSubmitProposal{
+		Proposer: getRandomValidatorAddress(),
+		Content: ProposalContent{
+			Type:     ParamChangeProposalType,
+			ParamKey: "gov/votingPeriod",
+			ParamVal: bytes,
+		},
+		Deposit: types.OneInt(),
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Deposit = types.ZeroInt()
+	assert.NotNil(t, m.ValidateBasic())
+	m.Deposit = types.OneInt()
+	m.Proposer = nil
+	assert.NotNil(t, m.ValidateBasic())
+	m.Proposer = getRandomValidatorAddress()
+	m.Content.Type = "not_a_proposal"
+	assert.NotNil(t, m.ValidateBasic())
+	m.Content = ProposalContent{
+		Type:    UpgradeProposalType,
+		Upgrade: NewUpgrade(1000, "2.0.0"),
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Content.Upgrade = NewUpgrade(0, "2.0.0")
+	assert.NotNil(t, m.ValidateBasic())
+	m.Content = ProposalContent{
+		Type:      DAOTransferProposalType,
+		DaoAction: DAOTransferString,
+		ToAddress: getRandomValidatorAddress(),
+		Amount:    types.OneInt(),
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Content.ToAddress = nil
+	assert.NotNil(t, m.ValidateBasic())
+	m.Content.DaoAction = DAOBurnString
+	assert.Nil(t, m.ValidateBasic())
+	m.Content.Amount = types.ZeroInt()
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgVote_ValidateBasic(t *testing.T) {
+	m := MsgVote{
+		ProposalID:       1,
+		ValidatorAddress: getRandomValidatorAddress(),
+		Signer:           getRandomValidatorAddress(),
+		Option:           VoteYesString,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Option = "maybe"
+	assert.NotNil(t, m.ValidateBasic())
+	m.Option = VoteAbstainString
+	m.Signer = nil
+	assert.NotNil(t, m.ValidateBasic())
+	m.Signer = getRandomValidatorAddress()
+	m.ValidatorAddress = nil
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgSignalReadiness_ValidateBasic(t *testing.T) {
+	m := MsgSignalReadiness{
+		ValidatorAddress: getRandomValidatorAddress(),
+		Signer:           getRandomValidatorAddress(),
+		Versions:         []string{"2.0.0"},
+		Features:         []string{"RSCAL"},
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Features = []string{"RSCAL:100"}
+	assert.NotNil(t, m.ValidateBasic())
+	m.Features = nil
+	m.Signer = nil
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgCreateMultisigOwner_ValidateBasic(t *testing.T) {
+	m := MsgCreateMultisigOwner{
+		FromAddress:  getRandomValidatorAddress(),
+		Members:      []types.Address{getRandomValidatorAddress(), getRandomValidatorAddress()},
+		Threshold:    2,
+		ExpiryBlocks: 10,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Threshold = 3
+	assert.NotNil(t, m.ValidateBasic())
+	m.Threshold = 2
+	m.FromAddress = nil
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgApproveParamChange_ValidateBasic(t *testing.T) {
+	m := MsgApproveParamChange{
+		FromAddress: getRandomValidatorAddress(),
+		ApprovalID:  1,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.FromAddress = nil
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgCreateDAOStream_ValidateBasic(t *testing.T) {
+	m := MsgCreateDAOStream{
+		FromAddress: getRandomValidatorAddress(),
+		ToAddress:   getRandomValidatorAddress(),
+		Total:       types.NewInt(100),
+		StartHeight: 10,
+		EndHeight:   20,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.EndHeight = 5
+	assert.NotNil(t, m.ValidateBasic())
+	m.EndHeight = 20
+	m.ToAddress = nil
+	assert.NotNil(t, m.ValidateBasic())
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	// MaxMultisigMembers is the maximum number of members of a multisig acl owner
+	MaxMultisigMembers = 20
+)
+
+// Multisig acl owner store keys
+var (
+	MultisigOwnersKey      = []byte{0x06} // prefix for each multisig owner
+	ParamApprovalIDKey     = []byte{0x07} // key for the next param approval id
+	ParamApprovalsKey      = []byte{0x08} // prefix for each param approval
+	ApprovalExpiryQueueKey = []byte{0x09} // prefix for the param approvals ordered by expiry height
+)
+
+// NewMultisigOwnerAddress - Derives the address of a multisig owner from its members, threshold and expiry
+func NewMultisigOwnerAddress(members []sdk.Address, threshold, expiryBlocks int64) sdk.Address {
+	sorted := make([]sdk.Address, len(members))
+	copy(sorted, members)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(threshold))
+	binary.BigEndian.PutUint64(bz[8:], uint64(expiryBlocks))
+	bz = append([]byte(ModuleName+"/multisig"), bz...)
+	for _, m := range sorted {
+		bz = append(bz, m...)
+	}
+	return sdk.Address(tmhash.SumTruncated(bz))
+}
+
+// ValidateMultisigOwner - stateless validation of the members, threshold and expiry of a multisig owner
+func ValidateMultisigOwner(members []sdk.Address, threshold, expiryBlocks int64) sdk.Error {
+	if len(members) == 0 || len(members) > MaxMultisigMembers {
+		return ErrInvalidMultisigOwner(ModuleName, "the number of members must be between 1 and the maximum")
+	}
+	seen := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		if err := sdk.VerifyAddressFormat(m); err != nil {
+			return ErrInvalidMultisigOwner(ModuleName, err.Error())
+		}
+		if _, found := seen[m.String()]; found {
+			return ErrInvalidMultisigOwner(ModuleName, "duplicate member "+m.String())
+		}
+		seen[m.String()] = struct{}{}
+	}
+	if threshold < 1 || threshold > int64(len(members)) {
+		return ErrInvalidMultisigOwner(ModuleName, "the threshold must be between 1 and the number of members")
+	}
+	if expiryBlocks <= 0 {
+		return ErrInvalidMultisigOwner(ModuleName, "the approval expiry must be positive")
+	}
+	return nil
+}
+
+// IsMember - Returns true if the address is one of the members of the multisig owner
+func (m MultisigOwner) IsMember(addr sdk.Address) bool {
+	return containsAddress(m.Members, addr)
+}
+
+// HasApproved - Returns true if the member already approved the change
+func (pa ParamApproval) HasApproved(addr sdk.Address) bool {
+	return containsAddress(pa.Approvals, addr)
+}
+
+func containsAddress(addrs []sdk.Address, addr sdk.Address) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyForMultisigOwner - Returns the key for a multisig owner
+func KeyForMultisigOwner(addr sdk.Address) []byte {
+	return append(append([]byte{}, MultisigOwnersKey...), addr...)
+}
+
+// KeyForParamApproval - Returns the key for a param approval
+func KeyForParamApproval(id uint64) []byte {
+	return append(append([]byte{}, ParamApprovalsKey...), proposalIDBytes(id)...)
+}
+
+// KeyForExpiringApproval - Returns the key for a param approval in the expiry queue
+func KeyForExpiringApproval(expiryHeight int64, id uint64) []byte {
+	return append(KeyForExpiringApprovals(expiryHeight), proposalIDBytes(id)...)
+}
+
+// KeyForExpiringApprovals - Returns the prefix of the param approvals expiring at the height
+func KeyForExpiringApprovals(expiryHeight int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(expiryHeight))
+	return append(append([]byte{}, ApprovalExpiryQueueKey...), bz...)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMultisigOwnerAddress(t *testing.T) {
+	a, b := getRandomValidatorAddress(), getRandomValidatorAddress()
+	addr := NewMultisigOwnerAddress([]sdk.Address{a, b}, 2, 10)
+	// the order of the members does not change the address
+	assert.Equal(t, addr, NewMultisigOwnerAddress([]sdk.Address{b, a}, 2, 10))
+	assert.NotEqual(t, addr, NewMultisigOwnerAddress([]sdk.Address{a, b}, 1, 10))
+	assert.NotEqual(t, addr, NewMultisigOwnerAddress([]sdk.Address{a, b}, 2, 20))
+	assert.Nil(t, sdk.VerifyAddressFormat(addr))
+}
+
+func TestValidateMultisigOwner(t *testing.T) {
+	a, b := getRandomValidatorAddress(), getRandomValidatorAddress()
+	assert.Nil(t, ValidateMultisigOwner([]sdk.Address{a, b}, 2, 10))
+	assert.NotNil(t, ValidateMultisigOwner(nil, 1, 10))
+	assert.NotNil(t, ValidateMultisigOwner([]sdk.Address{a, a}, 1, 10))
+	assert.NotNil(t, ValidateMultisigOwner([]sdk.Address{a, b}, 0, 10))
+	assert.NotNil(t, ValidateMultisigOwner([]sdk.Address{a, b}, 3, 10))
+	assert.NotNil(t, ValidateMultisigOwner([]sdk.Address{a, b}, 2, 0))
+}
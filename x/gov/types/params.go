@@ -12,13 +12,24 @@ import (
 const DefaultParamspace = ModuleName
 
 // Default parameter values
-const ()
+const (
+	DefaultVotingPeriod      int64 = 672 // 7 days of 15 minute blocks
+	DefaultProposalQuorum    int64 = 33
+	DefaultProposalThreshold int64 = 50
+)
+
+var DefaultMinProposalDeposit = sdk.NewInt(1000000000)
 
 // Parameter keys
 var (
 	ACLKey      = []byte("acl")
 	DAOOwnerKey = []byte("daoOwner")
 	UpgradeKey  = []byte("upgrade")
+
+	VotingPeriodKey       = []byte("votingPeriod")
+	MinProposalDepositKey = []byte("minProposalDeposit")
+	ProposalQuorumKey     = []byte("proposalQuorum")
+	ProposalThresholdKey  = []byte("proposalThreshold")
 )
 
 var _ sdk.ParamSet = (*Params)(nil)
@@ -28,6 +39,11 @@ type Params struct {
 	ACL      ACL         `json:"acl"`
 	DAOOwner sdk.Address `json:"dao_owner"`
 	Upgrade  Upgrade     `json:"upgrade"`
+
+	VotingPeriod       int64      `json:"voting_period"`        // number of blocks a proposal is open for voting
+	MinProposalDeposit sdk.BigInt `json:"min_proposal_deposit"` // minimum deposit to submit a proposal
+	ProposalQuorum     int64      `json:"proposal_quorum"`      // percentage of the staked tokens that must vote
+	ProposalThreshold  int64      `json:"proposal_threshold"`   // percentage of the yes/no votes that must be yes
 }
 
 // NewParams creates a new Params object
@@ -51,9 +67,24 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: ACLKey, Value: &p.ACL},
 		{Key: DAOOwnerKey, Value: &p.DAOOwner},
 		{Key: UpgradeKey, Value: &p.Upgrade},
+		{Key: VotingPeriodKey, Value: &p.VotingPeriod},
+		{Key: MinProposalDepositKey, Value: &p.MinProposalDeposit},
+		{Key: ProposalQuorumKey, Value: &p.ProposalQuorum},
+		{Key: ProposalThresholdKey, Value: &p.ProposalThreshold},
 	}
 }
 
+// ValidateProposalPercentages - Ensures the proposal quorum and threshold are percentages above zero
+func (p Params) ValidateProposalPercentages() sdk.Error {
+	if p.ProposalQuorum <= 0 || p.ProposalQuorum > 100 {
+		return ErrInvalidProposalPercentage(ModuleName, string(ProposalQuorumKey), p.ProposalQuorum)
+	}
+	if p.ProposalThreshold <= 0 || p.ProposalThreshold > 100 {
+		return ErrInvalidProposalPercentage(ModuleName, string(ProposalThresholdKey), p.ProposalThreshold)
+	}
+	return nil
+}
+
 // Equal returns a boolean determining if two Params types are identical.
 func (p Params) Equal(p2 Params) bool {
 	return reflect.DeepEqual(p, p2)
@@ -67,6 +98,11 @@ func DefaultParams() Params {
 		ACL:      acl,
 		DAOOwner: sdk.Address{},
 		Upgrade:  u,
+
+		VotingPeriod:       DefaultVotingPeriod,
+		MinProposalDeposit: DefaultMinProposalDeposit,
+		ProposalQuorum:     DefaultProposalQuorum,
+		ProposalThreshold:  DefaultProposalThreshold,
 	}
 }
 
@@ -77,5 +113,9 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("ACLKey: %v\n", p.ACL))
 	sb.WriteString(fmt.Sprintf("DAOOwnerKey: %s\n", p.DAOOwner))
 	sb.WriteString(fmt.Sprintf("UpgradeKey: %v\n", p.Upgrade))
+	sb.WriteString(fmt.Sprintf("VotingPeriod: %d\n", p.VotingPeriod))
+	sb.WriteString(fmt.Sprintf("MinProposalDeposit: %s\n", p.MinProposalDeposit))
+	sb.WriteString(fmt.Sprintf("ProposalQuorum: %d\n", p.ProposalQuorum))
+	sb.WriteString(fmt.Sprintf("ProposalThreshold: %d\n", p.ProposalThreshold))
 	return sb.String()
 }
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+// PendingParamsKey is the prefix for the param changes waiting for their activation height
+var PendingParamsKey = []byte{0x05}
+
+// KeyForPendingParamChange - Returns the key for a param change scheduled at the height
+func KeyForPendingParamChange(activationHeight int64, aclKey string) []byte {
+	return append(KeyForPendingParamChanges(activationHeight), []byte(aclKey)...)
+}
+
+// KeyForPendingParamChanges - Returns the prefix of the param changes scheduled at the height
+func KeyForPendingParamChanges(activationHeight int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(activationHeight))
+	return append(append([]byte{}, PendingParamsKey...), bz...)
+}
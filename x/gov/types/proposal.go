@@ -0,0 +1,190 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	ParamChangeProposalType = "param_change"
+	UpgradeProposalType     = "upgrade"
+	DAOTransferProposalType = "dao_transfer"
+
+	StatusVotingPeriod = "voting_period"
+	StatusPassed       = "passed"
+	StatusRejected     = "rejected"
+	StatusFailed       = "failed"
+)
+
+const (
+	VoteYesString     = "yes"
+	VoteNoString      = "no"
+	VoteAbstainString = "abstain"
+)
+
+const (
+	VoteYes VoteOption = iota + 1
+	VoteNo
+	VoteAbstain
+)
+
+// Proposal store keys
+var (
+	ProposalIDKey          = []byte{0x01} // key for the next proposal id
+	ProposalsKey           = []byte{0x02} // prefix for each proposal
+	VotesKey               = []byte{0x03} // prefix for each vote
+	ActiveProposalQueueKey = []byte{0x04} // prefix for the proposals in the voting period ordered by end height
+)
+
+type VoteOption int
+
+func (vo VoteOption) String() string {
+	switch vo {
+	case VoteYes:
+		return VoteYesString
+	case VoteNo:
+		return VoteNoString
+	case VoteAbstain:
+		return VoteAbstainString
+	}
+	return ""
+}
+
+func VoteOptionFromString(s string) (VoteOption, sdk.Error) {
+	switch s {
+	case VoteYesString:
+		return VoteYes, nil
+	case VoteNoString:
+		return VoteNo, nil
+	case VoteAbstainString:
+		return VoteAbstain, nil
+	default:
+		return 0, ErrUnrecognizedVoteOption(ModuleName, s)
+	}
+}
+
+// ValidateBasic - stateless validation of the proposal content
+func (pc ProposalContent) ValidateBasic() sdk.Error {
+	switch pc.Type {
+	case ParamChangeProposalType:
+		if pc.ParamKey == "" {
+			return ErrEmptyKey(ModuleName)
+		}
+		if pc.ParamVal == nil {
+			return ErrEmptyValue(ModuleName)
+		}
+	case UpgradeProposalType:
+		if pc.Upgrade.UpgradeHeight() == 0 {
+			return ErrZeroHeightUpgrade(ModuleName)
+		}
+		if pc.Upgrade.UpgradeVersion() == "" {
+			return ErrZeroHeightUpgrade(ModuleName)
+		}
+	case DAOTransferProposalType:
+		if pc.Amount.IsZero() || pc.Amount.IsNegative() {
+			return ErrZeroValueDAOAction(ModuleName)
+		}
+		daoAction, err := DAOActionFromString(pc.DaoAction)
+		if err != nil {
+			return err
+		}
+		if daoAction == DAOTransfer && pc.ToAddress == nil {
+			return sdk.ErrInvalidAddress("nil to address")
+		}
+	default:
+		return ErrUnrecognizedProposalType(ModuleName, pc.Type)
+	}
+	return nil
+}
+
+// IsActive - Returns true if the proposal is still in its voting period
+func (p Proposal) IsActive() bool {
+	return p.Status == StatusVotingPeriod
+}
+
+// NewTallyResult - Returns a zero tally of the staked tokens
+func NewTallyResult(totalStaked sdk.BigInt) TallyResult {
+	return TallyResult{
+		Yes:         sdk.ZeroInt(),
+		No:          sdk.ZeroInt(),
+		Abstain:     sdk.ZeroInt(),
+		TotalStaked: totalStaked,
+	}
+}
+
+// AddVote - Adds the voting power to the option of the tally
+func (tr TallyResult) AddVote(option VoteOption, power sdk.BigInt) TallyResult {
+	switch option {
+	case VoteYes:
+		tr.Yes = tr.Yes.Add(power)
+	case VoteNo:
+		tr.No = tr.No.Add(power)
+	case VoteAbstain:
+		tr.Abstain = tr.Abstain.Add(power)
+	}
+	return tr
+}
+
+// HasQuorum - Returns true if the voting power meets the quorum percentage of the staked tokens
+func (tr TallyResult) HasQuorum(quorum int64) bool {
+	if !tr.TotalStaked.IsPositive() {
+		return false
+	}
+	voted := tr.Yes.Add(tr.No).Add(tr.Abstain)
+	return voted.Mul(sdk.NewInt(100)).GTE(tr.TotalStaked.Mul(sdk.NewInt(quorum)))
+}
+
+// Passes - Returns true if the quorum is met and the yes votes exceed the threshold percentage of the non abstaining votes
+func (tr TallyResult) Passes(quorum, threshold int64) bool {
+	if !tr.HasQuorum(quorum) {
+		return false
+	}
+	decisive := tr.Yes.Add(tr.No)
+	if !decisive.IsPositive() {
+		return false
+	}
+	return tr.Yes.Mul(sdk.NewInt(100)).GT(decisive.Mul(sdk.NewInt(threshold)))
+}
+
+// KeyForProposal - Returns the key for a proposal
+func KeyForProposal(id uint64) []byte {
+	return append(ProposalsKey, proposalIDBytes(id)...)
+}
+
+// KeyForVotes - Returns the prefix for the votes of a proposal
+func KeyForVotes(id uint64) []byte {
+	return append(VotesKey, proposalIDBytes(id)...)
+}
+
+// KeyForVote - Returns the key for the vote of a validator on a proposal
+func KeyForVote(id uint64, validator sdk.Address) []byte {
+	return append(KeyForVotes(id), validator...)
+}
+
+// KeyForActiveProposal - Returns the key for a proposal in the active proposal queue
+func KeyForActiveProposal(endHeight int64, id uint64) []byte {
+	return append(KeyForActiveProposals(endHeight), proposalIDBytes(id)...)
+}
+
+// KeyForActiveProposals - Returns the prefix of the active proposals ending at the height
+func KeyForActiveProposals(endHeight int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(endHeight))
+	return append(append([]byte{}, ActiveProposalQueueKey...), bz...)
+}
+
+// ProposalIDFromBytes - Decodes a proposal id from the store
+func ProposalIDFromBytes(bz []byte) (uint64, error) {
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid proposal id length: %d", len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func proposalIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTallyResult_Passes(t *testing.T) {
+	tests := []struct {
+		name         string
+		yes, no, abs int64
+		hasQuorum    bool
+		passes       bool
+	}{
+		{"no votes", 0, 0, 0, false, false},
+		{"below quorum", 20, 0, 0, false, false},
+		{"at quorum", 33, 0, 0, true, true},
+		{"abstain counts for quorum only", 10, 5, 30, true, true},
+		{"tie does not pass", 20, 20, 0, true, false},
+		{"only abstain", 0, 0, 50, true, false},
+		{"no majority", 20, 40, 0, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tally := NewTallyResult(sdk.NewInt(100))
+			tally = tally.AddVote(VoteYes, sdk.NewInt(tt.yes))
+			tally = tally.AddVote(VoteNo, sdk.NewInt(tt.no))
+			tally = tally.AddVote(VoteAbstain, sdk.NewInt(tt.abs))
+			assert.Equal(t, tt.hasQuorum, tally.HasQuorum(DefaultProposalQuorum))
+			assert.Equal(t, tt.passes, tally.Passes(DefaultProposalQuorum, DefaultProposalThreshold))
+		})
+	}
+}
+
+func TestVoteOptionFromString(t *testing.T) {
+	assert.Equal(t, []VoteOption{1, 2, 3}, []VoteOption{VoteYes, VoteNo, VoteAbstain})
+	for _, option := range []VoteOption{VoteYes, VoteNo, VoteAbstain} {
+		o, err := VoteOptionFromString(option.String())
+		assert.Nil(t, err)
+		assert.Equal(t, option, o)
+	}
+	_, err := VoteOptionFromString("maybe")
+	assert.NotNil(t, err)
+}
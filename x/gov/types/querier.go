@@ -6,16 +6,25 @@ import (
 
 // query endpoints supported by the staking Querier
 const (
-	ModuleName                         = "gov"           // ModuleKey defines the name of the module
-	RouterKey                          = ModuleName      // RouterKey defines the routing key for a Parameter Change
-	StoreKey                           = "gov"           // StoreKey is the string store key for the param store
-	TStoreKey                          = "transient_gov" // TStoreKey is the string store key for the param transient store
-	DefaultCodespace sdk.CodespaceType = ModuleName      // default codespace for governance errors
-	QuerierRoute                       = ModuleName      // QuerierRoute is the querier route for the staking module
-	QueryACL                           = "acl"
-	QueryDAO                           = "dao"
-	QueryUpgrade                       = "upgrade"
-	QueryDAOOwner                      = "daoOwner"
+	ModuleName                              = "gov"           // ModuleKey defines the name of the module
+	RouterKey                               = ModuleName      // RouterKey defines the routing key for a Parameter Change
+	StoreKey                                = "gov"           // StoreKey is the string store key for the param store
+	TStoreKey                               = "transient_gov" // TStoreKey is the string store key for the param transient store
+	DefaultCodespace      sdk.CodespaceType = ModuleName      // default codespace for governance errors
+	QuerierRoute                            = ModuleName      // QuerierRoute is the querier route for the staking module
+	QueryACL                                = "acl"
+	QueryDAO                                = "dao"
+	QueryUpgrade                            = "upgrade"
+	QueryDAOOwner                           = "daoOwner"
+	QueryProposals                          = "proposals"
+	QueryProposal                           = "proposal"
+	QueryTally                              = "tally"
+	QueryPendingParams                      = "pendingParams"
+	QueryMultisigOwners                     = "multisigOwners"
+	QueryParamApprovals                     = "paramApprovals"
+	QueryDAOStreams                         = "daoStreams"
+	QueryUpgradeReadiness                   = "upgradeReadiness"
+	QueryFeatures                           = "features"
 )
 
 type QueryACLParams struct{}
@@ -23,3 +32,7 @@ type QueryACLParams struct{}
 type QueryDAOParams struct{}
 
 type QueryUpgradeParams struct{}
+
+type QueryProposalParams struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	// MaxSignalEntries is the maximum number of versions or feature keys in a readiness signal
+	MaxSignalEntries = 32
+	// MaxSignalEntryLength is the maximum length of a version or feature key in a readiness signal
+	MaxSignalEntryLength = 64
+)
+
+// UpgradeSignalsKey is the prefix for the latest readiness signal of each validator
+var UpgradeSignalsKey = []byte{0x0C}
+
+// UpgradeReadiness is the stake weighted readiness of the validators for the pending upgrade
+type UpgradeReadiness struct {
+	Version             string     `json:"version"`
+	Height              int64      `json:"height"`
+	Features            []string   `json:"features"`
+	ReadyStake          sdk.BigInt `json:"ready_stake"`
+	TotalStake          sdk.BigInt `json:"total_stake"`
+	ReadyValidators     int64      `json:"ready_validators"`
+	SignalledValidators int64      `json:"signalled_validators"`
+}
+
+// PendingUpgrade - Returns the version and feature keys of the upgrade not yet activated at the height
+func PendingUpgrade(u Upgrade, height int64) (version string, features []string) {
+	if u.Height > height {
+		version = u.Version
+	}
+	features = make([]string, 0)
+	for _, f := range u.Features {
+		kv := strings.Split(f, ":")
+		if len(kv) != 2 {
+			continue
+		}
+		activation, err := strconv.ParseInt(kv[1], 10, 64)
+		if err != nil || activation <= height {
+			continue
+		}
+		features = append(features, kv[0])
+	}
+	sort.Strings(features)
+	return
+}
+
+// ValidateSignalEntries - stateless validation of the versions or feature keys of a readiness signal
+func ValidateSignalEntries(entries []string) sdk.Error {
+	if len(entries) > MaxSignalEntries {
+		return ErrInvalidSignal(ModuleName, "too many entries")
+	}
+	for _, e := range entries {
+		if e == "" || len(e) > MaxSignalEntryLength || strings.Contains(e, ":") {
+			return ErrInvalidSignal(ModuleName, "invalid entry "+e)
+		}
+	}
+	return nil
+}
+
+// Supports - Returns true if the signal names the version, if any, and every feature key
+func (s UpgradeSignal) Supports(version string, features []string) bool {
+	if version != "" && !containsString(s.Versions, version) {
+		return false
+	}
+	for _, f := range features {
+		if !containsString(s.Features, f) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(arr []string, s string) bool {
+	for _, a := range arr {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyForUpgradeSignal - Returns the key for the readiness signal of a validator
+func KeyForUpgradeSignal(validator sdk.Address) []byte {
+	return append(append([]byte{}, UpgradeSignalsKey...), validator...)
+}
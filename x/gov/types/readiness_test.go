@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingUpgrade(t *testing.T) {
+	u := NewUpgrade(100, "2.0.0")
+	u.Features = []string{"VEDIT:200", "RSCAL:50", "BLOCK:150", "malformed"}
+	version, features := PendingUpgrade(u, 99)
+	assert.Equal(t, "2.0.0", version)
+	assert.Equal(t, []string{"BLOCK", "VEDIT"}, features)
+	version, features = PendingUpgrade(u, 150)
+	assert.Equal(t, "", version)
+	assert.Equal(t, []string{"VEDIT"}, features)
+}
+
+func TestUpgradeSignal_Supports(t *testing.T) {
+	s := UpgradeSignal{Versions: []string{"2.0.0"}, Features: []string{"RSCAL", "VEDIT"}}
+	assert.True(t, s.Supports("2.0.0", []string{"RSCAL"}))
+	assert.True(t, s.Supports("", nil))
+	assert.False(t, s.Supports("2.0.1", nil))
+	assert.False(t, s.Supports("2.0.0", []string{"BLOCK"}))
+}
+
+func TestValidateSignalEntries(t *testing.T) {
+	assert.Nil(t, ValidateSignalEntries([]string{"2.0.0", "RSCAL"}))
+	assert.NotNil(t, ValidateSignalEntries([]string{""}))
+	assert.NotNil(t, ValidateSignalEntries([]string{"RSCAL:100"}))
+	assert.NotNil(t, ValidateSignalEntries(make([]string, MaxSignalEntries+1)))
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// DAO stream store keys
+var (
+	DAOStreamIDKey = []byte{0x0A} // key for the next dao stream id
+	DAOStreamsKey  = []byte{0x0B} // prefix for each dao stream
+)
+
+// ValidateDAOStream - stateless validation of the schedule of a dao stream
+func ValidateDAOStream(total sdk.BigInt, startHeight, endHeight, cliffHeight int64) sdk.Error {
+	if total.IsZero() || total.IsNegative() {
+		return ErrZeroValueDAOAction(ModuleName)
+	}
+	if startHeight <= 0 || endHeight <= startHeight {
+		return ErrInvalidDAOStream(ModuleName, "the end height must be after the start height")
+	}
+	if cliffHeight != 0 && (cliffHeight < startHeight || cliffHeight > endHeight) {
+		return ErrInvalidDAOStream(ModuleName, "the cliff height must be between the start and end heights")
+	}
+	return nil
+}
+
+// VestedAt - Returns the amount of the stream vested at the height;
+// nothing vests before the cliff, then the total vests linearly from the start to the end height
+func (s DAOStream) VestedAt(height int64) sdk.BigInt {
+	if height < s.StartHeight || height < s.CliffHeight {
+		return sdk.ZeroInt()
+	}
+	if height >= s.EndHeight {
+		return s.Total
+	}
+	return s.Total.MulRaw(height - s.StartHeight).QuoRaw(s.EndHeight - s.StartHeight)
+}
+
+// Releasable - Returns the vested amount of the stream not yet released at the height
+func (s DAOStream) Releasable(height int64) sdk.BigInt {
+	return s.VestedAt(height).Sub(s.Released)
+}
+
+// IsComplete - Returns true if the total of the stream was released
+func (s DAOStream) IsComplete() bool {
+	return s.Released.GTE(s.Total)
+}
+
+// KeyForDAOStream - Returns the key for a dao stream
+func KeyForDAOStream(id uint64) []byte {
+	return append(append([]byte{}, DAOStreamsKey...), proposalIDBytes(id)...)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDAOStream_VestedAt(t *testing.T) {
+	s := DAOStream{
+		Total:       sdk.NewInt(1000),
+		Released:    sdk.ZeroInt(),
+		StartHeight: 100,
+		EndHeight:   200,
+	}
+	assert.True(t, s.VestedAt(99).IsZero())
+	assert.True(t, s.VestedAt(100).IsZero())
+	assert.Equal(t, sdk.NewInt(10), s.VestedAt(101))
+	assert.Equal(t, sdk.NewInt(500), s.VestedAt(150))
+	assert.Equal(t, sdk.NewInt(1000), s.VestedAt(200))
+	assert.Equal(t, sdk.NewInt(1000), s.VestedAt(300))
+	s.CliffHeight = 150
+	assert.True(t, s.VestedAt(149).IsZero())
+	assert.Equal(t, sdk.NewInt(500), s.VestedAt(150))
+	s.Released = sdk.NewInt(500)
+	assert.Equal(t, sdk.NewInt(100), s.Releasable(160))
+	assert.False(t, s.IsComplete())
+}
+
+func TestValidateDAOStream(t *testing.T) {
+	assert.Nil(t, ValidateDAOStream(sdk.NewInt(100), 10, 20, 0))
+	assert.Nil(t, ValidateDAOStream(sdk.NewInt(100), 10, 20, 20))
+	assert.NotNil(t, ValidateDAOStream(sdk.ZeroInt(), 10, 20, 0))
+	assert.NotNil(t, ValidateDAOStream(sdk.NewInt(100), 20, 20, 0))
+	assert.NotNil(t, ValidateDAOStream(sdk.NewInt(100), 10, 20, 5))
+}
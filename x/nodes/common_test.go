@@ -220,6 +220,7 @@ func getGenesisStateForTest(ctx sdk.Ctx, keeper keeper.Keeper, defaultparams boo
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		PartialUnstakes:          keeper.GetAllPartialUnstakes(ctx),
 	}
 
 }
@@ -49,6 +49,11 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(validator.GetTokens())
 		}
 	}
+	// partial unstakes are still held by the staked pool until they complete
+	for _, partialUnstake := range data.PartialUnstakes {
+		keeper.SetPartialUnstake(ctx, partialUnstake)
+		stakedTokens = stakedTokens.Add(partialUnstake.Amount)
+	}
 	// take the staked amount and create the corresponding coins object
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
@@ -139,6 +144,7 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		return false
 	})
 	prevProposer := keeper.GetPreviousProposer(ctx)
+	partialUnstakes := keeper.GetAllPartialUnstakes(ctx)
 
 	return types.GenesisState{
 		Params:                   params,
@@ -149,6 +155,7 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		PartialUnstakes:          partialUnstakes,
 	}
 }
 
@@ -2,6 +2,7 @@ package nodes
 
 import (
 	"fmt"
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/nodes/keeper"
@@ -27,6 +28,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 				return handleMsgSend(ctx, msg, k)
 			case types.MsgStake:
 				return handleStake(ctx, msg, k, signer)
+			case types.MsgPartialUnstake:
+				if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.PartialUnstakeKey) {
+					errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
+					return sdk.ErrUnknownRequest(errMsg).Result()
+				}
+				return handleMsgPartialUnstake(ctx, msg, k)
 			default:
 				errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 				return sdk.ErrUnknownRequest(errMsg).Result()
@@ -126,6 +133,41 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgPartialUnstake(ctx sdk.Ctx, msg types.MsgPartialUnstake, k keeper.Keeper) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+
+	ctx.Logger().Info("Partial Unstake Message received from " + msg.Address.String())
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	err, valid := keeper.ValidateValidatorMsgSigner(validator, msg.Signer, k)
+	if !valid {
+		return err.Result()
+	}
+	if err := k.ValidateValidatorPartialUnstake(ctx, validator, msg.Amount); err != nil {
+		return err.Result()
+	}
+	if err := k.PartialUnstakeValidator(ctx, validator, msg.Amount); err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePartialUnstake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // Validators must submit a transaction to unjail itself after todo
 // having been jailed (and thus unstaked) for downtime
 func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Result {
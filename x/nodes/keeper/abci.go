@@ -68,5 +68,7 @@ func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	validatorUpdates := k.UpdateTendermintValidators(ctx)
 	// Unstake all mature validators from the unstakeing queue.
 	k.unstakeAllMatureValidators(ctx)
+	// Release all mature partial unstakes back to their output addresses.
+	k.unstakeAllMaturePartialUnstakes(ctx)
 	return validatorUpdates
 }
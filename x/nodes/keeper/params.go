@@ -131,25 +131,38 @@ func (k Keeper) MaxJailedBlocks(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// RelaysToTokensMultiplierMap - Retrieve the relay token multipliers per chain
+func (k Keeper) RelaysToTokensMultiplierMap(ctx sdk.Ctx) types.ChainMultipliers {
+	res := make(types.ChainMultipliers)
+	k.Paramstore.GetIfExists(ctx, types.KeyRelaysToTokensMultiplierMap, &res)
+	return res
+}
+
+// ChainRelaysToTokensMultiplier - Retrieve the relay token multiplier of the chain, falling back to the global multiplier
+func (k Keeper) ChainRelaysToTokensMultiplier(ctx sdk.Ctx, chain string) sdk.BigInt {
+	return sdk.NewInt(k.RelaysToTokensMultiplierMap(ctx).Multiplier(chain, k.RelaysToTokensMultiplier(ctx).Int64()))
+}
+
 // GetParams - Retrieve all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
-		RelaysToTokensMultiplier: k.RelaysToTokensMultiplier(ctx).Int64(),
-		UnstakingTime:            k.UnStakingTime(ctx),
-		MaxValidators:            k.MaxValidators(ctx),
-		StakeDenom:               k.StakeDenom(ctx),
-		StakeMinimum:             k.MinimumStake(ctx),
-		SessionBlockFrequency:    k.BlocksPerSession(ctx),
-		DAOAllocation:            k.DAOAllocation(ctx),
-		ProposerAllocation:       k.ProposerAllocation(ctx),
-		MaximumChains:            k.MaxChains(ctx),
-		MaxJailedBlocks:          k.MaxJailedBlocks(ctx),
-		MaxEvidenceAge:           k.MaxEvidenceAge(ctx),
-		SignedBlocksWindow:       k.SignedBlocksWindow(ctx),
-		MinSignedPerWindow:       sdk.NewDec(k.MinSignedPerWindow(ctx)),
-		DowntimeJailDuration:     k.DowntimeJailDuration(ctx),
-		SlashFractionDoubleSign:  k.SlashFractionDoubleSign(ctx),
-		SlashFractionDowntime:    k.SlashFractionDowntime(ctx),
+		RelaysToTokensMultiplier:    k.RelaysToTokensMultiplier(ctx).Int64(),
+		UnstakingTime:               k.UnStakingTime(ctx),
+		MaxValidators:               k.MaxValidators(ctx),
+		StakeDenom:                  k.StakeDenom(ctx),
+		StakeMinimum:                k.MinimumStake(ctx),
+		SessionBlockFrequency:       k.BlocksPerSession(ctx),
+		DAOAllocation:               k.DAOAllocation(ctx),
+		ProposerAllocation:          k.ProposerAllocation(ctx),
+		MaximumChains:               k.MaxChains(ctx),
+		MaxJailedBlocks:             k.MaxJailedBlocks(ctx),
+		RelaysToTokensMultiplierMap: k.RelaysToTokensMultiplierMap(ctx),
+		MaxEvidenceAge:              k.MaxEvidenceAge(ctx),
+		SignedBlocksWindow:          k.SignedBlocksWindow(ctx),
+		MinSignedPerWindow:          sdk.NewDec(k.MinSignedPerWindow(ctx)),
+		DowntimeJailDuration:        k.DowntimeJailDuration(ctx),
+		SlashFractionDoubleSign:     k.SlashFractionDoubleSign(ctx),
+		SlashFractionDowntime:       k.SlashFractionDowntime(ctx),
 	}
 }
 
@@ -35,6 +35,12 @@ func (k Keeper) coinsFromStakedToUnstaked(ctx sdk.Ctx, validator types.Validator
 	return nil
 }
 
+// coinsFromStakedToOutput - Transfer an amount from the staked module account to an output address -> used in partial unstaking
+func (k Keeper) coinsFromStakedToOutput(ctx sdk.Ctx, output sdk.Address, amount sdk.BigInt) sdk.Error {
+	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
+	return k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, output, coins)
+}
+
 // coinsFromUnstakedToStaked - Transfer coins from the module account to validator -> used in staking
 func (k Keeper) coinsFromUnstakedToStaked(ctx sdk.Ctx, address sdk.Address, amount sdk.BigInt) sdk.Error {
 	if amount.LT(sdk.ZeroInt()) {
@@ -7,8 +7,8 @@ import (
 	"github.com/pokt-network/pocket-core/x/nodes/types"
 )
 
-// RewardForRelays - Award coins to an address (will be called at the beginning of the next block)
-func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address) sdk.BigInt {
+// RewardForRelays - Award coins to an address for relays serviced on the chain (will be called at the beginning of the next block)
+func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address, chain string) sdk.BigInt {
 	if k.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
 		var found bool
 		address, found = k.GetValidatorOutputAddress(ctx, address)
@@ -17,7 +17,7 @@ func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Addr
 			return sdk.ZeroInt()
 		}
 	}
-	coins := k.RelaysToTokensMultiplier(ctx).Mul(relays)
+	coins := k.ChainRelaysToTokensMultiplier(ctx, chain).Mul(relays)
 	toNode, toFeeCollector := k.NodeReward(ctx, coins)
 	if toNode.IsPositive() {
 		k.mint(ctx, toNode, address)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -171,14 +172,14 @@ func TestKeeper_rewardFromRelays(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			k := tt.fields.keeper
 			ctx := tt.args.ctx
-			k.RewardForRelays(tt.args.ctx, sdk.NewInt(10000), tt.args.validator)
+			k.RewardForRelays(tt.args.ctx, sdk.NewInt(10000), tt.args.validator, "0001")
 			acc := k.GetAccount(ctx, tt.args.Output)
 			assert.False(t, acc.Coins.IsZero())
 			assert.True(t, acc.Coins.IsEqual(sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(8900000)))))
 			acc = k.GetAccount(ctx, tt.args.validator)
 			assert.True(t, acc.Coins.IsZero())
 			// no output now
-			k.RewardForRelays(tt.args.ctx, sdk.NewInt(10000), tt.args.validatorNoOutput)
+			k.RewardForRelays(tt.args.ctx, sdk.NewInt(10000), tt.args.validatorNoOutput, "0001")
 			acc = k.GetAccount(ctx, tt.args.OutputNoOutput)
 			assert.False(t, acc.Coins.IsZero())
 			assert.True(t, acc.Coins.IsEqual(sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(8900000)))))
@@ -187,3 +188,46 @@ func TestKeeper_rewardFromRelays(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_rewardFromRelaysPerChain(t *testing.T) {
+	stakedValidator := getStakedValidator()
+	stakedValidator.OutputAddress = getRandomValidatorAddress()
+	codec.TestMode = -3
+	context, _, keeper := createTestInput(t, true)
+	keeper.SetValidator(context, stakedValidator)
+	p := keeper.GetParams(context)
+	p.RelaysToTokensMultiplierMap = types.ChainMultipliers{"0021": 2 * p.RelaysToTokensMultiplier}
+	keeper.SetParams(context, p)
+	assert.Equal(t, sdk.NewInt(p.RelaysToTokensMultiplier), keeper.ChainRelaysToTokensMultiplier(context, "0001"))
+	assert.Equal(t, sdk.NewInt(2*p.RelaysToTokensMultiplier), keeper.ChainRelaysToTokensMultiplier(context, "0021"))
+	// chains without an entry use the global multiplier
+	keeper.RewardForRelays(context, sdk.NewInt(10000), stakedValidator.GetAddress(), "0001")
+	acc := keeper.GetAccount(context, stakedValidator.OutputAddress)
+	assert.True(t, acc.Coins.IsEqual(sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(8900000)))))
+	keeper.RewardForRelays(context, sdk.NewInt(10000), stakedValidator.GetAddress(), "0021")
+	acc = keeper.GetAccount(context, stakedValidator.OutputAddress)
+	assert.True(t, acc.Coins.IsEqual(sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(3*8900000)))))
+}
+
+func TestKeeper_RelaysToTokensMultiplierMapUpdate(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	assert.Empty(t, keeper.RelaysToTokensMultiplierMap(context))
+	// before the param validation feature a param change replaces the map
+	assert.Nil(t, keeper.Paramstore.Update(context, types.KeyRelaysToTokensMultiplierMap, []byte(`{"0001":2000}`)))
+	assert.Nil(t, keeper.Paramstore.Update(context, types.KeyRelaysToTokensMultiplierMap, []byte(`{"0021":3000}`)))
+	assert.Equal(t, types.ChainMultipliers{"0021": 3000}, keeper.RelaysToTokensMultiplierMap(context))
+	context = context.WithBlockHeight(1)
+	codec.UpgradeFeatureMap[codec.ParamValidationKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.ParamValidationKey)
+	// a param change edits a single chain of the map
+	assert.Nil(t, keeper.Paramstore.Update(context, types.KeyRelaysToTokensMultiplierMap, []byte(`{"0001":2000}`)))
+	assert.Nil(t, keeper.Paramstore.Update(context, types.KeyRelaysToTokensMultiplierMap, []byte(`{"0021":3000}`)))
+	assert.Equal(t, types.ChainMultipliers{"0001": 2000, "0021": 3000}, keeper.RelaysToTokensMultiplierMap(context))
+	assert.Nil(t, keeper.Paramstore.Update(context, types.KeyRelaysToTokensMultiplierMap, []byte(`{"0001":0}`)))
+	assert.Equal(t, types.ChainMultipliers{"0021": 3000}, keeper.RelaysToTokensMultiplierMap(context))
+	assert.Equal(t, keeper.RelaysToTokensMultiplier(context), keeper.ChainRelaysToTokensMultiplier(context, "0001"))
+	// malformed chains and negative multipliers are rejected and leave the map untouched
+	assert.NotNil(t, keeper.Paramstore.Update(context, types.KeyRelaysToTokensMultiplierMap, []byte(`{"bad":1000}`)))
+	assert.NotNil(t, keeper.Paramstore.Update(context, types.KeyRelaysToTokensMultiplierMap, []byte(`{"0021":-1}`)))
+	assert.Equal(t, types.ChainMultipliers{"0021": 3000}, keeper.RelaysToTokensMultiplierMap(context))
+}
@@ -25,8 +25,14 @@ func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.BigInt) {
 	if validator.Address.Empty() {
 		return // invalid simple slash
 	}
+	// the pending partial unstakes bear their share of the burn
+	remaining := amount
+	if pending := k.getPartialUnstakedTokens(ctx, addr); pending.IsPositive() {
+		fraction := sdk.MinDec(amount.ToDec().Quo(validator.StakedTokens.Add(pending).ToDec()), sdk.OneDec())
+		remaining = remaining.Sub(k.slashPartialUnstakes(ctx, addr, fraction))
+	}
 	// cannot decrease balance below zero
-	tokensToBurn := sdk.MinInt(amount, validator.StakedTokens)
+	tokensToBurn := sdk.MinInt(remaining, validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
 	validator, err := k.removeValidatorTokens(ctx, validator, tokensToBurn)
 	if err != nil {
@@ -90,6 +96,8 @@ func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int
 	// Amount of slashing = slash slashFactor * power at time of infraction
 	amount := sdk.TokensFromConsensusPower(power)
 	slashAmount := amount.ToDec().Mul(slashFactor).TruncateInt()
+	// the pending partial unstakes are slashed first, the rest of the amount is burned from the stake
+	slashAmount = slashAmount.Sub(k.slashPartialUnstakes(ctx, addr, slashFactor))
 	// cannot decrease balance below zero
 	tokensToBurn := sdk.MinInt(slashAmount, validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+)
+
+// SetPartialUnstake - Store a partial unstake in the queue, merging with any entry of the validator at the same completion time
+func (k Keeper) SetPartialUnstake(ctx sdk.Ctx, partialUnstake types.PartialUnstake) {
+	if existing, found := k.GetPartialUnstake(ctx, partialUnstake.Address, partialUnstake.CompletionTime); found {
+		partialUnstake.Amount = partialUnstake.Amount.Add(existing.Amount)
+	}
+	k.setPartialUnstake(ctx, partialUnstake)
+}
+
+// setPartialUnstake - Store a partial unstake in the queue, replacing any entry of the validator at the same completion time
+func (k Keeper) setPartialUnstake(ctx sdk.Ctx, partialUnstake types.PartialUnstake) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.MarshalBinaryLengthPrefixed(&partialUnstake, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not marshal partial unstake: " + err.Error())
+		return
+	}
+	_ = store.Set(types.KeyForPartialUnstake(partialUnstake.CompletionTime, partialUnstake.Address), bz)
+}
+
+// GetPartialUnstake - Retrieve the partial unstake of a validator completing at exactly this time
+func (k Keeper) GetPartialUnstake(ctx sdk.Ctx, addr sdk.Address, completionTime time.Time) (partialUnstake types.PartialUnstake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForPartialUnstake(completionTime, addr))
+	if bz == nil {
+		return partialUnstake, false
+	}
+	err := k.Cdc.UnmarshalBinaryLengthPrefixed(bz, &partialUnstake, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("could not unmarshal partial unstake: " + err.Error())
+		return partialUnstake, false
+	}
+	return partialUnstake, true
+}
+
+// deletePartialUnstake - Remove a partial unstake from the queue
+func (k Keeper) deletePartialUnstake(ctx sdk.Ctx, partialUnstake types.PartialUnstake) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.KeyForPartialUnstake(partialUnstake.CompletionTime, partialUnstake.Address))
+}
+
+// GetPartialUnstakes - Retrieve all of the pending partial unstakes of a validator
+func (k Keeper) GetPartialUnstakes(ctx sdk.Ctx, addr sdk.Address) (partialUnstakes []types.PartialUnstake) {
+	partialUnstakes = make([]types.PartialUnstake, 0)
+	for _, partialUnstake := range k.GetAllPartialUnstakes(ctx) {
+		if partialUnstake.Address.Equals(addr) {
+			partialUnstakes = append(partialUnstakes, partialUnstake)
+		}
+	}
+	return partialUnstakes
+}
+
+// GetAllPartialUnstakes - Retrieve every pending partial unstake, ordered by completion time
+func (k Keeper) GetAllPartialUnstakes(ctx sdk.Ctx) (partialUnstakes []types.PartialUnstake) {
+	partialUnstakes = make([]types.PartialUnstake, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.PartialUnstakingKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var partialUnstake types.PartialUnstake
+		err := k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &partialUnstake, ctx.BlockHeight())
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal partial unstake at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		partialUnstakes = append(partialUnstakes, partialUnstake)
+	}
+	return partialUnstakes
+}
+
+// partialUnstakesIterator - Retrieve an iterator for all partial unstakes up to a certain time
+func (k Keeper) partialUnstakesIterator(ctx sdk.Ctx, endTime time.Time) (sdk.Iterator, error) {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.PartialUnstakingKey, sdk.PrefixEndBytes(types.KeyForPartialUnstakes(endTime)))
+}
+
+// ValidateValidatorPartialUnstake - Check if the validator is able to unstake the amount and remain staked
+func (k Keeper) ValidateValidatorPartialUnstake(ctx sdk.Ctx, validator types.Validator, amount sdk.BigInt) sdk.Error {
+	// must be staked to partially unstake
+	if !validator.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	// a validator on its way out should be fully unstaked instead
+	if k.IsWaitingValidator(ctx, validator.Address) {
+		return types.ErrValidatorWaitingToUnstake(types.ModuleName)
+	}
+	if !amount.IsPositive() {
+		return types.ErrBadPartialUnstakeAmount(k.codespace)
+	}
+	// the remaining stake must keep the validator above the minimum
+	if validator.StakedTokens.Sub(amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrMinimumPartialUnstake(k.codespace)
+	}
+	return nil
+}
+
+// PartialUnstakeValidator - Store ops when a validator moves part of its stake into the unstaking queue
+func (k Keeper) PartialUnstakeValidator(ctx sdk.Ctx, validator types.Validator, amount sdk.BigInt) sdk.Error {
+	// remove the tokens from the validator, this updates the staking set with the new power
+	validator, err := k.removeValidatorTokens(ctx, validator, amount)
+	if err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	// the tokens are released to the output address (if any) at completion
+	output := validator.OutputAddress
+	if output == nil {
+		output = validator.Address
+	}
+	k.SetPartialUnstake(ctx, types.PartialUnstake{
+		Address:        validator.Address,
+		OutputAddress:  output,
+		Amount:         amount,
+		CompletionTime: ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)),
+	})
+	ctx.Logger().Info(fmt.Sprintf("Began partially unstaking %s tokens of validator %s", amount.String(), validator.Address.String()))
+	return nil
+}
+
+// unstakeAllMaturePartialUnstakes - Release all the partial unstakes that have finished their unstaking period
+func (k Keeper) unstakeAllMaturePartialUnstakes(ctx sdk.Ctx) {
+	matured := make([]types.PartialUnstake, 0)
+	iterator, _ := k.partialUnstakesIterator(ctx, ctx.BlockHeader().Time)
+	for ; iterator.Valid(); iterator.Next() {
+		var partialUnstake types.PartialUnstake
+		err := k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &partialUnstake, ctx.BlockHeight())
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal mature partial unstake at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		matured = append(matured, partialUnstake)
+	}
+	iterator.Close()
+	for _, partialUnstake := range matured {
+		k.deletePartialUnstake(ctx, partialUnstake)
+		err := k.coinsFromStakedToOutput(ctx, partialUnstake.OutputAddress, partialUnstake.Amount)
+		if err != nil {
+			ctx.Logger().Error("Could not finish partial unstake of validator "+partialUnstake.Address.String()+": "+err.Error(), "at height: ", ctx.BlockHeight())
+			continue
+		}
+		ctx.Logger().Info(fmt.Sprintf("Finished partially unstaking %s tokens of validator %s", partialUnstake.Amount.String(), partialUnstake.Address.String()))
+		// create the event
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCompletePartialUnstake,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, partialUnstake.Address.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, partialUnstake.Amount.String()),
+			),
+		})
+	}
+}
+
+// slashPartialUnstakes - Burn the fraction of each pending partial unstake of a validator, as these tokens were at stake
+// during the infraction. Returns the amount burned
+func (k Keeper) slashPartialUnstakes(ctx sdk.Ctx, addr sdk.Address, slashFactor sdk.BigDec) sdk.BigInt {
+	burned := sdk.ZeroInt()
+	for _, partialUnstake := range k.GetPartialUnstakes(ctx, addr) {
+		toBurn := sdk.MinInt(partialUnstake.Amount.ToDec().Mul(slashFactor).TruncateInt(), partialUnstake.Amount)
+		if !toBurn.IsPositive() {
+			continue
+		}
+		partialUnstake.Amount = partialUnstake.Amount.Sub(toBurn)
+		if partialUnstake.Amount.IsZero() {
+			k.deletePartialUnstake(ctx, partialUnstake)
+		} else {
+			k.setPartialUnstake(ctx, partialUnstake)
+		}
+		burned = burned.Add(toBurn)
+	}
+	// the pending tokens are still held by the staked pool
+	if err := k.burnStakedTokens(ctx, burned); err != nil {
+		k.Logger(ctx).Error("could not burn partially unstaked tokens in slash: " + err.Error() + "\nfor validator " + addr.String())
+		return sdk.ZeroInt()
+	}
+	return burned
+}
+
+// getPartialUnstakedTokens - Retrieve the total of the pending partial unstakes of a validator
+func (k Keeper) getPartialUnstakedTokens(ctx sdk.Ctx, addr sdk.Address) sdk.BigInt {
+	total := sdk.ZeroInt()
+	for _, partialUnstake := range k.GetPartialUnstakes(ctx, addr) {
+		total = total.Add(partialUnstake.Amount)
+	}
+	return total
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_ValidateValidatorPartialUnstake(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	minimum := sdk.NewInt(keeper.MinimumStake(context))
+	stakedValidator := getStakedValidator()
+	stakedValidator.StakedTokens = minimum.Add(sdk.NewInt(100))
+	waitingValidator := getStakedValidator()
+	keeper.SetWaitingValidator(context, waitingValidator)
+	tests := []struct {
+		name      string
+		validator types.Validator
+		amount    sdk.BigInt
+		want      sdk.Error
+	}{
+		{"unstakes down to the minimum", stakedValidator, sdk.NewInt(100), nil},
+		{"FAIL unstakes below the minimum", stakedValidator, sdk.NewInt(101), types.ErrMinimumPartialUnstake("pos")},
+		{"FAIL unstakes a non positive amount", stakedValidator, sdk.ZeroInt(), types.ErrBadPartialUnstakeAmount("pos")},
+		{"FAIL validator is unstaking", getUnstakingValidator(), sdk.NewInt(1), types.ErrValidatorStatus("pos")},
+		{"FAIL validator is waiting to unstake", waitingValidator, sdk.NewInt(1), types.ErrValidatorWaitingToUnstake("pos")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, keeper.ValidateValidatorPartialUnstake(context, tt.validator, tt.amount))
+		})
+	}
+}
+
+func TestKeeper_PartialUnstakeValidator(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	stakeAmount := sdk.NewInt(100000000000)
+	unstakeAmount := sdk.NewInt(1000)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	validator.OutputAddress = getRandomValidatorAddress()
+	coins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(context), stakeAmount))
+	assert.Nil(t, keeper.AccountKeeper.MintCoins(context, types.StakedPoolName, coins))
+	assert.Nil(t, keeper.AccountKeeper.SendCoinsFromModuleToAccount(context, types.StakedPoolName, validator.Address, coins))
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount, validator.PublicKey))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	// begin the partial unstake
+	assert.Nil(t, keeper.PartialUnstakeValidator(context, validator, unstakeAmount))
+	got, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.True(t, got.IsStaked())
+	assert.Equal(t, stakeAmount.Sub(unstakeAmount), got.StakedTokens)
+	assert.Equal(t, stakeAmount, keeper.GetStakedTokens(context))
+	partialUnstakes := keeper.GetPartialUnstakes(context, validator.Address)
+	assert.Len(t, partialUnstakes, 1)
+	assert.Equal(t, unstakeAmount, partialUnstakes[0].Amount)
+	assert.Equal(t, validator.OutputAddress, partialUnstakes[0].OutputAddress)
+	// a second partial unstake in the same block is merged into the same entry
+	assert.Nil(t, keeper.PartialUnstakeValidator(context, got, unstakeAmount))
+	partialUnstakes = keeper.GetPartialUnstakes(context, validator.Address)
+	assert.Len(t, partialUnstakes, 1)
+	assert.Equal(t, unstakeAmount.MulRaw(2), partialUnstakes[0].Amount)
+	// nothing is released before the unstaking time
+	keeper.unstakeAllMaturePartialUnstakes(context)
+	assert.Len(t, keeper.GetPartialUnstakes(context, validator.Address), 1)
+	assert.True(t, keeper.GetBalance(context, validator.OutputAddress).IsZero())
+	// release after the unstaking time
+	matureCtx := context.WithBlockTime(context.BlockHeader().Time.Add(keeper.UnStakingTime(context)).Add(time.Second))
+	keeper.unstakeAllMaturePartialUnstakes(matureCtx)
+	assert.Len(t, keeper.GetPartialUnstakes(matureCtx, validator.Address), 0)
+	assert.Equal(t, unstakeAmount.MulRaw(2), keeper.GetBalance(matureCtx, validator.OutputAddress))
+	assert.Equal(t, stakeAmount.Sub(unstakeAmount.MulRaw(2)), keeper.GetStakedTokens(matureCtx))
+	got, _ = keeper.GetValidator(matureCtx, validator.Address)
+	assert.True(t, got.IsStaked())
+}
+
+func TestKeeper_SlashPartialUnstakes(t *testing.T) {
+	stakeAmount := sdk.NewInt(100000000000)
+	unstakeAmount := sdk.NewInt(10000000000)
+	setup := func(t *testing.T) (sdk.Context, Keeper, types.Validator) {
+		context, _, keeper := createTestInput(t, true)
+		validator := getUnstakedValidator()
+		validator.StakedTokens = sdk.ZeroInt()
+		coins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(context), stakeAmount))
+		assert.Nil(t, keeper.AccountKeeper.MintCoins(context, types.StakedPoolName, coins))
+		assert.Nil(t, keeper.AccountKeeper.SendCoinsFromModuleToAccount(context, types.StakedPoolName, validator.Address, coins))
+		assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount, validator.PublicKey))
+		validator, _ = keeper.GetValidator(context, validator.Address)
+		assert.Nil(t, keeper.PartialUnstakeValidator(context, validator, unstakeAmount))
+		validator, _ = keeper.GetValidator(context, validator.Address)
+		return context, keeper, validator
+	}
+	t.Run("a simple slash burns the pending tokens pro rata", func(t *testing.T) {
+		context, keeper, validator := setup(t)
+		// 1% of the stake at the time of the partial unstake
+		keeper.simpleSlash(context, validator.Address, sdk.NewInt(1000000000))
+		partialUnstakes := keeper.GetPartialUnstakes(context, validator.Address)
+		assert.Len(t, partialUnstakes, 1)
+		assert.Equal(t, unstakeAmount.Sub(sdk.NewInt(100000000)), partialUnstakes[0].Amount)
+		got, _ := keeper.GetValidator(context, validator.Address)
+		assert.Equal(t, stakeAmount.Sub(unstakeAmount).Sub(sdk.NewInt(900000000)), got.StakedTokens)
+		assert.Equal(t, stakeAmount.Sub(sdk.NewInt(1000000000)), keeper.GetStakedTokens(context))
+	})
+	t.Run("a slash burns the fraction of the pending tokens", func(t *testing.T) {
+		context, keeper, validator := setup(t)
+		power := sdk.TokensToConsensusPower(stakeAmount)
+		keeper.slash(context, validator.Address, context.BlockHeight(), power, sdk.NewDecWithPrec(5, 2))
+		partialUnstakes := keeper.GetPartialUnstakes(context, validator.Address)
+		assert.Len(t, partialUnstakes, 1)
+		assert.Equal(t, unstakeAmount.Sub(sdk.NewInt(500000000)), partialUnstakes[0].Amount)
+		got, _ := keeper.GetValidator(context, validator.Address)
+		assert.Equal(t, stakeAmount.Sub(unstakeAmount).Sub(sdk.NewInt(4500000000)), got.StakedTokens)
+		assert.Equal(t, stakeAmount.Sub(sdk.NewInt(5000000000)), keeper.GetStakedTokens(context))
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/pokt-network/pocket-core/crypto"
 
 	sdk "github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
 )
 
@@ -20,10 +21,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgBeginUnstake{}, "pos/8.0MsgBeginUnstake")
 	cdc.RegisterStructure(MsgProtoStake{}, "pos/8.0MsgProtoStake")
 	cdc.RegisterStructure(MsgStake{}, "pos/8.0MsgStake")
+	cdc.RegisterStructure(MsgPartialUnstake{}, "pos/MsgPartialUnstake")
 	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgUnjail{}, &MsgBeginUnstake{}, &MsgSend{}, &MsgStake{},
-		&LegacyMsgUnjail{}, &LegacyMsgBeginUnstake{}, &LegacyMsgStake{})
+		&LegacyMsgUnjail{}, &LegacyMsgBeginUnstake{}, &LegacyMsgStake{}, &MsgPartialUnstake{})
 	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgUnjail{}, &MsgBeginUnstake{}, &MsgSend{}, &MsgStake{},
-		&LegacyMsgUnjail{}, &LegacyMsgBeginUnstake{}, &LegacyMsgStake{})
+		&LegacyMsgUnjail{}, &LegacyMsgBeginUnstake{}, &LegacyMsgStake{}, &MsgPartialUnstake{})
 	cdc.RegisterInterface("nodes/validatorI", (*exported.ValidatorI)(nil), &Validator{}, &LegacyValidator{})
 	ModuleCdc = cdc
 }
@@ -35,4 +37,6 @@ func init() {
 	RegisterCodec(ModuleCdc)
 	crypto.RegisterAmino(ModuleCdc.AminoCodec().Amino)
 	ModuleCdc.AminoCodec().Seal()
+	// the stake locks the tokens of the node out of the reach of the spend limit, unlike a send
+	authTypes.RegisterUnscopedMsgTypes(MsgStakeName)
 }
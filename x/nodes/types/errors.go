@@ -37,6 +37,8 @@ const (
 	CodeUnequalOutputAddr        CodeType          = 124
 	CodeUnauthorizedSigner       CodeType          = 125
 	CodeNilSigner                CodeType          = 126
+	CodeBadPartialUnstake        CodeType          = 127
+	CodeMinimumPartialUnstake    CodeType          = 128
 )
 
 func ErrTooManyChains(codespace sdk.CodespaceType) sdk.Error {
@@ -108,6 +110,14 @@ func ErrBadSendAmount(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeBadSend, "the amount to send must be positive")
 }
 
+func ErrBadPartialUnstakeAmount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBadPartialUnstake, "the amount to partially unstake must be positive")
+}
+
+func ErrMinimumPartialUnstake(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMinimumPartialUnstake, "validator must keep at least the minimum stake after a partial unstake")
+}
+
 func ErrBadDenom(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "invalid coin denomination")
 }
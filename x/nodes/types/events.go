@@ -8,6 +8,8 @@ const (
 	EventTypeBeginUnstake            = "begin_unstake"
 	EventTypeWaitingToBeginUnstaking = "waiting_to_begin_unstaking"
 	EventTypeUnstake                 = "unstake"
+	EventTypePartialUnstake          = "partial_unstake"
+	EventTypeCompletePartialUnstake  = "complete_partial_unstake"
 	EventTypeProposerReward          = "proposer_reward"
 	EventTypeDAOAllocation           = "dao_allocation"
 	EventTypeSlash                   = "slash"
@@ -5,6 +5,8 @@ const (
 	UnstakeFee = 10000
 	UnjailFee  = 10000
 	SendFee    = 10000
+
+	PartialUnstakeFee = 10000
 )
 
 var (
@@ -13,5 +15,7 @@ var (
 		MsgUnstakeName: UnstakeFee,
 		MsgUnjailName:  UnjailFee,
 		MsgSendName:    SendFee,
+
+		MsgPartialUnstakeName: PartialUnstakeFee,
 	}
 )
@@ -29,6 +29,7 @@ var ( // Keys for store prefixes
 	AwardValidatorKey               = []byte{0x51} // prefix for awarding validators
 	BurnValidatorKey                = []byte{0x52} // prefix for awarding validators
 	WaitingToBeginUnstakingKey      = []byte{0x43} // prefix for waiting validators
+	PartialUnstakingKey             = []byte{0x44} // prefix for partial unstakes
 )
 
 func KeyForValidatorByNetworkID(addr sdk.Address, networkID []byte) []byte {
@@ -59,6 +60,17 @@ func KeyForUnstakingValidators(unstakingTime time.Time) []byte {
 	return append(UnstakingValidatorsKey, bz...) // use the unstaking time as part of the key
 }
 
+// generates the key for all partial unstakes completing at the unstaking time
+func KeyForPartialUnstakes(unstakingTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(unstakingTime)
+	return append(PartialUnstakingKey, bz...)
+}
+
+// generates the key for a validator's partial unstake completing at the unstaking time
+func KeyForPartialUnstake(unstakingTime time.Time, addr sdk.Address) []byte {
+	return append(KeyForPartialUnstakes(unstakingTime), addr.Bytes()...)
+}
+
 // generates the key for a validator in the staking set
 func KeyForValidatorInStakingSet(validator Validator) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
@@ -13,6 +13,7 @@ var (
 	_ sdk.ProtoMsg = &MsgUnjail{}
 	_ sdk.ProtoMsg = &MsgSend{}
 	_ sdk.ProtoMsg = &MsgStake{}
+	_ sdk.ProtoMsg = &MsgPartialUnstake{}
 )
 
 const (
@@ -20,6 +21,8 @@ const (
 	MsgUnstakeName = "begin_unstake_validator"
 	MsgUnjailName  = "unjail_validator"
 	MsgSendName    = "send"
+
+	MsgPartialUnstakeName = "partial_unstake_validator"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -141,6 +144,53 @@ func (msg MsgSend) GetFee() sdk.BigInt {
 	return sdk.NewInt(NodeFeeMap[msg.Type()])
 }
 
+// GetSpend returns the tokens sent out of the account of the signer
+func (msg MsgSend) GetSpend() sdk.BigInt {
+	return msg.Amount
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgPartialUnstake) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Signer, msg.Address}
+}
+
+func (msg MsgPartialUnstake) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPartialUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgPartialUnstake) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Signer.Empty() {
+		return ErrNilSignerAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadPartialUnstakeAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgPartialUnstake) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgPartialUnstake) Type() string { return MsgPartialUnstakeName }
+
+// GetFee get fee for msg
+func (msg MsgPartialUnstake) GetFee() sdk.BigInt {
+	return sdk.NewInt(NodeFeeMap[msg.Type()])
+}
+
 //----------------------------------------------------------------------------------------------------------------------
 var _ codec.ProtoMarshaler = &MsgStake{}
 
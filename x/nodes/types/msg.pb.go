@@ -312,6 +312,46 @@ func (m *MsgSend) GetToAddress() github_com_pokt_network_pocket_core_types.Addre
 func (*MsgSend) XXX_MessageName() string {
 	return "x.nodes.MsgSend"
 }
+
+type MsgPartialUnstake struct {
+	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=Address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"validator_address" yaml:"validator_address"`
+	Amount  github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount" yaml:"amount"`
+	Signer  github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,3,opt,name=Signer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"signer_address" yaml:"signer_address"`
+}
+
+func (m *MsgPartialUnstake) Reset()         { *m = MsgPartialUnstake{} }
+func (m *MsgPartialUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialUnstake) ProtoMessage()    {}
+func (*MsgPartialUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0de9b62fa75e413f, []int{7}
+}
+func (m *MsgPartialUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialUnstake.Merge(m, src)
+}
+func (m *MsgPartialUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialUnstake proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgProtoStake)(nil), "x.nodes.MsgProtoStake")
 	proto.RegisterType((*LegacyMsgProtoStake)(nil), "x.nodes.LegacyMsgProtoStake")
@@ -320,55 +360,57 @@ func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "x.nodes.MsgUnjail")
 	proto.RegisterType((*LegacyMsgUnjail)(nil), "x.nodes.LegacyMsgUnjail")
 	proto.RegisterType((*MsgSend)(nil), "x.nodes.MsgSend")
+	proto.RegisterType((*MsgPartialUnstake)(nil), "x.nodes.MsgPartialUnstake")
 }
 
 func init() { proto.RegisterFile("x/nodes/msg.proto", fileDescriptor_0de9b62fa75e413f) }
 
 var fileDescriptor_0de9b62fa75e413f = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
+	// 704 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0x13, 0x4f,
-	0x1c, 0xcd, 0xa4, 0xdf, 0x26, 0x64, 0x9a, 0xb4, 0x74, 0xfb, 0x2d, 0x2c, 0x0a, 0x99, 0xb2, 0x22,
-	0xf4, 0x60, 0x13, 0xa5, 0xb7, 0xde, 0x1a, 0x51, 0x10, 0x0d, 0xd6, 0xc4, 0x8a, 0x88, 0x50, 0xb7,
-	0x9b, 0xe9, 0x74, 0xbb, 0x3f, 0x66, 0xd9, 0x99, 0x8d, 0xc9, 0x45, 0xc4, 0x53, 0x2f, 0x42, 0x8f,
-	0x7a, 0x2b, 0x5e, 0xf4, 0x4f, 0x29, 0x78, 0xe9, 0xb1, 0x78, 0x18, 0xa4, 0xbd, 0xc8, 0x1e, 0x73,
-	0x14, 0x0f, 0x92, 0x9d, 0xdd, 0x6e, 0x36, 0x07, 0x29, 0x29, 0xa8, 0x07, 0x6f, 0x99, 0xf7, 0x99,
-	0x99, 0xf7, 0xf6, 0xbd, 0x4f, 0x3e, 0x0c, 0x9c, 0xef, 0xd5, 0x5d, 0xda, 0xc1, 0xac, 0xee, 0x30,
-	0x52, 0xf3, 0x7c, 0xca, 0xa9, 0x52, 0xec, 0xd5, 0x22, 0xe8, 0xca, 0xff, 0x84, 0x12, 0x1a, 0x61,
-	0xf5, 0xe1, 0x2f, 0x59, 0xd6, 0x4e, 0xa6, 0x60, 0xa5, 0xc9, 0xc8, 0xc6, 0x70, 0xd1, 0xe6, 0xba,
-	0x85, 0x95, 0x75, 0x58, 0xda, 0x08, 0xb6, 0x6d, 0xd3, 0xb0, 0x70, 0x5f, 0x05, 0x4b, 0x60, 0xb9,
-	0xdc, 0xb8, 0x16, 0x0a, 0x04, 0xbd, 0x08, 0xdc, 0xb2, 0x70, 0x7f, 0x20, 0xd0, 0x7c, 0x5f, 0x77,
-	0xec, 0x35, 0x2d, 0xc5, 0xb4, 0x56, 0x7a, 0x4a, 0x59, 0x85, 0x85, 0xdb, 0xbb, 0xba, 0xe9, 0x32,
-	0x35, 0xbf, 0x34, 0xb5, 0x5c, 0x6a, 0x5c, 0x0d, 0x05, 0x2a, 0x18, 0x11, 0x32, 0x10, 0xa8, 0x22,
-	0xcf, 0xca, 0xb5, 0xd6, 0x8a, 0xb7, 0x2a, 0x04, 0x4e, 0x77, 0x75, 0x3b, 0xc0, 0xea, 0xd4, 0x12,
-	0x58, 0x2e, 0x35, 0x1e, 0x1d, 0x09, 0x94, 0xfb, 0x22, 0xd0, 0x4d, 0x62, 0xf2, 0xdd, 0x60, 0xbb,
-	0x66, 0x50, 0xa7, 0xee, 0x51, 0x8b, 0xaf, 0xb8, 0x98, 0xbf, 0xa4, 0xbe, 0x55, 0xf7, 0xa8, 0x61,
-	0x61, 0xbe, 0x62, 0x50, 0x1f, 0xd7, 0x79, 0xdf, 0xc3, 0xac, 0xd6, 0x30, 0xc9, 0x3d, 0x97, 0x87,
-	0x02, 0xc9, 0x8b, 0x06, 0x02, 0x95, 0x25, 0x55, 0xb4, 0xd4, 0x5a, 0x12, 0x56, 0xee, 0x40, 0xd8,
-	0xc6, 0x7e, 0xd7, 0x34, 0xf0, 0xa6, 0x6f, 0xab, 0xff, 0x45, 0x6c, 0xd7, 0x43, 0x81, 0x66, 0x98,
-	0x44, 0xb7, 0x02, 0xdf, 0x1e, 0x08, 0xa4, 0xc8, 0xb3, 0x23, 0xa0, 0xd6, 0x1a, 0x39, 0xa8, 0x1c,
-	0x00, 0x58, 0x79, 0x18, 0x70, 0x2f, 0xe0, 0xeb, 0x9d, 0x8e, 0x8f, 0x19, 0x53, 0xa7, 0x23, 0xb3,
-	0xf6, 0x42, 0x81, 0x54, 0x1a, 0x15, 0xb6, 0x74, 0x59, 0xb9, 0x41, 0x1d, 0x93, 0x63, 0xc7, 0xe3,
-	0x43, 0xeb, 0x16, 0xe5, 0xbd, 0xd9, 0x1d, 0xda, 0x77, 0x81, 0x6e, 0x5d, 0xfc, 0x4b, 0x63, 0xc6,
-	0x56, 0x56, 0xc0, 0x5a, 0x79, 0xff, 0x10, 0xe5, 0xde, 0x1d, 0x22, 0xf0, 0xed, 0x10, 0x01, 0xed,
-	0x73, 0x1e, 0x2e, 0x3c, 0xc0, 0x44, 0x37, 0xfa, 0xff, 0x02, 0x9e, 0x20, 0xe0, 0x31, 0x37, 0x3f,
-	0xe6, 0xe1, 0x5c, 0x93, 0x91, 0x06, 0x26, 0xa6, 0xbb, 0xe9, 0xb2, 0xc8, 0xc9, 0xd7, 0x00, 0x16,
-	0x93, 0xf0, 0xa5, 0x91, 0x3b, 0xa1, 0x40, 0xf3, 0x5d, 0xdd, 0x36, 0x3b, 0x3a, 0xa7, 0x7e, 0x92,
-	0xee, 0x40, 0x20, 0xf5, 0x5c, 0x68, 0xb6, 0x34, 0x61, 0xf0, 0x09, 0xad, 0xf2, 0x06, 0xc0, 0x42,
-	0xdb, 0x24, 0x2e, 0xf6, 0xd5, 0x7c, 0xda, 0x7e, 0x2c, 0x42, 0x7e, 0xd5, 0x7e, 0xd9, 0x1d, 0x13,
-	0xaa, 0x88, 0x99, 0xc7, 0x9c, 0xfa, 0x04, 0xe0, 0xe2, 0x79, 0xdf, 0xfd, 0x65, 0x7e, 0x8d, 0x49,
-	0x7d, 0x9b, 0x87, 0xa5, 0x26, 0x23, 0x9b, 0xee, 0x9e, 0x6e, 0xda, 0x4a, 0x0f, 0x56, 0x9e, 0x24,
-	0x7c, 0xc3, 0xfd, 0xb1, 0xc6, 0x56, 0x28, 0x50, 0x31, 0x55, 0x36, 0x2b, 0x95, 0x5d, 0xf2, 0x8f,
-	0x9b, 0x21, 0x52, 0x7a, 0x63, 0x21, 0xbe, 0x08, 0x05, 0x9a, 0xcd, 0x46, 0xf4, 0x5b, 0xa2, 0x7b,
-	0x0f, 0xe0, 0xdc, 0x79, 0x74, 0x7f, 0xda, 0x95, 0x31, 0x6d, 0x3f, 0xf2, 0xb0, 0xd8, 0x64, 0xa4,
-	0x8d, 0xdd, 0x8e, 0xf2, 0x0a, 0xce, 0xdc, 0xf5, 0xa9, 0x93, 0xed, 0xa5, 0xe7, 0xa1, 0x40, 0xe5,
-	0x1d, 0x9f, 0x3a, 0x23, 0x96, 0x2d, 0x48, 0x59, 0xa3, 0xe8, 0x84, 0xda, 0x46, 0x09, 0x95, 0x2e,
-	0x2c, 0x3d, 0xa6, 0x09, 0xbb, 0x8c, 0xec, 0xe9, 0x70, 0x84, 0x72, 0x3a, 0xc2, 0x1d, 0x8f, 0xd0,
-	0x14, 0x9b, 0x90, 0x39, 0xa5, 0x52, 0x2c, 0x58, 0xd0, 0x1d, 0x1a, 0xb8, 0x3c, 0x9e, 0xa1, 0xed,
-	0x4b, 0xcc, 0xd0, 0xf8, 0xa6, 0x74, 0x5e, 0xcb, 0xb5, 0xd6, 0x8a, 0x0b, 0x6b, 0xe5, 0xc4, 0xfa,
-	0xfd, 0x0f, 0x08, 0x34, 0xee, 0x1f, 0x9d, 0x56, 0xc1, 0xf1, 0x69, 0x15, 0x7c, 0x3d, 0xad, 0x82,
-	0x83, 0xb3, 0x6a, 0xee, 0xf8, 0xac, 0x9a, 0x3b, 0x39, 0xab, 0xe6, 0x9e, 0x5d, 0xe8, 0x93, 0x92,
-	0x87, 0x49, 0x24, 0x62, 0xbb, 0x10, 0x3d, 0x3e, 0x56, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x6a,
-	0x0a, 0x12, 0xe0, 0xb0, 0x08, 0x00, 0x00,
+	0x1c, 0xcd, 0x24, 0xdf, 0x26, 0x64, 0x9a, 0xb4, 0x64, 0xfb, 0x2d, 0x2c, 0x0a, 0x99, 0xb2, 0x22,
+	0xf4, 0x60, 0x13, 0xa5, 0xb7, 0xde, 0x1a, 0x51, 0x10, 0x0d, 0xd6, 0x8d, 0x15, 0x11, 0xa1, 0x6e,
+	0x37, 0xd3, 0xed, 0x76, 0x7f, 0xcc, 0xb2, 0x3b, 0x1b, 0x93, 0x8b, 0x88, 0xa7, 0x82, 0x08, 0x3d,
+	0xea, 0xad, 0x78, 0xd1, 0x3f, 0xa5, 0xe0, 0xa5, 0xc7, 0xe2, 0x61, 0x90, 0xf6, 0x22, 0x7b, 0xcc,
+	0x51, 0x3c, 0x48, 0x76, 0x76, 0xbb, 0xbb, 0x39, 0x48, 0x49, 0xd1, 0xf6, 0xe0, 0x2d, 0xf3, 0x3e,
+	0x33, 0xf3, 0x5e, 0xde, 0x9b, 0xf9, 0xec, 0xc0, 0x5a, 0xbf, 0x69, 0x93, 0x2e, 0xf6, 0x9a, 0x96,
+	0xa7, 0x35, 0x1c, 0x97, 0x50, 0x22, 0x94, 0xfa, 0x8d, 0x10, 0xba, 0xf2, 0xbf, 0x46, 0x34, 0x12,
+	0x62, 0xcd, 0xd1, 0x2f, 0x5e, 0x96, 0x8e, 0x0a, 0xb0, 0xda, 0xf6, 0xb4, 0xb5, 0xd1, 0xa0, 0x43,
+	0x15, 0x03, 0x0b, 0xab, 0xb0, 0xbc, 0xe6, 0x6f, 0x9a, 0xba, 0x6a, 0xe0, 0x81, 0x08, 0x16, 0xc0,
+	0x62, 0xa5, 0x75, 0x2d, 0x60, 0x08, 0x3a, 0x21, 0xb8, 0x61, 0xe0, 0xc1, 0x90, 0xa1, 0xda, 0x40,
+	0xb1, 0xcc, 0x15, 0x29, 0xc1, 0x24, 0x39, 0x59, 0x25, 0x2c, 0xc3, 0xe2, 0xed, 0x6d, 0x45, 0xb7,
+	0x3d, 0x31, 0xbf, 0x50, 0x58, 0x2c, 0xb7, 0xae, 0x06, 0x0c, 0x15, 0xd5, 0x10, 0x19, 0x32, 0x54,
+	0xe5, 0x6b, 0xf9, 0x58, 0x92, 0xa3, 0xa9, 0x82, 0x06, 0xa7, 0x7a, 0x8a, 0xe9, 0x63, 0xb1, 0xb0,
+	0x00, 0x16, 0xcb, 0xad, 0x47, 0x07, 0x0c, 0xe5, 0xbe, 0x32, 0x74, 0x53, 0xd3, 0xe9, 0xb6, 0xbf,
+	0xd9, 0x50, 0x89, 0xd5, 0x74, 0x88, 0x41, 0x97, 0x6c, 0x4c, 0x5f, 0x12, 0xd7, 0x68, 0x3a, 0x44,
+	0x35, 0x30, 0x5d, 0x52, 0x89, 0x8b, 0x9b, 0x74, 0xe0, 0x60, 0xaf, 0xd1, 0xd2, 0xb5, 0x7b, 0x36,
+	0x0d, 0x18, 0xe2, 0x1b, 0x0d, 0x19, 0xaa, 0x70, 0xaa, 0x70, 0x28, 0xc9, 0x1c, 0x16, 0xee, 0x40,
+	0xd8, 0xc1, 0x6e, 0x4f, 0x57, 0xf1, 0xba, 0x6b, 0x8a, 0xff, 0x85, 0x6c, 0xd7, 0x03, 0x86, 0xa6,
+	0x3d, 0x8e, 0x6e, 0xf8, 0xae, 0x39, 0x64, 0x48, 0xe0, 0x6b, 0x53, 0xa0, 0x24, 0xa7, 0x16, 0x0a,
+	0x7b, 0x00, 0x56, 0x1f, 0xfa, 0xd4, 0xf1, 0xe9, 0x6a, 0xb7, 0xeb, 0x62, 0xcf, 0x13, 0xa7, 0x42,
+	0xb3, 0x76, 0x02, 0x86, 0x44, 0x12, 0x16, 0x36, 0x14, 0x5e, 0xb9, 0x41, 0x2c, 0x9d, 0x62, 0xcb,
+	0xa1, 0x23, 0xeb, 0xe6, 0xf9, 0xbe, 0xd9, 0x19, 0xd2, 0x0f, 0x86, 0x6e, 0x9d, 0xfd, 0x9f, 0x46,
+	0x8c, 0x72, 0x56, 0xc0, 0x4a, 0x65, 0x77, 0x1f, 0xe5, 0xde, 0xef, 0x23, 0xf0, 0x7d, 0x1f, 0x01,
+	0xe9, 0x4b, 0x1e, 0xce, 0x3d, 0xc0, 0x9a, 0xa2, 0x0e, 0xfe, 0x05, 0x3c, 0x41, 0xc0, 0x63, 0x6e,
+	0x7e, 0xca, 0xc3, 0xd9, 0xb6, 0xa7, 0xb5, 0xb0, 0xa6, 0xdb, 0xeb, 0xb6, 0x17, 0x3a, 0xf9, 0x1a,
+	0xc0, 0x52, 0x1c, 0x3e, 0x37, 0x72, 0x2b, 0x60, 0xa8, 0xd6, 0x53, 0x4c, 0xbd, 0xab, 0x50, 0xe2,
+	0xc6, 0xe9, 0x0e, 0x19, 0x12, 0x4f, 0x85, 0x66, 0x4b, 0x13, 0x06, 0x1f, 0xd3, 0x0a, 0x6f, 0x00,
+	0x2c, 0x76, 0x74, 0xcd, 0xc6, 0xae, 0x98, 0x4f, 0x8e, 0x9f, 0x17, 0x22, 0xbf, 0x3b, 0x7e, 0xd9,
+	0x19, 0x13, 0xaa, 0x88, 0x98, 0xc7, 0x9c, 0xfa, 0x0c, 0xe0, 0xfc, 0xe9, 0xb9, 0xbb, 0x64, 0x7e,
+	0x8d, 0x49, 0x7d, 0x97, 0x87, 0xe5, 0xb6, 0xa7, 0xad, 0xdb, 0x3b, 0x8a, 0x6e, 0x0a, 0x7d, 0x58,
+	0x7d, 0x12, 0xf3, 0x8d, 0xe6, 0x47, 0x1a, 0xe5, 0x80, 0xa1, 0x52, 0xa2, 0x6c, 0x86, 0x2b, 0x3b,
+	0xe7, 0xc5, 0xcd, 0x10, 0x09, 0xfd, 0xb1, 0x10, 0x5f, 0x04, 0x0c, 0xcd, 0x64, 0x23, 0xfa, 0x2b,
+	0xd1, 0x7d, 0x00, 0x70, 0xf6, 0x34, 0xba, 0x8b, 0x76, 0x65, 0x4c, 0xdb, 0xcf, 0x3c, 0x2c, 0xb5,
+	0x3d, 0xad, 0x83, 0xed, 0xae, 0xf0, 0x0a, 0x4e, 0xdf, 0x75, 0x89, 0x95, 0x3d, 0x4b, 0xcf, 0x03,
+	0x86, 0x2a, 0x5b, 0x2e, 0xb1, 0x52, 0x96, 0xcd, 0x71, 0x59, 0x69, 0x74, 0x42, 0x6d, 0x69, 0x42,
+	0xa1, 0x07, 0xcb, 0x8f, 0x49, 0xcc, 0xce, 0x23, 0x7b, 0x3a, 0x6a, 0xa1, 0x94, 0xa4, 0xb8, 0xa3,
+	0x16, 0x4a, 0xc9, 0x39, 0x99, 0x13, 0x2a, 0xc1, 0x80, 0x45, 0xc5, 0x22, 0xbe, 0x4d, 0xa3, 0x1e,
+	0xda, 0x39, 0x47, 0x0f, 0x8d, 0x76, 0x4a, 0xfa, 0x35, 0x1f, 0x4b, 0x72, 0x54, 0x58, 0xa9, 0xc4,
+	0xd6, 0xef, 0x7e, 0x44, 0x40, 0x7a, 0x5b, 0x80, 0xb5, 0xd1, 0x77, 0x44, 0x71, 0xa9, 0xae, 0x98,
+	0x97, 0xa8, 0x03, 0x26, 0x9e, 0xe4, 0xff, 0xb8, 0x27, 0xa9, 0x8b, 0x5a, 0xb8, 0xc8, 0x8b, 0xda,
+	0xba, 0x7f, 0x70, 0x5c, 0x07, 0x87, 0xc7, 0x75, 0xf0, 0xed, 0xb8, 0x0e, 0xf6, 0x4e, 0xea, 0xb9,
+	0xc3, 0x93, 0x7a, 0xee, 0xe8, 0xa4, 0x9e, 0x7b, 0x76, 0x26, 0x8a, 0xf8, 0x99, 0x18, 0x52, 0x6d,
+	0x16, 0xc3, 0xa7, 0xe0, 0xf2, 0xaf, 0x01, 0x00, 0x9f, 0xa2, 0x64, 0x5e, 0x3e, 0x0a, 0x00, 0x00,
 }
 
 func (this *MsgProtoStake) Equal(that interface{}) bool {
@@ -582,6 +624,36 @@ func (this *MsgSend) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgPartialUnstake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPartialUnstake)
+	if !ok {
+		that2, ok := that.(MsgPartialUnstake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !bytes.Equal(this.Signer, that1.Signer) {
+		return false
+	}
+	return true
+}
 func (m *MsgProtoStake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -882,6 +954,53 @@ func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPartialUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsg(v)
 	base := offset
@@ -1026,6 +1145,25 @@ func (m *MsgSend) Size() (n int) {
 	return n
 }
 
+func (m *MsgPartialUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsg(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
 func sovMsg(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2007,6 +2145,161 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPartialUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsg(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -207,64 +207,112 @@ func (m *ValidatorSigningInfo) GetJailedBlocksCounter() int64 {
 	return 0
 }
 
+// PartialUnstake defines an amount removed from a staked validator that is waiting out the unstaking time
+type PartialUnstake struct {
+	Address        github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=Address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address" yaml:"address"`
+	OutputAddress  github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=OutputAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"output_address" yaml:"output_address"`
+	Amount         github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=Amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount"`
+	CompletionTime time.Time                                         `protobuf:"bytes,4,opt,name=CompletionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *PartialUnstake) Reset()         { *m = PartialUnstake{} }
+func (m *PartialUnstake) String() string { return proto.CompactTextString(m) }
+func (*PartialUnstake) ProtoMessage()    {}
+func (*PartialUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63cb49073b61e33a, []int{3}
+}
+func (m *PartialUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartialUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialUnstake.Merge(m, src)
+}
+func (m *PartialUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialUnstake proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ProtoValidator)(nil), "x.nodes.ProtoValidator")
 	proto.RegisterType((*LegacyProtoValidator)(nil), "x.nodes.LegacyProtoValidator")
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "x.nodes.ValidatorSigningInfo")
+	proto.RegisterType((*PartialUnstake)(nil), "x.nodes.PartialUnstake")
 }
 
 func init() { proto.RegisterFile("x/nodes/nodes.proto", fileDescriptor_63cb49073b61e33a) }
 
 var fileDescriptor_63cb49073b61e33a = []byte{
-	// 767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xbf, 0x6f, 0xf3, 0x44,
-	0x18, 0xc7, 0x63, 0xda, 0x24, 0xcd, 0x25, 0x14, 0xe1, 0xb4, 0xc2, 0xaa, 0x50, 0x2e, 0x32, 0x03,
-	0x19, 0x68, 0x0c, 0x74, 0xa2, 0x12, 0x12, 0x75, 0x17, 0x4a, 0x2b, 0x51, 0xb9, 0x2d, 0x43, 0x17,
-	0xcb, 0xb1, 0x2f, 0xce, 0xd5, 0x3f, 0xce, 0xf2, 0x9d, 0xa1, 0xf9, 0x0f, 0x60, 0xeb, 0xd8, 0x31,
-	0x7f, 0x4e, 0xc7, 0x2e, 0x48, 0x88, 0xe1, 0x40, 0xad, 0x84, 0x90, 0xc7, 0xb0, 0x31, 0x21, 0xdf,
-	0x39, 0x24, 0xa9, 0xf2, 0xbe, 0xaa, 0xaa, 0x77, 0xec, 0x52, 0xfb, 0x3e, 0xf7, 0xdc, 0xf3, 0xbd,
-	0xfa, 0xf9, 0x0c, 0x01, 0xed, 0x6b, 0x23, 0x26, 0x1e, 0xa2, 0xf2, 0x6f, 0x3f, 0x49, 0x09, 0x23,
-	0x6a, 0xfd, 0xba, 0x2f, 0x96, 0x3b, 0x5b, 0x3e, 0xf1, 0x89, 0x60, 0x46, 0xf1, 0x26, 0xb7, 0x77,
-	0xa0, 0x4f, 0x88, 0x1f, 0x22, 0x43, 0xac, 0x06, 0xd9, 0xd0, 0x60, 0x38, 0x42, 0x94, 0x39, 0x51,
-	0x52, 0x16, 0x74, 0x9e, 0x16, 0x78, 0x59, 0xea, 0x30, 0x4c, 0x62, 0xb9, 0xaf, 0xff, 0x53, 0x05,
-	0x9b, 0xa7, 0xc5, 0xdb, 0x0f, 0x4e, 0x88, 0x3d, 0x87, 0x91, 0x54, 0x0d, 0x41, 0xfd, 0xc0, 0xf3,
-	0x52, 0x44, 0xa9, 0xa6, 0x74, 0x95, 0x5e, 0xcb, 0xb4, 0x72, 0x0e, 0xeb, 0x8e, 0x44, 0x53, 0x0e,
-	0x37, 0xc7, 0x4e, 0x14, 0xee, 0xeb, 0x25, 0xd0, 0xff, 0xe5, 0xf0, 0x0b, 0x1f, 0xb3, 0x51, 0x36,
-	0xe8, 0xbb, 0x24, 0x32, 0x12, 0x12, 0xb0, 0xdd, 0x18, 0xb1, 0x9f, 0x48, 0x1a, 0x18, 0x09, 0x71,
-	0x03, 0xc4, 0x76, 0x5d, 0x92, 0x22, 0x83, 0x8d, 0x13, 0x44, 0xfb, 0x65, 0x67, 0x6b, 0x16, 0xa1,
-	0x1e, 0x80, 0xc6, 0x69, 0x36, 0x08, 0xb1, 0x7b, 0x8c, 0xc6, 0xda, 0x7b, 0x22, 0xef, 0x93, 0x9c,
-	0x43, 0x90, 0x08, 0x68, 0x07, 0x68, 0x3c, 0xe5, 0xf0, 0x43, 0x19, 0x39, 0x67, 0xba, 0x35, 0x3f,
-	0xa5, 0xea, 0xa0, 0x76, 0xe5, 0xe0, 0x10, 0x79, 0xda, 0x5a, 0x57, 0xe9, 0x6d, 0x98, 0x20, 0xe7,
-	0xb0, 0x24, 0x56, 0xf9, 0x2c, 0x6a, 0x28, 0x73, 0x58, 0x46, 0xb5, 0xf5, 0xae, 0xd2, 0xab, 0xca,
-	0x1a, 0x49, 0xac, 0xf2, 0x59, 0xd4, 0x1c, 0x8e, 0x1c, 0x1c, 0x53, 0xad, 0xda, 0x5d, 0xeb, 0x35,
-	0x64, 0x8d, 0x2b, 0x88, 0x55, 0xee, 0xa8, 0x06, 0x00, 0x67, 0x28, 0xfd, 0x11, 0xbb, 0xe8, 0xc2,
-	0x3a, 0xd1, 0x6a, 0x5d, 0xa5, 0xd7, 0x30, 0x3f, 0xc8, 0x39, 0x6c, 0x52, 0x49, 0xed, 0x2c, 0x0d,
-	0xad, 0x85, 0x12, 0x75, 0x08, 0x5a, 0x67, 0xcc, 0x09, 0x90, 0x77, 0x4e, 0x02, 0x14, 0x53, 0xad,
-	0x2e, 0x8e, 0x98, 0x77, 0x1c, 0x56, 0x7e, 0xe7, 0xf0, 0xf3, 0xe7, 0x7f, 0x39, 0x13, 0xfb, 0x47,
-	0x31, 0x2b, 0xae, 0xc4, 0x44, 0x27, 0x6b, 0xa9, 0xaf, 0xfa, 0x8b, 0x02, 0x3e, 0xba, 0x88, 0x29,
-	0x73, 0x02, 0x1c, 0xfb, 0x87, 0x24, 0x4a, 0x42, 0x54, 0x8c, 0xf9, 0x1c, 0x47, 0x48, 0xdb, 0xe8,
-	0x2a, 0xbd, 0xe6, 0x97, 0x3b, 0x7d, 0xe9, 0x42, 0x7f, 0xe6, 0x42, 0xff, 0x7c, 0x26, 0x8b, 0xb9,
-	0x57, 0xdc, 0x27, 0xe7, 0x70, 0x33, 0x9b, 0xb5, 0xb0, 0x0b, 0x93, 0xa6, 0x1c, 0x6e, 0xcb, 0x4f,
-	0xbf, 0xcc, 0xf5, 0x9b, 0x3f, 0xa0, 0x62, 0xbd, 0x29, 0x4f, 0xbd, 0x51, 0xc0, 0xfb, 0xdf, 0x67,
-	0x2c, 0xc9, 0xd8, 0x4c, 0xa4, 0x86, 0x18, 0xec, 0x55, 0xce, 0xa1, 0x46, 0xc4, 0x86, 0x5d, 0xea,
-	0xf3, 0x19, 0x89, 0x30, 0x43, 0x51, 0xc2, 0xc6, 0xf3, 0xac, 0xe5, 0x8a, 0x17, 0x0a, 0xb6, 0x7c,
-	0x81, 0xfd, 0xd6, 0xcf, 0x13, 0x58, 0xb9, 0x9d, 0x40, 0xe5, 0xef, 0x09, 0x54, 0xf4, 0xbf, 0xd6,
-	0xc1, 0xd6, 0x09, 0xf2, 0x1d, 0x77, 0xfc, 0xea, 0xfe, 0xab, 0xfb, 0xef, 0xd2, 0xfd, 0x27, 0xa2,
-	0xfd, 0xba, 0x0e, 0xb6, 0xfe, 0xb7, 0xeb, 0x0c, 0xfb, 0x31, 0x8e, 0xfd, 0xa3, 0x78, 0x48, 0xd4,
-	0x4b, 0x30, 0xb3, 0xaa, 0x14, 0xed, 0x9b, 0x05, 0xd1, 0x5e, 0xa8, 0x55, 0x79, 0x5a, 0xfd, 0x0e,
-	0xb4, 0x28, 0x73, 0x52, 0x66, 0x8f, 0x10, 0xf6, 0x47, 0x4c, 0x98, 0xb5, 0x66, 0x7e, 0x9a, 0x73,
-	0xb8, 0xc4, 0xa7, 0x1c, 0xb6, 0xe5, 0x3f, 0xb8, 0x48, 0x75, 0xab, 0x29, 0x96, 0xdf, 0x8a, 0x95,
-	0xfa, 0x35, 0xa8, 0x1e, 0xc5, 0x1e, 0xba, 0x16, 0x7a, 0x95, 0x4d, 0x70, 0x01, 0x6c, 0x32, 0x1c,
-	0x52, 0xb4, 0xd0, 0x64, 0x91, 0xea, 0x96, 0x3c, 0xa5, 0xc6, 0xa0, 0x25, 0x25, 0xb4, 0xb3, 0x98,
-	0xe1, 0x50, 0x08, 0xf8, 0xf6, 0x69, 0x18, 0xe5, 0x34, 0x96, 0xce, 0xcd, 0x53, 0x16, 0xa9, 0x9c,
-	0x44, 0x53, 0xa2, 0x8b, 0x82, 0xa8, 0x11, 0xd8, 0x8e, 0x30, 0xa5, 0xc8, 0xb3, 0x07, 0x21, 0x71,
-	0x03, 0x6a, 0xbb, 0x24, 0x8b, 0x19, 0x4a, 0xb5, 0xaa, 0xb8, 0xfe, 0x57, 0x39, 0x87, 0xab, 0x0b,
-	0xa6, 0x1c, 0x7e, 0x2c, 0x13, 0x56, 0x6e, 0xeb, 0x56, 0x5b, 0x72, 0x53, 0xe0, 0x43, 0x49, 0x8b,
-	0xb8, 0xf2, 0x42, 0x4f, 0xe2, 0x6a, 0xf3, 0xb8, 0x95, 0x05, 0xf3, 0xb8, 0x95, 0xdb, 0xba, 0xd5,
-	0x96, 0x7c, 0x29, 0x6e, 0x7f, 0xe3, 0x76, 0x02, 0x2b, 0x85, 0x57, 0xe6, 0xf1, 0xdd, 0x43, 0x47,
-	0xb9, 0x7f, 0xe8, 0x28, 0x7f, 0x3e, 0x74, 0x94, 0x9b, 0xc7, 0x4e, 0xe5, 0xfe, 0xb1, 0x53, 0xf9,
-	0xed, 0xb1, 0x53, 0xb9, 0x7c, 0x96, 0x38, 0xb3, 0x5f, 0x1a, 0x42, 0xa0, 0x41, 0x4d, 0x8c, 0x61,
-	0xef, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x43, 0xc2, 0x5b, 0xa7, 0x81, 0x08, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x63, 0xd2, 0x24, 0xed, 0x34, 0x64, 0x85, 0xdb, 0x05, 0xab, 0x42, 0x99, 0xc8, 0x1c,
+	0xc8, 0x81, 0x8d, 0x81, 0x15, 0x07, 0x2a, 0x21, 0x51, 0xf7, 0x42, 0xd9, 0x95, 0xa8, 0xdc, 0x96,
+	0x43, 0x2f, 0x66, 0x62, 0x4f, 0xdc, 0x59, 0xff, 0x18, 0xcb, 0x33, 0x86, 0xe6, 0x1f, 0x40, 0x70,
+	0xeb, 0x71, 0x8f, 0xfd, 0x73, 0xf6, 0xb8, 0x17, 0x24, 0xc4, 0x61, 0x40, 0xad, 0x84, 0x90, 0xc5,
+	0x29, 0xdc, 0x38, 0x21, 0xcf, 0x38, 0x9b, 0x38, 0x14, 0x76, 0xb5, 0xfc, 0x38, 0xf5, 0x52, 0x7b,
+	0xbe, 0xef, 0xcd, 0xfb, 0x3e, 0xcf, 0x7c, 0xfa, 0x14, 0xb0, 0x75, 0x6e, 0x25, 0xd4, 0xc7, 0x4c,
+	0xfd, 0x1d, 0xa5, 0x19, 0xe5, 0x54, 0xef, 0x9c, 0x8f, 0xe4, 0x72, 0x67, 0x3b, 0xa0, 0x01, 0x95,
+	0x9a, 0x55, 0xbe, 0xa9, 0xf0, 0x0e, 0x0c, 0x28, 0x0d, 0x22, 0x6c, 0xc9, 0xd5, 0x38, 0x9f, 0x58,
+	0x9c, 0xc4, 0x98, 0x71, 0x14, 0xa7, 0x55, 0x42, 0x7f, 0x35, 0xc1, 0xcf, 0x33, 0xc4, 0x09, 0x4d,
+	0x54, 0xdc, 0xfc, 0xad, 0x05, 0x7a, 0x87, 0xe5, 0xdb, 0xe7, 0x28, 0x22, 0x3e, 0xe2, 0x34, 0xd3,
+	0x23, 0xd0, 0xd9, 0xf3, 0xfd, 0x0c, 0x33, 0x66, 0x68, 0x03, 0x6d, 0xd8, 0xb5, 0x9d, 0x42, 0xc0,
+	0x0e, 0x52, 0xd2, 0x4c, 0xc0, 0xde, 0x14, 0xc5, 0xd1, 0xae, 0x59, 0x09, 0xe6, 0xef, 0x02, 0xbe,
+	0x17, 0x10, 0x7e, 0x96, 0x8f, 0x47, 0x1e, 0x8d, 0xad, 0x94, 0x86, 0xfc, 0x5e, 0x82, 0xf9, 0x57,
+	0x34, 0x0b, 0xad, 0x94, 0x7a, 0x21, 0xe6, 0xf7, 0x3c, 0x9a, 0x61, 0x8b, 0x4f, 0x53, 0xcc, 0x46,
+	0x55, 0x65, 0x67, 0x6e, 0xa1, 0xef, 0x81, 0x8d, 0xc3, 0x7c, 0x1c, 0x11, 0xef, 0x01, 0x9e, 0x1a,
+	0xaf, 0x48, 0xbf, 0xb7, 0x0a, 0x01, 0x41, 0x2a, 0x45, 0x37, 0xc4, 0xd3, 0x99, 0x80, 0xaf, 0x29,
+	0xcb, 0x85, 0x66, 0x3a, 0x8b, 0x5d, 0xba, 0x09, 0xda, 0x8f, 0x10, 0x89, 0xb0, 0x6f, 0x34, 0x07,
+	0xda, 0x70, 0xdd, 0x06, 0x85, 0x80, 0x95, 0xe2, 0x54, 0xcf, 0x32, 0x87, 0x71, 0xc4, 0x73, 0x66,
+	0xac, 0x0d, 0xb4, 0x61, 0x4b, 0xe5, 0x28, 0xc5, 0xa9, 0x9e, 0x65, 0xce, 0xfe, 0x19, 0x22, 0x09,
+	0x33, 0x5a, 0x83, 0xe6, 0x70, 0x43, 0xe5, 0x78, 0x52, 0x71, 0xaa, 0x88, 0x6e, 0x01, 0x70, 0x84,
+	0xb3, 0x2f, 0x89, 0x87, 0x4f, 0x9c, 0x87, 0x46, 0x7b, 0xa0, 0x0d, 0x37, 0xec, 0x3b, 0x85, 0x80,
+	0x9b, 0x4c, 0xa9, 0x6e, 0x9e, 0x45, 0xce, 0x52, 0x8a, 0x3e, 0x01, 0xdd, 0x23, 0x8e, 0x42, 0xec,
+	0x1f, 0xd3, 0x10, 0x27, 0xcc, 0xe8, 0xc8, 0x2d, 0xf6, 0x13, 0x01, 0x1b, 0x3f, 0x08, 0xf8, 0xee,
+	0x8b, 0x9f, 0x9c, 0x4d, 0x82, 0x83, 0x84, 0x97, 0x2d, 0x71, 0x59, 0xc9, 0xa9, 0xd5, 0xd5, 0xbf,
+	0xd5, 0xc0, 0x1b, 0x27, 0x09, 0xe3, 0x28, 0x24, 0x49, 0xb0, 0x4f, 0xe3, 0x34, 0xc2, 0xe5, 0x35,
+	0x1f, 0x93, 0x18, 0x1b, 0xeb, 0x03, 0x6d, 0xb8, 0xf9, 0xfe, 0xce, 0x48, 0xb1, 0x30, 0x9a, 0xb3,
+	0x30, 0x3a, 0x9e, 0xc3, 0x62, 0xdf, 0x2f, 0xfb, 0x29, 0x04, 0xec, 0xe5, 0xf3, 0x12, 0x6e, 0x49,
+	0xd2, 0x4c, 0xc0, 0xbb, 0xea, 0xe8, 0xeb, 0xba, 0x79, 0xf1, 0x23, 0xd4, 0x9c, 0xbf, 0xf2, 0xd3,
+	0x2f, 0x34, 0xf0, 0xea, 0x67, 0x39, 0x4f, 0x73, 0x3e, 0x07, 0x69, 0x43, 0x5e, 0xec, 0xa3, 0x42,
+	0x40, 0x83, 0xca, 0x80, 0x5b, 0xe1, 0xf3, 0x0e, 0x8d, 0x09, 0xc7, 0x71, 0xca, 0xa7, 0x0b, 0xaf,
+	0x7a, 0xc6, 0x4b, 0x02, 0x56, 0x6f, 0x60, 0xb7, 0xfb, 0xcd, 0x25, 0x6c, 0x3c, 0xbe, 0x84, 0xda,
+	0x2f, 0x97, 0x50, 0x33, 0x7f, 0x5e, 0x03, 0xdb, 0x0f, 0x71, 0x80, 0xbc, 0xe9, 0x2d, 0xfb, 0xb7,
+	0xec, 0xff, 0x9b, 0xec, 0xaf, 0x80, 0xf6, 0xdd, 0x1a, 0xd8, 0x7e, 0x46, 0xd7, 0x11, 0x09, 0x12,
+	0x92, 0x04, 0x07, 0xc9, 0x84, 0xea, 0xa7, 0xa0, 0x83, 0x6a, 0xa0, 0x7d, 0xbc, 0x04, 0xda, 0x4b,
+	0x62, 0x55, 0xed, 0xd6, 0x3f, 0x05, 0x5d, 0xc6, 0x51, 0xc6, 0xdd, 0x33, 0x4c, 0x82, 0x33, 0x2e,
+	0xc9, 0x6a, 0xda, 0x6f, 0x17, 0x02, 0xd6, 0xf4, 0x99, 0x80, 0x5b, 0xea, 0x03, 0x97, 0x55, 0xd3,
+	0xd9, 0x94, 0xcb, 0x4f, 0xe4, 0x4a, 0xff, 0x08, 0xb4, 0x0e, 0x12, 0x1f, 0x9f, 0x1b, 0xcd, 0x45,
+	0x11, 0x52, 0x0a, 0x2e, 0x9d, 0x4c, 0x18, 0x5e, 0x2a, 0xb2, 0xac, 0x9a, 0x8e, 0xda, 0xa5, 0x27,
+	0xa0, 0xab, 0x20, 0x74, 0xf3, 0x84, 0x93, 0xc8, 0x58, 0x7b, 0xee, 0x6d, 0x58, 0xd5, 0x6d, 0xd4,
+	0xf6, 0x2d, 0x5c, 0x96, 0x55, 0x75, 0x13, 0x9b, 0x4a, 0x3a, 0x29, 0x15, 0x3d, 0x06, 0x77, 0x63,
+	0xc2, 0x18, 0xf6, 0xdd, 0x71, 0x44, 0xbd, 0x90, 0xb9, 0x1e, 0xcd, 0x13, 0x8e, 0x33, 0xa3, 0x25,
+	0xdb, 0xff, 0xb0, 0x10, 0xf0, 0xe6, 0x84, 0x99, 0x80, 0x6f, 0x2a, 0x87, 0x1b, 0xc3, 0xa6, 0xb3,
+	0xa5, 0x74, 0x5b, 0xca, 0xfb, 0x4a, 0x2d, 0xed, 0xaa, 0x86, 0x56, 0xec, 0xda, 0x0b, 0xbb, 0x1b,
+	0x13, 0x16, 0x76, 0x37, 0x86, 0x4d, 0x67, 0x4b, 0xe9, 0x35, 0xbb, 0xdd, 0xf5, 0xc7, 0x97, 0xb0,
+	0x21, 0xb9, 0xfa, 0xb5, 0x09, 0x7a, 0x87, 0x28, 0xe3, 0x04, 0x45, 0x0a, 0x44, 0xfc, 0x3f, 0x8f,
+	0xae, 0xaf, 0xff, 0x34, 0xe2, 0xd5, 0xfc, 0xfa, 0xa2, 0xfc, 0x47, 0xaa, 0x0f, 0xf0, 0xff, 0x74,
+	0xb0, 0xeb, 0xa7, 0xa0, 0xbd, 0x17, 0x97, 0xe7, 0x63, 0x34, 0xff, 0xf9, 0x74, 0x41, 0xb2, 0x92,
+	0x53, 0x55, 0xd4, 0xa7, 0xa0, 0xb7, 0x32, 0x4d, 0x9e, 0xcf, 0xef, 0x07, 0x15, 0xbf, 0x77, 0xbc,
+	0x67, 0x3b, 0xe7, 0xe3, 0xe4, 0x75, 0x75, 0x0a, 0x2b, 0x01, 0x45, 0x71, 0xef, 0xef, 0xc6, 0x88,
+	0xfd, 0xe0, 0xc9, 0x55, 0x5f, 0x7b, 0x7a, 0xd5, 0xd7, 0x7e, 0xba, 0xea, 0x6b, 0x17, 0xd7, 0xfd,
+	0xc6, 0xd3, 0xeb, 0x7e, 0xe3, 0xfb, 0xeb, 0x7e, 0xe3, 0xf4, 0x85, 0x0e, 0x70, 0xfe, 0xc3, 0x52,
+	0x7e, 0xee, 0xb8, 0x2d, 0xbb, 0xbe, 0xff, 0xc7, 0x00, 0x3c, 0xc5, 0xde, 0xea, 0x70, 0x0a, 0x00,
+	0x00,
 }
 
 func (this *ProtoValidator) Equal(that interface{}) bool {
@@ -409,6 +457,39 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PartialUnstake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PartialUnstake)
+	if !ok {
+		that2, ok := that.(PartialUnstake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.OutputAddress, that1.OutputAddress) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	return true
+}
 func (m *ProtoValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -646,6 +727,61 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PartialUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartialUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartialUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintNodes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNodes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OutputAddress) > 0 {
+		i -= len(m.OutputAddress)
+		copy(dAtA[i:], m.OutputAddress)
+		i = encodeVarintNodes(dAtA, i, uint64(len(m.OutputAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNodes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNodes(v)
 	base := offset
@@ -762,6 +898,27 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *PartialUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodes(uint64(l))
+	}
+	l = len(m.OutputAddress)
+	if l > 0 {
+		n += 1 + l + sovNodes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovNodes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovNodes(uint64(l))
+	return n
+}
+
 func sovNodes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1580,6 +1737,194 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PartialUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputAddress = append(m.OutputAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputAddress == nil {
+				m.OutputAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNodes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -44,6 +47,7 @@ var (
 	KeyProposerAllocation          = []byte("ProposerPercentage")
 	KeyMaxChains                   = []byte("MaximumChains")
 	KeyMaxJailedBlocks             = []byte("MaxJailedBlocks")
+	KeyRelaysToTokensMultiplierMap = []byte("RelaysToTokensMultiplierMap")
 	DoubleSignJailEndTime          = time.Unix(253402300799, 0) // forever
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
@@ -54,22 +58,23 @@ var _ sdk.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for pos module
 type Params struct {
-	RelaysToTokensMultiplier int64         `json:"relays_to_tokens_multiplier" yaml:"relays_to_tokens_multiplier"`
-	UnstakingTime            time.Duration `json:"unstaking_time" yaml:"unstaking_time"`                   // how much time must pass between the begin_unstaking_tx and the node going to -> unstaked status
-	MaxValidators            int64         `json:"max_validators" yaml:"max_validators"`                   // maximum number of validators in the network at any given block
-	StakeDenom               string        `json:"stake_denom" yaml:"stake_denom"`                         // the monetary denomination of the coins in the network `uPOKT` or `uAtom` or `Wei`
-	StakeMinimum             int64         `json:"stake_minimum" yaml:"stake_minimum"`                     // minimum amount of `uPOKT` needed to stake in the network as a node
-	SessionBlockFrequency    int64         `json:"session_block_frequency" yaml:"session_block_frequency"` // how many blocks are in a session (pocket network unit)
-	DAOAllocation            int64         `json:"dao_allocation" yaml:"dao_allocation"`
-	ProposerAllocation       int64         `json:"proposer_allocation" yaml:"proposer_allocation"`
-	MaximumChains            int64         `json:"maximum_chains" yaml:"maximum_chains"`
-	MaxJailedBlocks          int64         `json:"max_jailed_blocks" yaml:"max_jailed_blocks"`
-	MaxEvidenceAge           time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`                     // maximum age of tendermint evidence that is still valid (currently not implemented in Cosmos or Pocket-Core)
-	SignedBlocksWindow       int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`             // window of time in blocks (unit) used for signature verification -> specifically in not signing (missing) blocks
-	MinSignedPerWindow       sdk.BigDec    `json:"min_signed_per_window" yaml:"min_signed_per_window"`           // minimum number of blocks the node must sign per window
-	DowntimeJailDuration     time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`         // minimum amount of time node must spend in jail after missing blocks
-	SlashFractionDoubleSign  sdk.BigDec    `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"` // the factor of which a node is slashed for a double sign
-	SlashFractionDowntime    sdk.BigDec    `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`       // the factor of which a node is slashed for missing blocks
+	RelaysToTokensMultiplier    int64            `json:"relays_to_tokens_multiplier" yaml:"relays_to_tokens_multiplier"`
+	RelaysToTokensMultiplierMap ChainMultipliers `json:"relays_to_tokens_multiplier_map" yaml:"relays_to_tokens_multiplier_map"` // the multiplier per relay chain, chains not in the map use the global multiplier
+	UnstakingTime               time.Duration    `json:"unstaking_time" yaml:"unstaking_time"`                                   // how much time must pass between the begin_unstaking_tx and the node going to -> unstaked status
+	MaxValidators               int64            `json:"max_validators" yaml:"max_validators"`                                   // maximum number of validators in the network at any given block
+	StakeDenom                  string           `json:"stake_denom" yaml:"stake_denom"`                                         // the monetary denomination of the coins in the network `uPOKT` or `uAtom` or `Wei`
+	StakeMinimum                int64            `json:"stake_minimum" yaml:"stake_minimum"`                                     // minimum amount of `uPOKT` needed to stake in the network as a node
+	SessionBlockFrequency       int64            `json:"session_block_frequency" yaml:"session_block_frequency"`                 // how many blocks are in a session (pocket network unit)
+	DAOAllocation               int64            `json:"dao_allocation" yaml:"dao_allocation"`
+	ProposerAllocation          int64            `json:"proposer_allocation" yaml:"proposer_allocation"`
+	MaximumChains               int64            `json:"maximum_chains" yaml:"maximum_chains"`
+	MaxJailedBlocks             int64            `json:"max_jailed_blocks" yaml:"max_jailed_blocks"`
+	MaxEvidenceAge              time.Duration    `json:"max_evidence_age" yaml:"max_evidence_age"`                     // maximum age of tendermint evidence that is still valid (currently not implemented in Cosmos or Pocket-Core)
+	SignedBlocksWindow          int64            `json:"signed_blocks_window" yaml:"signed_blocks_window"`             // window of time in blocks (unit) used for signature verification -> specifically in not signing (missing) blocks
+	MinSignedPerWindow          sdk.BigDec       `json:"min_signed_per_window" yaml:"min_signed_per_window"`           // minimum number of blocks the node must sign per window
+	DowntimeJailDuration        time.Duration    `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`         // minimum amount of time node must spend in jail after missing blocks
+	SlashFractionDoubleSign     sdk.BigDec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"` // the factor of which a node is slashed for a double sign
+	SlashFractionDowntime       sdk.BigDec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`       // the factor of which a node is slashed for missing blocks
 }
 
 // Implements sdk.ParamSet
@@ -91,28 +96,30 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: KeyRelaysToTokensMultiplier, Value: &p.RelaysToTokensMultiplier},
 		{Key: KeyMaxChains, Value: &p.MaximumChains},
 		{Key: KeyMaxJailedBlocks, Value: &p.MaxJailedBlocks},
+		{Key: KeyRelaysToTokensMultiplierMap, Value: &p.RelaysToTokensMultiplierMap},
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		UnstakingTime:            DefaultUnstakingTime,
-		MaxValidators:            DefaultMaxValidators,
-		StakeMinimum:             DefaultMinStake,
-		StakeDenom:               sdk.DefaultStakeDenom,
-		MaxEvidenceAge:           DefaultMaxEvidenceAge,
-		SignedBlocksWindow:       DefaultSignedBlocksWindow,
-		MinSignedPerWindow:       DefaultMinSignedPerWindow,
-		DowntimeJailDuration:     DefaultDowntimeJailDuration,
-		SlashFractionDoubleSign:  DefaultSlashFractionDoubleSign,
-		SlashFractionDowntime:    DefaultSlashFractionDowntime,
-		SessionBlockFrequency:    DefaultSessionBlocktime,
-		DAOAllocation:            DefaultDAOAllocation,
-		ProposerAllocation:       DefaultProposerAllocation,
-		RelaysToTokensMultiplier: DefaultRelaysToTokensMultiplier,
-		MaximumChains:            DefaultMaxChains,
-		MaxJailedBlocks:          DefaultMaxJailedBlocks,
+		UnstakingTime:               DefaultUnstakingTime,
+		MaxValidators:               DefaultMaxValidators,
+		StakeMinimum:                DefaultMinStake,
+		StakeDenom:                  sdk.DefaultStakeDenom,
+		MaxEvidenceAge:              DefaultMaxEvidenceAge,
+		SignedBlocksWindow:          DefaultSignedBlocksWindow,
+		MinSignedPerWindow:          DefaultMinSignedPerWindow,
+		DowntimeJailDuration:        DefaultDowntimeJailDuration,
+		SlashFractionDoubleSign:     DefaultSlashFractionDoubleSign,
+		SlashFractionDowntime:       DefaultSlashFractionDowntime,
+		SessionBlockFrequency:       DefaultSessionBlocktime,
+		DAOAllocation:               DefaultDAOAllocation,
+		ProposerAllocation:          DefaultProposerAllocation,
+		RelaysToTokensMultiplier:    DefaultRelaysToTokensMultiplier,
+		MaximumChains:               DefaultMaxChains,
+		MaxJailedBlocks:             DefaultMaxJailedBlocks,
+		RelaysToTokensMultiplierMap: make(ChainMultipliers),
 	}
 }
 
@@ -139,6 +146,9 @@ func (p Params) Validate() error {
 	if p.ProposerAllocation+p.DAOAllocation > 100 {
 		return fmt.Errorf("the combo of proposer allocation and dao allocation mnust not be greater than 100")
 	}
+	if err := p.RelaysToTokensMultiplierMap.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -164,7 +174,8 @@ func (p Params) String() string {
   Proposer Allocation      %d
   DAO allocation           %d
   Maximum Chains           %d
-  Max Jailed Blocks        %d`,
+  Max Jailed Blocks        %d
+  Chain Multipliers        %s`,
 		p.UnstakingTime,
 		p.MaxValidators,
 		p.StakeDenom,
@@ -179,5 +190,87 @@ func (p Params) String() string {
 		p.ProposerAllocation,
 		p.DAOAllocation,
 		p.MaximumChains,
-		p.MaxJailedBlocks)
+		p.MaxJailedBlocks,
+		p.RelaysToTokensMultiplierMap)
+}
+
+// ChainMultipliers - The relays to tokens multiplier per relay chain
+type ChainMultipliers map[string]int64
+
+// Multiplier - Retrieve the multiplier of the chain, or the fallback if the chain has none
+func (cm ChainMultipliers) Multiplier(chain string, fallback int64) int64 {
+	if multiplier, ok := cm[chain]; ok {
+		return multiplier
+	}
+	return fallback
+}
+
+// Validate - Check the chains and multipliers of the map
+func (cm ChainMultipliers) Validate() error {
+	for chain, multiplier := range cm {
+		if err := ValidateNetworkIdentifier(chain); err != nil {
+			return fmt.Errorf("the relays to tokens multiplier map has an invalid chain %s: %s", chain, err.Error())
+		}
+		if multiplier <= 0 {
+			return fmt.Errorf("the relays to tokens multiplier of chain %s must be a positive integer", chain)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON - Deterministic JSON encoding (sorted chains) as the param bytes are part of the state
+func (cm ChainMultipliers) MarshalJSON() ([]byte, error) {
+	if cm == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(map[string]int64(cm))
+}
+
+// UnmarshalJSON - Decode the map, rejecting negative multipliers
+func (cm *ChainMultipliers) UnmarshalJSON(bz []byte) error {
+	var entries map[string]int64
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return err
+	}
+	for chain, multiplier := range entries {
+		if multiplier < 0 {
+			return fmt.Errorf("the relays to tokens multiplier of chain %s must not be negative", chain)
+		}
+	}
+	*cm = ChainMultipliers(entries)
+	return nil
+}
+
+// MergeJSON - Merge the decoded entries into the map, so a param change may edit a single chain;
+// an entry with a zero multiplier removes the chain from the map
+func (cm *ChainMultipliers) MergeJSON(bz []byte) error {
+	var entries ChainMultipliers
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return err
+	}
+	if *cm == nil {
+		*cm = make(ChainMultipliers, len(entries))
+	}
+	for chain, multiplier := range entries {
+		if multiplier == 0 {
+			delete(*cm, chain)
+			continue
+		}
+		(*cm)[chain] = multiplier
+	}
+	return nil
+}
+
+// String returns a human readable string representation of the map
+func (cm ChainMultipliers) String() string {
+	chains := make([]string, 0, len(cm))
+	for chain := range cm {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+	entries := make([]string, 0, len(chains))
+	for _, chain := range chains {
+		entries = append(entries, fmt.Sprintf("%s:%d", chain, cm[chain]))
+	}
+	return strings.Join(entries, ", ")
 }
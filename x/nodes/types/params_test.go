@@ -16,22 +16,23 @@ func TestDefaultParams(t *testing.T) {
 	}{
 		{"Default Test",
 			Params{
-				UnstakingTime:            DefaultUnstakingTime,
-				MaxValidators:            DefaultMaxValidators,
-				StakeMinimum:             DefaultMinStake,
-				StakeDenom:               types.DefaultStakeDenom,
-				MaxEvidenceAge:           DefaultMaxEvidenceAge,
-				SignedBlocksWindow:       DefaultSignedBlocksWindow,
-				MinSignedPerWindow:       DefaultMinSignedPerWindow,
-				DowntimeJailDuration:     DefaultDowntimeJailDuration,
-				SlashFractionDoubleSign:  DefaultSlashFractionDoubleSign,
-				SlashFractionDowntime:    DefaultSlashFractionDowntime,
-				SessionBlockFrequency:    DefaultSessionBlocktime,
-				DAOAllocation:            DefaultDAOAllocation,
-				ProposerAllocation:       DefaultProposerAllocation,
-				RelaysToTokensMultiplier: DefaultRelaysToTokensMultiplier,
-				MaximumChains:            DefaultMaxChains,
-				MaxJailedBlocks:          DefaultMaxJailedBlocks,
+				UnstakingTime:               DefaultUnstakingTime,
+				MaxValidators:               DefaultMaxValidators,
+				StakeMinimum:                DefaultMinStake,
+				StakeDenom:                  types.DefaultStakeDenom,
+				MaxEvidenceAge:              DefaultMaxEvidenceAge,
+				SignedBlocksWindow:          DefaultSignedBlocksWindow,
+				MinSignedPerWindow:          DefaultMinSignedPerWindow,
+				DowntimeJailDuration:        DefaultDowntimeJailDuration,
+				SlashFractionDoubleSign:     DefaultSlashFractionDoubleSign,
+				SlashFractionDowntime:       DefaultSlashFractionDowntime,
+				SessionBlockFrequency:       DefaultSessionBlocktime,
+				DAOAllocation:               DefaultDAOAllocation,
+				ProposerAllocation:          DefaultProposerAllocation,
+				RelaysToTokensMultiplier:    DefaultRelaysToTokensMultiplier,
+				MaximumChains:               DefaultMaxChains,
+				MaxJailedBlocks:             DefaultMaxJailedBlocks,
+				RelaysToTokensMultiplierMap: make(ChainMultipliers),
 			},
 		}}
 	for _, tt := range tests {
@@ -448,7 +449,8 @@ func TestParams_String(t *testing.T) {
   Proposer Allocation      %d
   DAO allocation           %d
   Maximum Chains           %d
-  Max Jailed Blocks        %d`,
+  Max Jailed Blocks        %d
+  Chain Multipliers        %s`,
 			DefaultUnstakingTime,
 			DefaultMaxValidators,
 			types.DefaultStakeDenom,
@@ -463,7 +465,8 @@ func TestParams_String(t *testing.T) {
 			DefaultProposerAllocation,
 			DefaultDAOAllocation,
 			DefaultMaxChains,
-			DefaultMaxJailedBlocks)},
+			DefaultMaxJailedBlocks,
+			"")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -490,3 +493,41 @@ func TestParams_String(t *testing.T) {
 		})
 	}
 }
+
+func TestChainMultipliers_UnmarshalJSON(t *testing.T) {
+	cm := ChainMultipliers{"0001": 1000, "0021": 2000}
+	bz, err := cm.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bz) != `{"0001":1000,"0021":2000}` {
+		t.Errorf("MarshalJSON() = %s, not deterministic", bz)
+	}
+	// decoding replaces the map
+	decoded := ChainMultipliers{"0040": 500}
+	if err := decoded.UnmarshalJSON(bz); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, cm) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", decoded, cm)
+	}
+	if err := decoded.UnmarshalJSON([]byte(`{"0021":-1}`)); err == nil {
+		t.Errorf("UnmarshalJSON() expected an error for a negative multiplier")
+	}
+	// a change edits a single entry, a zero multiplier removes the chain
+	if err := cm.MergeJSON([]byte(`{"0021":3000,"0001":0,"0040":500}`)); err != nil {
+		t.Fatal(err)
+	}
+	if want := (ChainMultipliers{"0021": 3000, "0040": 500}); !reflect.DeepEqual(cm, want) {
+		t.Errorf("MergeJSON() = %v, want %v", cm, want)
+	}
+	if err := cm.MergeJSON([]byte(`{"0021":-1}`)); err == nil {
+		t.Errorf("MergeJSON() expected an error for a negative multiplier")
+	}
+	if got := cm.Multiplier("0001", 1000); got != 1000 {
+		t.Errorf("Multiplier() = %d, want the fallback", got)
+	}
+	if err := (ChainMultipliers{"bad": 1}).Validate(); err == nil {
+		t.Errorf("Validate() expected an error for an invalid chain")
+	}
+}
@@ -19,6 +19,31 @@ func AATGeneration(appPubKey string, clientPubKey string, key crypto.PrivateKey)
 		ClientPublicKey:      clientPubKey,
 		ApplicationSignature: "",
 	}
+	return signAAT(aat, key)
+}
+
+// "ScopedAATGeneration" - Generates a scoped application authentication token that expires after the expiration height,
+// caps the relays the client may send per session and restricts the client to the allowed chains. Zero values leave
+// the corresponding scope unrestricted.
+func ScopedAATGeneration(appPubKey string, clientPubKey string, expirationHeight, maxRelaysPerSession int64, allowedChains []string, key crypto.PrivateKey) (pc.AAT, sdk.Error) {
+	// create the aat object
+	aat := pc.AAT{
+		Version:              pc.ScopedTokenVersion,
+		ApplicationPublicKey: appPubKey,
+		ClientPublicKey:      clientPubKey,
+		ApplicationSignature: "",
+		ExpirationHeight:     expirationHeight,
+		MaxRelaysPerSession:  maxRelaysPerSession,
+		AllowedChains:        allowedChains,
+	}
+	if err := aat.ValidateScope(); err != nil {
+		return pc.AAT{}, pc.NewInvalidTokenError(pc.ModuleName, err)
+	}
+	return signAAT(aat, key)
+}
+
+// "signAAT" - Signs the aat with the application private key
+func signAAT(aat pc.AAT, key crypto.PrivateKey) (pc.AAT, sdk.Error) {
 	// marshal aat using json
 	sig, err := key.Sign(aat.Hash())
 	if err != nil {
@@ -20,3 +20,23 @@ func TestAATGeneration(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Nil(t, res.Validate())
 }
+
+func TestScopedAATGeneration(t *testing.T) {
+	passphrase := "test"
+	kb := NewTestKeybase()
+	kp, err := kb.Create(passphrase)
+	assert.Nil(t, err)
+	privkey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, passphrase)
+	assert.Nil(t, err)
+	appPubKey := kp.PublicKey
+	res, err := ScopedAATGeneration(appPubKey.RawString(), appPubKey.RawString(), 100, 10, []string{"0001"}, privkey)
+	assert.Nil(t, err)
+	assert.Nil(t, res.Validate())
+	assert.True(t, res.IsExpired(101))
+	assert.False(t, res.IsChainAllowed("0002"))
+	// the scope is covered by the signature
+	res.MaxRelaysPerSession = 11
+	assert.NotNil(t, res.Validate())
+	_, err = ScopedAATGeneration(appPubKey.RawString(), appPubKey.RawString(), -1, 0, nil, privkey)
+	assert.NotNil(t, err)
+}
@@ -45,9 +45,9 @@ func (k Keeper) GetSelfNode(ctx sdk.Ctx) (node exported.ValidatorI, er sdk.Error
 	return self, nil
 }
 
-// "AwardCoinsForRelays" - Award coins to nodes for relays completed using the nodes keeper
-func (k Keeper) AwardCoinsForRelays(ctx sdk.Ctx, relays int64, toAddr sdk.Address) sdk.BigInt {
-	return k.posKeeper.RewardForRelays(ctx, sdk.NewInt(relays), toAddr)
+// "AwardCoinsForRelays" - Award coins to nodes for relays completed on the chain using the nodes keeper
+func (k Keeper) AwardCoinsForRelays(ctx sdk.Ctx, relays int64, toAddr sdk.Address, chain string) sdk.BigInt {
+	return k.posKeeper.RewardForRelays(ctx, sdk.NewInt(relays), toAddr, chain)
 }
 
 // "BurnCoinsForChallenges" - Executes the burn for challenge function in the nodes module
@@ -12,7 +12,6 @@ import (
 	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/tendermint/tendermint/rpc/client"
 	"math"
-	"reflect"
 )
 
 // auto sends a proof transaction for the claim
@@ -154,6 +153,12 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 	if !found {
 		return servicerAddr, claim, pc.NewAppNotFoundError(pc.ModuleName)
 	}
+	// validate the scope of the token of a relay proof
+	if rp, ok := pc.RelayProofOf(proof.GetLeaf()); ok {
+		if er := k.validateProofToken(ctx, sessionCtx, application.GetAddress(), rp, claim.SessionHeader.SessionBlockHeight); er != nil {
+			return nil, claim, er
+		}
+	}
 	// validate the proof depending on the type of proof it is
 	er := proof.GetLeaf().Validate(application.GetChains(), int(k.SessionNodeCount(sessionCtx)), claim.SessionHeader.SessionBlockHeight)
 	if er != nil {
@@ -163,23 +168,46 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 	return servicerAddr, claim, nil
 }
 
-func (k Keeper) ExecuteProof(ctx sdk.Ctx, proof pc.MsgProof, claim pc.MsgClaim) (tokens sdk.BigInt, err sdk.Error) {
-	// convert to value for switch consistency
-	l := proof.GetLeaf()
-	if reflect.ValueOf(l).Kind() == reflect.Ptr {
-		l = reflect.Indirect(reflect.ValueOf(l)).Interface().(pc.Proof)
+// validateProofToken - Checks the scope of the token of the relay proof: a scoped token is not valid before the feature is
+// activated, after which the client must not be revoked by the application at the session height, the token must not
+// be expired at the session height and must allow the blockchain
+func (k Keeper) validateProofToken(ctx, sessionCtx sdk.Ctx, appAddr sdk.Address, rp pc.RelayProof, sessionHeight int64) sdk.Error {
+	if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ScopedTokenKey) {
+		if rp.Token.Version == pc.ScopedTokenVersion {
+			return pc.NewInvalidTokenError(pc.ModuleName, pc.UnsupportedTokenVersionError)
+		}
+		return nil
+	}
+	if k.appKeeper.IsClientRevoked(sessionCtx, appAddr, rp.Token.ClientPublicKey) {
+		return pc.NewRevokedTokenError(pc.ModuleName)
 	}
-	switch l.(type) {
-	case pc.RelayProof:
+	if rp.Token.IsExpired(sessionHeight) {
+		return pc.NewExpiredTokenError(pc.ModuleName)
+	}
+	if !rp.Token.IsChainAllowed(rp.Blockchain) {
+		return pc.NewTokenChainNotAllowedError(pc.ModuleName)
+	}
+	return nil
+}
+
+func (k Keeper) ExecuteProof(ctx sdk.Ctx, proof pc.MsgProof, claim pc.MsgClaim) (tokens sdk.BigInt, err sdk.Error) {
+	switch leaf := proof.GetLeaf().(type) {
+	case pc.RelayProof, *pc.RelayProof:
 		ctx.Logger().Info(fmt.Sprintf("reward coins to %s, for %d relays", claim.FromAddress.String(), claim.TotalProofs))
-		tokens = k.AwardCoinsForRelays(ctx, claim.TotalProofs, claim.FromAddress)
+		tokens = k.AwardCoinsForRelays(ctx, claim.TotalProofs, claim.FromAddress, claim.SessionHeader.Chain)
+		// record the claimed relays against the application of the session for usage based throughput
+		if sessionCtx, er := ctx.PrevCtx(claim.SessionHeader.SessionBlockHeight); er == nil {
+			if app, found := k.GetAppFromPublicKey(sessionCtx, claim.SessionHeader.ApplicationPubKey); found {
+				k.appKeeper.AddRelayUsage(ctx, app.GetAddress(), claim.SessionHeader.SessionBlockHeight, claim.TotalProofs)
+			}
+		}
 		err := k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.RelayEvidence)
 		if err != nil {
 			return tokens, sdk.ErrInternal(err.Error())
 		}
-	case pc.ChallengeProofInvalidData:
+	case pc.ChallengeProofInvalidData, *pc.ChallengeProofInvalidData:
 		ctx.Logger().Info(fmt.Sprintf("burning coins from %s, for %d valid challenges", claim.FromAddress.String(), claim.TotalProofs))
-		proof, ok := proof.GetLeaf().(pc.ChallengeProofInvalidData)
+		proof, ok := leaf.(pc.ChallengeProofInvalidData)
 		if !ok {
 			return sdk.ZeroInt(), pc.NewInvalidProofsError(pc.ModuleName)
 		}
@@ -194,7 +222,7 @@ func (k Keeper) ExecuteProof(ctx sdk.Ctx, proof pc.MsgProof, claim pc.MsgClaim)
 			return sdk.ZeroInt(), sdk.ErrInternal(err.Error())
 		}
 		// small reward for the challenge proof invalid data
-		tokens = k.AwardCoinsForRelays(ctx, claim.TotalProofs/100, claim.FromAddress)
+		tokens = k.AwardCoinsForRelays(ctx, claim.TotalProofs/100, claim.FromAddress, claim.SessionHeader.Chain)
 	}
 	return tokens, nil
 }
@@ -233,21 +261,35 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msg sdk.ProtoMsg, n client.Client, key c
 	fromAddr := sdk.Address(key.PublicKey().Address())
 	// create a client context for sending
 	cliCtx = util.NewCLIContext(n, fromAddr, "").WithCodec(k.Cdc).WithHeight(ctx.BlockHeight())
-	pk, err := k.GetPKFromFile(ctx)
+	// sign with the session key if one is configured
+	pk, err := k.GetSigningKey(ctx)
 	if err != nil {
 		return txBuilder, cliCtx, err
 	}
 	cliCtx.PrivateKey = pk
 	// broadcast synchronously
 	cliCtx.BroadcastMode = util.BroadcastSync
+	// pay the fees from the fee payer if one is configured
+	feePayer, err := k.GetFeePayer(ctx, fromAddr)
+	if err != nil {
+		return txBuilder, cliCtx, err
+	}
+	payer := fromAddr
+	if feePayer != nil {
+		payer = feePayer
+	}
 	// get the account to ensure balance
 	// retrieve the account for a balance check (and ensure it exists)
-	account := k.authKeeper.GetAccount(ctx, fromAddr)
+	account := k.authKeeper.GetAccount(ctx, payer)
 	if account == nil {
-		return txBuilder, cliCtx, fmt.Errorf("unable to locate an account at address: %s", fromAddr)
+		return txBuilder, cliCtx, fmt.Errorf("unable to locate an account at address: %s", payer)
 	}
 	// check the fee amount
 	fee := k.authKeeper.GetFee(ctx, msg)
+	// tip at least the minimum of the node so it admits its own tx to its mempool
+	if pc.GlobalPocketConfig.MinTip > 0 && k.authKeeper.IsFeeMarketEnabled(ctx) {
+		fee = fee.Add(sdk.NewInt(pc.GlobalPocketConfig.MinTip))
+	}
 	if account.GetCoins().AmountOf(k.posKeeper.StakeDenom(ctx)).LT(fee) {
 		return txBuilder, cliCtx, fmt.Errorf("insufficient funds for the auto %s transaction: the fee needed is %v ", msg.Type(), fee)
 	}
@@ -258,6 +300,6 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msg sdk.ProtoMsg, n client.Client, key c
 		ctx.ChainID(),
 		"",
 		sdk.NewCoins(sdk.NewCoin(k.posKeeper.StakeDenom(ctx), fee)),
-	)
+	).WithFeePayer(feePayer)
 	return
 }
@@ -2,21 +2,88 @@ package keeper
 
 import (
 	"encoding/binary"
+	"encoding/hex"
 	"math/rand"
 	"testing"
 
 	"time"
 
+	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
+	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
+	mockCtx, keeper, proofMsg, _ := newTestProof(t)
+	// validate proof
+	_, _, err := keeper.ValidateProof(mockCtx, proofMsg)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+}
+
+func TestKeeper_ValidateProofRevokedClient(t *testing.T) {
+	mockCtx, keeper, proofMsg, relayKeys := newTestProof(t)
+	codec.UpgradeFeatureMap[codec.ScopedTokenKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.ScopedTokenKey)
+	_, _, err := keeper.ValidateProof(mockCtx, proofMsg)
+	assert.Nil(t, err)
+	// the proof of a client the application revoked is not valid
+	appAddr := sdk.Address(relayKeys.private.PublicKey().Address())
+	keeper.appKeeper.(appsKeeper.Keeper).RevokeClient(mockCtx, appAddr, relayKeys.client.PublicKey().RawString())
+	_, _, err = keeper.ValidateProof(mockCtx, proofMsg)
+	assert.Equal(t, types.NewRevokedTokenError(types.ModuleName), err)
+}
+
+func TestKeeper_ValidateProofToken(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	clientKey := getRandomPrivateKey()
+	npk := getRandomPubKey()
+	chain := hex.EncodeToString([]byte{01})
+	appAddr := sdk.Address(getTestApplicationPrivateKey().PublicKey().Address())
+	scopedProof := func(expirationHeight int64, allowedChains []string) types.RelayProof {
+		proof := createProof(getTestApplicationPrivateKey(), clientKey, npk, chain, 0).(types.RelayProof)
+		proof.Token.Version = types.ScopedTokenVersion
+		proof.Token.ExpirationHeight = expirationHeight
+		proof.Token.AllowedChains = allowedChains
+		return proof
+	}
+	// a scoped token is not valid before the activation
+	err := keeper.validateProofToken(ctx, ctx, appAddr, scopedProof(0, nil), 1)
+	assert.Equal(t, types.NewInvalidTokenError(types.ModuleName, types.UnsupportedTokenVersionError), err)
+
+	codec.UpgradeFeatureMap[codec.ScopedTokenKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.ScopedTokenKey)
+	tests := []struct {
+		name          string
+		proof         types.RelayProof
+		sessionHeight int64
+		err           sdk.Error
+	}{
+		{"scoped token", scopedProof(10, []string{chain}), 1, nil},
+		{"token expiring at the session height", scopedProof(1, nil), 1, nil},
+		{"token expired before the session height", scopedProof(1, nil), 5, types.NewExpiredTokenError(types.ModuleName)},
+		{"chain not allowed", scopedProof(0, []string{"0002"}), 1, types.NewTokenChainNotAllowedError(types.ModuleName)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, keeper.validateProofToken(ctx, ctx, appAddr, tt.proof, tt.sessionHeight))
+		})
+	}
+	// a revoked client is not valid whatever the token
+	keeper.appKeeper.(appsKeeper.Keeper).RevokeClient(ctx, appAddr, clientKey.PublicKey().RawString())
+	err = keeper.validateProofToken(ctx, ctx, appAddr, scopedProof(0, nil), 1)
+	assert.Equal(t, types.NewRevokedTokenError(types.ModuleName), err)
+}
+
+// newTestProof - Returns a valid proof of simulated relays, along with the keys of the relays
+func newTestProof(t *testing.T) (*Ctx, Keeper, types.MsgProof, simulateRelayKeys) {
 	ctx, _, _, _, keeper, keys, _ := createTestInput(t, false)
 	types.ClearEvidence()
-	npk, header, _ := simulateRelays(t, keeper, &ctx, 5)
+	npk, header, relayKeys := simulateRelays(t, keeper, &ctx, 5)
 	evidence, err := types.GetEvidence(header, types.RelayEvidence, sdk.NewInt(1000))
 	if err != nil {
 		t.Fatalf("Set evidence not found")
@@ -58,11 +125,7 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	if err != nil {
 		t.Fatal(err)
 	}
-	// validate proof
-	_, _, err = keeper.ValidateProof(mockCtx, proofMsg)
-	if err != nil {
-		t.Fatalf(err.Error())
-	}
+	return mockCtx, keeper, proofMsg, relayKeys
 }
 
 func TestKeeper_GetPsuedorandomIndex(t *testing.T) {
@@ -71,6 +71,12 @@ func (k Keeper) GetLatestSessionBlockHeight(ctx sdk.Ctx) (sessionBlockHeight int
 	return
 }
 
+// "PrevCtxRetention" - Returns the number of recent blocks whose state is still read through PrevCtx
+// (from the session of a claim that may yet be submitted, to the expiration of that claim)
+func (k Keeper) PrevCtxRetention(ctx sdk.Ctx) int64 {
+	return (k.ClaimSubmissionWindow(ctx) + k.ClaimExpiration(ctx) + 2) * k.BlocksPerSession(ctx)
+}
+
 // "IsPocketSupportedBlockchain" - Returns true if network identifier param is supported by pocket
 func (k Keeper) IsPocketSupportedBlockchain(ctx sdk.Ctx, chain string) bool {
 	// loop through supported blockchains (network identifiers)
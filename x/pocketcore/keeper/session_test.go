@@ -49,6 +49,13 @@ func TestKeeper_IsSessionBlock(t *testing.T) {
 	assert.False(t, keeper.IsSessionBlock(notSessionContext.WithBlockHeight(977)))
 }
 
+func TestKeeper_PrevCtxRetention(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	expected := (keeper.ClaimSubmissionWindow(ctx) + keeper.ClaimExpiration(ctx) + 2) * keeper.BlocksPerSession(ctx)
+	assert.Equal(t, expected, keeper.PrevCtxRetention(ctx))
+	assert.Greater(t, keeper.PrevCtxRetention(ctx), keeper.ClaimExpiration(ctx)*keeper.BlocksPerSession(ctx))
+}
+
 func TestKeeper_IsPocketSupportedBlockchain(t *testing.T) {
 	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
 	sb := []string{"ethereum"}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
@@ -20,3 +22,38 @@ func (k Keeper) GetPKFromFile(ctx sdk.Ctx) (crypto.PrivateKey, error) {
 	}
 	return pk, nil
 }
+
+// "GetSigningKey" - Returns the session key signing the automatic transactions of the node if one is configured
+// and granted by the validator, otherwise the private validator key
+func (k Keeper) GetSigningKey(ctx sdk.Ctx) (crypto.PrivateKey, error) {
+	pk, err := k.GetPKFromFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessionKey, ok := types.GetSessionKeyFile()
+	if !ok || !k.authKeeper.IsSessionKeysActivated(ctx) {
+		return pk, nil
+	}
+	if _, found := k.authKeeper.GetSessionKey(ctx, sdk.Address(pk.PublicKey().Address()), sdk.Address(sessionKey.Address)); !found {
+		ctx.Logger().Error(fmt.Sprintf("the session key %s is not granted by the validator, signing with the validator key", sessionKey.Address))
+		return pk, nil
+	}
+	return crypto.PrivKeyToPrivateKey(sessionKey.PrivKey)
+}
+
+// "GetFeePayer" - Returns the account paying the fees of the automatic transactions of the node if one is configured
+// and it granted a fee allowance to the validator, otherwise nil so the validator pays them
+func (k Keeper) GetFeePayer(ctx sdk.Ctx, validator sdk.Address) (sdk.Address, error) {
+	if types.GlobalPocketConfig.FeePayer == "" || !k.authKeeper.IsFeeGrantActivated(ctx) {
+		return nil, nil
+	}
+	feePayer, err := sdk.AddressFromHex(types.GlobalPocketConfig.FeePayer)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.authKeeper.GetFeeAllowance(ctx, feePayer, validator); !found {
+		ctx.Logger().Error(fmt.Sprintf("the fee payer %s has no fee allowance for the validator %s, paying the fees from the validator", feePayer, validator))
+		return nil, nil
+	}
+	return feePayer, nil
+}
@@ -7,10 +7,15 @@ import (
 	"log"
 )
 
+const (
+	// The token version that carries an expiration height, a relay cap and a chain allow-list
+	ScopedTokenVersion = "0.0.2"
+)
+
 var (
 	// A list of supported token versions
 	// Requires major (semantic) upgrade to update this list
-	SupportedTokenVersions = []string{"0.0.1"}
+	SupportedTokenVersions = []string{"0.0.1", ScopedTokenVersion}
 )
 
 // "VersionIsIncluded" - Returns if the version is included
@@ -54,6 +59,9 @@ func (a AAT) Bytes() []byte {
 		ApplicationPublicKey: a.ApplicationPublicKey,
 		ClientPublicKey:      a.ClientPublicKey,
 		Version:              a.Version,
+		ExpirationHeight:     a.ExpirationHeight,
+		MaxRelaysPerSession:  a.MaxRelaysPerSession,
+		AllowedChains:        a.AllowedChains,
 	})
 	if err != nil {
 		log.Fatal(fmt.Sprintf("an error occured hashing the aat:\n%v", err))
@@ -101,9 +109,56 @@ func (a AAT) ValidateMessage() error {
 	if err := PubKeyVerification(a.ClientPublicKey); err != nil {
 		return err
 	}
+	// check the scope of the aat
+	if err := a.ValidateScope(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// "IsScoped" - Returns if the AAT carries any scope restrictions
+func (a AAT) IsScoped() bool {
+	return a.ExpirationHeight != 0 || a.MaxRelaysPerSession != 0 || len(a.AllowedChains) != 0
+}
+
+// "ValidateScope" - Confirms the scope fields of the AAT
+func (a AAT) ValidateScope() error {
+	if !a.IsScoped() {
+		return nil
+	}
+	// only the scoped version may carry restrictions
+	if a.Version != ScopedTokenVersion {
+		return UnsupportedTokenScopeError
+	}
+	if a.ExpirationHeight < 0 || a.MaxRelaysPerSession < 0 {
+		return InvalidTokenScopeError
+	}
+	for _, chain := range a.AllowedChains {
+		if err := NetworkIdentifierVerification(chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "IsExpired" - Returns if the AAT is no longer valid at the height
+func (a AAT) IsExpired(height int64) bool {
+	return a.ExpirationHeight != 0 && height > a.ExpirationHeight
+}
+
+// "IsChainAllowed" - Returns if the AAT may be used to relay for the chain
+func (a AAT) IsChainAllowed(chain string) bool {
+	if len(a.AllowedChains) == 0 {
+		return true
+	}
+	for _, c := range a.AllowedChains {
+		if c == chain {
+			return true
+		}
+	}
+	return false
+}
+
 // "ValidateSignature" - Confirms the signature field of the AAT
 func (a AAT) ValidateSignature() error {
 	// check for valid signature
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -271,3 +272,66 @@ func TestAAT_Validate(t *testing.T) {
 	AAT.ApplicationSignature = hex.EncodeToString(applicationSignature)
 	assert.Nil(t, AAT.Validate())
 }
+
+func TestAAT_ValidateScope(t *testing.T) {
+	appPrivKey := GetRandomPrivateKey()
+	clientPrivKey := GetRandomPrivateKey()
+	var AATScoped = AAT{
+		Version:              ScopedTokenVersion,
+		ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+		ClientPublicKey:      clientPrivKey.PublicKey().RawString(),
+		ExpirationHeight:     100,
+		MaxRelaysPerSession:  10,
+		AllowedChains:        []string{"0001"},
+	}
+	AATScopedOldVersion := AATScoped
+	AATScopedOldVersion.Version = "0.0.1"
+	AATNegativeScope := AATScoped
+	AATNegativeScope.MaxRelaysPerSession = -1
+	AATBadChain := AATScoped
+	AATBadChain.AllowedChains = []string{"bad chain"}
+	tests := []struct {
+		name     string
+		aat      AAT
+		hasError bool
+	}{
+		{
+			name:     "AAT is scoped with the scoped version",
+			aat:      AATScoped,
+			hasError: false,
+		},
+		{
+			name:     "AAT is scoped with an unscoped version",
+			aat:      AATScopedOldVersion,
+			hasError: true,
+		},
+		{
+			name:     "AAT has a negative scope",
+			aat:      AATNegativeScope,
+			hasError: true,
+		},
+		{
+			name:     "AAT allows an invalid chain",
+			aat:      AATBadChain,
+			hasError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.aat.ValidateScope() != nil, tt.hasError)
+		})
+	}
+}
+
+func TestAAT_BytesUnscoped(t *testing.T) {
+	appPrivKey := GetRandomPrivateKey()
+	clientPrivKey := GetRandomPrivateKey()
+	aat := AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+		ClientPublicKey:      clientPrivKey.PublicKey().RawString(),
+	}
+	// the scope fields must not change the encoding of unscoped tokens
+	expected := fmt.Sprintf(`{"version":"0.0.1","app_pub_key":"%s","client_pub_key":"%s","signature":""}`, aat.ApplicationPublicKey, aat.ClientPublicKey)
+	assert.Equal(t, expected, string(aat.Bytes()))
+}
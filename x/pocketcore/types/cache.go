@@ -42,7 +42,7 @@ type CacheObject interface {
 }
 
 // "Init" - Initializes a cache storage object
-func (cs *CacheStorage) Init(dir, name string, options config.LevelDBOptions, maxEntries int, inMemoryDB bool) {
+func (cs *CacheStorage) Init(dir, name, backend string, options config.LevelDBOptions, maxEntries int, inMemoryDB bool) {
 	// init the lru cache with a max entries
 	cs.Cache = sdk.NewCache(maxEntries)
 	// intialize the db
@@ -51,7 +51,7 @@ func (cs *CacheStorage) Init(dir, name string, options config.LevelDBOptions, ma
 		cs.DB = db.NewGoLevelMemDBWithCapacity(maxEntries)
 		return
 	}
-	cs.DB, err = sdk.NewLevelDB(name, dir, options.ToGoLevelDBOpts())
+	cs.DB, err = sdk.OpenDB(name, dir, backend, options.ToGoLevelDBOpts())
 	if err != nil {
 		if err == syscall.EWOULDBLOCK {
 			message := fmt.Sprintf("can't open files needed for execution. Another instance may be running. path: %s\n", filepath.Join(dir, name+".db"))
@@ -336,6 +336,7 @@ func DeleteEvidence(header SessionHeader, evidenceType EvidenceType) error {
 	// delete from cache
 	globalEvidenceCache.Delete(key)
 	globalEvidenceSealedMap.Delete(header.HashString())
+	globalClientProofCounts.delete(header, evidenceType)
 	return nil
 }
 
@@ -355,6 +356,7 @@ func ClearEvidence() {
 	if globalEvidenceCache != nil {
 		globalEvidenceCache.Clear()
 		globalEvidenceSealedMap = sync.Map{}
+		globalClientProofCounts.clear()
 	}
 }
 
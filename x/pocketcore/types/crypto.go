@@ -17,6 +17,7 @@ var (
 	NetworkIdentifierLength = 4
 	AddrLength              = tmhash.TruncatedSize
 	globalPVKeyFile         = privval.FilePVKey{}
+	globalSessionKeyFile    = privval.FilePVKey{}
 )
 
 // "NetworkIdentifierVerification"- Verify the netID format (hex string)
@@ -80,6 +81,16 @@ func GetPVKeyFile() (privval.FilePVKey, sdk.Error) {
 	}
 }
 
+// "InitSessionKeyFile" - Initializes the global session key variable
+func InitSessionKeyFile(filePVKey privval.FilePVKey) {
+	globalSessionKeyFile = filePVKey
+}
+
+// "GetSessionKeyFile" - Returns the globalSessionKeyFile instance, if a session key is configured
+func GetSessionKeyFile() (privval.FilePVKey, bool) {
+	return globalSessionKeyFile, globalSessionKeyFile.PrivKey != nil
+}
+
 // "PubKeyVerification" - Verifies the public key format (hex string)
 func PubKeyVerification(pk string) sdk.Error {
 	// decode the bz
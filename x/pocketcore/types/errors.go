@@ -94,6 +94,10 @@ const (
 	CodeInvalidExpirationHeightErr       = 88
 	CodeInvalidMerkleRangeError          = 89
 	CodeEvidenceSealed                   = 90
+	CodeExpiredTokenError                = 91
+	CodeTokenChainNotAllowedError        = 92
+	CodeRevokedTokenError                = 93
+	CodeTokenOverServiceError            = 94
 )
 
 var (
@@ -102,6 +106,12 @@ var (
 	MissingApplicationPublicKeyError = errors.New("the applicaiton public key included in the AAT is not valid")
 	MissingClientPublicKeyError      = errors.New("the client public key included in the AAT is not valid")
 	InvalidTokenSignatureErorr       = errors.New("the application signature on the AAT is not valid")
+	UnsupportedTokenScopeError       = errors.New("the AAT scope fields are only supported by token version " + ScopedTokenVersion)
+	InvalidTokenScopeError           = errors.New("the AAT expiration height and max relays per session must not be negative")
+	ExpiredTokenError                = errors.New("the application authentication token is expired")
+	TokenChainNotAllowedError        = errors.New("the blockchain in the relay request is not allowed by the application authentication token")
+	RevokedTokenError                = errors.New("the client public key of the application authentication token has been revoked by the application")
+	TokenOverServiceError            = errors.New("the max number of relays per session for the application authentication token is exceeded")
 	NegativeICCounterError           = errors.New("the IC counter is less than 0")
 	MaximumEntropyError              = errors.New("the entropy exceeds the maximum allowed relays")
 	NodeNotInSessionError            = errors.New("the node is not within the session")
@@ -188,6 +198,22 @@ var (
 	SealedEvidenceError              = errors.New("the evidence is sealed, either max relays reached or claim already submitted")
 )
 
+func NewExpiredTokenError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeExpiredTokenError, ExpiredTokenError.Error())
+}
+
+func NewTokenChainNotAllowedError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenChainNotAllowedError, TokenChainNotAllowedError.Error())
+}
+
+func NewRevokedTokenError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRevokedTokenError, RevokedTokenError.Error())
+}
+
+func NewTokenOverServiceError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenOverServiceError, TokenOverServiceError.Error())
+}
+
 func NewSealedEvidenceError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEvidenceSealed, SealedEvidenceError.Error())
 }
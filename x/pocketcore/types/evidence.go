@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/types"
@@ -25,6 +26,89 @@ func (e Evidence) IsSealed() bool {
 	return ok
 }
 
+// "NumOfClientProofs" - Returns the number of relay proofs in the evidence that were sent by the client
+func (e Evidence) NumOfClientProofs(clientPubKey string) int64 {
+	return globalClientProofCounts.get(e, clientPubKey)
+}
+
+// "clientProofCounts" - Counts the relay proofs of each client per evidence as they are added, so that the relay cap of
+// a token is checked without scanning the evidence on every relay
+type clientProofCounts struct {
+	l      sync.Mutex
+	counts map[string]map[string]int64 // evidence -> client public key -> number of relay proofs
+}
+
+var globalClientProofCounts = clientProofCounts{counts: make(map[string]map[string]int64)}
+
+// "get" - Returns the count of the client, counting the proofs of an evidence loaded from the database once
+func (c *clientProofCounts) get(e Evidence, clientPubKey string) int64 {
+	c.l.Lock()
+	defer c.l.Unlock()
+	return c.load(e)[clientPubKey]
+}
+
+// "add" - Counts a proof just added to the evidence
+func (c *clientProofCounts) add(e Evidence, p Proof) {
+	rp, ok := RelayProofOf(p)
+	if !ok {
+		return
+	}
+	c.l.Lock()
+	defer c.l.Unlock()
+	key := clientProofCountsKey(e.SessionHeader, e.EvidenceType)
+	if _, ok := c.counts[key]; !ok {
+		// the evidence already holds the proof
+		c.load(e)
+		return
+	}
+	c.counts[key][rp.Token.ClientPublicKey]++
+}
+
+// "load" - Returns the counts of the evidence, counting its proofs if needed
+func (c *clientProofCounts) load(e Evidence) map[string]int64 {
+	key := clientProofCountsKey(e.SessionHeader, e.EvidenceType)
+	counts, ok := c.counts[key]
+	if !ok {
+		counts = make(map[string]int64)
+		for _, proof := range e.Proofs {
+			if rp, ok := RelayProofOf(proof); ok {
+				counts[rp.Token.ClientPublicKey]++
+			}
+		}
+		c.counts[key] = counts
+	}
+	return counts
+}
+
+// "delete" - Removes the counts of the evidence
+func (c *clientProofCounts) delete(header SessionHeader, evidenceType EvidenceType) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	delete(c.counts, clientProofCountsKey(header, evidenceType))
+}
+
+// "clear" - Removes the counts of every evidence
+func (c *clientProofCounts) clear() {
+	c.l.Lock()
+	defer c.l.Unlock()
+	c.counts = make(map[string]map[string]int64)
+}
+
+func clientProofCountsKey(header SessionHeader, evidenceType EvidenceType) string {
+	return fmt.Sprintf("%s/%d", header.HashString(), evidenceType)
+}
+
+// "RelayProofOf" - Returns the proof as a relay proof, whether it is held by value or by pointer
+func RelayProofOf(p Proof) (RelayProof, bool) {
+	switch rp := p.(type) {
+	case RelayProof:
+		return rp, true
+	case *RelayProof:
+		return *rp, true
+	}
+	return RelayProof{}, false
+}
+
 func (e Evidence) Seal() CacheObject {
 	globalEvidenceSealedMap.Store(e.HashString(), struct{}{})
 	return e
@@ -50,6 +134,8 @@ func (e *Evidence) AddProof(p Proof) {
 	e.NumOfProofs = e.NumOfProofs + 1
 	// add proof to bloom filter
 	e.Bloom.Add(p.Hash())
+	// count the proof of the client
+	globalClientProofCounts.add(*e, p)
 }
 
 // "GenerateMerkleProof" - Generates the merkle Proof for an GOBEvidence
@@ -5,11 +5,12 @@ import (
 	sdk "github.com/pokt-network/pocket-core/types"
 	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	authexported "github.com/pokt-network/pocket-core/x/auth/exported"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	nodesexported "github.com/pokt-network/pocket-core/x/nodes/exported"
 )
 
 type PosKeeper interface {
-	RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address) sdk.BigInt
+	RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address, chain string) sdk.BigInt
 	GetStakedTokens(ctx sdk.Ctx) sdk.BigInt
 	Validator(ctx sdk.Ctx, addr sdk.Address) nodesexported.ValidatorI
 	TotalTokens(ctx sdk.Ctx) sdk.BigInt
@@ -28,6 +29,8 @@ type AppsKeeper interface {
 	AllApplications(ctx sdk.Ctx) (applications []appexported.ApplicationI)
 	TotalTokens(ctx sdk.Ctx) sdk.BigInt
 	JailApplication(ctx sdk.Ctx, addr sdk.Address)
+	IsClientRevoked(ctx sdk.Ctx, appAddr sdk.Address, clientPubKey string) bool
+	AddRelayUsage(ctx sdk.Ctx, addr sdk.Address, sessionBlockHeight int64, relays int64)
 }
 
 type PocketKeeper interface {
@@ -37,5 +40,10 @@ type PocketKeeper interface {
 
 type AuthKeeper interface {
 	GetFee(ctx sdk.Ctx, msg sdk.Msg) sdk.BigInt
+	IsFeeMarketEnabled(ctx sdk.Ctx) bool
 	GetAccount(ctx sdk.Ctx, addr sdk.Address) authexported.Account
+	IsSessionKeysActivated(ctx sdk.Ctx) bool
+	GetSessionKey(ctx sdk.Ctx, granter, addr sdk.Address) (authTypes.SessionKey, bool)
+	IsFeeGrantActivated(ctx sdk.Ctx) bool
+	GetFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) (authTypes.FeeAllowance, bool)
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
@@ -56,6 +57,23 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, appsKeeper AppsKeeper
 	if !found {
 		return sdk.ZeroInt(), NewAppNotFoundError(ModuleName)
 	}
+	// validate the scope of the token
+	if pocketKeeper.Codec().IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ScopedTokenKey) {
+		// ensure the application hasn't revoked the client
+		if appsKeeper.IsClientRevoked(ctx, app.GetAddress(), r.Proof.Token.ClientPublicKey) {
+			return sdk.ZeroInt(), NewRevokedTokenError(ModuleName)
+		}
+		// ensure the token hasn't expired
+		if r.Proof.Token.IsExpired(ctx.BlockHeight()) {
+			return sdk.ZeroInt(), NewExpiredTokenError(ModuleName)
+		}
+		// ensure the token allows the blockchain
+		if !r.Proof.Token.IsChainAllowed(r.Proof.Blockchain) {
+			return sdk.ZeroInt(), NewTokenChainNotAllowedError(ModuleName)
+		}
+	} else if r.Proof.Token.Version == ScopedTokenVersion {
+		return sdk.ZeroInt(), NewInvalidTokenError(ModuleName, UnsupportedTokenVersionError)
+	}
 	// get session node count from that session height
 	sessionNodeCount := pocketKeeper.SessionNodeCount(sessionCtx)
 	// get max possible relays
@@ -79,6 +97,10 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, appsKeeper AppsKeeper
 	if sdk.NewInt(totalRelays).GTE(maxPossibleRelays) {
 		return sdk.ZeroInt(), NewOverServiceError(ModuleName)
 	}
+	// validate the client is not over the relay cap of the token
+	if maxRelays := r.Proof.Token.MaxRelaysPerSession; maxRelays > 0 && evidence.NumOfClientProofs(r.Proof.Token.ClientPublicKey) >= maxRelays {
+		return sdk.ZeroInt(), NewTokenOverServiceError(ModuleName)
+	}
 	// validate the Proof
 	if err := r.Proof.ValidateLocal(app.GetChains(), int(sessionNodeCount), sessionBlockHeight, node); err != nil {
 		return sdk.ZeroInt(), err
@@ -140,6 +140,85 @@ func TestRelay_Validate(t *testing.T) { // TODO add overservice, and not unique
 	}
 }
 
+func TestRelay_ValidateTokenScope(t *testing.T) {
+	clientPrivateKey := GetRandomPrivateKey()
+	clientPubKey := clientPrivateKey.PublicKey().RawString()
+	appPrivateKey := GetRandomPrivateKey()
+	npk := getRandomPubKey()
+	ethereum := hex.EncodeToString([]byte{01})
+	// the relays are sent at the session height, the height of the context
+	ctx := newContext(t, false).WithAppVersion("0.0.0").WithBlockHeight(3)
+	newRelay := func(entropy int64, expirationHeight, maxRelays int64, allowedChains []string) Relay {
+		relay := Relay{
+			Payload: Payload{Data: "{\"jsonrpc\":\"2.0\",\"method\":\"web3_clientVersion\",\"params\":[],\"id\":67}"},
+			Meta:    RelayMeta{BlockHeight: 3},
+			Proof: RelayProof{
+				Entropy:            entropy,
+				SessionBlockHeight: 3,
+				ServicerPubKey:     npk.RawString(),
+				Blockchain:         ethereum,
+				Token: AAT{
+					Version:              ScopedTokenVersion,
+					ApplicationPublicKey: appPrivateKey.PublicKey().RawString(),
+					ClientPublicKey:      clientPubKey,
+					ExpirationHeight:     expirationHeight,
+					MaxRelaysPerSession:  maxRelays,
+					AllowedChains:        allowedChains,
+				},
+			},
+		}
+		relay.Proof.RequestHash = relay.RequestHashString()
+		appSig, err := appPrivateKey.Sign(relay.Proof.Token.Hash())
+		assert.Nil(t, err)
+		relay.Proof.Token.ApplicationSignature = hex.EncodeToString(appSig)
+		clientSig, err := clientPrivateKey.Sign(relay.Proof.Hash())
+		assert.Nil(t, err)
+		relay.Proof.Signature = hex.EncodeToString(clientSig)
+		return relay
+	}
+	appPubKey := appPrivateKey.PublicKey().(crypto.Ed25519PublicKey)
+	app := appsType.Application{
+		Address:      sdk.Address(appPubKey.Address()),
+		PublicKey:    appPubKey,
+		Status:       sdk.Staked,
+		Chains:       []string{ethereum},
+		StakedTokens: sdk.NewInt(1000),
+		MaxRelays:    sdk.NewInt(1000),
+	}
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: "https://www.google.com:443"}}}
+	validate := func(relay Relay, revoked bool) sdk.Error {
+		k := MockPosKeeper{}
+		k2 := MockAppsKeeper{Applications: []exported2.ApplicationI{app}, RevokedClients: map[string]bool{clientPubKey: revoked}}
+		_, err := relay.Validate(ctx, k, k2, MockPocketKeeper{}, sdk.Address(npk.Address()), &hb, 3)
+		return err
+	}
+	// a scoped token is not valid before the activation
+	assert.Equal(t, NewInvalidTokenError(ModuleName, UnsupportedTokenVersionError), validate(newRelay(1, 0, 0, nil), false))
+
+	codec.UpgradeFeatureMap[codec.ScopedTokenKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.ScopedTokenKey)
+	assert.Equal(t, NewRevokedTokenError(ModuleName), validate(newRelay(1, 0, 0, nil), true))
+	assert.Equal(t, NewExpiredTokenError(ModuleName), validate(newRelay(1, 2, 0, nil), false))
+	assert.Equal(t, NewTokenChainNotAllowedError(ModuleName), validate(newRelay(1, 0, 0, []string{"0002"}), false))
+	// the token in scope goes on to the session checks, failing for the lack of nodes
+	for _, relay := range []Relay{newRelay(1, 3, 0, []string{ethereum}), newRelay(1, 0, 2, nil)} {
+		assert.Equal(t, NewInsufficientNodesError(ModuleName), validate(relay, false))
+	}
+
+	// the client is capped at the relays per session of its token
+	header := SessionHeader{ApplicationPubKey: appPrivateKey.PublicKey().RawString(), Chain: ethereum, SessionBlockHeight: 3}
+	defer func() { _ = DeleteEvidence(header, RelayEvidence) }()
+	SetProof(header, RelayEvidence, newRelay(1, 0, 2, nil).Proof, sdk.NewInt(1000))
+	assert.Equal(t, NewInsufficientNodesError(ModuleName), validate(newRelay(2, 0, 2, nil), false))
+	SetProof(header, RelayEvidence, newRelay(2, 0, 2, nil).Proof, sdk.NewInt(1000))
+	assert.Equal(t, NewTokenOverServiceError(ModuleName), validate(newRelay(3, 0, 2, nil), false))
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), evidence.NumOfClientProofs(clientPubKey))
+	assert.Zero(t, evidence.NumOfClientProofs(GetRandomPrivateKey().PublicKey().RawString()))
+	ClearSessionCache()
+}
+
 func TestRelay_Execute(t *testing.T) {
 	clientPrivateKey := GetRandomPrivateKey()
 	clientPubKey := clientPrivateKey.PublicKey().RawString()
@@ -295,7 +374,8 @@ type MockValidatorI interface {
 }
 
 type MockAppsKeeper struct {
-	Applications []exported2.ApplicationI
+	Applications   []exported2.ApplicationI
+	RevokedClients map[string]bool
 }
 
 func (m MockAppsKeeper) GetStakedTokens(ctx sdk.Ctx) sdk.BigInt {
@@ -323,6 +403,13 @@ func (m MockAppsKeeper) JailApplication(ctx sdk.Ctx, addr sdk.Address) {
 	panic("implement me")
 }
 
+func (m MockAppsKeeper) IsClientRevoked(ctx sdk.Ctx, appAddr sdk.Address, clientPubKey string) bool {
+	return m.RevokedClients[clientPubKey]
+}
+
+func (m MockAppsKeeper) AddRelayUsage(ctx sdk.Ctx, addr sdk.Address, sessionBlockHeight int64, relays int64) {
+}
+
 type MockPosKeeper struct {
 	Validators []exported.ValidatorI
 }
@@ -351,7 +438,7 @@ func (m MockPosKeeper) GetValidatorsByChain(ctx sdk.Ctx, networkID string) (vali
 	return
 }
 
-func (m MockPosKeeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address) sdk.BigInt {
+func (m MockPosKeeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address, chain string) sdk.BigInt {
 	panic("implement me")
 }
 